@@ -0,0 +1,92 @@
+package types
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+	"sigs.k8s.io/yaml"
+)
+
+// crdValidator returns a validator for the schema of the first version
+// of the CRD at path, as applied by the API server to new resources.
+func crdValidator(t *testing.T, path string) *validate.SchemaValidator {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	assert.NilError(t, err)
+	var crd apiextensionsv1.CustomResourceDefinition
+	assert.NilError(t, yaml.Unmarshal(data, &crd))
+	encoded, err := json.Marshal(crd.Spec.Versions[0].Schema.OpenAPIV3Schema)
+	assert.NilError(t, err)
+	var schema spec.Schema
+	assert.NilError(t, json.Unmarshal(encoded, &schema))
+	return validate.NewSchemaValidator(&schema, nil, "", strfmt.Default)
+}
+
+func TestConnectorCRDTargets(t *testing.T) {
+	tests := []struct {
+		name  string
+		spec  map[string]interface{}
+		valid bool
+	}{
+		{
+			name:  "selector",
+			spec:  map[string]interface{}{"selector": "app=backend"},
+			valid: true,
+		},
+		{
+			name:  "host",
+			spec:  map[string]interface{}{"host": "backend"},
+			valid: true,
+		},
+		{
+			name:  "hosts",
+			spec:  map[string]interface{}{"hosts": []interface{}{"backend-1", "backend-2:9090"}},
+			valid: true,
+		},
+		{
+			name:  "srvRecord",
+			spec:  map[string]interface{}{"srvRecord": "_http._tcp.backend.example.com"},
+			valid: true,
+		},
+		{
+			name: "no target",
+			spec: map[string]interface{}{},
+		},
+		{
+			name: "host and hosts",
+			spec: map[string]interface{}{"host": "backend", "hosts": []interface{}{"backend-1"}},
+		},
+	}
+	for _, path := range []string{"crds/skupper_connector_crd.yaml", "../../config/crd/bases/skupper_connector_crd.yaml"} {
+		validator := crdValidator(t, path)
+		for _, tt := range tests {
+			t.Run(path+"/"+tt.name, func(t *testing.T) {
+				spec := map[string]interface{}{
+					"routingKey": "backend",
+					"port":       int64(8080),
+				}
+				for key, value := range tt.spec {
+					spec[key] = value
+				}
+				connector := map[string]interface{}{
+					"apiVersion": "skupper.io/v2alpha1",
+					"kind":       "Connector",
+					"metadata":   map[string]interface{}{"name": "backend"},
+					"spec":       spec,
+				}
+				result := validator.Validate(connector)
+				if tt.valid {
+					assert.Assert(t, result.IsValid(), result.AsError())
+				} else {
+					assert.Assert(t, !result.IsValid())
+				}
+			})
+		}
+	}
+}
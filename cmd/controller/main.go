@@ -10,6 +10,7 @@ import (
 
 	internalclient "github.com/skupperproject/skupper/internal/kube/client"
 	"github.com/skupperproject/skupper/internal/kube/controller"
+	"github.com/skupperproject/skupper/internal/kube/metrics"
 	"github.com/skupperproject/skupper/internal/version"
 )
 
@@ -68,11 +69,19 @@ func main() {
 	}
 	config.Namespace = cli.Namespace
 
+	if config.MetricsAddress != "" {
+		metrics.RegisterWorkqueueMetrics()
+	}
+
 	controller, err := controller.NewController(cli, config)
 	if err != nil {
 		log.Fatal("Error getting new site controller ", err.Error())
 	}
 
+	if config.MetricsAddress != "" {
+		metrics.NewServer(config.MetricsAddress, controller.Healthy, controller.Ready).Start(stopCh)
+	}
+
 	if err = controller.Run(stopCh); err != nil {
 		log.Fatal("Error running site controller: ", err.Error())
 	}
@@ -22,14 +22,45 @@ type Config struct {
 
 	RouterURL     string
 	RouterTLS     TLSSpec
+	NetworksFile  string
 	FlowRecordTTL time.Duration
 
 	VanflowLoggingProfile string
 
+	Export ExportSpec
+
+	AlertRulesFile string
+
 	EnableProfile bool
 	CORSAllowAll  bool
 }
 
+// ExportSpec configures the external systems vanflow records are exported
+// to. Each configured sink receives every record change.
+type ExportSpec struct {
+	File string
+
+	KafkaBrokers string
+	KafkaTopic   string
+	// KafkaTLSEnabled connects to the brokers using TLS, which is also
+	// used when any of KafkaTLS is set.
+	KafkaTLSEnabled    bool
+	KafkaTLS           TLSSpec
+	KafkaSASLMechanism string
+	KafkaUsername      string
+	KafkaPasswordFile  string
+
+	NATSURL             string
+	NATSSubject         string
+	NATSTLS             TLSSpec
+	NATSCredentialsFile string
+	NATSUsername        string
+	NATSPasswordFile    string
+	NATSTokenFile       string
+
+	Backpressure string
+}
+
 type TLSSpec struct {
 	CA         string
 	Cert       string
@@ -41,6 +72,10 @@ func (t TLSSpec) hasCert() bool {
 	return len(t.Cert) > 0
 }
 
+func (t TLSSpec) isSet() bool {
+	return len(t.CA) > 0 || t.hasCert() || t.SkipVerify
+}
+
 func (t TLSSpec) config() (*tls.Config, error) {
 	config := tlscfg.Modern()
 
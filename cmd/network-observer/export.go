@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/skupperproject/skupper/pkg/vanflow/export"
+)
+
+// configureExporters returns an Exporter for each sink configured in spec.
+func configureExporters(spec ExportSpec, logger *slog.Logger) ([]*export.Exporter, error) {
+	options := export.Options{Logger: logger}
+	switch spec.Backpressure {
+	case "", "drop":
+		options.Backpressure = export.Drop
+	case "block":
+		options.Backpressure = export.Block
+	default:
+		return nil, fmt.Errorf("unknown export backpressure: %s", spec.Backpressure)
+	}
+
+	var sinks []export.Sink
+	closeAll := func() {
+		for _, sink := range sinks {
+			sink.Close()
+		}
+	}
+	if spec.File != "" {
+		sink, err := export.NewFileSink(spec.File, export.FileSinkOptions{})
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+		logger.Info("Exporting vanflow records to file", slog.String("path", spec.File))
+	}
+	if spec.KafkaBrokers != "" {
+		brokers := strings.Split(spec.KafkaBrokers, ",")
+		kafkaOptions, err := spec.kafkaOptions()
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		sink, err := export.NewKafkaSink(brokers, spec.KafkaTopic, kafkaOptions)
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+		logger.Info("Exporting vanflow records to kafka",
+			slog.Any("brokers", brokers),
+			slog.String("topic", spec.KafkaTopic))
+	}
+	if spec.NATSURL != "" {
+		natsOptions, err := spec.natsOptions()
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		sink, err := export.NewNATSSink(spec.NATSURL, spec.NATSSubject, natsOptions)
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+		logger.Info("Exporting vanflow records to nats",
+			slog.String("url", spec.NATSURL),
+			slog.String("subject", spec.NATSSubject))
+	}
+
+	exporters := make([]*export.Exporter, 0, len(sinks))
+	for _, sink := range sinks {
+		exporters = append(exporters, export.New(sink, options))
+	}
+	return exporters, nil
+}
+
+func (spec ExportSpec) kafkaOptions() (export.KafkaSinkOptions, error) {
+	options := export.KafkaSinkOptions{
+		SASLMechanism: spec.KafkaSASLMechanism,
+		Username:      spec.KafkaUsername,
+	}
+	if spec.KafkaTLSEnabled || spec.KafkaTLS.isSet() {
+		tlsConfig, err := spec.KafkaTLS.config()
+		if err != nil {
+			return options, fmt.Errorf("invalid kafka tls configuration: %w", err)
+		}
+		options.TLS = tlsConfig
+	}
+	password, err := readSecretFile(spec.KafkaPasswordFile)
+	if err != nil {
+		return options, fmt.Errorf("failed to read kafka password: %w", err)
+	}
+	options.Password = password
+	return options, nil
+}
+
+func (spec ExportSpec) natsOptions() (export.NATSSinkOptions, error) {
+	options := export.NATSSinkOptions{
+		CredentialsFile: spec.NATSCredentialsFile,
+		Username:        spec.NATSUsername,
+	}
+	if spec.NATSTLS.isSet() {
+		tlsConfig, err := spec.NATSTLS.config()
+		if err != nil {
+			return options, fmt.Errorf("invalid nats tls configuration: %w", err)
+		}
+		options.TLS = tlsConfig
+	}
+	password, err := readSecretFile(spec.NATSPasswordFile)
+	if err != nil {
+		return options, fmt.Errorf("failed to read nats password: %w", err)
+	}
+	options.Password = password
+	token, err := readSecretFile(spec.NATSTokenFile)
+	if err != nil {
+		return options, fmt.Errorf("failed to read nats token: %w", err)
+	}
+	options.Token = token
+	return options, nil
+}
+
+// readSecretFile returns the contents of the file at path without
+// surrounding whitespace, or an empty string if path is empty.
+func readSecretFile(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestConfigureExporters(t *testing.T) {
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	if err := os.WriteFile(passwordFile, []byte("secret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		Name          string
+		Spec          ExportSpec
+		ExpectedCount int
+		ExpectError   bool
+	}{
+		{
+			Name: "disabled",
+		}, {
+			Name:          "file",
+			Spec:          ExportSpec{File: filepath.Join(t.TempDir(), "records.jsonl"), Backpressure: "block"},
+			ExpectedCount: 1,
+		}, {
+			Name:          "file and kafka",
+			Spec:          ExportSpec{File: filepath.Join(t.TempDir(), "records.jsonl"), KafkaBrokers: "kafka-0:9092,kafka-1:9092", KafkaTopic: "vanflow"},
+			ExpectedCount: 2,
+		}, {
+			Name: "kafka with tls and sasl",
+			Spec: ExportSpec{
+				KafkaBrokers:       "kafka-0:9093",
+				KafkaTopic:         "vanflow",
+				KafkaTLSEnabled:    true,
+				KafkaSASLMechanism: "SCRAM-SHA-512",
+				KafkaUsername:      "observer",
+				KafkaPasswordFile:  passwordFile,
+			},
+			ExpectedCount: 1,
+		}, {
+			Name:        "kafka with unsupported sasl mechanism",
+			Spec:        ExportSpec{KafkaBrokers: "kafka-0:9093", KafkaTopic: "vanflow", KafkaSASLMechanism: "GSSAPI"},
+			ExpectError: true,
+		}, {
+			Name:        "kafka with missing password file",
+			Spec:        ExportSpec{KafkaBrokers: "kafka-0:9093", KafkaTopic: "vanflow", KafkaSASLMechanism: "PLAIN", KafkaPasswordFile: filepath.Join(t.TempDir(), "missing")},
+			ExpectError: true,
+		}, {
+			// the server is not connected to until records are published
+			Name:          "nats with credentials",
+			Spec:          ExportSpec{NATSURL: "nats://127.0.0.1:1", NATSUsername: "observer", NATSPasswordFile: passwordFile},
+			ExpectedCount: 1,
+		}, {
+			Name:        "nats with invalid ca",
+			Spec:        ExportSpec{NATSURL: "tls://127.0.0.1:1", NATSTLS: TLSSpec{CA: passwordFile}},
+			ExpectError: true,
+		}, {
+			Name:        "kafka without topic",
+			Spec:        ExportSpec{KafkaBrokers: "kafka-0:9092"},
+			ExpectError: true,
+		}, {
+			Name:        "unknown backpressure",
+			Spec:        ExportSpec{Backpressure: "wait"},
+			ExpectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			exporters, err := configureExporters(tc.Spec, slog.Default())
+			if tc.ExpectError {
+				if err == nil {
+					t.Error("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(exporters) != tc.ExpectedCount {
+				t.Errorf("expected %d exporters but got %d", tc.ExpectedCount, len(exporters))
+			}
+		})
+	}
+}
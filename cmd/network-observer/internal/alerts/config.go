@@ -0,0 +1,178 @@
+package alerts
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// Rule kinds evaluated by the Engine.
+const (
+	// KindLinkDown fires for a link whose status is down.
+	KindLinkDown = "linkDown"
+	// KindListenerWithoutConnector fires for a listener whose routing key
+	// has no connector.
+	KindListenerWithoutConnector = "listenerWithoutConnector"
+	// KindConnectorErrorRate fires for a connector when the share of its
+	// connections over the window that failed exceeds the threshold.
+	KindConnectorErrorRate = "connectorErrorRate"
+)
+
+// Receiver types notified by the Engine.
+const (
+	ReceiverWebhook = "webhook"
+	ReceiverSlack   = "slack"
+	ReceiverEmail   = "email"
+)
+
+const (
+	defaultInterval          = 15 * time.Second
+	defaultSeverity          = "warning"
+	defaultErrorRateWindow   = 5 * time.Minute
+	defaultErrorRateMinConns = 10
+)
+
+// Config is the alerting configuration read from a YAML file.
+type Config struct {
+	// Interval is the time between rule evaluations.
+	Interval  metav1.Duration `json:"interval"`
+	Rules     []Rule          `json:"rules"`
+	Receivers []Receiver      `json:"receivers"`
+}
+
+// Rule describes a condition and how long it must hold before its alerts
+// fire.
+type Rule struct {
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	Severity string `json:"severity"`
+	// For is how long the condition must hold before an alert fires. An
+	// alert is pending until then.
+	For metav1.Duration `json:"for"`
+	// RepeatInterval is the time after which receivers are notified again
+	// of an alert that is still firing. Receivers are only notified once
+	// when zero.
+	RepeatInterval metav1.Duration `json:"repeatInterval"`
+
+	// Threshold, Window and MinConnections parameterize the
+	// connectorErrorRate kind. Threshold is the share of failed
+	// connections, between 0 and 1, above which the rule fires.
+	Threshold      float64         `json:"threshold"`
+	Window         metav1.Duration `json:"window"`
+	MinConnections int             `json:"minConnections"`
+}
+
+// Receiver describes where notifications are sent.
+type Receiver struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// URL is the endpoint notifications are posted to for the webhook and
+	// slack types.
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+	// Rules limits the notifications to the alerts of the named rules.
+	// Alerts of all rules are notified when empty.
+	Rules []string `json:"rules"`
+	// SendResolved controls whether receivers are notified when alerts
+	// resolve. Defaults to true.
+	SendResolved *bool `json:"sendResolved"`
+
+	SMTP SMTP `json:"smtp"`
+}
+
+// SMTP configures the email receiver type.
+type SMTP struct {
+	// Address is the host:port of the mail server.
+	Address  string   `json:"address"`
+	From     string   `json:"from"`
+	To       []string `json:"to"`
+	Username string   `json:"username"`
+	Password string   `json:"password"`
+}
+
+// LoadConfig reads and validates an alerting configuration file.
+func LoadConfig(path string) (Config, error) {
+	var config Config
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return config, err
+	}
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return config, fmt.Errorf("invalid alerting configuration: %s", err)
+	}
+	if err := config.validate(); err != nil {
+		return config, fmt.Errorf("invalid alerting configuration: %s", err)
+	}
+	return config, nil
+}
+
+func (c *Config) validate() error {
+	if c.Interval.Duration <= 0 {
+		c.Interval.Duration = defaultInterval
+	}
+	rules := make(map[string]bool, len(c.Rules))
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.Name == "" {
+			return fmt.Errorf("rule %d has no name", i)
+		}
+		if rules[rule.Name] {
+			return fmt.Errorf("rule %q defined more than once", rule.Name)
+		}
+		rules[rule.Name] = true
+		if rule.Severity == "" {
+			rule.Severity = defaultSeverity
+		}
+		if rule.For.Duration < 0 || rule.RepeatInterval.Duration < 0 {
+			return fmt.Errorf("rule %q: durations must not be negative", rule.Name)
+		}
+		switch rule.Kind {
+		case KindLinkDown, KindListenerWithoutConnector:
+		case KindConnectorErrorRate:
+			if rule.Threshold <= 0 || rule.Threshold > 1 {
+				return fmt.Errorf("rule %q: threshold must be greater than 0 and at most 1", rule.Name)
+			}
+			if rule.Window.Duration <= 0 {
+				rule.Window.Duration = defaultErrorRateWindow
+			}
+			if rule.MinConnections <= 0 {
+				rule.MinConnections = defaultErrorRateMinConns
+			}
+		default:
+			return fmt.Errorf("rule %q: unknown kind %q", rule.Name, rule.Kind)
+		}
+	}
+	receivers := make(map[string]bool, len(c.Receivers))
+	for i := range c.Receivers {
+		receiver := &c.Receivers[i]
+		if receiver.Name == "" {
+			return fmt.Errorf("receiver %d has no name", i)
+		}
+		if receivers[receiver.Name] {
+			return fmt.Errorf("receiver %q defined more than once", receiver.Name)
+		}
+		receivers[receiver.Name] = true
+		for _, name := range receiver.Rules {
+			if !rules[name] {
+				return fmt.Errorf("receiver %q: unknown rule %q", receiver.Name, name)
+			}
+		}
+		switch receiver.Type {
+		case ReceiverWebhook, ReceiverSlack:
+			if u, err := url.Parse(receiver.URL); err != nil || u.Scheme == "" || u.Host == "" {
+				return fmt.Errorf("receiver %q: url is not valid: %q", receiver.Name, receiver.URL)
+			}
+		case ReceiverEmail:
+			if receiver.SMTP.Address == "" || receiver.SMTP.From == "" || len(receiver.SMTP.To) == 0 {
+				return fmt.Errorf("receiver %q: smtp address, from and to are required", receiver.Name)
+			}
+		default:
+			return fmt.Errorf("receiver %q: unknown type %q", receiver.Name, receiver.Type)
+		}
+	}
+	return nil
+}
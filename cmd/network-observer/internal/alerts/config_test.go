@@ -0,0 +1,93 @@
+package alerts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestLoadConfig(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Content     string
+		ExpectError string
+		Check       func(t *testing.T, config Config)
+	}{
+		{
+			Name: "defaults",
+			Content: `
+rules:
+- name: link-down
+  kind: linkDown
+  for: 2m
+- name: errors
+  kind: connectorErrorRate
+  threshold: 0.05
+receivers:
+- name: hook
+  type: webhook
+  url: https://hooks.example.com/alerts
+  rules: [link-down]
+- name: mail
+  type: email
+  sendResolved: false
+  smtp:
+    address: smtp.example.com:587
+    from: observer@example.com
+    to: [ops@example.com]
+`,
+			Check: func(t *testing.T, config Config) {
+				assert.Equal(t, config.Interval.Duration, defaultInterval)
+				assert.Equal(t, config.Rules[0].For.Duration, 2*time.Minute)
+				assert.Equal(t, config.Rules[0].Severity, "warning")
+				assert.Equal(t, config.Rules[1].Window.Duration, defaultErrorRateWindow)
+				assert.Equal(t, config.Rules[1].MinConnections, defaultErrorRateMinConns)
+				assert.Equal(t, *config.Receivers[1].SendResolved, false)
+			},
+		}, {
+			Name:        "unknown field",
+			Content:     "rules:\n- name: a\n  kind: linkDown\n  bogus: true\n",
+			ExpectError: `unknown field "bogus"`,
+		}, {
+			Name:        "unknown kind",
+			Content:     "rules:\n- name: a\n  kind: siteDown\n",
+			ExpectError: `rule "a": unknown kind "siteDown"`,
+		}, {
+			Name:        "duplicate rule",
+			Content:     "rules:\n- name: a\n  kind: linkDown\n- name: a\n  kind: linkDown\n",
+			ExpectError: `rule "a" defined more than once`,
+		}, {
+			Name:        "threshold",
+			Content:     "rules:\n- name: a\n  kind: connectorErrorRate\n  threshold: 5\n",
+			ExpectError: `rule "a": threshold must be greater than 0 and at most 1`,
+		}, {
+			Name:        "receiver unknown rule",
+			Content:     "receivers:\n- name: hook\n  type: webhook\n  url: http://localhost\n  rules: [a]\n",
+			ExpectError: `receiver "hook": unknown rule "a"`,
+		}, {
+			Name:        "receiver url",
+			Content:     "receivers:\n- name: hook\n  type: slack\n  url: hooks.example.com\n",
+			ExpectError: `receiver "hook": url is not valid: "hooks.example.com"`,
+		}, {
+			Name:        "receiver smtp",
+			Content:     "receivers:\n- name: mail\n  type: email\n  smtp:\n    address: localhost:25\n",
+			ExpectError: `receiver "mail": smtp address, from and to are required`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "alerts.yaml")
+			assert.NilError(t, os.WriteFile(path, []byte(tc.Content), 0644))
+			config, err := LoadConfig(path)
+			if tc.ExpectError != "" {
+				assert.ErrorContains(t, err, tc.ExpectError)
+				return
+			}
+			assert.NilError(t, err)
+			tc.Check(t, config)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package alerts
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+)
+
+// State is the stage of an alert's lifecycle.
+type State string
+
+const (
+	// StatePending alerts have a condition that holds but has not held for
+	// the rule's For duration yet.
+	StatePending State = "pending"
+	// StateFiring alerts have been notified to receivers.
+	StateFiring State = "firing"
+	// StateResolved alerts were firing and their condition no longer holds.
+	StateResolved State = "resolved"
+)
+
+// resolvedRetention is how long resolved alerts are kept for the API.
+const resolvedRetention = time.Hour
+
+// Alert is an instance of a rule's condition holding for one subject.
+type Alert struct {
+	// ID identifies the alert by its rule and subject so that the same
+	// condition is tracked, and notified, as a single alert.
+	ID       string `json:"id"`
+	Network  string `json:"network,omitempty"`
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	State    State  `json:"state"`
+
+	SubjectID   string `json:"subjectId"`
+	SubjectName string `json:"subjectName"`
+	Summary     string `json:"summary"`
+
+	// StartTime is when the condition was first seen to hold. FiringTime
+	// is zero until the alert fires and EndTime until it resolves.
+	StartTime  time.Time `json:"startTime"`
+	FiringTime time.Time `json:"firingTime,omitzero"`
+	EndTime    time.Time `json:"endTime,omitzero"`
+
+	lastNotified time.Time
+}
+
+// Provider lists the current alerts.
+type Provider interface {
+	Alerts() []Alert
+}
+
+// Engine evaluates alerting rules against the records and graph of a
+// collector and notifies receivers as alerts fire and resolve.
+type Engine struct {
+	logger    *slog.Logger
+	network   string
+	records   store.Interface
+	graph     collector.Graph
+	interval  time.Duration
+	rules     []Rule
+	receivers []receiver
+	now       func() time.Time
+
+	notifications chan notification
+
+	mu     sync.Mutex
+	alerts map[string]*Alert
+}
+
+// New returns an Engine for the rules and receivers in config. Network names
+// the network the records belong to and is included in the alerts when set.
+func New(logger *slog.Logger, network string, records store.Interface, graph collector.Graph, config Config) (*Engine, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	receivers := make([]receiver, 0, len(config.Receivers))
+	for _, spec := range config.Receivers {
+		receivers = append(receivers, newReceiver(spec))
+	}
+	return &Engine{
+		logger:        logger,
+		network:       network,
+		records:       records,
+		graph:         graph,
+		interval:      config.Interval.Duration,
+		rules:         config.Rules,
+		receivers:     receivers,
+		now:           time.Now,
+		notifications: make(chan notification, 64),
+		alerts:        make(map[string]*Alert),
+	}, nil
+}
+
+// Alerts returns the pending, firing and recently resolved alerts ordered
+// by the time their condition was first seen.
+func (e *Engine) Alerts() []Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	result := make([]Alert, 0, len(e.alerts))
+	for _, alert := range e.alerts {
+		result = append(result, *alert)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].StartTime.Equal(result[j].StartTime) {
+			return result[i].StartTime.Before(result[j].StartTime)
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
+
+// Run evaluates the rules every interval and delivers notifications until
+// ctx is cancelled.
+func (e *Engine) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case n := <-e.notifications:
+				e.deliver(ctx, n)
+			}
+		}
+	}()
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		for _, n := range e.evaluate(e.now()) {
+			select {
+			case e.notifications <- n:
+			default:
+				e.logger.Error("Dropping alert notification: queue full",
+					slog.String("receiver", n.receiver.name()),
+					slog.String("status", string(n.status)))
+			}
+		}
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// evaluate advances the state of the alerts of every rule and returns the
+// notifications due as a result.
+func (e *Engine) evaluate(now time.Time) []notification {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var firing, resolved []Alert
+	for _, rule := range e.rules {
+		active := make(map[string]bool)
+		for _, s := range rule.condition()(e.records, e.graph, now) {
+			id := fingerprint(rule.Name, s.ID)
+			active[id] = true
+			alert, ok := e.alerts[id]
+			if !ok || alert.State == StateResolved {
+				alert = &Alert{
+					ID:        id,
+					Network:   e.network,
+					Rule:      rule.Name,
+					Severity:  rule.Severity,
+					State:     StatePending,
+					SubjectID: s.ID,
+					StartTime: now,
+				}
+				e.alerts[id] = alert
+			}
+			alert.SubjectName = s.Name
+			alert.Summary = s.Summary
+			switch alert.State {
+			case StatePending:
+				if now.Sub(alert.StartTime) >= rule.For.Duration {
+					alert.State = StateFiring
+					alert.FiringTime = now
+					alert.lastNotified = now
+					firing = append(firing, *alert)
+				}
+			case StateFiring:
+				if rule.RepeatInterval.Duration > 0 && now.Sub(alert.lastNotified) >= rule.RepeatInterval.Duration {
+					alert.lastNotified = now
+					firing = append(firing, *alert)
+				}
+			}
+		}
+		for id, alert := range e.alerts {
+			if alert.Rule != rule.Name || active[id] {
+				continue
+			}
+			switch alert.State {
+			case StatePending:
+				delete(e.alerts, id)
+			case StateFiring:
+				alert.State = StateResolved
+				alert.EndTime = now
+				resolved = append(resolved, *alert)
+			case StateResolved:
+				if now.Sub(alert.EndTime) >= resolvedRetention {
+					delete(e.alerts, id)
+				}
+			}
+		}
+	}
+	var notifications []notification
+	for _, r := range e.receivers {
+		if alerts := r.filter(firing); len(alerts) > 0 {
+			notifications = append(notifications, notification{receiver: r, status: StateFiring, alerts: alerts})
+		}
+		if !r.sendResolved() {
+			continue
+		}
+		if alerts := r.filter(resolved); len(alerts) > 0 {
+			notifications = append(notifications, notification{receiver: r, status: StateResolved, alerts: alerts})
+		}
+	}
+	return notifications
+}
+
+// deliver sends a notification, retrying a few times before giving up.
+func (e *Engine) deliver(ctx context.Context, n notification) {
+	const attempts = 3
+	backoff := time.Second
+	for attempt := 1; ; attempt++ {
+		err := n.receiver.send(ctx, n.status, n.alerts)
+		if err == nil {
+			return
+		}
+		if attempt >= attempts || ctx.Err() != nil {
+			e.logger.Error("Failed to send alert notification",
+				slog.String("receiver", n.receiver.name()),
+				slog.String("status", string(n.status)),
+				slog.Int("alerts", len(n.alerts)),
+				slog.Any("error", err))
+			return
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func fingerprint(rule, subjectID string) string {
+	sum := sha256.Sum256([]byte(rule + "\x00" + subjectID))
+	return hex.EncodeToString(sum[:8])
+}
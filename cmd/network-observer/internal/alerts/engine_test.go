@@ -0,0 +1,261 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type reindexer interface {
+	Reindex(vanflow.Record)
+}
+
+func ptrTo[T any](c T) *T {
+	return &c
+}
+
+type testRecords struct {
+	stor  store.Interface
+	graph collector.Graph
+}
+
+func newTestRecords() testRecords {
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	return testRecords{stor: stor, graph: collector.NewGraph(stor)}
+}
+
+func (r testRecords) add(records ...vanflow.Record) {
+	for _, record := range records {
+		r.stor.Add(record, store.SourceRef{})
+		r.graph.(reindexer).Reindex(record)
+	}
+}
+
+func (r testRecords) update(record vanflow.Record) {
+	r.stor.Update(record)
+}
+
+func newTestEngine(t *testing.T, records testRecords, rules ...Rule) *Engine {
+	t.Helper()
+	engine, err := New(slog.Default(), "", records.stor, records.graph, Config{
+		Rules:     rules,
+		Receivers: []Receiver{{Name: "test", Type: ReceiverWebhook, URL: "http://localhost/"}},
+	})
+	assert.NilError(t, err)
+	return engine
+}
+
+func minutes(n int) metav1.Duration {
+	return metav1.Duration{Duration: time.Duration(n) * time.Minute}
+}
+
+func states(alerts []Alert) map[string]State {
+	result := make(map[string]State, len(alerts))
+	for _, alert := range alerts {
+		result[alert.SubjectID] = alert.State
+	}
+	return result
+}
+
+func TestLinkDown(t *testing.T) {
+	records := newTestRecords()
+	records.add(
+		vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-a"), Name: ptrTo("east")},
+		vanflow.RouterRecord{BaseRecord: vanflow.NewBase("router-a"), Name: ptrTo("east-router"), Parent: ptrTo("site-a")},
+		vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link-1"), Name: ptrTo("to-west"), Parent: ptrTo("router-a"), Status: ptrTo("down")},
+		vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link-2"), Name: ptrTo("to-north"), Parent: ptrTo("router-a"), Status: ptrTo("up")},
+	)
+	engine := newTestEngine(t, records, Rule{Name: "link-down", Kind: KindLinkDown, For: minutes(2), Severity: "critical"})
+	t0 := time.Now()
+
+	notifications := engine.evaluate(t0)
+	assert.Equal(t, len(notifications), 0)
+	assert.DeepEqual(t, states(engine.Alerts()), map[string]State{"link-1": StatePending})
+
+	notifications = engine.evaluate(t0.Add(time.Minute))
+	assert.Equal(t, len(notifications), 0)
+
+	notifications = engine.evaluate(t0.Add(2 * time.Minute))
+	assert.Equal(t, len(notifications), 1)
+	assert.Equal(t, notifications[0].status, StateFiring)
+	alert := notifications[0].alerts[0]
+	assert.Equal(t, alert.Rule, "link-down")
+	assert.Equal(t, alert.Severity, "critical")
+	assert.Equal(t, alert.SubjectName, "east/to-west")
+	assert.Equal(t, alert.Summary, "link east/to-west is down")
+	assert.Equal(t, alert.StartTime, t0)
+	assert.Equal(t, alert.FiringTime, t0.Add(2*time.Minute))
+
+	// firing alerts are deduplicated
+	notifications = engine.evaluate(t0.Add(10 * time.Minute))
+	assert.Equal(t, len(notifications), 0)
+	assert.Equal(t, len(engine.Alerts()), 1)
+
+	records.update(vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link-1"), Status: ptrTo("up")})
+	notifications = engine.evaluate(t0.Add(11 * time.Minute))
+	assert.Equal(t, len(notifications), 1)
+	assert.Equal(t, notifications[0].status, StateResolved)
+	assert.Equal(t, notifications[0].alerts[0].EndTime, t0.Add(11*time.Minute))
+	assert.DeepEqual(t, states(engine.Alerts()), map[string]State{"link-1": StateResolved})
+
+	// a recurring condition starts a new alert
+	records.update(vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link-1"), Status: ptrTo("down")})
+	engine.evaluate(t0.Add(12 * time.Minute))
+	assert.DeepEqual(t, states(engine.Alerts()), map[string]State{"link-1": StatePending})
+	assert.Equal(t, engine.Alerts()[0].StartTime, t0.Add(12*time.Minute))
+
+	// pending alerts are dropped without notification
+	records.update(vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link-1"), Status: ptrTo("up")})
+	notifications = engine.evaluate(t0.Add(13 * time.Minute))
+	assert.Equal(t, len(notifications), 0)
+	assert.Equal(t, len(engine.Alerts()), 0)
+}
+
+func TestRepeatAndRetention(t *testing.T) {
+	records := newTestRecords()
+	records.add(vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link-1"), Status: ptrTo("down")})
+	engine := newTestEngine(t, records, Rule{Name: "link-down", Kind: KindLinkDown, RepeatInterval: minutes(30)})
+	t0 := time.Now()
+
+	notifications := engine.evaluate(t0)
+	assert.Equal(t, len(notifications), 1)
+	assert.Equal(t, notifications[0].alerts[0].SubjectName, "link-1")
+	assert.Equal(t, len(engine.evaluate(t0.Add(29*time.Minute))), 0)
+	assert.Equal(t, len(engine.evaluate(t0.Add(30*time.Minute))), 1)
+	assert.Equal(t, len(engine.evaluate(t0.Add(31*time.Minute))), 0)
+
+	records.update(vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link-1"), Status: ptrTo("up")})
+	assert.Equal(t, len(engine.evaluate(t0.Add(40*time.Minute))), 1)
+	engine.evaluate(t0.Add(40*time.Minute + resolvedRetention - time.Second))
+	assert.Equal(t, len(engine.Alerts()), 1)
+	engine.evaluate(t0.Add(40*time.Minute + resolvedRetention))
+	assert.Equal(t, len(engine.Alerts()), 0)
+}
+
+func TestListenerWithoutConnector(t *testing.T) {
+	records := newTestRecords()
+	records.add(
+		vanflow.RouterRecord{BaseRecord: vanflow.NewBase("router-a")},
+		vanflow.ListenerRecord{BaseRecord: vanflow.NewBase("listener-1"), Name: ptrTo("db"), Parent: ptrTo("router-a"), Address: ptrTo("db"), Protocol: ptrTo("tcp")},
+		vanflow.ListenerRecord{BaseRecord: vanflow.NewBase("listener-2"), Name: ptrTo("web"), Parent: ptrTo("router-a"), Address: ptrTo("web"), Protocol: ptrTo("tcp")},
+		vanflow.ConnectorRecord{BaseRecord: vanflow.NewBase("connector-1"), Parent: ptrTo("router-a"), Address: ptrTo("web"), Protocol: ptrTo("tcp")},
+		// terminated connectors do not count
+		vanflow.ConnectorRecord{BaseRecord: vanflow.NewBase("connector-2", time.Now(), time.Now()), Parent: ptrTo("router-a"), Address: ptrTo("db"), Protocol: ptrTo("tcp")},
+	)
+	engine := newTestEngine(t, records, Rule{Name: "unmatched", Kind: KindListenerWithoutConnector})
+
+	notifications := engine.evaluate(time.Now())
+	assert.Equal(t, len(notifications), 1)
+	assert.Equal(t, len(notifications[0].alerts), 1)
+	assert.Equal(t, notifications[0].alerts[0].SubjectID, "listener-1")
+	assert.Equal(t, notifications[0].alerts[0].Summary, `listener db has no connector for routing key "db"`)
+}
+
+func TestConnectorErrorRate(t *testing.T) {
+	records := newTestRecords()
+	flows := store.NewSyncMapStore(store.SyncMapStoreConfig{})
+	t0 := time.Now()
+	addConnections := func(connector string, total, failed int, start time.Time) {
+		for i := 0; i < total; i++ {
+			id := fmt.Sprintf("%s-flow-%d-%d", connector, start.Unix(), i)
+			flow := vanflow.TransportBiflowRecord{BaseRecord: vanflow.NewBase(id, start)}
+			if i < failed {
+				flow.ErrorConnector = ptrTo("connection refused")
+			}
+			flows.Add(flow, store.SourceRef{})
+			records.stor.Add(collector.ConnectionRecord{
+				ID:        id,
+				Connector: collector.NamedReference{ID: connector, Name: connector + "-name"},
+				FlowStore: flows,
+			}, store.SourceRef{})
+		}
+	}
+	addConnections("connector-1", 20, 2, t0.Add(-time.Minute)) // 10% failed
+	addConnections("connector-2", 20, 1, t0.Add(-time.Minute)) // 5% failed
+	addConnections("connector-2", 20, 20, t0.Add(-time.Hour))  // outside the window
+	addConnections("connector-3", 4, 4, t0.Add(-time.Minute))  // too few connections
+	engine := newTestEngine(t, records, Rule{
+		Name:      "errors",
+		Kind:      KindConnectorErrorRate,
+		Threshold: 0.05,
+	})
+
+	notifications := engine.evaluate(t0)
+	assert.Equal(t, len(notifications), 1)
+	assert.Equal(t, len(notifications[0].alerts), 1)
+	alert := notifications[0].alerts[0]
+	assert.Equal(t, alert.SubjectID, "connector-1")
+	assert.Equal(t, alert.SubjectName, "connector-1-name")
+	assert.Equal(t, alert.Summary, "connector connector-1-name failed 2 of 20 connections (10.0%) in the last 5m0s")
+}
+
+func TestReceiverRouting(t *testing.T) {
+	records := newTestRecords()
+	records.add(
+		vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link-1"), Status: ptrTo("down")},
+		vanflow.ListenerRecord{BaseRecord: vanflow.NewBase("listener-1"), Address: ptrTo("db"), Protocol: ptrTo("tcp")},
+	)
+	engine, err := New(slog.Default(), "east", records.stor, records.graph, Config{
+		Rules: []Rule{
+			{Name: "link-down", Kind: KindLinkDown},
+			{Name: "unmatched", Kind: KindListenerWithoutConnector},
+		},
+		Receivers: []Receiver{
+			{Name: "all", Type: ReceiverWebhook, URL: "http://localhost/"},
+			{Name: "links", Type: ReceiverWebhook, URL: "http://localhost/", Rules: []string{"link-down"}, SendResolved: ptrTo(false)},
+		},
+	})
+	assert.NilError(t, err)
+	t0 := time.Now()
+
+	byReceiver := func(notifications []notification) map[string]int {
+		result := map[string]int{}
+		for _, n := range notifications {
+			result[n.receiver.name()+"/"+string(n.status)] += len(n.alerts)
+		}
+		return result
+	}
+	notifications := engine.evaluate(t0)
+	assert.DeepEqual(t, byReceiver(notifications), map[string]int{"all/firing": 2, "links/firing": 1})
+	assert.Equal(t, notifications[0].alerts[0].Network, "east")
+
+	records.update(vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link-1"), Status: ptrTo("up")})
+	notifications = engine.evaluate(t0.Add(time.Minute))
+	assert.DeepEqual(t, byReceiver(notifications), map[string]int{"all/resolved": 1})
+}
+
+func TestRunDeliversNotifications(t *testing.T) {
+	records := newTestRecords()
+	records.add(vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link-1"), Status: ptrTo("down")})
+	engine := newTestEngine(t, records, Rule{Name: "link-down", Kind: KindLinkDown})
+	delivered := make(chan []Alert, 1)
+	engine.receivers = []receiver{configuredReceiver{
+		spec: Receiver{Name: "test"},
+		sender: func(ctx context.Context, status State, alerts []Alert) error {
+			delivered <- alerts
+			return nil
+		},
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error)
+	go func() { done <- engine.Run(ctx) }()
+
+	select {
+	case alerts := <-delivered:
+		assert.Equal(t, alerts[0].SubjectID, "link-1")
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for notification")
+	}
+	cancel()
+	assert.NilError(t, <-done)
+}
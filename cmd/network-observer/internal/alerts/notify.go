@@ -0,0 +1,247 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// sendTimeout bounds the delivery of a notification to a receiver.
+const sendTimeout = 10 * time.Second
+
+// Notification is the payload posted to webhook receivers.
+type Notification struct {
+	Version string  `json:"version"`
+	Status  State   `json:"status"`
+	Alerts  []Alert `json:"alerts"`
+}
+
+type notification struct {
+	receiver receiver
+	status   State
+	alerts   []Alert
+}
+
+type receiver interface {
+	name() string
+	filter(alerts []Alert) []Alert
+	sendResolved() bool
+	send(ctx context.Context, status State, alerts []Alert) error
+}
+
+type sender func(ctx context.Context, status State, alerts []Alert) error
+
+type configuredReceiver struct {
+	spec Receiver
+	sender
+}
+
+func (r configuredReceiver) name() string { return r.spec.Name }
+
+func (r configuredReceiver) sendResolved() bool {
+	return r.spec.SendResolved == nil || *r.spec.SendResolved
+}
+
+func (r configuredReceiver) filter(alerts []Alert) []Alert {
+	if len(r.spec.Rules) == 0 {
+		return alerts
+	}
+	var result []Alert
+	for _, alert := range alerts {
+		if slices.Contains(r.spec.Rules, alert.Rule) {
+			result = append(result, alert)
+		}
+	}
+	return result
+}
+
+func (r configuredReceiver) send(ctx context.Context, status State, alerts []Alert) error {
+	return r.sender(ctx, status, alerts)
+}
+
+func newReceiver(spec Receiver) receiver {
+	client := &http.Client{Timeout: sendTimeout}
+	var send sender
+	switch spec.Type {
+	case ReceiverWebhook:
+		send = func(ctx context.Context, status State, alerts []Alert) error {
+			return postJSON(ctx, client, spec.URL, spec.Headers, Notification{
+				Version: "1",
+				Status:  status,
+				Alerts:  alerts,
+			})
+		}
+	case ReceiverSlack:
+		send = func(ctx context.Context, status State, alerts []Alert) error {
+			return postJSON(ctx, client, spec.URL, spec.Headers, slackMessage(status, alerts))
+		}
+	case ReceiverEmail:
+		send = func(ctx context.Context, status State, alerts []Alert) error {
+			return sendEmail(ctx, spec.SMTP, status, alerts)
+		}
+	}
+	return configuredReceiver{spec: spec, sender: send}
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected response status %q", resp.Status)
+	}
+	return nil
+}
+
+type slackPayload struct {
+	Text        string            `json:"text"`
+	Attachments []slackAttachment `json:"attachments"`
+}
+
+type slackAttachment struct {
+	Color  string `json:"color"`
+	Title  string `json:"title"`
+	Text   string `json:"text"`
+	Footer string `json:"footer,omitempty"`
+	TS     int64  `json:"ts"`
+}
+
+// slackMessage formats alerts as a Slack incoming webhook message.
+func slackMessage(status State, alerts []Alert) slackPayload {
+	color := "danger"
+	if status == StateResolved {
+		color = "good"
+	}
+	payload := slackPayload{Text: title(status, alerts)}
+	for _, alert := range alerts {
+		ts := alert.FiringTime
+		if status == StateResolved {
+			ts = alert.EndTime
+		}
+		payload.Attachments = append(payload.Attachments, slackAttachment{
+			Color:  color,
+			Title:  fmt.Sprintf("[%s] %s: %s", alert.Severity, alert.Rule, alert.SubjectName),
+			Text:   alert.Summary,
+			Footer: alert.Network,
+			TS:     ts.Unix(),
+		})
+	}
+	return payload
+}
+
+func title(status State, alerts []Alert) string {
+	rules := make([]string, 0, len(alerts))
+	for _, alert := range alerts {
+		if !slices.Contains(rules, alert.Rule) {
+			rules = append(rules, alert.Rule)
+		}
+	}
+	return fmt.Sprintf("[%s:%d] %s", strings.ToUpper(string(status)), len(alerts), strings.Join(rules, ", "))
+}
+
+// emailMessage formats alerts as a plain text email.
+func emailMessage(config SMTP, status State, alerts []Alert) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", config.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(config.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", title(status, alerts))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	for _, alert := range alerts {
+		fmt.Fprintf(&b, "[%s] %s: %s\r\n", alert.Severity, alert.Rule, alert.SubjectName)
+		fmt.Fprintf(&b, "  %s\r\n", alert.Summary)
+		if alert.Network != "" {
+			fmt.Fprintf(&b, "  network: %s\r\n", alert.Network)
+		}
+		fmt.Fprintf(&b, "  since: %s\r\n", alert.StartTime.Format(time.RFC3339))
+		if status == StateResolved {
+			fmt.Fprintf(&b, "  resolved: %s\r\n", alert.EndTime.Format(time.RFC3339))
+		}
+		b.WriteString("\r\n")
+	}
+	return b.Bytes()
+}
+
+// sendEmail delivers alerts in the same way as smtp.SendMail, upgrading
+// the connection with STARTTLS when the server offers it, but abandons
+// the attempt once ctx is done or sendTimeout has passed.
+func sendEmail(ctx context.Context, config SMTP, status State, alerts []Alert) error {
+	host, _, err := net.SplitHostPort(config.Address)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", config.Address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+	// unblock any exchange in progress when ctx is cancelled early
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+	if config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", config.Username, config.Password, host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(config.From); err != nil {
+		return err
+	}
+	for _, to := range config.To {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(emailMessage(config, status, alerts)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
@@ -0,0 +1,207 @@
+package alerts
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+var testAlerts = []Alert{{
+	ID:          "a1",
+	Network:     "east",
+	Rule:        "link-down",
+	Severity:    "critical",
+	State:       StateFiring,
+	SubjectID:   "link-1",
+	SubjectName: "east/to-west",
+	Summary:     "link east/to-west is down",
+	StartTime:   time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+	FiringTime:  time.Date(2024, 5, 1, 12, 2, 0, 0, time.UTC),
+}}
+
+type capturedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func newCapturingServer(t *testing.T, status int) (*httptest.Server, <-chan capturedRequest) {
+	t.Helper()
+	requests := make(chan capturedRequest, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- capturedRequest{header: r.Header, body: body}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, requests
+}
+
+func TestWebhookReceiver(t *testing.T) {
+	srv, requests := newCapturingServer(t, http.StatusOK)
+	r := newReceiver(Receiver{
+		Name:    "hook",
+		Type:    ReceiverWebhook,
+		URL:     srv.URL,
+		Headers: map[string]string{"Authorization": "Bearer token"},
+	})
+	assert.NilError(t, r.send(context.Background(), StateFiring, testAlerts))
+
+	req := <-requests
+	assert.Equal(t, req.header.Get("Content-Type"), "application/json")
+	assert.Equal(t, req.header.Get("Authorization"), "Bearer token")
+	var payload Notification
+	assert.NilError(t, json.Unmarshal(req.body, &payload))
+	assert.Equal(t, payload.Version, "1")
+	assert.Equal(t, payload.Status, StateFiring)
+	assert.Equal(t, len(payload.Alerts), 1)
+	assert.Equal(t, payload.Alerts[0].SubjectID, "link-1")
+	assert.Equal(t, payload.Alerts[0].StartTime, testAlerts[0].StartTime)
+	assert.Assert(t, !strings.Contains(string(req.body), "endTime"))
+}
+
+func TestWebhookReceiverError(t *testing.T) {
+	srv, _ := newCapturingServer(t, http.StatusServiceUnavailable)
+	r := newReceiver(Receiver{Name: "hook", Type: ReceiverWebhook, URL: srv.URL})
+	err := r.send(context.Background(), StateFiring, testAlerts)
+	assert.ErrorContains(t, err, "503")
+}
+
+func TestSlackReceiver(t *testing.T) {
+	srv, requests := newCapturingServer(t, http.StatusOK)
+	r := newReceiver(Receiver{Name: "slack", Type: ReceiverSlack, URL: srv.URL})
+	resolved := testAlerts[0]
+	resolved.State = StateResolved
+	resolved.EndTime = resolved.FiringTime.Add(time.Minute)
+	assert.NilError(t, r.send(context.Background(), StateResolved, []Alert{resolved}))
+
+	var payload slackPayload
+	assert.NilError(t, json.Unmarshal((<-requests).body, &payload))
+	assert.DeepEqual(t, payload, slackPayload{
+		Text: "[RESOLVED:1] link-down",
+		Attachments: []slackAttachment{{
+			Color:  "good",
+			Title:  "[critical] link-down: east/to-west",
+			Text:   "link east/to-west is down",
+			Footer: "east",
+			TS:     resolved.EndTime.Unix(),
+		}},
+	})
+}
+
+// smtpStandIn accepts a single message with the minimum of SMTP needed by
+// net/smtp and returns it on the channel.
+func smtpStandIn(t *testing.T) (string, <-chan string) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NilError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	messages := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		rd := bufio.NewReader(conn)
+		reply := func(line string) { fmt.Fprintf(conn, "%s\r\n", line) }
+		reply("220 localhost ESMTP")
+		var envelope []string
+		for {
+			line, err := rd.ReadString('\n')
+			if err != nil {
+				return
+			}
+			cmd := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "MAIL FROM"), strings.HasPrefix(cmd, "RCPT TO"):
+				envelope = append(envelope, strings.TrimSpace(line))
+				reply("250 OK")
+			case cmd == "DATA":
+				reply("354 go ahead")
+				var data strings.Builder
+				for {
+					line, err := rd.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if line == ".\r\n" {
+						break
+					}
+					data.WriteString(line)
+				}
+				messages <- strings.Join(envelope, "\n") + "\n" + data.String()
+				reply("250 OK")
+			case cmd == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+	return ln.Addr().String(), messages
+}
+
+func TestEmailReceiver(t *testing.T) {
+	addr, messages := smtpStandIn(t)
+	r := newReceiver(Receiver{
+		Name: "mail",
+		Type: ReceiverEmail,
+		SMTP: SMTP{
+			Address: addr,
+			From:    "observer@example.com",
+			To:      []string{"ops@example.com", "oncall@example.com"},
+		},
+	})
+	assert.NilError(t, r.send(context.Background(), StateFiring, testAlerts))
+
+	msg := <-messages
+	assert.Assert(t, strings.Contains(msg, "MAIL FROM:<observer@example.com>"), msg)
+	assert.Assert(t, strings.Contains(msg, "RCPT TO:<ops@example.com>"), msg)
+	assert.Assert(t, strings.Contains(msg, "RCPT TO:<oncall@example.com>"), msg)
+	assert.Assert(t, strings.Contains(msg, "Subject: [FIRING:1] link-down\r\n"), msg)
+	assert.Assert(t, strings.Contains(msg, "To: ops@example.com, oncall@example.com\r\n"), msg)
+	assert.Assert(t, strings.Contains(msg, "[critical] link-down: east/to-west\r\n  link east/to-west is down\r\n  network: east\r\n"), msg)
+}
+
+func TestEmailReceiverTimeout(t *testing.T) {
+	// a server that accepts connections but never greets the client
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NilError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { conn.Close() })
+		}
+	}()
+	r := newReceiver(Receiver{
+		Name: "mail",
+		Type: ReceiverEmail,
+		SMTP: SMTP{
+			Address: ln.Addr().String(),
+			From:    "observer@example.com",
+			To:      []string{"ops@example.com"},
+		},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	assert.Assert(t, r.send(ctx, StateFiring, testAlerts) != nil)
+	assert.Assert(t, time.Since(start) < 5*time.Second, "send not abandoned when its context ended")
+}
@@ -0,0 +1,140 @@
+package alerts
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+)
+
+// subject is something a rule's condition holds for.
+type subject struct {
+	ID      string
+	Name    string
+	Summary string
+}
+
+// condition returns the subjects a rule's condition currently holds for.
+type condition func(records store.Interface, graph collector.Graph, now time.Time) []subject
+
+func (r Rule) condition() condition {
+	switch r.Kind {
+	case KindLinkDown:
+		return linksDown
+	case KindListenerWithoutConnector:
+		return listenersWithoutConnector
+	case KindConnectorErrorRate:
+		return connectorErrorRate(r.Threshold, r.Window.Duration, r.MinConnections)
+	}
+	return func(store.Interface, collector.Graph, time.Time) []subject { return nil }
+}
+
+func linksDown(records store.Interface, graph collector.Graph, now time.Time) []subject {
+	var subjects []subject
+	for _, entry := range records.Index(store.TypeIndex, store.Entry{Record: vanflow.LinkRecord{}}) {
+		link, ok := entry.Record.(vanflow.LinkRecord)
+		if !ok || link.EndTime != nil || link.Status == nil || *link.Status != "down" {
+			continue
+		}
+		name := deref(link.Name, link.ID)
+		if site, ok := graph.Link(link.ID).Parent().Parent().GetRecord(); ok && site.Name != nil {
+			name = *site.Name + "/" + name
+		}
+		subjects = append(subjects, subject{
+			ID:      link.ID,
+			Name:    name,
+			Summary: fmt.Sprintf("link %s is down", name),
+		})
+	}
+	return subjects
+}
+
+func listenersWithoutConnector(records store.Interface, graph collector.Graph, now time.Time) []subject {
+	var subjects []subject
+	for _, entry := range records.Index(store.TypeIndex, store.Entry{Record: vanflow.ListenerRecord{}}) {
+		listener, ok := entry.Record.(vanflow.ListenerRecord)
+		if !ok || listener.EndTime != nil || listener.Address == nil {
+			continue
+		}
+		if slices.ContainsFunc(graph.Listener(listener.ID).RoutingKey().Connectors(), isActive) {
+			continue
+		}
+		name := deref(listener.Name, listener.ID)
+		subjects = append(subjects, subject{
+			ID:      listener.ID,
+			Name:    name,
+			Summary: fmt.Sprintf("listener %s has no connector for routing key %q", name, *listener.Address),
+		})
+	}
+	return subjects
+}
+
+func connectorErrorRate(threshold float64, window time.Duration, minConnections int) condition {
+	type counts struct {
+		name   string
+		total  int
+		failed int
+	}
+	return func(records store.Interface, graph collector.Graph, now time.Time) []subject {
+		since := now.Add(-window)
+		byConnector := make(map[string]*counts)
+		var order []string
+		for _, entry := range records.Index(store.TypeIndex, store.Entry{Record: collector.ConnectionRecord{}}) {
+			conn, ok := entry.Record.(collector.ConnectionRecord)
+			if !ok || conn.Connector.ID == "" || conn.FlowStore == nil {
+				continue
+			}
+			flow, ok := conn.GetFlow()
+			if !ok || flow.StartTime == nil || flow.StartTime.Before(since) {
+				continue
+			}
+			c, ok := byConnector[conn.Connector.ID]
+			if !ok {
+				c = &counts{name: conn.Connector.Name}
+				byConnector[conn.Connector.ID] = c
+				order = append(order, conn.Connector.ID)
+			}
+			c.total++
+			if flow.ErrorConnector != nil && *flow.ErrorConnector != "" {
+				c.failed++
+			}
+		}
+		var subjects []subject
+		for _, id := range order {
+			c := byConnector[id]
+			if c.total < minConnections {
+				continue
+			}
+			rate := float64(c.failed) / float64(c.total)
+			if rate <= threshold {
+				continue
+			}
+			name := c.name
+			if name == "" {
+				name = id
+			}
+			subjects = append(subjects, subject{
+				ID:   id,
+				Name: name,
+				Summary: fmt.Sprintf("connector %s failed %d of %d connections (%.1f%%) in the last %s",
+					name, c.failed, c.total, rate*100, window),
+			})
+		}
+		return subjects
+	}
+}
+
+func isActive(c collector.Connector) bool {
+	record, ok := c.GetRecord()
+	return ok && record.EndTime == nil
+}
+
+func deref(s *string, fallback string) string {
+	if s == nil || *s == "" {
+		return fallback
+	}
+	return *s
+}
@@ -4,6 +4,21 @@ package api
 // Implements ResponseSetter and CollectionResponseSetter for the generated
 // response objects
 
+// SetCount
+func (r *AlertListResponse) SetCount(v int64) {
+	r.Count = v
+}
+
+// SetResults
+func (r *AlertListResponse) SetResults(v []AlertRecord) {
+	r.Results = v
+}
+
+// SetTimeRangeCount
+func (r *AlertListResponse) SetTimeRangeCount(v int64) {
+	r.TimeRangeCount = v
+}
+
 // SetCount
 func (r *ApplicationFlowResponse) SetCount(v int64) {
 	r.Count = v
@@ -94,6 +109,21 @@ func (r *FlowAggregateResponse) SetResults(v FlowAggregateRecord) {
 	r.Results = v
 }
 
+// SetCount
+func (r *HistoryListResponse) SetCount(v int64) {
+	r.Count = v
+}
+
+// SetResults
+func (r *HistoryListResponse) SetResults(v []HistoryRecord) {
+	r.Results = v
+}
+
+// SetTimeRangeCount
+func (r *HistoryListResponse) SetTimeRangeCount(v int64) {
+	r.TimeRangeCount = v
+}
+
 // SetCount
 func (r *ListenerListResponse) SetCount(v int64) {
 	r.Count = v
@@ -114,6 +144,36 @@ func (r *ListenerResponse) SetResults(v ListenerRecord) {
 	r.Results = v
 }
 
+// SetCount
+func (r *LogListResponse) SetCount(v int64) {
+	r.Count = v
+}
+
+// SetResults
+func (r *LogListResponse) SetResults(v []LogRecord) {
+	r.Results = v
+}
+
+// SetTimeRangeCount
+func (r *LogListResponse) SetTimeRangeCount(v int64) {
+	r.TimeRangeCount = v
+}
+
+// SetCount
+func (r *NetworkListResponse) SetCount(v int64) {
+	r.Count = v
+}
+
+// SetResults
+func (r *NetworkListResponse) SetResults(v []NetworkRecord) {
+	r.Results = v
+}
+
+// SetTimeRangeCount
+func (r *NetworkListResponse) SetTimeRangeCount(v int64) {
+	r.TimeRangeCount = v
+}
+
 // SetCount
 func (r *ProcessListResponse) SetCount(v int64) {
 	r.Count = v
@@ -246,6 +306,16 @@ func (r *CollectionResponse) SetTimeRangeCount(v int64) {
 
 // Implements Record interface for the generated record objects
 
+// GetEndTime
+func (r AlertRecord) GetEndTime() uint64 {
+	return r.EndTime
+}
+
+// GetStartTime
+func (r AlertRecord) GetStartTime() uint64 {
+	return r.StartTime
+}
+
 // GetEndTime
 func (r ApplicationFlowRecord) GetEndTime() uint64 {
 	return r.EndTime
@@ -296,6 +366,16 @@ func (r FlowAggregateRecord) GetStartTime() uint64 {
 	return r.StartTime
 }
 
+// GetEndTime
+func (r HistoryRecord) GetEndTime() uint64 {
+	return r.EndTime
+}
+
+// GetStartTime
+func (r HistoryRecord) GetStartTime() uint64 {
+	return r.StartTime
+}
+
 // GetEndTime
 func (r ListenerRecord) GetEndTime() uint64 {
 	return r.EndTime
@@ -306,6 +386,16 @@ func (r ListenerRecord) GetStartTime() uint64 {
 	return r.StartTime
 }
 
+// GetEndTime
+func (r LogRecord) GetEndTime() uint64 {
+	return r.EndTime
+}
+
+// GetStartTime
+func (r LogRecord) GetStartTime() uint64 {
+	return r.StartTime
+}
+
 // GetEndTime
 func (r ProcessRecord) GetEndTime() uint64 {
 	return r.EndTime
@@ -23,6 +23,13 @@ const (
 	Remote   ProcessRecordRole = "remote"
 )
 
+// Defines values for AlertStateType.
+const (
+	AlertStateFiring   AlertStateType = "firing"
+	AlertStatePending  AlertStateType = "pending"
+	AlertStateResolved AlertStateType = "resolved"
+)
+
 // Defines values for FlowAggregatePairType.
 const (
 	PROCESS      FlowAggregatePairType = "PROCESS"
@@ -30,6 +37,13 @@ const (
 	SITE         FlowAggregatePairType = "SITE"
 )
 
+// Defines values for HistoryResolutionType.
+const (
+	HistoryResolution10m HistoryResolutionType = "10m"
+	HistoryResolution1h  HistoryResolutionType = "1h"
+	HistoryResolution1m  HistoryResolutionType = "1m"
+)
+
 // Defines values for LinkRoleType.
 const (
 	LinkRoleTypeEdge        LinkRoleType = "edge"
@@ -37,6 +51,18 @@ const (
 	LinkRoleTypeUnknown     LinkRoleType = "unknown"
 )
 
+// Defines values for LogSeverityType.
+const (
+	LogSeverityCritical LogSeverityType = "critical"
+	LogSeverityDebug    LogSeverityType = "debug"
+	LogSeverityError    LogSeverityType = "error"
+	LogSeverityInfo     LogSeverityType = "info"
+	LogSeverityNotice   LogSeverityType = "notice"
+	LogSeverityTrace    LogSeverityType = "trace"
+	LogSeverityUnknown  LogSeverityType = "unknown"
+	LogSeverityWarning  LogSeverityType = "warning"
+)
+
 // Defines values for OperStatusType.
 const (
 	Down OperStatusType = "down"
@@ -58,6 +84,44 @@ const (
 	SitePlatformTypeUnknown    SitePlatformType = "unknown"
 )
 
+// AlertListResponse defines model for AlertListResponse.
+type AlertListResponse struct {
+	// Count number of results in response
+	Count   int64         `json:"count"`
+	Results []AlertRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// AlertRecord defines model for AlertRecord.
+type AlertRecord struct {
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// FiringTime The time the alert fired, in microseconds since the epoch
+	FiringTime *uint64 `json:"firingTime,omitempty"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network *string `json:"network,omitempty"`
+
+	// Rule The name of the rule that raised the alert
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64         `json:"startTime"`
+	State     AlertStateType `json:"state"`
+
+	// SubjectId The identity of the record the alert is about
+	SubjectId   string `json:"subjectId"`
+	SubjectName string `json:"subjectName"`
+	Summary     string `json:"summary"`
+}
+
 // ApplicationFlowRecord defines model for ApplicationFlowRecord.
 type ApplicationFlowRecord struct {
 	ConnectionId    string  `json:"connectionId"`
@@ -71,16 +135,19 @@ type ApplicationFlowRecord struct {
 	EndTime uint64 `json:"endTime"`
 
 	// Identity The unique identifier for the record.
-	Identity          string `json:"identity"`
-	Method            string `json:"method"`
-	OctetCount        uint64 `json:"octetCount"`
-	OctetReverseCount uint64 `json:"octetReverseCount"`
-	Protocol          string `json:"protocol"`
-	RoutingKey        string `json:"routingKey"`
-	SourceProcessId   string `json:"sourceProcessId"`
-	SourceProcessName string `json:"sourceProcessName"`
-	SourceSiteId      string `json:"sourceSiteId"`
-	SourceSiteName    string `json:"sourceSiteName"`
+	Identity string `json:"identity"`
+	Method   string `json:"method"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network           *string `json:"network,omitempty"`
+	OctetCount        uint64  `json:"octetCount"`
+	OctetReverseCount uint64  `json:"octetReverseCount"`
+	Protocol          string  `json:"protocol"`
+	RoutingKey        string  `json:"routingKey"`
+	SourceProcessId   string  `json:"sourceProcessId"`
+	SourceProcessName string  `json:"sourceProcessName"`
+	SourceSiteId      string  `json:"sourceSiteId"`
+	SourceSiteName    string  `json:"sourceSiteName"`
 
 	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
 	StartTime uint64 `json:"startTime"`
@@ -119,10 +186,13 @@ type ComponentRecord struct {
 	EndTime uint64 `json:"endTime"`
 
 	// Identity The unique identifier for the record.
-	Identity     string `json:"identity"`
-	Name         string `json:"name"`
-	ProcessCount int    `json:"processCount"`
-	Role         string `json:"role"`
+	Identity string `json:"identity"`
+	Name     string `json:"name"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network      *string `json:"network,omitempty"`
+	ProcessCount int     `json:"processCount"`
+	Role         string  `json:"role"`
 
 	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
 	StartTime uint64 `json:"startTime"`
@@ -160,11 +230,14 @@ type ConnectionRecord struct {
 	EndTime uint64 `json:"endTime"`
 
 	// Identity The unique identifier for the record.
-	Identity          string  `json:"identity"`
-	Latency           uint64  `json:"latency"`
-	LatencyReverse    uint64  `json:"latencyReverse"`
-	ListenerError     *string `json:"listenerError"`
-	ListenerId        string  `json:"listenerId"`
+	Identity       string  `json:"identity"`
+	Latency        uint64  `json:"latency"`
+	LatencyReverse uint64  `json:"latencyReverse"`
+	ListenerError  *string `json:"listenerError"`
+	ListenerId     string  `json:"listenerId"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network           *string `json:"network,omitempty"`
 	OctetCount        uint64  `json:"octetCount"`
 	OctetReverseCount uint64  `json:"octetReverseCount"`
 	ProcessPairId     *string `json:"processPairId"`
@@ -209,8 +282,11 @@ type ConnectorRecord struct {
 	EndTime uint64 `json:"endTime"`
 
 	// Identity The unique identifier for the record.
-	Identity   string  `json:"identity"`
-	Name       string  `json:"name"`
+	Identity string `json:"identity"`
+	Name     string `json:"name"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network    *string `json:"network,omitempty"`
 	ProcessId  string  `json:"processId"`
 	Protocol   string  `json:"protocol"`
 	RouterId   string  `json:"routerId"`
@@ -256,7 +332,10 @@ type FlowAggregateRecord struct {
 	EndTime uint64 `json:"endTime"`
 
 	// Identity The unique identifier for the record.
-	Identity       string                `json:"identity"`
+	Identity string `json:"identity"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network        *string               `json:"network,omitempty"`
 	PairType       FlowAggregatePairType `json:"pairType"`
 	Protocol       string                `json:"protocol"`
 	RecordCount    uint64                `json:"recordCount"`
@@ -274,6 +353,46 @@ type FlowAggregateResponse struct {
 	Results FlowAggregateRecord `json:"results"`
 }
 
+// HistoryListResponse defines model for HistoryListResponse.
+type HistoryListResponse struct {
+	// Count number of results in response
+	Count   int64           `json:"count"`
+	Results []HistoryRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// HistoryRecord defines model for HistoryRecord.
+type HistoryRecord struct {
+	// ClosedCount Number of connections closed
+	ClosedCount uint64 `json:"closedCount"`
+
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network *string `json:"network,omitempty"`
+
+	// OctetCount Bytes sent from source to destination
+	OctetCount uint64 `json:"octetCount"`
+
+	// OctetReverseCount Bytes sent from destination to source
+	OctetReverseCount uint64 `json:"octetReverseCount"`
+
+	// OpenedCount Number of connections opened
+	OpenedCount uint64 `json:"openedCount"`
+
+	// Resolution The width of a traffic history bucket.
+	Resolution HistoryResolutionType `json:"resolution"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+}
+
 // ListenerListResponse defines model for ListenerListResponse.
 type ListenerListResponse struct {
 	// Count number of results in response
@@ -293,8 +412,11 @@ type ListenerRecord struct {
 	EndTime uint64 `json:"endTime"`
 
 	// Identity The unique identifier for the record.
-	Identity   string  `json:"identity"`
-	Name       string  `json:"name"`
+	Identity string `json:"identity"`
+	Name     string `json:"name"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network    *string `json:"network,omitempty"`
 	Protocol   string  `json:"protocol"`
 	RouterId   string  `json:"routerId"`
 	RoutingKey string  `json:"routingKey"`
@@ -311,6 +433,63 @@ type ListenerResponse struct {
 	Results ListenerRecord `json:"results"`
 }
 
+// LogListResponse defines model for LogListResponse.
+type LogListResponse struct {
+	// Count number of results in response
+	Count   int64       `json:"count"`
+	Results []LogRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// LogRecord defines model for LogRecord.
+type LogRecord struct {
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+
+	// Module The router module that logged the message, when known
+	Module string `json:"module"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network    *string `json:"network,omitempty"`
+	RouterId   string  `json:"routerId"`
+	RouterName string  `json:"routerName"`
+
+	// Severity The severity of a router log message.
+	Severity   LogSeverityType `json:"severity"`
+	SiteId     string          `json:"siteId"`
+	SiteName   string          `json:"siteName"`
+	SourceFile *string         `json:"sourceFile,omitempty"`
+	SourceLine *uint64         `json:"sourceLine,omitempty"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+	Text      string `json:"text"`
+}
+
+// NetworkListResponse defines model for NetworkListResponse.
+type NetworkListResponse struct {
+	// Count number of results in response
+	Count   int64           `json:"count"`
+	Results []NetworkRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// NetworkRecord defines model for NetworkRecord.
+type NetworkRecord struct {
+	// Name The name identifying the network in record identities and the network query parameter.
+	Name string `json:"name"`
+
+	// RouterEndpoint The router endpoint the observer collects records from for the network.
+	RouterEndpoint string `json:"routerEndpoint"`
+}
+
 // ProcessListResponse defines model for ProcessListResponse.
 type ProcessListResponse struct {
 	// Count number of results in response
@@ -341,6 +520,9 @@ type ProcessRecord struct {
 	ImageName *string `json:"imageName"`
 	Name      string  `json:"name"`
 
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network *string `json:"network,omitempty"`
+
 	// Role Internal processes are processes related to Skupper. Remote processes are processes indirectly connected, such as a proxy
 	Role     ProcessRecordRole        `json:"role"`
 	Services *[]ServiceIdentifierType `json:"services"`
@@ -383,8 +565,11 @@ type RouterAccessRecord struct {
 	Identity  string `json:"identity"`
 	LinkCount uint64 `json:"linkCount"`
 	Name      string `json:"name"`
-	Role      string `json:"role"`
-	RouterId  string `json:"routerId"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network  *string `json:"network,omitempty"`
+	Role     string  `json:"role"`
+	RouterId string  `json:"routerId"`
 
 	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
 	StartTime uint64 `json:"startTime"`
@@ -426,10 +611,13 @@ type RouterLinkRecord struct {
 	EndTime uint64 `json:"endTime"`
 
 	// Identity The unique identifier for the record.
-	Identity          string `json:"identity"`
-	Name              string `json:"name"`
-	OctetCount        uint64 `json:"octetCount"`
-	OctetReverseCount uint64 `json:"octetReverseCount"`
+	Identity string `json:"identity"`
+	Name     string `json:"name"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network           *string `json:"network,omitempty"`
+	OctetCount        uint64  `json:"octetCount"`
+	OctetReverseCount uint64  `json:"octetReverseCount"`
 
 	// Role The class of skupper link
 	Role LinkRoleType `json:"role"`
@@ -476,7 +664,10 @@ type RouterRecord struct {
 	Mode         string  `json:"mode"`
 	Name         string  `json:"name"`
 	Namespace    *string `json:"namespace,omitempty"`
-	SiteId       string  `json:"siteId"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network *string `json:"network,omitempty"`
+	SiteId  string  `json:"siteId"`
 
 	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
 	StartTime uint64 `json:"startTime"`
@@ -515,6 +706,9 @@ type ServiceRecord struct {
 	ListenerCount int    `json:"listenerCount"`
 	Name          string `json:"name"`
 
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network *string `json:"network,omitempty"`
+
 	// ObservedApplicationProtocols Array of the observed application level protocols
 	ObservedApplicationProtocols []string `json:"observedApplicationProtocols"`
 	Protocol                     string   `json:"protocol"`
@@ -548,6 +742,9 @@ type SiteRecord struct {
 	Name      string  `json:"name"`
 	Namespace *string `json:"namespace"`
 
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network *string `json:"network,omitempty"`
+
 	// Platform The platform used for the site.
 	Platform SitePlatformType `json:"platform"`
 
@@ -567,6 +764,9 @@ type SiteResponse struct {
 	Results SiteRecord `json:"results"`
 }
 
+// AlertStateType defines model for alertStateType.
+type AlertStateType string
+
 // BaseRecord defines model for baseRecord.
 type BaseRecord struct {
 	// EndTime The end time in microseconds of the record in Unix timestamp format.
@@ -575,6 +775,9 @@ type BaseRecord struct {
 	// Identity The unique identifier for the record.
 	Identity string `json:"identity"`
 
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network *string `json:"network,omitempty"`
+
 	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
 	StartTime uint64 `json:"startTime"`
 }
@@ -591,9 +794,15 @@ type CollectionResponse struct {
 // FlowAggregatePairType defines model for flowAggregatePairType.
 type FlowAggregatePairType string
 
+// HistoryResolutionType The width of a traffic history bucket.
+type HistoryResolutionType string
+
 // LinkRoleType The class of skupper link
 type LinkRoleType string
 
+// LogSeverityType The severity of a router log message.
+type LogSeverityType string
+
 // OperStatusType defines model for operStatusType.
 type OperStatusType string
 
@@ -606,6 +815,9 @@ type ServiceIdentifierType = AtmarkDelimitedString
 // SitePlatformType The platform used for the site.
 type SitePlatformType string
 
+// Filter defines model for filter.
+type Filter = string
+
 // PathID defines model for pathID.
 type PathID = string
 
@@ -615,6 +827,9 @@ type ErrorBadRequest = ErrorResponse
 // ErrorNotFound defines model for errorNotFound.
 type ErrorNotFound = ErrorResponse
 
+// GetAlerts defines model for getAlerts.
+type GetAlerts = AlertListResponse
+
 // GetApplicationFlows defines model for getApplicationFlows.
 type GetApplicationFlows = ApplicationFlowResponse
 
@@ -639,12 +854,21 @@ type GetFlowAggregateByID = FlowAggregateResponse
 // GetFlowAggregates defines model for getFlowAggregates.
 type GetFlowAggregates = FlowAggregateListResponse
 
+// GetHistory defines model for getHistory.
+type GetHistory = HistoryListResponse
+
 // GetListenerByID defines model for getListenerByID.
 type GetListenerByID = ListenerResponse
 
 // GetListeners defines model for getListeners.
 type GetListeners = ListenerListResponse
 
+// GetLogs defines model for getLogs.
+type GetLogs = LogListResponse
+
+// GetNetworks defines model for getNetworks.
+type GetNetworks = NetworkListResponse
+
 // GetProcessByID defines model for getProcessByID.
 type GetProcessByID = ProcessResponse
 
@@ -684,6 +908,156 @@ type GetSites = SiteListResponse
 // NotSupported defines model for notSupported.
 type NotSupported = ErrorResponse
 
+// AlertsParams defines parameters for Alerts.
+type AlertsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ApplicationflowsParams defines parameters for Applicationflows.
+type ApplicationflowsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ExportApplicationflowsParams defines parameters for ExportApplicationflows.
+type ExportApplicationflowsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ComponentpairsParams defines parameters for Componentpairs.
+type ComponentpairsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ComponentsParams defines parameters for Components.
+type ComponentsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ConnectionsParams defines parameters for Connections.
+type ConnectionsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ExportConnectionsParams defines parameters for ExportConnections.
+type ExportConnectionsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ConnectorsParams defines parameters for Connectors.
+type ConnectorsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ListenersParams defines parameters for Listeners.
+type ListenersParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// LogsParams defines parameters for Logs.
+type LogsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ProcessesParams defines parameters for Processes.
+type ProcessesParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ProcesspairsParams defines parameters for Processpairs.
+type ProcesspairsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// RouteraccessParams defines parameters for Routeraccess.
+type RouteraccessParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// RouterlinksParams defines parameters for Routerlinks.
+type RouterlinksParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// RoutersParams defines parameters for Routers.
+type RoutersParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// LogsByRouterParams defines parameters for LogsByRouter.
+type LogsByRouterParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ServicesParams defines parameters for Services.
+type ServicesParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ConnectionsByServiceParams defines parameters for ConnectionsByService.
+type ConnectionsByServiceParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ProcessesByServiceParams defines parameters for ProcessesByService.
+type ProcessesByServiceParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ProcessPairsByServiceParams defines parameters for ProcessPairsByService.
+type ProcessPairsByServiceParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// SitepairsParams defines parameters for Sitepairs.
+type SitepairsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// SitesParams defines parameters for Sites.
+type SitesParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// LogsBySiteParams defines parameters for LogsBySite.
+type LogsBySiteParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ProcessesBySiteParams defines parameters for ProcessesBySite.
+type ProcessesBySiteParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// RoutersBySiteParams defines parameters for RoutersBySite.
+type RoutersBySiteParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -757,26 +1131,35 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// Alerts request
+	Alerts(ctx context.Context, params *AlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Applicationflows request
-	Applicationflows(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Applicationflows(ctx context.Context, params *ApplicationflowsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportApplicationflows request
+	ExportApplicationflows(ctx context.Context, params *ExportApplicationflowsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Componentpairs request
-	Componentpairs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Componentpairs(ctx context.Context, params *ComponentpairsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ComponentpairByID request
 	ComponentpairByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Components request
-	Components(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Components(ctx context.Context, params *ComponentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ComponentByID request
 	ComponentByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Connections request
-	Connections(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Connections(ctx context.Context, params *ConnectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportConnections request
+	ExportConnections(ctx context.Context, params *ExportConnectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Connectors request
-	Connectors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Connectors(ctx context.Context, params *ConnectorsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ConnectorByID request
 	ConnectorByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -788,64 +1171,82 @@ type ClientInterface interface {
 	HostsByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Listeners request
-	Listeners(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Listeners(ctx context.Context, params *ListenersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListenerByID request
 	ListenerByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// Logs request
+	Logs(ctx context.Context, params *LogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Networks request
+	Networks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Processes request
-	Processes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Processes(ctx context.Context, params *ProcessesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ProcessById request
 	ProcessById(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Processpairs request
-	Processpairs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Processpairs(ctx context.Context, params *ProcesspairsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ProcesspairByID request
 	ProcesspairByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// HistoryByProcesspair request
+	HistoryByProcesspair(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Routeraccess request
-	Routeraccess(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Routeraccess(ctx context.Context, params *RouteraccessParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// RouteraccessByID request
 	RouteraccessByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Routerlinks request
-	Routerlinks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Routerlinks(ctx context.Context, params *RouterlinksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// RouterlinkByID request
 	RouterlinkByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Routers request
-	Routers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Routers(ctx context.Context, params *RoutersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// RouterByID request
 	RouterByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// LogsByRouter request
+	LogsByRouter(ctx context.Context, id PathID, params *LogsByRouterParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Services request
-	Services(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Services(ctx context.Context, params *ServicesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ServiceByID request
 	ServiceByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ConnectionsByService request
-	ConnectionsByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ConnectionsByService(ctx context.Context, id PathID, params *ConnectionsByServiceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HistoryByService request
+	HistoryByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ProcessesByService request
-	ProcessesByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ProcessesByService(ctx context.Context, id PathID, params *ProcessesByServiceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ProcessPairsByService request
-	ProcessPairsByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ProcessPairsByService(ctx context.Context, id PathID, params *ProcessPairsByServiceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Sitepairs request
-	Sitepairs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Sitepairs(ctx context.Context, params *SitepairsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// SitepairByID request
 	SitepairByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// HistoryBySitepair request
+	HistoryBySitepair(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Sites request
-	Sites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Sites(ctx context.Context, params *SitesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// SiteById request
 	SiteById(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -853,15 +1254,42 @@ type ClientInterface interface {
 	// HostsBySite request
 	HostsBySite(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// LogsBySite request
+	LogsBySite(ctx context.Context, id PathID, params *LogsBySiteParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ProcessesBySite request
-	ProcessesBySite(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ProcessesBySite(ctx context.Context, id PathID, params *ProcessesBySiteParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// RoutersBySite request
-	RoutersBySite(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	RoutersBySite(ctx context.Context, id PathID, params *RoutersBySiteParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) Alerts(ctx context.Context, params *AlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAlertsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Applicationflows(ctx context.Context, params *ApplicationflowsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplicationflowsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) Applicationflows(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApplicationflowsRequest(c.Server)
+func (c *Client) ExportApplicationflows(ctx context.Context, params *ExportApplicationflowsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportApplicationflowsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -872,8 +1300,8 @@ func (c *Client) Applicationflows(ctx context.Context, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) Componentpairs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewComponentpairsRequest(c.Server)
+func (c *Client) Componentpairs(ctx context.Context, params *ComponentpairsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewComponentpairsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -896,8 +1324,8 @@ func (c *Client) ComponentpairByID(ctx context.Context, id PathID, reqEditors ..
 	return c.Client.Do(req)
 }
 
-func (c *Client) Components(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewComponentsRequest(c.Server)
+func (c *Client) Components(ctx context.Context, params *ComponentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewComponentsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -920,8 +1348,20 @@ func (c *Client) ComponentByID(ctx context.Context, id PathID, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) Connections(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewConnectionsRequest(c.Server)
+func (c *Client) Connections(ctx context.Context, params *ConnectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConnectionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportConnections(ctx context.Context, params *ExportConnectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportConnectionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -932,8 +1372,8 @@ func (c *Client) Connections(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) Connectors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewConnectorsRequest(c.Server)
+func (c *Client) Connectors(ctx context.Context, params *ConnectorsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConnectorsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -980,8 +1420,8 @@ func (c *Client) HostsByID(ctx context.Context, id PathID, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) Listeners(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListenersRequest(c.Server)
+func (c *Client) Listeners(ctx context.Context, params *ListenersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListenersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1004,8 +1444,32 @@ func (c *Client) ListenerByID(ctx context.Context, id PathID, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) Processes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewProcessesRequest(c.Server)
+func (c *Client) Logs(ctx context.Context, params *LogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Networks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNetworksRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Processes(ctx context.Context, params *ProcessesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1028,8 +1492,8 @@ func (c *Client) ProcessById(ctx context.Context, id PathID, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) Processpairs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewProcesspairsRequest(c.Server)
+func (c *Client) Processpairs(ctx context.Context, params *ProcesspairsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcesspairsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1052,8 +1516,20 @@ func (c *Client) ProcesspairByID(ctx context.Context, id PathID, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) Routeraccess(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRouteraccessRequest(c.Server)
+func (c *Client) HistoryByProcesspair(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHistoryByProcesspairRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Routeraccess(ctx context.Context, params *RouteraccessParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRouteraccessRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1076,8 +1552,8 @@ func (c *Client) RouteraccessByID(ctx context.Context, id PathID, reqEditors ...
 	return c.Client.Do(req)
 }
 
-func (c *Client) Routerlinks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRouterlinksRequest(c.Server)
+func (c *Client) Routerlinks(ctx context.Context, params *RouterlinksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRouterlinksRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1100,8 +1576,8 @@ func (c *Client) RouterlinkByID(ctx context.Context, id PathID, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) Routers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRoutersRequest(c.Server)
+func (c *Client) Routers(ctx context.Context, params *RoutersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRoutersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1124,8 +1600,8 @@ func (c *Client) RouterByID(ctx context.Context, id PathID, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) Services(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewServicesRequest(c.Server)
+func (c *Client) LogsByRouter(ctx context.Context, id PathID, params *LogsByRouterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogsByRouterRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1136,8 +1612,8 @@ func (c *Client) Services(ctx context.Context, reqEditors ...RequestEditorFn) (*
 	return c.Client.Do(req)
 }
 
-func (c *Client) ServiceByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewServiceByIDRequest(c.Server, id)
+func (c *Client) Services(ctx context.Context, params *ServicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewServicesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1148,8 +1624,8 @@ func (c *Client) ServiceByID(ctx context.Context, id PathID, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) ConnectionsByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewConnectionsByServiceRequest(c.Server, id)
+func (c *Client) ServiceByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewServiceByIDRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1160,8 +1636,8 @@ func (c *Client) ConnectionsByService(ctx context.Context, id PathID, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) ProcessesByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewProcessesByServiceRequest(c.Server, id)
+func (c *Client) ConnectionsByService(ctx context.Context, id PathID, params *ConnectionsByServiceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConnectionsByServiceRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1172,8 +1648,8 @@ func (c *Client) ProcessesByService(ctx context.Context, id PathID, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) ProcessPairsByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewProcessPairsByServiceRequest(c.Server, id)
+func (c *Client) HistoryByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHistoryByServiceRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1184,8 +1660,8 @@ func (c *Client) ProcessPairsByService(ctx context.Context, id PathID, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) Sitepairs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSitepairsRequest(c.Server)
+func (c *Client) ProcessesByService(ctx context.Context, id PathID, params *ProcessesByServiceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessesByServiceRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1196,8 +1672,8 @@ func (c *Client) Sitepairs(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-func (c *Client) SitepairByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSitepairByIDRequest(c.Server, id)
+func (c *Client) ProcessPairsByService(ctx context.Context, id PathID, params *ProcessPairsByServiceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessPairsByServiceRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1208,8 +1684,8 @@ func (c *Client) SitepairByID(ctx context.Context, id PathID, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) Sites(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSitesRequest(c.Server)
+func (c *Client) Sitepairs(ctx context.Context, params *SitepairsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSitepairsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1220,8 +1696,8 @@ func (c *Client) Sites(ctx context.Context, reqEditors ...RequestEditorFn) (*htt
 	return c.Client.Do(req)
 }
 
-func (c *Client) SiteById(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSiteByIdRequest(c.Server, id)
+func (c *Client) SitepairByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSitepairByIDRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1232,8 +1708,8 @@ func (c *Client) SiteById(ctx context.Context, id PathID, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) HostsBySite(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHostsBySiteRequest(c.Server, id)
+func (c *Client) HistoryBySitepair(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHistoryBySitepairRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1244,8 +1720,8 @@ func (c *Client) HostsBySite(ctx context.Context, id PathID, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) ProcessesBySite(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewProcessesBySiteRequest(c.Server, id)
+func (c *Client) Sites(ctx context.Context, params *SitesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSitesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1256,8 +1732,8 @@ func (c *Client) ProcessesBySite(ctx context.Context, id PathID, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) RoutersBySite(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRoutersBySiteRequest(c.Server, id)
+func (c *Client) SiteById(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSiteByIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1268,8 +1744,105 @@ func (c *Client) RoutersBySite(ctx context.Context, id PathID, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-// NewApplicationflowsRequest generates requests for Applicationflows
-func NewApplicationflowsRequest(server string) (*http.Request, error) {
+func (c *Client) HostsBySite(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHostsBySiteRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LogsBySite(ctx context.Context, id PathID, params *LogsBySiteParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogsBySiteRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProcessesBySite(ctx context.Context, id PathID, params *ProcessesBySiteParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessesBySiteRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RoutersBySite(ctx context.Context, id PathID, params *RoutersBySiteParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRoutersBySiteRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAlertsRequest generates requests for Alerts
+func NewAlertsRequest(server string, params *AlertsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/alerts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApplicationflowsRequest generates requests for Applicationflows
+func NewApplicationflowsRequest(server string, params *ApplicationflowsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1287,6 +1860,77 @@ func NewApplicationflowsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportApplicationflowsRequest generates requests for ExportApplicationflows
+func NewExportApplicationflowsRequest(server string, params *ExportApplicationflowsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/applicationflows/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1296,7 +1940,7 @@ func NewApplicationflowsRequest(server string) (*http.Request, error) {
 }
 
 // NewComponentpairsRequest generates requests for Componentpairs
-func NewComponentpairsRequest(server string) (*http.Request, error) {
+func NewComponentpairsRequest(server string, params *ComponentpairsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1314,6 +1958,28 @@ func NewComponentpairsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1357,7 +2023,7 @@ func NewComponentpairByIDRequest(server string, id PathID) (*http.Request, error
 }
 
 // NewComponentsRequest generates requests for Components
-func NewComponentsRequest(server string) (*http.Request, error) {
+func NewComponentsRequest(server string, params *ComponentsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1375,6 +2041,28 @@ func NewComponentsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1418,7 +2106,7 @@ func NewComponentByIDRequest(server string, id PathID) (*http.Request, error) {
 }
 
 // NewConnectionsRequest generates requests for Connections
-func NewConnectionsRequest(server string) (*http.Request, error) {
+func NewConnectionsRequest(server string, params *ConnectionsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1436,6 +2124,77 @@ func NewConnectionsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportConnectionsRequest generates requests for ExportConnections
+func NewExportConnectionsRequest(server string, params *ExportConnectionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/connections/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1445,7 +2204,7 @@ func NewConnectionsRequest(server string) (*http.Request, error) {
 }
 
 // NewConnectorsRequest generates requests for Connectors
-func NewConnectorsRequest(server string) (*http.Request, error) {
+func NewConnectorsRequest(server string, params *ConnectorsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1463,6 +2222,28 @@ func NewConnectorsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1567,7 +2348,7 @@ func NewHostsByIDRequest(server string, id PathID) (*http.Request, error) {
 }
 
 // NewListenersRequest generates requests for Listeners
-func NewListenersRequest(server string) (*http.Request, error) {
+func NewListenersRequest(server string, params *ListenersParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1585,6 +2366,28 @@ func NewListenersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1627,8 +2430,84 @@ func NewListenerByIDRequest(server string, id PathID) (*http.Request, error) {
 	return req, nil
 }
 
+// NewLogsRequest generates requests for Logs
+func NewLogsRequest(server string, params *LogsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/logs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNetworksRequest generates requests for Networks
+func NewNetworksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/networks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewProcessesRequest generates requests for Processes
-func NewProcessesRequest(server string) (*http.Request, error) {
+func NewProcessesRequest(server string, params *ProcessesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1646,6 +2525,28 @@ func NewProcessesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1689,7 +2590,7 @@ func NewProcessByIdRequest(server string, id PathID) (*http.Request, error) {
 }
 
 // NewProcesspairsRequest generates requests for Processpairs
-func NewProcesspairsRequest(server string) (*http.Request, error) {
+func NewProcesspairsRequest(server string, params *ProcesspairsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1707,6 +2608,28 @@ func NewProcesspairsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1749,8 +2672,42 @@ func NewProcesspairByIDRequest(server string, id PathID) (*http.Request, error)
 	return req, nil
 }
 
+// NewHistoryByProcesspairRequest generates requests for HistoryByProcesspair
+func NewHistoryByProcesspairRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/processpairs/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewRouteraccessRequest generates requests for Routeraccess
-func NewRouteraccessRequest(server string) (*http.Request, error) {
+func NewRouteraccessRequest(server string, params *RouteraccessParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1768,6 +2725,28 @@ func NewRouteraccessRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1811,7 +2790,7 @@ func NewRouteraccessByIDRequest(server string, id PathID) (*http.Request, error)
 }
 
 // NewRouterlinksRequest generates requests for Routerlinks
-func NewRouterlinksRequest(server string) (*http.Request, error) {
+func NewRouterlinksRequest(server string, params *RouterlinksParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1829,6 +2808,28 @@ func NewRouterlinksRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1872,7 +2873,7 @@ func NewRouterlinkByIDRequest(server string, id PathID) (*http.Request, error) {
 }
 
 // NewRoutersRequest generates requests for Routers
-func NewRoutersRequest(server string) (*http.Request, error) {
+func NewRoutersRequest(server string, params *RoutersParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1890,6 +2891,28 @@ func NewRoutersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1932,8 +2955,64 @@ func NewRouterByIDRequest(server string, id PathID) (*http.Request, error) {
 	return req, nil
 }
 
+// NewLogsByRouterRequest generates requests for LogsByRouter
+func NewLogsByRouterRequest(server string, id PathID, params *LogsByRouterParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/routers/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewServicesRequest generates requests for Services
-func NewServicesRequest(server string) (*http.Request, error) {
+func NewServicesRequest(server string, params *ServicesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1951,6 +3030,28 @@ func NewServicesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -1994,7 +3095,7 @@ func NewServiceByIDRequest(server string, id PathID) (*http.Request, error) {
 }
 
 // NewConnectionsByServiceRequest generates requests for ConnectionsByService
-func NewConnectionsByServiceRequest(server string, id PathID) (*http.Request, error) {
+func NewConnectionsByServiceRequest(server string, id PathID, params *ConnectionsByServiceParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2019,6 +3120,62 @@ func NewConnectionsByServiceRequest(server string, id PathID) (*http.Request, er
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHistoryByServiceRequest generates requests for HistoryByService
+func NewHistoryByServiceRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/services/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2028,7 +3185,7 @@ func NewConnectionsByServiceRequest(server string, id PathID) (*http.Request, er
 }
 
 // NewProcessesByServiceRequest generates requests for ProcessesByService
-func NewProcessesByServiceRequest(server string, id PathID) (*http.Request, error) {
+func NewProcessesByServiceRequest(server string, id PathID, params *ProcessesByServiceParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2053,6 +3210,28 @@ func NewProcessesByServiceRequest(server string, id PathID) (*http.Request, erro
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2062,7 +3241,7 @@ func NewProcessesByServiceRequest(server string, id PathID) (*http.Request, erro
 }
 
 // NewProcessPairsByServiceRequest generates requests for ProcessPairsByService
-func NewProcessPairsByServiceRequest(server string, id PathID) (*http.Request, error) {
+func NewProcessPairsByServiceRequest(server string, id PathID, params *ProcessPairsByServiceParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2087,6 +3266,28 @@ func NewProcessPairsByServiceRequest(server string, id PathID) (*http.Request, e
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2096,7 +3297,7 @@ func NewProcessPairsByServiceRequest(server string, id PathID) (*http.Request, e
 }
 
 // NewSitepairsRequest generates requests for Sitepairs
-func NewSitepairsRequest(server string) (*http.Request, error) {
+func NewSitepairsRequest(server string, params *SitepairsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2114,6 +3315,28 @@ func NewSitepairsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2156,8 +3379,42 @@ func NewSitepairByIDRequest(server string, id PathID) (*http.Request, error) {
 	return req, nil
 }
 
+// NewHistoryBySitepairRequest generates requests for HistoryBySitepair
+func NewHistoryBySitepairRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/sitepairs/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewSitesRequest generates requests for Sites
-func NewSitesRequest(server string) (*http.Request, error) {
+func NewSitesRequest(server string, params *SitesParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2175,6 +3432,28 @@ func NewSitesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2199,7 +3478,41 @@ func NewSiteByIdRequest(server string, id PathID) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v2alpha1/sites/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v2alpha1/sites/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHostsBySiteRequest generates requests for HostsBySite
+func NewHostsBySiteRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/sites/%s/hosts", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2217,8 +3530,8 @@ func NewSiteByIdRequest(server string, id PathID) (*http.Request, error) {
 	return req, nil
 }
 
-// NewHostsBySiteRequest generates requests for HostsBySite
-func NewHostsBySiteRequest(server string, id PathID) (*http.Request, error) {
+// NewLogsBySiteRequest generates requests for LogsBySite
+func NewLogsBySiteRequest(server string, id PathID, params *LogsBySiteParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2233,7 +3546,7 @@ func NewHostsBySiteRequest(server string, id PathID) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v2alpha1/sites/%s/hosts", pathParam0)
+	operationPath := fmt.Sprintf("/api/v2alpha1/sites/%s/logs", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2243,6 +3556,28 @@ func NewHostsBySiteRequest(server string, id PathID) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2252,7 +3587,7 @@ func NewHostsBySiteRequest(server string, id PathID) (*http.Request, error) {
 }
 
 // NewProcessesBySiteRequest generates requests for ProcessesBySite
-func NewProcessesBySiteRequest(server string, id PathID) (*http.Request, error) {
+func NewProcessesBySiteRequest(server string, id PathID, params *ProcessesBySiteParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2277,6 +3612,28 @@ func NewProcessesBySiteRequest(server string, id PathID) (*http.Request, error)
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2286,7 +3643,7 @@ func NewProcessesBySiteRequest(server string, id PathID) (*http.Request, error)
 }
 
 // NewRoutersBySiteRequest generates requests for RoutersBySite
-func NewRoutersBySiteRequest(server string, id PathID) (*http.Request, error) {
+func NewRoutersBySiteRequest(server string, id PathID, params *RoutersBySiteParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2311,6 +3668,28 @@ func NewRoutersBySiteRequest(server string, id PathID) (*http.Request, error) {
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2362,26 +3741,35 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// AlertsWithResponse request
+	AlertsWithResponse(ctx context.Context, params *AlertsParams, reqEditors ...RequestEditorFn) (*AlertsResponse, error)
+
 	// ApplicationflowsWithResponse request
-	ApplicationflowsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ApplicationflowsResponse, error)
+	ApplicationflowsWithResponse(ctx context.Context, params *ApplicationflowsParams, reqEditors ...RequestEditorFn) (*ApplicationflowsResponse, error)
+
+	// ExportApplicationflowsWithResponse request
+	ExportApplicationflowsWithResponse(ctx context.Context, params *ExportApplicationflowsParams, reqEditors ...RequestEditorFn) (*ExportApplicationflowsResponse, error)
 
 	// ComponentpairsWithResponse request
-	ComponentpairsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ComponentpairsResponse, error)
+	ComponentpairsWithResponse(ctx context.Context, params *ComponentpairsParams, reqEditors ...RequestEditorFn) (*ComponentpairsResponse, error)
 
 	// ComponentpairByIDWithResponse request
 	ComponentpairByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ComponentpairByIDResponse, error)
 
 	// ComponentsWithResponse request
-	ComponentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ComponentsResponse, error)
+	ComponentsWithResponse(ctx context.Context, params *ComponentsParams, reqEditors ...RequestEditorFn) (*ComponentsResponse, error)
 
 	// ComponentByIDWithResponse request
 	ComponentByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ComponentByIDResponse, error)
 
 	// ConnectionsWithResponse request
-	ConnectionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ConnectionsResponse, error)
+	ConnectionsWithResponse(ctx context.Context, params *ConnectionsParams, reqEditors ...RequestEditorFn) (*ConnectionsResponse, error)
+
+	// ExportConnectionsWithResponse request
+	ExportConnectionsWithResponse(ctx context.Context, params *ExportConnectionsParams, reqEditors ...RequestEditorFn) (*ExportConnectionsResponse, error)
 
 	// ConnectorsWithResponse request
-	ConnectorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ConnectorsResponse, error)
+	ConnectorsWithResponse(ctx context.Context, params *ConnectorsParams, reqEditors ...RequestEditorFn) (*ConnectorsResponse, error)
 
 	// ConnectorByIDWithResponse request
 	ConnectorByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ConnectorByIDResponse, error)
@@ -2393,64 +3781,82 @@ type ClientWithResponsesInterface interface {
 	HostsByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HostsByIDResponse, error)
 
 	// ListenersWithResponse request
-	ListenersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListenersResponse, error)
+	ListenersWithResponse(ctx context.Context, params *ListenersParams, reqEditors ...RequestEditorFn) (*ListenersResponse, error)
 
 	// ListenerByIDWithResponse request
 	ListenerByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ListenerByIDResponse, error)
 
+	// LogsWithResponse request
+	LogsWithResponse(ctx context.Context, params *LogsParams, reqEditors ...RequestEditorFn) (*LogsResponse, error)
+
+	// NetworksWithResponse request
+	NetworksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NetworksResponse, error)
+
 	// ProcessesWithResponse request
-	ProcessesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ProcessesResponse, error)
+	ProcessesWithResponse(ctx context.Context, params *ProcessesParams, reqEditors ...RequestEditorFn) (*ProcessesResponse, error)
 
 	// ProcessByIdWithResponse request
 	ProcessByIdWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ProcessByIdResponse, error)
 
 	// ProcesspairsWithResponse request
-	ProcesspairsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ProcesspairsResponse, error)
+	ProcesspairsWithResponse(ctx context.Context, params *ProcesspairsParams, reqEditors ...RequestEditorFn) (*ProcesspairsResponse, error)
 
 	// ProcesspairByIDWithResponse request
 	ProcesspairByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ProcesspairByIDResponse, error)
 
+	// HistoryByProcesspairWithResponse request
+	HistoryByProcesspairWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HistoryByProcesspairResponse, error)
+
 	// RouteraccessWithResponse request
-	RouteraccessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RouteraccessResponse, error)
+	RouteraccessWithResponse(ctx context.Context, params *RouteraccessParams, reqEditors ...RequestEditorFn) (*RouteraccessResponse, error)
 
 	// RouteraccessByIDWithResponse request
 	RouteraccessByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*RouteraccessByIDResponse, error)
 
 	// RouterlinksWithResponse request
-	RouterlinksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RouterlinksResponse, error)
+	RouterlinksWithResponse(ctx context.Context, params *RouterlinksParams, reqEditors ...RequestEditorFn) (*RouterlinksResponse, error)
 
 	// RouterlinkByIDWithResponse request
 	RouterlinkByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*RouterlinkByIDResponse, error)
 
 	// RoutersWithResponse request
-	RoutersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RoutersResponse, error)
+	RoutersWithResponse(ctx context.Context, params *RoutersParams, reqEditors ...RequestEditorFn) (*RoutersResponse, error)
 
 	// RouterByIDWithResponse request
 	RouterByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*RouterByIDResponse, error)
 
+	// LogsByRouterWithResponse request
+	LogsByRouterWithResponse(ctx context.Context, id PathID, params *LogsByRouterParams, reqEditors ...RequestEditorFn) (*LogsByRouterResponse, error)
+
 	// ServicesWithResponse request
-	ServicesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ServicesResponse, error)
+	ServicesWithResponse(ctx context.Context, params *ServicesParams, reqEditors ...RequestEditorFn) (*ServicesResponse, error)
 
 	// ServiceByIDWithResponse request
 	ServiceByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ServiceByIDResponse, error)
 
 	// ConnectionsByServiceWithResponse request
-	ConnectionsByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ConnectionsByServiceResponse, error)
+	ConnectionsByServiceWithResponse(ctx context.Context, id PathID, params *ConnectionsByServiceParams, reqEditors ...RequestEditorFn) (*ConnectionsByServiceResponse, error)
+
+	// HistoryByServiceWithResponse request
+	HistoryByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HistoryByServiceResponse, error)
 
 	// ProcessesByServiceWithResponse request
-	ProcessesByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ProcessesByServiceResponse, error)
+	ProcessesByServiceWithResponse(ctx context.Context, id PathID, params *ProcessesByServiceParams, reqEditors ...RequestEditorFn) (*ProcessesByServiceResponse, error)
 
 	// ProcessPairsByServiceWithResponse request
-	ProcessPairsByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ProcessPairsByServiceResponse, error)
+	ProcessPairsByServiceWithResponse(ctx context.Context, id PathID, params *ProcessPairsByServiceParams, reqEditors ...RequestEditorFn) (*ProcessPairsByServiceResponse, error)
 
 	// SitepairsWithResponse request
-	SitepairsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SitepairsResponse, error)
+	SitepairsWithResponse(ctx context.Context, params *SitepairsParams, reqEditors ...RequestEditorFn) (*SitepairsResponse, error)
 
 	// SitepairByIDWithResponse request
 	SitepairByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*SitepairByIDResponse, error)
 
+	// HistoryBySitepairWithResponse request
+	HistoryBySitepairWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HistoryBySitepairResponse, error)
+
 	// SitesWithResponse request
-	SitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SitesResponse, error)
+	SitesWithResponse(ctx context.Context, params *SitesParams, reqEditors ...RequestEditorFn) (*SitesResponse, error)
 
 	// SiteByIdWithResponse request
 	SiteByIdWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*SiteByIdResponse, error)
@@ -2458,11 +3864,37 @@ type ClientWithResponsesInterface interface {
 	// HostsBySiteWithResponse request
 	HostsBySiteWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HostsBySiteResponse, error)
 
+	// LogsBySiteWithResponse request
+	LogsBySiteWithResponse(ctx context.Context, id PathID, params *LogsBySiteParams, reqEditors ...RequestEditorFn) (*LogsBySiteResponse, error)
+
 	// ProcessesBySiteWithResponse request
-	ProcessesBySiteWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ProcessesBySiteResponse, error)
+	ProcessesBySiteWithResponse(ctx context.Context, id PathID, params *ProcessesBySiteParams, reqEditors ...RequestEditorFn) (*ProcessesBySiteResponse, error)
 
 	// RoutersBySiteWithResponse request
-	RoutersBySiteWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*RoutersBySiteResponse, error)
+	RoutersBySiteWithResponse(ctx context.Context, id PathID, params *RoutersBySiteParams, reqEditors ...RequestEditorFn) (*RoutersBySiteResponse, error)
+}
+
+type AlertsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetAlerts
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
 type ApplicationflowsResponse struct {
@@ -2487,6 +3919,28 @@ func (r ApplicationflowsResponse) StatusCode() int {
 	return 0
 }
 
+type ExportApplicationflowsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportApplicationflowsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportApplicationflowsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ComponentpairsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2601,6 +4055,28 @@ func (r ConnectionsResponse) StatusCode() int {
 	return 0
 }
 
+type ExportConnectionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportConnectionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportConnectionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ConnectorsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2738,6 +4214,51 @@ func (r ListenerByIDResponse) StatusCode() int {
 	return 0
 }
 
+type LogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetLogs
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r LogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NetworksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetNetworks
+}
+
+// Status returns HTTPResponse.Status
+func (r NetworksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NetworksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ProcessesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2830,6 +4351,30 @@ func (r ProcesspairByIDResponse) StatusCode() int {
 	return 0
 }
 
+type HistoryByProcesspairResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetHistory
+	JSON400      *ErrorBadRequest
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r HistoryByProcesspairResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HistoryByProcesspairResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type RouteraccessResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -2968,6 +4513,30 @@ func (r RouterByIDResponse) StatusCode() int {
 	return 0
 }
 
+type LogsByRouterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetLogs
+	JSON400      *ErrorBadRequest
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r LogsByRouterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogsByRouterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ServicesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -3037,6 +4606,30 @@ func (r ConnectionsByServiceResponse) StatusCode() int {
 	return 0
 }
 
+type HistoryByServiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetHistory
+	JSON400      *ErrorBadRequest
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r HistoryByServiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HistoryByServiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ProcessesByServiceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -3129,6 +4722,30 @@ func (r SitepairByIDResponse) StatusCode() int {
 	return 0
 }
 
+type HistoryBySitepairResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetHistory
+	JSON400      *ErrorBadRequest
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r HistoryBySitepairResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HistoryBySitepairResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type SitesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -3197,6 +4814,30 @@ func (r HostsBySiteResponse) StatusCode() int {
 	return 0
 }
 
+type LogsBySiteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetLogs
+	JSON400      *ErrorBadRequest
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r LogsBySiteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogsBySiteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ProcessesBySiteResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -3243,18 +4884,36 @@ func (r RoutersBySiteResponse) StatusCode() int {
 	return 0
 }
 
+// AlertsWithResponse request returning *AlertsResponse
+func (c *ClientWithResponses) AlertsWithResponse(ctx context.Context, params *AlertsParams, reqEditors ...RequestEditorFn) (*AlertsResponse, error) {
+	rsp, err := c.Alerts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertsResponse(rsp)
+}
+
 // ApplicationflowsWithResponse request returning *ApplicationflowsResponse
-func (c *ClientWithResponses) ApplicationflowsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ApplicationflowsResponse, error) {
-	rsp, err := c.Applicationflows(ctx, reqEditors...)
+func (c *ClientWithResponses) ApplicationflowsWithResponse(ctx context.Context, params *ApplicationflowsParams, reqEditors ...RequestEditorFn) (*ApplicationflowsResponse, error) {
+	rsp, err := c.Applicationflows(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseApplicationflowsResponse(rsp)
 }
 
+// ExportApplicationflowsWithResponse request returning *ExportApplicationflowsResponse
+func (c *ClientWithResponses) ExportApplicationflowsWithResponse(ctx context.Context, params *ExportApplicationflowsParams, reqEditors ...RequestEditorFn) (*ExportApplicationflowsResponse, error) {
+	rsp, err := c.ExportApplicationflows(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportApplicationflowsResponse(rsp)
+}
+
 // ComponentpairsWithResponse request returning *ComponentpairsResponse
-func (c *ClientWithResponses) ComponentpairsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ComponentpairsResponse, error) {
-	rsp, err := c.Componentpairs(ctx, reqEditors...)
+func (c *ClientWithResponses) ComponentpairsWithResponse(ctx context.Context, params *ComponentpairsParams, reqEditors ...RequestEditorFn) (*ComponentpairsResponse, error) {
+	rsp, err := c.Componentpairs(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3271,8 +4930,8 @@ func (c *ClientWithResponses) ComponentpairByIDWithResponse(ctx context.Context,
 }
 
 // ComponentsWithResponse request returning *ComponentsResponse
-func (c *ClientWithResponses) ComponentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ComponentsResponse, error) {
-	rsp, err := c.Components(ctx, reqEditors...)
+func (c *ClientWithResponses) ComponentsWithResponse(ctx context.Context, params *ComponentsParams, reqEditors ...RequestEditorFn) (*ComponentsResponse, error) {
+	rsp, err := c.Components(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3289,17 +4948,26 @@ func (c *ClientWithResponses) ComponentByIDWithResponse(ctx context.Context, id
 }
 
 // ConnectionsWithResponse request returning *ConnectionsResponse
-func (c *ClientWithResponses) ConnectionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ConnectionsResponse, error) {
-	rsp, err := c.Connections(ctx, reqEditors...)
+func (c *ClientWithResponses) ConnectionsWithResponse(ctx context.Context, params *ConnectionsParams, reqEditors ...RequestEditorFn) (*ConnectionsResponse, error) {
+	rsp, err := c.Connections(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseConnectionsResponse(rsp)
 }
 
+// ExportConnectionsWithResponse request returning *ExportConnectionsResponse
+func (c *ClientWithResponses) ExportConnectionsWithResponse(ctx context.Context, params *ExportConnectionsParams, reqEditors ...RequestEditorFn) (*ExportConnectionsResponse, error) {
+	rsp, err := c.ExportConnections(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportConnectionsResponse(rsp)
+}
+
 // ConnectorsWithResponse request returning *ConnectorsResponse
-func (c *ClientWithResponses) ConnectorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ConnectorsResponse, error) {
-	rsp, err := c.Connectors(ctx, reqEditors...)
+func (c *ClientWithResponses) ConnectorsWithResponse(ctx context.Context, params *ConnectorsParams, reqEditors ...RequestEditorFn) (*ConnectorsResponse, error) {
+	rsp, err := c.Connectors(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3334,8 +5002,8 @@ func (c *ClientWithResponses) HostsByIDWithResponse(ctx context.Context, id Path
 }
 
 // ListenersWithResponse request returning *ListenersResponse
-func (c *ClientWithResponses) ListenersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListenersResponse, error) {
-	rsp, err := c.Listeners(ctx, reqEditors...)
+func (c *ClientWithResponses) ListenersWithResponse(ctx context.Context, params *ListenersParams, reqEditors ...RequestEditorFn) (*ListenersResponse, error) {
+	rsp, err := c.Listeners(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3348,12 +5016,30 @@ func (c *ClientWithResponses) ListenerByIDWithResponse(ctx context.Context, id P
 	if err != nil {
 		return nil, err
 	}
-	return ParseListenerByIDResponse(rsp)
+	return ParseListenerByIDResponse(rsp)
+}
+
+// LogsWithResponse request returning *LogsResponse
+func (c *ClientWithResponses) LogsWithResponse(ctx context.Context, params *LogsParams, reqEditors ...RequestEditorFn) (*LogsResponse, error) {
+	rsp, err := c.Logs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogsResponse(rsp)
+}
+
+// NetworksWithResponse request returning *NetworksResponse
+func (c *ClientWithResponses) NetworksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NetworksResponse, error) {
+	rsp, err := c.Networks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNetworksResponse(rsp)
 }
 
 // ProcessesWithResponse request returning *ProcessesResponse
-func (c *ClientWithResponses) ProcessesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ProcessesResponse, error) {
-	rsp, err := c.Processes(ctx, reqEditors...)
+func (c *ClientWithResponses) ProcessesWithResponse(ctx context.Context, params *ProcessesParams, reqEditors ...RequestEditorFn) (*ProcessesResponse, error) {
+	rsp, err := c.Processes(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3370,8 +5056,8 @@ func (c *ClientWithResponses) ProcessByIdWithResponse(ctx context.Context, id Pa
 }
 
 // ProcesspairsWithResponse request returning *ProcesspairsResponse
-func (c *ClientWithResponses) ProcesspairsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ProcesspairsResponse, error) {
-	rsp, err := c.Processpairs(ctx, reqEditors...)
+func (c *ClientWithResponses) ProcesspairsWithResponse(ctx context.Context, params *ProcesspairsParams, reqEditors ...RequestEditorFn) (*ProcesspairsResponse, error) {
+	rsp, err := c.Processpairs(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3387,9 +5073,18 @@ func (c *ClientWithResponses) ProcesspairByIDWithResponse(ctx context.Context, i
 	return ParseProcesspairByIDResponse(rsp)
 }
 
+// HistoryByProcesspairWithResponse request returning *HistoryByProcesspairResponse
+func (c *ClientWithResponses) HistoryByProcesspairWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HistoryByProcesspairResponse, error) {
+	rsp, err := c.HistoryByProcesspair(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHistoryByProcesspairResponse(rsp)
+}
+
 // RouteraccessWithResponse request returning *RouteraccessResponse
-func (c *ClientWithResponses) RouteraccessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RouteraccessResponse, error) {
-	rsp, err := c.Routeraccess(ctx, reqEditors...)
+func (c *ClientWithResponses) RouteraccessWithResponse(ctx context.Context, params *RouteraccessParams, reqEditors ...RequestEditorFn) (*RouteraccessResponse, error) {
+	rsp, err := c.Routeraccess(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3406,8 +5101,8 @@ func (c *ClientWithResponses) RouteraccessByIDWithResponse(ctx context.Context,
 }
 
 // RouterlinksWithResponse request returning *RouterlinksResponse
-func (c *ClientWithResponses) RouterlinksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RouterlinksResponse, error) {
-	rsp, err := c.Routerlinks(ctx, reqEditors...)
+func (c *ClientWithResponses) RouterlinksWithResponse(ctx context.Context, params *RouterlinksParams, reqEditors ...RequestEditorFn) (*RouterlinksResponse, error) {
+	rsp, err := c.Routerlinks(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3424,8 +5119,8 @@ func (c *ClientWithResponses) RouterlinkByIDWithResponse(ctx context.Context, id
 }
 
 // RoutersWithResponse request returning *RoutersResponse
-func (c *ClientWithResponses) RoutersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RoutersResponse, error) {
-	rsp, err := c.Routers(ctx, reqEditors...)
+func (c *ClientWithResponses) RoutersWithResponse(ctx context.Context, params *RoutersParams, reqEditors ...RequestEditorFn) (*RoutersResponse, error) {
+	rsp, err := c.Routers(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3441,9 +5136,18 @@ func (c *ClientWithResponses) RouterByIDWithResponse(ctx context.Context, id Pat
 	return ParseRouterByIDResponse(rsp)
 }
 
+// LogsByRouterWithResponse request returning *LogsByRouterResponse
+func (c *ClientWithResponses) LogsByRouterWithResponse(ctx context.Context, id PathID, params *LogsByRouterParams, reqEditors ...RequestEditorFn) (*LogsByRouterResponse, error) {
+	rsp, err := c.LogsByRouter(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogsByRouterResponse(rsp)
+}
+
 // ServicesWithResponse request returning *ServicesResponse
-func (c *ClientWithResponses) ServicesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ServicesResponse, error) {
-	rsp, err := c.Services(ctx, reqEditors...)
+func (c *ClientWithResponses) ServicesWithResponse(ctx context.Context, params *ServicesParams, reqEditors ...RequestEditorFn) (*ServicesResponse, error) {
+	rsp, err := c.Services(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3460,17 +5164,26 @@ func (c *ClientWithResponses) ServiceByIDWithResponse(ctx context.Context, id Pa
 }
 
 // ConnectionsByServiceWithResponse request returning *ConnectionsByServiceResponse
-func (c *ClientWithResponses) ConnectionsByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ConnectionsByServiceResponse, error) {
-	rsp, err := c.ConnectionsByService(ctx, id, reqEditors...)
+func (c *ClientWithResponses) ConnectionsByServiceWithResponse(ctx context.Context, id PathID, params *ConnectionsByServiceParams, reqEditors ...RequestEditorFn) (*ConnectionsByServiceResponse, error) {
+	rsp, err := c.ConnectionsByService(ctx, id, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseConnectionsByServiceResponse(rsp)
 }
 
+// HistoryByServiceWithResponse request returning *HistoryByServiceResponse
+func (c *ClientWithResponses) HistoryByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HistoryByServiceResponse, error) {
+	rsp, err := c.HistoryByService(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHistoryByServiceResponse(rsp)
+}
+
 // ProcessesByServiceWithResponse request returning *ProcessesByServiceResponse
-func (c *ClientWithResponses) ProcessesByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ProcessesByServiceResponse, error) {
-	rsp, err := c.ProcessesByService(ctx, id, reqEditors...)
+func (c *ClientWithResponses) ProcessesByServiceWithResponse(ctx context.Context, id PathID, params *ProcessesByServiceParams, reqEditors ...RequestEditorFn) (*ProcessesByServiceResponse, error) {
+	rsp, err := c.ProcessesByService(ctx, id, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3478,8 +5191,8 @@ func (c *ClientWithResponses) ProcessesByServiceWithResponse(ctx context.Context
 }
 
 // ProcessPairsByServiceWithResponse request returning *ProcessPairsByServiceResponse
-func (c *ClientWithResponses) ProcessPairsByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ProcessPairsByServiceResponse, error) {
-	rsp, err := c.ProcessPairsByService(ctx, id, reqEditors...)
+func (c *ClientWithResponses) ProcessPairsByServiceWithResponse(ctx context.Context, id PathID, params *ProcessPairsByServiceParams, reqEditors ...RequestEditorFn) (*ProcessPairsByServiceResponse, error) {
+	rsp, err := c.ProcessPairsByService(ctx, id, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3487,8 +5200,8 @@ func (c *ClientWithResponses) ProcessPairsByServiceWithResponse(ctx context.Cont
 }
 
 // SitepairsWithResponse request returning *SitepairsResponse
-func (c *ClientWithResponses) SitepairsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SitepairsResponse, error) {
-	rsp, err := c.Sitepairs(ctx, reqEditors...)
+func (c *ClientWithResponses) SitepairsWithResponse(ctx context.Context, params *SitepairsParams, reqEditors ...RequestEditorFn) (*SitepairsResponse, error) {
+	rsp, err := c.Sitepairs(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3504,9 +5217,18 @@ func (c *ClientWithResponses) SitepairByIDWithResponse(ctx context.Context, id P
 	return ParseSitepairByIDResponse(rsp)
 }
 
+// HistoryBySitepairWithResponse request returning *HistoryBySitepairResponse
+func (c *ClientWithResponses) HistoryBySitepairWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HistoryBySitepairResponse, error) {
+	rsp, err := c.HistoryBySitepair(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHistoryBySitepairResponse(rsp)
+}
+
 // SitesWithResponse request returning *SitesResponse
-func (c *ClientWithResponses) SitesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SitesResponse, error) {
-	rsp, err := c.Sites(ctx, reqEditors...)
+func (c *ClientWithResponses) SitesWithResponse(ctx context.Context, params *SitesParams, reqEditors ...RequestEditorFn) (*SitesResponse, error) {
+	rsp, err := c.Sites(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3531,9 +5253,18 @@ func (c *ClientWithResponses) HostsBySiteWithResponse(ctx context.Context, id Pa
 	return ParseHostsBySiteResponse(rsp)
 }
 
+// LogsBySiteWithResponse request returning *LogsBySiteResponse
+func (c *ClientWithResponses) LogsBySiteWithResponse(ctx context.Context, id PathID, params *LogsBySiteParams, reqEditors ...RequestEditorFn) (*LogsBySiteResponse, error) {
+	rsp, err := c.LogsBySite(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogsBySiteResponse(rsp)
+}
+
 // ProcessesBySiteWithResponse request returning *ProcessesBySiteResponse
-func (c *ClientWithResponses) ProcessesBySiteWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ProcessesBySiteResponse, error) {
-	rsp, err := c.ProcessesBySite(ctx, id, reqEditors...)
+func (c *ClientWithResponses) ProcessesBySiteWithResponse(ctx context.Context, id PathID, params *ProcessesBySiteParams, reqEditors ...RequestEditorFn) (*ProcessesBySiteResponse, error) {
+	rsp, err := c.ProcessesBySite(ctx, id, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -3541,14 +5272,47 @@ func (c *ClientWithResponses) ProcessesBySiteWithResponse(ctx context.Context, i
 }
 
 // RoutersBySiteWithResponse request returning *RoutersBySiteResponse
-func (c *ClientWithResponses) RoutersBySiteWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*RoutersBySiteResponse, error) {
-	rsp, err := c.RoutersBySite(ctx, id, reqEditors...)
+func (c *ClientWithResponses) RoutersBySiteWithResponse(ctx context.Context, id PathID, params *RoutersBySiteParams, reqEditors ...RequestEditorFn) (*RoutersBySiteResponse, error) {
+	rsp, err := c.RoutersBySite(ctx, id, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseRoutersBySiteResponse(rsp)
 }
 
+// ParseAlertsResponse parses an HTTP response from a AlertsWithResponse call
+func ParseAlertsResponse(rsp *http.Response) (*AlertsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AlertsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetAlerts
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseApplicationflowsResponse parses an HTTP response from a ApplicationflowsWithResponse call
 func ParseApplicationflowsResponse(rsp *http.Response) (*ApplicationflowsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3575,6 +5339,32 @@ func ParseApplicationflowsResponse(rsp *http.Response) (*ApplicationflowsRespons
 	return response, nil
 }
 
+// ParseExportApplicationflowsResponse parses an HTTP response from a ExportApplicationflowsWithResponse call
+func ParseExportApplicationflowsResponse(rsp *http.Response) (*ExportApplicationflowsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportApplicationflowsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseComponentpairsResponse parses an HTTP response from a ComponentpairsWithResponse call
 func ParseComponentpairsResponse(rsp *http.Response) (*ComponentpairsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3733,6 +5523,32 @@ func ParseConnectionsResponse(rsp *http.Response) (*ConnectionsResponse, error)
 	return response, nil
 }
 
+// ParseExportConnectionsResponse parses an HTTP response from a ExportConnectionsWithResponse call
+func ParseExportConnectionsResponse(rsp *http.Response) (*ExportConnectionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportConnectionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseConnectorsResponse parses an HTTP response from a ConnectorsWithResponse call
 func ParseConnectorsResponse(rsp *http.Response) (*ConnectorsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3924,6 +5740,65 @@ func ParseListenerByIDResponse(rsp *http.Response) (*ListenerByIDResponse, error
 	return response, nil
 }
 
+// ParseLogsResponse parses an HTTP response from a LogsWithResponse call
+func ParseLogsResponse(rsp *http.Response) (*LogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetLogs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNetworksResponse parses an HTTP response from a NetworksWithResponse call
+func ParseNetworksResponse(rsp *http.Response) (*NetworksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NetworksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetNetworks
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseProcessesResponse parses an HTTP response from a ProcessesWithResponse call
 func ParseProcessesResponse(rsp *http.Response) (*ProcessesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -4056,6 +5931,46 @@ func ParseProcesspairByIDResponse(rsp *http.Response) (*ProcesspairByIDResponse,
 	return response, nil
 }
 
+// ParseHistoryByProcesspairResponse parses an HTTP response from a HistoryByProcesspairWithResponse call
+func ParseHistoryByProcesspairResponse(rsp *http.Response) (*HistoryByProcesspairResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HistoryByProcesspairResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseRouteraccessResponse parses an HTTP response from a RouteraccessWithResponse call
 func ParseRouteraccessResponse(rsp *http.Response) (*RouteraccessResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -4254,6 +6169,46 @@ func ParseRouterByIDResponse(rsp *http.Response) (*RouterByIDResponse, error) {
 	return response, nil
 }
 
+// ParseLogsByRouterResponse parses an HTTP response from a LogsByRouterWithResponse call
+func ParseLogsByRouterResponse(rsp *http.Response) (*LogsByRouterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogsByRouterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetLogs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseServicesResponse parses an HTTP response from a ServicesWithResponse call
 func ParseServicesResponse(rsp *http.Response) (*ServicesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -4339,7 +6294,47 @@ func ParseConnectionsByServiceResponse(rsp *http.Response) (*ConnectionsByServic
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHistoryByServiceResponse parses an HTTP response from a HistoryByServiceWithResponse call
+func ParseHistoryByServiceResponse(rsp *http.Response) (*HistoryByServiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HistoryByServiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorNotFound
@@ -4485,6 +6480,46 @@ func ParseSitepairByIDResponse(rsp *http.Response) (*SitepairByIDResponse, error
 	return response, nil
 }
 
+// ParseHistoryBySitepairResponse parses an HTTP response from a HistoryBySitepairWithResponse call
+func ParseHistoryBySitepairResponse(rsp *http.Response) (*HistoryBySitepairResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HistoryBySitepairResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseSitesResponse parses an HTTP response from a SitesWithResponse call
 func ParseSitesResponse(rsp *http.Response) (*SitesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -4577,6 +6612,46 @@ func ParseHostsBySiteResponse(rsp *http.Response) (*HostsBySiteResponse, error)
 	return response, nil
 }
 
+// ParseLogsBySiteResponse parses an HTTP response from a LogsBySiteWithResponse call
+func ParseLogsBySiteResponse(rsp *http.Response) (*LogsBySiteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogsBySiteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetLogs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseProcessesBySiteResponse parses an HTTP response from a ProcessesBySiteWithResponse call
 func ParseProcessesBySiteResponse(rsp *http.Response) (*ProcessesBySiteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -4646,26 +6721,35 @@ func ParseRoutersBySiteResponse(rsp *http.Response) (*RoutersBySiteResponse, err
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /api/v2alpha1/alerts)
+	Alerts(w http.ResponseWriter, r *http.Request, params AlertsParams)
+
 	// (GET /api/v2alpha1/applicationflows)
-	Applicationflows(w http.ResponseWriter, r *http.Request)
+	Applicationflows(w http.ResponseWriter, r *http.Request, params ApplicationflowsParams)
+
+	// (GET /api/v2alpha1/applicationflows/export)
+	ExportApplicationflows(w http.ResponseWriter, r *http.Request, params ExportApplicationflowsParams)
 
 	// (GET /api/v2alpha1/componentpairs)
-	Componentpairs(w http.ResponseWriter, r *http.Request)
+	Componentpairs(w http.ResponseWriter, r *http.Request, params ComponentpairsParams)
 
 	// (GET /api/v2alpha1/componentpairs/{id})
 	ComponentpairByID(w http.ResponseWriter, r *http.Request, id PathID)
 
 	// (GET /api/v2alpha1/components)
-	Components(w http.ResponseWriter, r *http.Request)
+	Components(w http.ResponseWriter, r *http.Request, params ComponentsParams)
 
 	// (GET /api/v2alpha1/components/{id})
 	ComponentByID(w http.ResponseWriter, r *http.Request, id PathID)
 
 	// (GET /api/v2alpha1/connections)
-	Connections(w http.ResponseWriter, r *http.Request)
+	Connections(w http.ResponseWriter, r *http.Request, params ConnectionsParams)
+
+	// (GET /api/v2alpha1/connections/export)
+	ExportConnections(w http.ResponseWriter, r *http.Request, params ExportConnectionsParams)
 
 	// (GET /api/v2alpha1/connectors)
-	Connectors(w http.ResponseWriter, r *http.Request)
+	Connectors(w http.ResponseWriter, r *http.Request, params ConnectorsParams)
 
 	// (GET /api/v2alpha1/connectors/{id})
 	ConnectorByID(w http.ResponseWriter, r *http.Request, id PathID)
@@ -4677,76 +6761,97 @@ type ServerInterface interface {
 	HostsByID(w http.ResponseWriter, r *http.Request, id PathID)
 
 	// (GET /api/v2alpha1/listeners)
-	Listeners(w http.ResponseWriter, r *http.Request)
+	Listeners(w http.ResponseWriter, r *http.Request, params ListenersParams)
 
 	// (GET /api/v2alpha1/listeners/{id})
 	ListenerByID(w http.ResponseWriter, r *http.Request, id PathID)
+	// List the recent log messages of all routers
+	// (GET /api/v2alpha1/logs)
+	Logs(w http.ResponseWriter, r *http.Request, params LogsParams)
+
+	// (GET /api/v2alpha1/networks)
+	Networks(w http.ResponseWriter, r *http.Request)
 
 	// (GET /api/v2alpha1/processes)
-	Processes(w http.ResponseWriter, r *http.Request)
+	Processes(w http.ResponseWriter, r *http.Request, params ProcessesParams)
 
 	// (GET /api/v2alpha1/processes/{id})
 	ProcessById(w http.ResponseWriter, r *http.Request, id PathID)
 
 	// (GET /api/v2alpha1/processpairs)
-	Processpairs(w http.ResponseWriter, r *http.Request)
+	Processpairs(w http.ResponseWriter, r *http.Request, params ProcesspairsParams)
 
 	// (GET /api/v2alpha1/processpairs/{id})
 	ProcesspairByID(w http.ResponseWriter, r *http.Request, id PathID)
 
+	// (GET /api/v2alpha1/processpairs/{id}/history)
+	HistoryByProcesspair(w http.ResponseWriter, r *http.Request, id PathID)
+
 	// (GET /api/v2alpha1/routeraccess)
-	Routeraccess(w http.ResponseWriter, r *http.Request)
+	Routeraccess(w http.ResponseWriter, r *http.Request, params RouteraccessParams)
 
 	// (GET /api/v2alpha1/routeraccess/{id})
 	RouteraccessByID(w http.ResponseWriter, r *http.Request, id PathID)
 
 	// (GET /api/v2alpha1/routerlinks)
-	Routerlinks(w http.ResponseWriter, r *http.Request)
+	Routerlinks(w http.ResponseWriter, r *http.Request, params RouterlinksParams)
 
 	// (GET /api/v2alpha1/routerlinks/{id})
 	RouterlinkByID(w http.ResponseWriter, r *http.Request, id PathID)
 
 	// (GET /api/v2alpha1/routers)
-	Routers(w http.ResponseWriter, r *http.Request)
+	Routers(w http.ResponseWriter, r *http.Request, params RoutersParams)
 
 	// (GET /api/v2alpha1/routers/{id})
 	RouterByID(w http.ResponseWriter, r *http.Request, id PathID)
+	// List the recent log messages of a router
+	// (GET /api/v2alpha1/routers/{id}/logs)
+	LogsByRouter(w http.ResponseWriter, r *http.Request, id PathID, params LogsByRouterParams)
 
 	// (GET /api/v2alpha1/services)
-	Services(w http.ResponseWriter, r *http.Request)
+	Services(w http.ResponseWriter, r *http.Request, params ServicesParams)
 
 	// (GET /api/v2alpha1/services/{id})
 	ServiceByID(w http.ResponseWriter, r *http.Request, id PathID)
 
 	// (GET /api/v2alpha1/services/{id}/connections)
-	ConnectionsByService(w http.ResponseWriter, r *http.Request, id PathID)
+	ConnectionsByService(w http.ResponseWriter, r *http.Request, id PathID, params ConnectionsByServiceParams)
+
+	// (GET /api/v2alpha1/services/{id}/history)
+	HistoryByService(w http.ResponseWriter, r *http.Request, id PathID)
 
 	// (GET /api/v2alpha1/services/{id}/processes)
-	ProcessesByService(w http.ResponseWriter, r *http.Request, id PathID)
+	ProcessesByService(w http.ResponseWriter, r *http.Request, id PathID, params ProcessesByServiceParams)
 
 	// (GET /api/v2alpha1/services/{id}/processpairs)
-	ProcessPairsByService(w http.ResponseWriter, r *http.Request, id PathID)
+	ProcessPairsByService(w http.ResponseWriter, r *http.Request, id PathID, params ProcessPairsByServiceParams)
 
 	// (GET /api/v2alpha1/sitepairs)
-	Sitepairs(w http.ResponseWriter, r *http.Request)
+	Sitepairs(w http.ResponseWriter, r *http.Request, params SitepairsParams)
 
 	// (GET /api/v2alpha1/sitepairs/{id})
 	SitepairByID(w http.ResponseWriter, r *http.Request, id PathID)
 
+	// (GET /api/v2alpha1/sitepairs/{id}/history)
+	HistoryBySitepair(w http.ResponseWriter, r *http.Request, id PathID)
+
 	// (GET /api/v2alpha1/sites)
-	Sites(w http.ResponseWriter, r *http.Request)
+	Sites(w http.ResponseWriter, r *http.Request, params SitesParams)
 
 	// (GET /api/v2alpha1/sites/{id})
 	SiteById(w http.ResponseWriter, r *http.Request, id PathID)
 
 	// (GET /api/v2alpha1/sites/{id}/hosts)
 	HostsBySite(w http.ResponseWriter, r *http.Request, id PathID)
+	// List the recent log messages of the routers of a site
+	// (GET /api/v2alpha1/sites/{id}/logs)
+	LogsBySite(w http.ResponseWriter, r *http.Request, id PathID, params LogsBySiteParams)
 
 	// (GET /api/v2alpha1/sites/{id}/processes)
-	ProcessesBySite(w http.ResponseWriter, r *http.Request, id PathID)
+	ProcessesBySite(w http.ResponseWriter, r *http.Request, id PathID, params ProcessesBySiteParams)
 
 	// (GET /api/v2alpha1/sites/{id}/routers)
-	RoutersBySite(w http.ResponseWriter, r *http.Request, id PathID)
+	RoutersBySite(w http.ResponseWriter, r *http.Request, id PathID, params RoutersBySiteParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -4758,12 +6863,81 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// Alerts operation middleware
+func (siw *ServerInterfaceWrapper) Alerts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AlertsParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Alerts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // Applicationflows operation middleware
 func (siw *ServerInterfaceWrapper) Applicationflows(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApplicationflowsParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Applicationflows(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ExportApplicationflows operation middleware
+func (siw *ServerInterfaceWrapper) ExportApplicationflows(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportApplicationflowsParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Applicationflows(w, r)
+		siw.Handler.ExportApplicationflows(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4777,8 +6951,21 @@ func (siw *ServerInterfaceWrapper) Applicationflows(w http.ResponseWriter, r *ht
 func (siw *ServerInterfaceWrapper) Componentpairs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ComponentpairsParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Componentpairs(w, r)
+		siw.Handler.Componentpairs(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4818,8 +7005,21 @@ func (siw *ServerInterfaceWrapper) ComponentpairByID(w http.ResponseWriter, r *h
 func (siw *ServerInterfaceWrapper) Components(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ComponentsParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Components(w, r)
+		siw.Handler.Components(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4859,8 +7059,49 @@ func (siw *ServerInterfaceWrapper) ComponentByID(w http.ResponseWriter, r *http.
 func (siw *ServerInterfaceWrapper) Connections(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConnectionsParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Connections(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ExportConnections operation middleware
+func (siw *ServerInterfaceWrapper) ExportConnections(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportConnectionsParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Connections(w, r)
+		siw.Handler.ExportConnections(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4874,8 +7115,21 @@ func (siw *ServerInterfaceWrapper) Connections(w http.ResponseWriter, r *http.Re
 func (siw *ServerInterfaceWrapper) Connectors(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConnectorsParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Connectors(w, r)
+		siw.Handler.Connectors(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4932,17 +7186,99 @@ func (siw *ServerInterfaceWrapper) HostsByID(w http.ResponseWriter, r *http.Requ
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id PathID
+	// ------------- Path parameter "id" -------------
+	var id PathID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HostsByID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// Listeners operation middleware
+func (siw *ServerInterfaceWrapper) Listeners(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListenersParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Listeners(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListenerByID operation middleware
+func (siw *ServerInterfaceWrapper) ListenerByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PathID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListenerByID(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// Logs operation middleware
+func (siw *ServerInterfaceWrapper) Logs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LogsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HostsByID(w, r, id)
+		siw.Handler.Logs(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4952,12 +7288,12 @@ func (siw *ServerInterfaceWrapper) HostsByID(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Listeners operation middleware
-func (siw *ServerInterfaceWrapper) Listeners(w http.ResponseWriter, r *http.Request) {
+// Networks operation middleware
+func (siw *ServerInterfaceWrapper) Networks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Listeners(w, r)
+		siw.Handler.Networks(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4967,38 +7303,25 @@ func (siw *ServerInterfaceWrapper) Listeners(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListenerByID operation middleware
-func (siw *ServerInterfaceWrapper) ListenerByID(w http.ResponseWriter, r *http.Request) {
+// Processes operation middleware
+func (siw *ServerInterfaceWrapper) Processes(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id PathID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProcessesParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListenerByID(w, r, id)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// Processes operation middleware
-func (siw *ServerInterfaceWrapper) Processes(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Processes(w, r)
+		siw.Handler.Processes(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5038,8 +7361,21 @@ func (siw *ServerInterfaceWrapper) ProcessById(w http.ResponseWriter, r *http.Re
 func (siw *ServerInterfaceWrapper) Processpairs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProcesspairsParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Processpairs(w, r)
+		siw.Handler.Processpairs(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5075,12 +7411,51 @@ func (siw *ServerInterfaceWrapper) ProcesspairByID(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// HistoryByProcesspair operation middleware
+func (siw *ServerInterfaceWrapper) HistoryByProcesspair(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PathID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HistoryByProcesspair(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // Routeraccess operation middleware
 func (siw *ServerInterfaceWrapper) Routeraccess(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RouteraccessParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Routeraccess(w, r)
+		siw.Handler.Routeraccess(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5120,8 +7495,21 @@ func (siw *ServerInterfaceWrapper) RouteraccessByID(w http.ResponseWriter, r *ht
 func (siw *ServerInterfaceWrapper) Routerlinks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RouterlinksParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Routerlinks(w, r)
+		siw.Handler.Routerlinks(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5161,8 +7549,21 @@ func (siw *ServerInterfaceWrapper) RouterlinkByID(w http.ResponseWriter, r *http
 func (siw *ServerInterfaceWrapper) Routers(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RoutersParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Routers(w, r)
+		siw.Handler.Routers(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5198,12 +7599,62 @@ func (siw *ServerInterfaceWrapper) RouterByID(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// LogsByRouter operation middleware
+func (siw *ServerInterfaceWrapper) LogsByRouter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PathID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LogsByRouterParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LogsByRouter(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // Services operation middleware
 func (siw *ServerInterfaceWrapper) Services(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ServicesParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Services(w, r)
+		siw.Handler.Services(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5254,8 +7705,45 @@ func (siw *ServerInterfaceWrapper) ConnectionsByService(w http.ResponseWriter, r
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConnectionsByServiceParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConnectionsByService(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HistoryByService operation middleware
+func (siw *ServerInterfaceWrapper) HistoryByService(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PathID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ConnectionsByService(w, r, id)
+		siw.Handler.HistoryByService(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5280,8 +7768,19 @@ func (siw *ServerInterfaceWrapper) ProcessesByService(w http.ResponseWriter, r *
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProcessesByServiceParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ProcessesByService(w, r, id)
+		siw.Handler.ProcessesByService(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5306,8 +7805,19 @@ func (siw *ServerInterfaceWrapper) ProcessPairsByService(w http.ResponseWriter,
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProcessPairsByServiceParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ProcessPairsByService(w, r, id)
+		siw.Handler.ProcessPairsByService(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5321,8 +7831,21 @@ func (siw *ServerInterfaceWrapper) ProcessPairsByService(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) Sitepairs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SitepairsParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Sitepairs(w, r)
+		siw.Handler.Sitepairs(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5358,12 +7881,51 @@ func (siw *ServerInterfaceWrapper) SitepairByID(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// HistoryBySitepair operation middleware
+func (siw *ServerInterfaceWrapper) HistoryBySitepair(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PathID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HistoryBySitepair(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // Sites operation middleware
 func (siw *ServerInterfaceWrapper) Sites(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SitesParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Sites(w, r)
+		siw.Handler.Sites(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5425,6 +7987,43 @@ func (siw *ServerInterfaceWrapper) HostsBySite(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// LogsBySite operation middleware
+func (siw *ServerInterfaceWrapper) LogsBySite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id PathID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", mux.Vars(r)["id"], &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LogsBySiteParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LogsBySite(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // ProcessesBySite operation middleware
 func (siw *ServerInterfaceWrapper) ProcessesBySite(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -5440,8 +8039,19 @@ func (siw *ServerInterfaceWrapper) ProcessesBySite(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProcessesBySiteParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ProcessesBySite(w, r, id)
+		siw.Handler.ProcessesBySite(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5466,8 +8076,19 @@ func (siw *ServerInterfaceWrapper) RoutersBySite(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RoutersBySiteParams
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RoutersBySite(w, r, id)
+		siw.Handler.RoutersBySite(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5590,8 +8211,12 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/alerts", wrapper.Alerts).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/applicationflows", wrapper.Applicationflows).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/applicationflows/export", wrapper.ExportApplicationflows).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/componentpairs", wrapper.Componentpairs).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/componentpairs/{id}", wrapper.ComponentpairByID).Methods("GET")
@@ -5602,6 +8227,8 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/connections", wrapper.Connections).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/connections/export", wrapper.ExportConnections).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/connectors", wrapper.Connectors).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/connectors/{id}", wrapper.ConnectorByID).Methods("GET")
@@ -5614,6 +8241,10 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/listeners/{id}", wrapper.ListenerByID).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/logs", wrapper.Logs).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/networks", wrapper.Networks).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/processes", wrapper.Processes).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/processes/{id}", wrapper.ProcessById).Methods("GET")
@@ -5622,6 +8253,8 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/processpairs/{id}", wrapper.ProcesspairByID).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/processpairs/{id}/history", wrapper.HistoryByProcesspair).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/routeraccess", wrapper.Routeraccess).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/routeraccess/{id}", wrapper.RouteraccessByID).Methods("GET")
@@ -5634,12 +8267,16 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/routers/{id}", wrapper.RouterByID).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/routers/{id}/logs", wrapper.LogsByRouter).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/services", wrapper.Services).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/services/{id}", wrapper.ServiceByID).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/services/{id}/connections", wrapper.ConnectionsByService).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/services/{id}/history", wrapper.HistoryByService).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/services/{id}/processes", wrapper.ProcessesByService).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/services/{id}/processpairs", wrapper.ProcessPairsByService).Methods("GET")
@@ -5648,12 +8285,16 @@ func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.H
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/sitepairs/{id}", wrapper.SitepairByID).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/sitepairs/{id}/history", wrapper.HistoryBySitepair).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/sites", wrapper.Sites).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/sites/{id}", wrapper.SiteById).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/sites/{id}/hosts", wrapper.HostsBySite).Methods("GET")
 
+	r.HandleFunc(options.BaseURL+"/api/v2alpha1/sites/{id}/logs", wrapper.LogsBySite).Methods("GET")
+
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/sites/{id}/processes", wrapper.ProcessesBySite).Methods("GET")
 
 	r.HandleFunc(options.BaseURL+"/api/v2alpha1/sites/{id}/routers", wrapper.RoutersBySite).Methods("GET")
@@ -21,7 +21,11 @@ Intended for internal use. API is not stable.
 
 	ensure-secret:
 		Provisions kubernetes Secrets related to the execution of the
-		network-observer.`
+		network-observer.
+
+	export:
+		Streams flow records from a running network-observer in ndjson,
+		csv or parquet format.`
 const ensureSecretCmd string = "ensure-secret"
 const ensureSecretDesc string = `Creates a kubernetes secret <secret name> with randomly generated contents
 based on one of the preconfigured formats when the secret does not already exist.`
@@ -34,6 +38,11 @@ func Run(args []string) {
 			slog.Error("ensure secret error", slog.Any("error", err))
 			os.Exit(1)
 		}
+	case exportCmd:
+		if err := runExport(args); err != nil {
+			slog.Error("export error", slog.Any("error", err))
+			os.Exit(1)
+		}
 	default:
 		fmt.Printf("Unknown command %q\n", subcommand)
 		fmt.Println(usage)
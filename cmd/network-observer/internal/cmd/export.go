@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	iflag "github.com/skupperproject/skupper/internal/flag"
+)
+
+const exportCmd string = "export"
+const exportDesc string = `Streams connection or application flow records from a running network-observer
+to a file (or stdout) in ndjson, csv or parquet format. Any additional
+arguments of the form <field>=<value> filter the exported records in the same
+way as the equivalent API query parameters.`
+
+type exportOptions struct {
+	URL      string
+	Records  string
+	Format   string
+	Output   string
+	Since    time.Duration
+	State    string
+	Insecure bool
+	Filters  []string
+}
+
+func runExport(args []string) error {
+	var opts exportOptions
+	flags := flag.NewFlagSet(exportCmd, flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage of %s %s [options...] [<field>=<value>...]\n", os.Args[0], args[0])
+		fmt.Fprintln(flags.Output(), exportDesc)
+		flags.PrintDefaults()
+	}
+	iflag.StringVar(flags, &opts.URL, "url", "NETWORK_OBSERVER_URL", "http://localhost:8080", "Base URL of the network-observer API")
+	iflag.StringVar(flags, &opts.Records, "records", "EXPORT_RECORDS", "connections", "Records to export. One of [connections, applicationflows]")
+	iflag.StringVar(flags, &opts.Format, "format", "EXPORT_FORMAT", "ndjson", "Export format. One of [ndjson, csv, parquet]")
+	iflag.StringVar(flags, &opts.Output, "output", "EXPORT_OUTPUT", "-", "File to write the export to, or - for stdout")
+	iflag.StringVar(flags, &opts.State, "state", "EXPORT_STATE", "all", "Record state to export. One of [all, active, terminated]")
+	flags.BoolVar(&opts.Insecure, "insecure", false, "Skip verification of the API server certificate")
+	flags.DurationVar(&opts.Since, "since", 0, "Only export records active within this duration of now. Zero exports all retained records")
+	flags.Parse(args[1:])
+	opts.Filters = flags.Args()
+
+	out := io.Writer(os.Stdout)
+	if opts.Output != "-" && opts.Output != "" {
+		f, err := os.Create(opts.Output)
+		if err != nil {
+			return fmt.Errorf("error creating output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	httpClient := http.DefaultClient
+	if opts.Insecure {
+		httpClient = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		}
+	}
+	client, err := api.NewClient(opts.URL, api.WithHTTPClient(httpClient))
+	if err != nil {
+		return fmt.Errorf("error creating api client: %w", err)
+	}
+	return export(context.Background(), client, opts, time.Now(), out)
+}
+
+// export requests the records described by opts from the API and copies
+// the response body to out as it is received.
+func export(ctx context.Context, client api.ClientInterface, opts exportOptions, now time.Time, out io.Writer) error {
+	params, err := opts.query(now)
+	if err != nil {
+		return err
+	}
+	withQuery := func(ctx context.Context, req *http.Request) error {
+		req.URL.RawQuery = params
+		return nil
+	}
+
+	var resp *http.Response
+	switch opts.Records {
+	case "connections":
+		resp, err = client.ExportConnections(ctx, nil, withQuery)
+	case "applicationflows":
+		resp, err = client.ExportApplicationflows(ctx, nil, withQuery)
+	default:
+		return fmt.Errorf("records %q not supported: expected one of connections or applicationflows", opts.Records)
+	}
+	if err != nil {
+		return fmt.Errorf("export request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr api.ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err == nil && apiErr.Message != "" {
+			return fmt.Errorf("export request failed: %s: %s", resp.Status, apiErr.Message)
+		}
+		return fmt.Errorf("export request failed: %s", resp.Status)
+	}
+	if _, err := io.Copy(out, resp.Body); err != nil {
+		return fmt.Errorf("error writing export: %w", err)
+	}
+	return nil
+}
+
+func (opts exportOptions) query(now time.Time) (string, error) {
+	params := url.Values{}
+	add := params.Add
+	add("format", opts.Format)
+	add("state", opts.State)
+	if opts.Since > 0 {
+		add("timeRangeStart", fmt.Sprint(now.Add(-opts.Since).UnixMicro()))
+	} else {
+		add("timeRangeStart", "0")
+	}
+	add("timeRangeEnd", fmt.Sprint(now.UnixMicro()))
+	for _, filter := range opts.Filters {
+		field, value, ok := strings.Cut(filter, "=")
+		if !ok || field == "" {
+			return "", fmt.Errorf("invalid filter %q: expected <field>=<value>", filter)
+		}
+		add(field, value)
+	}
+	return params.Encode(), nil
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"gotest.tools/v3/assert"
+)
+
+func TestExport(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		Name        string
+		Opts        exportOptions
+		ExpectPath  string
+		ExpectQuery url.Values
+		ExpectErr   string
+	}{
+		{
+			Name:       "connections",
+			Opts:       exportOptions{Records: "connections", Format: "csv", State: "all", Filters: []string{"routingKey=backend"}},
+			ExpectPath: "/api/v2alpha1/connections/export",
+			ExpectQuery: url.Values{
+				"format":         {"csv"},
+				"state":          {"all"},
+				"timeRangeStart": {"0"},
+				"timeRangeEnd":   {fmt.Sprint(now.UnixMicro())},
+				"routingKey":     {"backend"},
+			},
+		}, {
+			Name:       "applicationflows since",
+			Opts:       exportOptions{Records: "applicationflows", Format: "parquet", State: "terminated", Since: time.Hour},
+			ExpectPath: "/api/v2alpha1/applicationflows/export",
+			ExpectQuery: url.Values{
+				"format":         {"parquet"},
+				"state":          {"terminated"},
+				"timeRangeStart": {fmt.Sprint(now.Add(-time.Hour).UnixMicro())},
+				"timeRangeEnd":   {fmt.Sprint(now.UnixMicro())},
+			},
+		}, {
+			Name:      "bad records",
+			Opts:      exportOptions{Records: "sites"},
+			ExpectErr: `records "sites" not supported: expected one of connections or applicationflows`,
+		}, {
+			Name:      "bad filter",
+			Opts:      exportOptions{Records: "connections", Filters: []string{"routingKey"}},
+			ExpectErr: `invalid filter "routingKey": expected <field>=<value>`,
+		}, {
+			Name:       "server error",
+			Opts:       exportOptions{Records: "connections", Format: "xml"},
+			ExpectPath: "/api/v2alpha1/connections/export",
+			ExpectErr:  `export request failed: 400 Bad Request: invalid format "xml"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, r.URL.Path, tc.ExpectPath)
+				if r.URL.Query().Get("format") == "xml" {
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(http.StatusBadRequest)
+					fmt.Fprint(w, `{"code":"ErrBadRequest","message":"invalid format \"xml\""}`)
+					return
+				}
+				assert.DeepEqual(t, r.URL.Query(), tc.ExpectQuery)
+				fmt.Fprint(w, "exported")
+			}))
+			defer srv.Close()
+			client, err := api.NewClient(srv.URL)
+			assert.Assert(t, err)
+
+			var out bytes.Buffer
+			err = export(context.Background(), client, tc.Opts, now, &out)
+			if tc.ExpectErr != "" {
+				assert.Error(t, err, tc.ExpectErr)
+				return
+			}
+			assert.Assert(t, err)
+			assert.Equal(t, out.String(), "exported")
+		})
+	}
+}
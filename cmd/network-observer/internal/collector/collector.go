@@ -23,7 +23,7 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
-func New(logger *slog.Logger, factory session.ContainerFactory, reg *prometheus.Registry, flowRecordTTL time.Duration, flowLogger func(vanflow.RecordMessage)) *Collector {
+func New(logger *slog.Logger, factory session.ContainerFactory, reg prometheus.Registerer, flowRecordTTL time.Duration, flowLogger func(vanflow.RecordMessage)) *Collector {
 	sessionCtr := factory.Create()
 
 	collector := &Collector{
@@ -37,6 +37,7 @@ func New(logger *slog.Logger, factory session.ContainerFactory, reg *prometheus.
 		recordRouting:  make(eventsource.RecordStoreMap),
 		metrics:        register(reg),
 		metricsAdaptor: opmetrics.New(reg),
+		history:        newHistory(HistoryResolutions),
 		flowLogging:    flowLogger,
 	}
 
@@ -52,6 +53,7 @@ func New(logger *slog.Logger, factory session.ContainerFactory, reg *prometheus.
 	collector.processManager = newProcessManager(logger, collector.Records, collector.graph, newStableIdentityProvider(), collector.metrics)
 	collector.addressManager = newAddressManager(collector.logger, collector.Records)
 	collector.pairManager = newPairManager(logger, collector.Records, collector.graph, collector.metrics)
+	collector.logs = newLogs(collector.Records, LogsPerRouter)
 	routerCfg := collector.recordRouting
 	for _, typ := range standardRecordTypes {
 		routerCfg[typ.String()] = collector.Records
@@ -78,6 +80,9 @@ type Collector struct {
 	addressManager *addressManager
 	pairManager    *pairManager
 	metricsAdaptor *opmetrics.Adaptor
+	history        *history
+	logs           *logs
+	storeHandlers  []store.EventHandlerFuncs
 
 	events     chan changeEvent
 	purgeQueue chan store.SourceRef
@@ -94,6 +99,21 @@ func (c *Collector) GetGraph() Graph {
 	return c.graph
 }
 
+func (c *Collector) GetHistory() History {
+	return c.history
+}
+
+func (c *Collector) GetLogs() Logs {
+	return c.logs
+}
+
+// AddStoreHandlers registers handlers called for every record added to,
+// changed in or deleted from the collector's store, including the records
+// inferred by the collector. It must be called before Run.
+func (c *Collector) AddStoreHandlers(handlers store.EventHandlerFuncs) {
+	c.storeHandlers = append(c.storeHandlers, handlers)
+}
+
 func (c *Collector) Run(ctx context.Context) error {
 	c.session.Start(ctx)
 	g, ctx := errgroup.WithContext(ctx)
@@ -105,6 +125,7 @@ func (c *Collector) Run(ctx context.Context) error {
 	g.Go(c.processManager.run(ctx))
 	g.Go(c.addressManager.run(ctx))
 	g.Go(c.pairManager.run(ctx))
+	g.Go(c.history.run(ctx))
 	return g.Wait()
 }
 
@@ -277,6 +298,11 @@ func (c *Collector) runRecordCleanup(ctx context.Context) func() error {
 }
 
 func (c *Collector) handleStoreAdd(e store.Entry) {
+	for _, h := range c.storeHandlers {
+		if h.OnAdd != nil {
+			h.OnAdd(e)
+		}
+	}
 	switch e.Record.(type) {
 	case RequestRecord:
 		return
@@ -291,6 +317,11 @@ func (c *Collector) handleStoreAdd(e store.Entry) {
 }
 
 func (c *Collector) handleStoreChange(p, e store.Entry) {
+	for _, h := range c.storeHandlers {
+		if h.OnChange != nil {
+			h.OnChange(p, e)
+		}
+	}
 	switch e.Record.(type) {
 	case RequestRecord:
 		return
@@ -304,6 +335,11 @@ func (c *Collector) handleStoreChange(p, e store.Entry) {
 	}
 }
 func (c *Collector) handleStoreDelete(e store.Entry) {
+	for _, h := range c.storeHandlers {
+		if h.OnDelete != nil {
+			h.OnDelete(e)
+		}
+	}
 	switch e.Record.(type) {
 	case RequestRecord:
 		return
@@ -364,6 +400,8 @@ func (c *Collector) discoveryHandler(ctx context.Context) func(eventsource.Info)
 			addresses = append(addresses, eventsource.FromSourceAddressHeartbeats()) // listen to .heartbeats
 		case "ROUTER":
 			addresses = append(addresses, eventsource.FromSourceAddressFlows()) // listen to .flows
+			addresses = append(addresses, eventsource.FromSourceAddressLogs())  // listen to .logs
+			client.OnRecord(c.logs.handler(sourceRef(source)))
 			sourceCtr.manager = newConnectionmanager(
 				ctx,
 				c.logger.With(slog.String("eventsource", fmt.Sprintf("%d/%s", source.Version, source.ID))),
@@ -371,6 +409,7 @@ func (c *Collector) discoveryHandler(ctx context.Context) func(eventsource.Info)
 				c.Records,
 				c.graph,
 				c.metrics,
+				c.history,
 				c.flowRecordTTL,
 			)
 
@@ -422,6 +461,7 @@ func (c *Collector) handleForgotten(source eventsource.Info) {
 		}
 		delete(c.sources, source.ID)
 	}
+	c.logs.forget(sourceRef(source))
 	c.purgeQueue <- sourceRef(source)
 }
 
@@ -26,6 +26,7 @@ type connectionManager struct {
 	graph                 *graph
 	idp                   idProvider
 	metrics               metrics
+	history               *history
 	mcMu                  sync.Mutex
 	requestMetricsCache   map[labelSet]appMetrics
 	transportMetricsCache map[labelSet]transportMetrics
@@ -47,7 +48,7 @@ type connectionManager struct {
 	routerCache     map[string]routerAttrs
 }
 
-func newConnectionmanager(ctx context.Context, log *slog.Logger, source store.SourceRef, records store.Interface, graph *graph, metrics metrics, ttl time.Duration) *connectionManager {
+func newConnectionmanager(ctx context.Context, log *slog.Logger, source store.SourceRef, records store.Interface, graph *graph, metrics metrics, history *history, ttl time.Duration) *connectionManager {
 	m := &connectionManager{
 		logger:                  log,
 		records:                 records,
@@ -55,6 +56,7 @@ func newConnectionmanager(ctx context.Context, log *slog.Logger, source store.So
 		source:                  source,
 		idp:                     newStableIdentityProvider(),
 		metrics:                 metrics,
+		history:                 history,
 		ttl:                     ttl,
 		transportProcessingTime: metrics.internal.flowProcessingTime.WithLabelValues(vanflow.TransportBiflowRecord{}.GetTypeMeta().String()),
 		appProcessingTime:       metrics.internal.flowProcessingTime.WithLabelValues(vanflow.AppBiflowRecord{}.GetTypeMeta().String()),
@@ -100,16 +102,19 @@ func (c *connectionManager) handleTransportFlow(record vanflow.TransportBiflowRe
 		c.transportFlows.Push(record.ID, state)
 		return
 	}
+	var delta historyDelta
 	if !state.Opened {
 		metrics.opened.Inc()
 		metrics.closed.Add(0)
 		state.Opened = true
+		delta.Opened = 1
 	}
 	if !state.Terminated && record.EndTime != nil {
 		terminated := record.EndTime.Compare(dref(record.StartTime).Time) >= 0
 		if terminated {
 			state.Terminated = true
 			metrics.closed.Inc()
+			delta.Closed = 1
 		}
 	}
 	if !state.LatencySet && record.Latency != nil && record.LatencyReverse != nil {
@@ -125,9 +130,16 @@ func (c *connectionManager) handleTransportFlow(record vanflow.TransportBiflowRe
 	if receivedInc != 0 {
 		metrics.sent.Add(sentInc)
 		metrics.received.Add(receivedInc)
+		if bs > state.BytesSent {
+			delta.Octets = bs - state.BytesSent
+		}
+		if br > state.BytesReceived {
+			delta.OctetsReverse = br - state.BytesReceived
+		}
 		state.BytesSent = bs
 		state.BytesReceived = br
 	}
+	c.history.record(metrics.historyIDs, state.LastSeen, delta)
 	c.transportFlows.Push(record.ID, state)
 }
 
@@ -390,6 +402,11 @@ func (c *connectionManager) reconcile(state transportState) (ConnectionRecord, r
 		FlowStore: c.flows,
 	}
 	cr.metrics = c.getTransportMetricSet(cr.toLabelSet())
+	cr.metrics.historyIDs = []string{
+		c.idp.ID("processpair", cr.Source.ID, cr.Dest.ID, cr.Protocol),
+		c.idp.ID("sitepair", cr.SourceSite.ID, cr.DestSite.ID, cr.Protocol),
+		c.idp.ID("adr", cr.RoutingKey, cr.Protocol),
+	}
 	return cr, success
 }
 
@@ -840,6 +857,10 @@ type transportMetrics struct {
 	latency              prometheus.Observer
 	latencyLegacy        prometheus.Observer
 	latencyLegacyReverse prometheus.Observer
+
+	// historyIDs identify the process pair, site pair and address series
+	// the flow contributes to in the traffic history
+	historyIDs []string
 }
 type appMetrics struct {
 	requests *prometheus.CounterVec
@@ -375,13 +375,18 @@ func (n Listener) GetRecord() (record vanflow.ListenerRecord, found bool) {
 	return getrecord[vanflow.ListenerRecord](n)
 }
 
-func (n Listener) Parent() Router   { return parentOfType[Router](n.dag, n.identity) }
-func (n Listener) Address() Address { return parentOfType[RoutingKey](n.dag, n.identity).Parent() }
+func (n Listener) Parent() Router         { return parentOfType[Router](n.dag, n.identity) }
+func (n Listener) RoutingKey() RoutingKey { return parentOfType[RoutingKey](n.dag, n.identity) }
+func (n Listener) Address() Address       { return n.RoutingKey().Parent() }
 
 type Connector struct {
 	baseNode
 }
 
+func (n Connector) GetRecord() (record vanflow.ConnectorRecord, found bool) {
+	return getrecord[vanflow.ConnectorRecord](n)
+}
+
 func (n Connector) Parent() Router { return parentOfType[Router](n.dag, n.identity) }
 
 func (n Connector) Address() Address {
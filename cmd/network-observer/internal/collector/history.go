@@ -0,0 +1,177 @@
+package collector
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// HistoryResolution describes one tier of the traffic history rollups: the
+// width of each bucket and how long buckets are retained for.
+type HistoryResolution struct {
+	Name      string
+	Width     time.Duration
+	Retention time.Duration
+}
+
+// HistoryResolutions are the fixed set of rollup tiers maintained for each
+// series, from finest to coarsest.
+var HistoryResolutions = []HistoryResolution{
+	{Name: "1m", Width: time.Minute, Retention: 6 * time.Hour},
+	{Name: "10m", Width: 10 * time.Minute, Retention: 48 * time.Hour},
+	{Name: "1h", Width: time.Hour, Retention: 14 * 24 * time.Hour},
+}
+
+// HistoryBucket holds the transport flow activity observed within a single
+// fixed width time window.
+type HistoryBucket struct {
+	Start         time.Time
+	End           time.Time
+	Octets        uint64
+	OctetsReverse uint64
+	Opened        uint64
+	Closed        uint64
+}
+
+// History provides time series rollups of transport flow activity. Series
+// are identified by the ID of the process pair, site pair or address
+// (routing key) record they describe.
+type History interface {
+	// Buckets returns the non-empty buckets retained for a series at the
+	// named resolution ordered by start time. Returns false when the
+	// resolution is unknown.
+	Buckets(id string, resolution string) ([]HistoryBucket, bool)
+}
+
+func newHistory(resolutions []HistoryResolution) *history {
+	return &history{
+		resolutions: resolutions,
+		series:      make(map[string]*historySeries),
+	}
+}
+
+type history struct {
+	mu          sync.Mutex
+	resolutions []HistoryResolution
+	series      map[string]*historySeries
+}
+
+type historySeries struct {
+	// buckets for each resolution in the same order as history.resolutions
+	buckets [][]HistoryBucket
+}
+
+type historyDelta struct {
+	Octets        uint64
+	OctetsReverse uint64
+	Opened        uint64
+	Closed        uint64
+}
+
+func (d historyDelta) isZero() bool {
+	return d == historyDelta{}
+}
+
+func (h *history) Buckets(id string, resolution string) ([]HistoryBucket, bool) {
+	if h == nil {
+		return nil, false
+	}
+	idx := -1
+	for i, r := range h.resolutions {
+		if r.Name == resolution {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return nil, false
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.series[id]
+	if !ok {
+		return []HistoryBucket{}, true
+	}
+	out := make([]HistoryBucket, len(s.buckets[idx]))
+	copy(out, s.buckets[idx])
+	return out, true
+}
+
+// record adds the delta to the current bucket of each resolution for each
+// of the identified series.
+func (h *history) record(ids []string, at time.Time, delta historyDelta) {
+	if h == nil || delta.isZero() {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, id := range ids {
+		s, ok := h.series[id]
+		if !ok {
+			s = &historySeries{
+				buckets: make([][]HistoryBucket, len(h.resolutions)),
+			}
+			h.series[id] = s
+		}
+		for i, r := range h.resolutions {
+			start := at.Truncate(r.Width)
+			buckets := s.buckets[i]
+			pos := sort.Search(len(buckets), func(j int) bool {
+				return !buckets[j].Start.Before(start)
+			})
+			if pos == len(buckets) || !buckets[pos].Start.Equal(start) {
+				buckets = append(buckets, HistoryBucket{})
+				copy(buckets[pos+1:], buckets[pos:])
+				buckets[pos] = HistoryBucket{Start: start, End: start.Add(r.Width)}
+			}
+			b := &buckets[pos]
+			b.Octets += delta.Octets
+			b.OctetsReverse += delta.OctetsReverse
+			b.Opened += delta.Opened
+			b.Closed += delta.Closed
+			s.buckets[i] = buckets
+		}
+	}
+}
+
+// prune discards buckets that have aged out of their retention window and
+// any series left without buckets.
+func (h *history) prune(now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for id, s := range h.series {
+		empty := true
+		for i, r := range h.resolutions {
+			cutoff := now.Add(-r.Retention)
+			buckets := s.buckets[i]
+			pos := sort.Search(len(buckets), func(j int) bool {
+				return buckets[j].End.After(cutoff)
+			})
+			if pos > 0 {
+				s.buckets[i] = append(buckets[:0:0], buckets[pos:]...)
+			}
+			if len(s.buckets[i]) > 0 {
+				empty = false
+			}
+		}
+		if empty {
+			delete(h.series, id)
+		}
+	}
+}
+
+func (h *history) run(ctx context.Context) func() error {
+	return func() error {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case now := <-ticker.C:
+				h.prune(now)
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package collector
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestHistory(t *testing.T) {
+	h := newHistory([]HistoryResolution{
+		{Name: "1m", Width: time.Minute, Retention: 5 * time.Minute},
+		{Name: "10m", Width: 10 * time.Minute, Retention: time.Hour},
+	})
+	t0 := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	h.record([]string{"pp1", "sp1"}, t0.Add(10*time.Second), historyDelta{Opened: 1, Octets: 100})
+	h.record([]string{"pp1"}, t0.Add(70*time.Second), historyDelta{Octets: 50, OctetsReverse: 25, Closed: 1})
+	// buckets may be updated out of order
+	h.record([]string{"pp1"}, t0.Add(-50*time.Second), historyDelta{Opened: 1})
+	h.record([]string{"pp1"}, t0.Add(20*time.Second), historyDelta{})
+
+	buckets, ok := h.Buckets("pp1", "1m")
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, buckets, []HistoryBucket{
+		{Start: t0.Add(-time.Minute), End: t0, Opened: 1},
+		{Start: t0, End: t0.Add(time.Minute), Opened: 1, Octets: 100},
+		{Start: t0.Add(time.Minute), End: t0.Add(2 * time.Minute), Octets: 50, OctetsReverse: 25, Closed: 1},
+	})
+	buckets, ok = h.Buckets("pp1", "10m")
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, buckets, []HistoryBucket{
+		{Start: t0.Add(-10 * time.Minute), End: t0, Opened: 1},
+		{Start: t0, End: t0.Add(10 * time.Minute), Opened: 1, Octets: 150, OctetsReverse: 25, Closed: 1},
+	})
+	buckets, ok = h.Buckets("sp1", "1m")
+	assert.Assert(t, ok)
+	assert.Equal(t, len(buckets), 1)
+
+	buckets, ok = h.Buckets("unknown", "1m")
+	assert.Assert(t, ok)
+	assert.Equal(t, len(buckets), 0)
+	_, ok = h.Buckets("pp1", "1d")
+	assert.Assert(t, !ok)
+
+	h.prune(t0.Add(6 * time.Minute))
+	buckets, _ = h.Buckets("pp1", "1m")
+	assert.Equal(t, len(buckets), 1)
+	assert.Equal(t, buckets[0].Start, t0.Add(time.Minute))
+	buckets, _ = h.Buckets("pp1", "10m")
+	assert.Equal(t, len(buckets), 2)
+
+	h.prune(t0.Add(2 * time.Hour))
+	assert.Equal(t, len(h.series), 0)
+}
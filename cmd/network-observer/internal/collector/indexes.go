@@ -16,6 +16,7 @@ var standardRecordTypes []vanflow.TypeMeta = []vanflow.TypeMeta{
 	vanflow.ConnectorRecord{}.GetTypeMeta(),
 	vanflow.ListenerRecord{}.GetTypeMeta(),
 	vanflow.ProcessRecord{}.GetTypeMeta(),
+	vanflow.SiteProbeRecord{}.GetTypeMeta(),
 }
 
 var flowRecordTypes []vanflow.TypeMeta = []vanflow.TypeMeta{
@@ -109,6 +110,8 @@ func indexByTypeParent(e store.Entry) []string {
 		return optionalSingle(record.GetTypeMeta().String(), record.Parent)
 	case vanflow.ProcessRecord:
 		return optionalSingle(record.GetTypeMeta().String(), record.Parent)
+	case vanflow.SiteProbeRecord:
+		return optionalSingle(record.GetTypeMeta().String(), record.Parent)
 	default:
 		return nil
 	}
@@ -165,6 +168,8 @@ func indexByLifecycleStatus(e store.Entry) []string {
 		return lifecycleState(record.BaseRecord)
 	case vanflow.ProcessRecord:
 		return lifecycleState(record.BaseRecord)
+	case vanflow.SiteProbeRecord:
+		return lifecycleState(record.BaseRecord)
 	default:
 		return nil
 	}
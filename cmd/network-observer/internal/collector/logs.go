@@ -0,0 +1,191 @@
+package collector
+
+import (
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+)
+
+// LogsPerRouter is the number of log messages retained for each router.
+// Once reached, the oldest message is dropped for each new one received.
+const LogsPerRouter = 512
+
+// LogEntry is a log message emitted by a router as a vanflow LogRecord along
+// with the router and site that emitted it.
+type LogEntry struct {
+	ID         string
+	Time       time.Time
+	Severity   string
+	Module     string
+	Text       string
+	SourceFile string
+	SourceLine uint64
+
+	RouterID   string
+	RouterName string
+	SiteID     string
+	SiteName   string
+}
+
+// Logs provides the recent log messages received from routers.
+type Logs interface {
+	// Entries returns the retained log messages from all routers ordered by
+	// time.
+	Entries() []LogEntry
+}
+
+// logSeverities maps the router log level bits used for the LogSeverity
+// attribute to their names.
+var logSeverities = map[uint64]string{
+	0x01: "trace",
+	0x02: "debug",
+	0x04: "info",
+	0x08: "notice",
+	0x10: "warning",
+	0x20: "error",
+	0x40: "critical",
+}
+
+// logPrefixPattern matches the "MODULE (severity) " prefix the router
+// includes in formatted log text.
+var logPrefixPattern = regexp.MustCompile(`^([A-Z][A-Z0-9_]*) \(([a-z]+)\) `)
+
+func newLogs(records store.Interface, capacity int) *logs {
+	return &logs{
+		records:  records,
+		capacity: capacity,
+		rings:    make(map[string]*logRing),
+	}
+}
+
+type logs struct {
+	mu       sync.Mutex
+	records  store.Interface
+	capacity int
+	// rings of log entries keyed by event source (router) ID
+	rings map[string]*logRing
+}
+
+type logRing struct {
+	source  store.SourceRef
+	entries []LogEntry
+	next    int
+}
+
+func (r *logRing) add(entry LogEntry, capacity int) {
+	if len(r.entries) < capacity {
+		r.entries = append(r.entries, entry)
+		return
+	}
+	r.entries[r.next] = entry
+	r.next = (r.next + 1) % capacity
+}
+
+// handler returns a RecordMessage handler that retains the LogRecords
+// received from the given event source.
+func (l *logs) handler(source store.SourceRef) func(vanflow.RecordMessage) {
+	return func(msg vanflow.RecordMessage) {
+		for _, record := range msg.Records {
+			if record, ok := record.(vanflow.LogRecord); ok {
+				l.add(source, record)
+			}
+		}
+	}
+}
+
+func (l *logs) add(source store.SourceRef, record vanflow.LogRecord) {
+	entry := LogEntry{
+		ID:       record.ID,
+		Time:     time.Now(),
+		Severity: "unknown",
+	}
+	if record.StartTime != nil {
+		entry.Time = record.StartTime.Time
+	}
+	if record.LogSeverity != nil {
+		if severity, ok := logSeverities[*record.LogSeverity]; ok {
+			entry.Severity = severity
+		}
+	}
+	if record.LogText != nil {
+		entry.Text = *record.LogText
+		if match := logPrefixPattern.FindStringSubmatch(entry.Text); match != nil {
+			entry.Module = match[1]
+			entry.Text = entry.Text[len(match[0]):]
+		}
+	}
+	if record.SourceFile != nil {
+		entry.SourceFile = *record.SourceFile
+	}
+	if record.SourceLine != nil {
+		entry.SourceLine = *record.SourceLine
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ring, ok := l.rings[source.ID]
+	if !ok {
+		ring = &logRing{source: source}
+		l.rings[source.ID] = ring
+	}
+	ring.add(entry, l.capacity)
+}
+
+// forget drops the log messages retained for an event source.
+func (l *logs) forget(source store.SourceRef) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.rings, source.ID)
+}
+
+func (l *logs) Entries() []LogEntry {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var results []LogEntry
+	for _, ring := range l.rings {
+		routerID, routerName, siteID, siteName := l.describeSource(ring.source)
+		for _, entry := range ring.entries {
+			entry.RouterID, entry.RouterName = routerID, routerName
+			entry.SiteID, entry.SiteName = siteID, siteName
+			results = append(results, entry)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Time.Before(results[j].Time)
+	})
+	return results
+}
+
+// describeSource returns the identity and name of the router reporting
+// through an event source and of the site it belongs to, where known.
+func (l *logs) describeSource(source store.SourceRef) (routerID, routerName, siteID, siteName string) {
+	exemplar := store.Entry{Metadata: store.Metadata{Source: source}}
+	for _, e := range l.records.Index(store.SourceIndex, exemplar) {
+		router, ok := e.Record.(vanflow.RouterRecord)
+		if !ok {
+			continue
+		}
+		routerID = router.ID
+		if router.Name != nil {
+			routerName = *router.Name
+		}
+		if router.Parent == nil {
+			break
+		}
+		siteID = *router.Parent
+		if e, ok := l.records.Get(siteID); ok {
+			if site, ok := e.Record.(vanflow.SiteRecord); ok && site.Name != nil {
+				siteName = *site.Name
+			}
+		}
+		break
+	}
+	return
+}
@@ -0,0 +1,68 @@
+package collector
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestLogs(t *testing.T) {
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: RecordIndexers()})
+	sourceA := store.SourceRef{Version: "1", ID: "router-a"}
+	sourceB := store.SourceRef{Version: "1", ID: "router-b"}
+	stor.Add(vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-1"), Name: ptrTo("east")}, sourceA)
+	stor.Add(vanflow.RouterRecord{BaseRecord: vanflow.NewBase("router-a"), Name: ptrTo("east-router"), Parent: ptrTo("site-1")}, sourceA)
+
+	logs := newLogs(stor, 3)
+	t0 := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	logRecord := func(i int, severity uint64, text string) vanflow.LogRecord {
+		return vanflow.LogRecord{
+			BaseRecord:  vanflow.NewBase(fmt.Sprintf("log-%d", i), t0.Add(time.Duration(i)*time.Second)),
+			LogSeverity: &severity,
+			LogText:     &text,
+		}
+	}
+
+	handleA, handleB := logs.handler(sourceA), logs.handler(sourceB)
+	handleA(vanflow.RecordMessage{Records: []vanflow.Record{
+		logRecord(0, 0x04, "ROUTER (info) starting"),
+		vanflow.SiteRecord{BaseRecord: vanflow.NewBase("ignored")},
+		logRecord(2, 0x20, "ROUTER_CORE (error) Link connection failed: TLS handshake"),
+	}})
+	handleB(vanflow.RecordMessage{Records: []vanflow.Record{
+		logRecord(1, 0x10, "plain message"),
+		logRecord(3, 0x99, "odd severity"),
+	}})
+
+	entries := logs.Entries()
+	assert.DeepEqual(t, entries, []LogEntry{
+		{ID: "log-0", Time: t0, Severity: "info", Module: "ROUTER", Text: "starting",
+			RouterID: "router-a", RouterName: "east-router", SiteID: "site-1", SiteName: "east"},
+		{ID: "log-1", Time: t0.Add(time.Second), Severity: "warning", Text: "plain message"},
+		{ID: "log-2", Time: t0.Add(2 * time.Second), Severity: "error", Module: "ROUTER_CORE", Text: "Link connection failed: TLS handshake",
+			RouterID: "router-a", RouterName: "east-router", SiteID: "site-1", SiteName: "east"},
+		{ID: "log-3", Time: t0.Add(3 * time.Second), Severity: "unknown", Text: "odd severity"},
+	})
+
+	// oldest entries are dropped once a router's ring is full
+	handleA(vanflow.RecordMessage{Records: []vanflow.Record{
+		logRecord(4, 0x04, "four"),
+		logRecord(5, 0x04, "five"),
+	}})
+	var fromA []string
+	for _, entry := range logs.Entries() {
+		if entry.RouterID == "router-a" {
+			fromA = append(fromA, entry.ID)
+		}
+	}
+	assert.DeepEqual(t, fromA, []string{"log-2", "log-4", "log-5"})
+
+	logs.forget(sourceA)
+	entries = logs.Entries()
+	assert.Equal(t, len(entries), 2)
+	assert.Equal(t, entries[0].ID, "log-1")
+}
@@ -25,6 +25,10 @@ var (
 		"role",
 	}
 	siteIDMetricLabels = []string{"site_id"}
+	siteProbeLabels    = []string{
+		"source_site_id",
+		"dest_site_id",
+	}
 )
 
 func New(reg prometheus.Registerer) *Adaptor {
@@ -35,6 +39,7 @@ func New(reg prometheus.Registerer) *Adaptor {
 		listeners:     make(map[string]*gaugeMetricByID),
 		connectors:    make(map[string]*gaugeMetricByID),
 		linkErrors:    make(map[siteLinkErrors]*counterMetricByItem),
+		probeErrors:   make(map[siteProbes]*counterMetricByItem),
 		pendingRouter: make(map[string]map[string]vanflow.Record),
 	}
 	h.siteInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
@@ -68,6 +73,27 @@ func New(reg prometheus.Registerer) *Adaptor {
 		Help:      "Count of link connection errors across the application network",
 	}, linkErrorMetricLablels)
 
+	h.siteProbeLatency = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "skupper",
+		Name:      "site_probe_latency_seconds",
+		Help:      "Round trip latency last measured by actively probing one site from another",
+	}, siteProbeLabels)
+	h.siteProbeThroughput = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "skupper",
+		Name:      "site_probe_throughput_bytes_per_second",
+		Help:      "Throughput last measured by actively probing one site from another",
+	}, siteProbeLabels)
+	h.siteProbeReachable = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "skupper",
+		Name:      "site_probe_reachable",
+		Help:      "Whether one site answered the latest probes from another",
+	}, siteProbeLabels)
+	h.siteProbeErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "skupper",
+		Name:      "site_probe_errors_total",
+		Help:      "Count of probe rounds left unanswered by one site probed from another",
+	}, siteProbeLabels)
+
 	reg.MustRegister(
 		h.siteInfo,
 		h.routerInfo,
@@ -75,6 +101,10 @@ func New(reg prometheus.Registerer) *Adaptor {
 		h.siteListenerInfo,
 		h.siteConnectorInfo,
 		h.siteLinkErrors,
+		h.siteProbeLatency,
+		h.siteProbeThroughput,
+		h.siteProbeReachable,
+		h.siteProbeErrors,
 	)
 	return h
 }
@@ -89,6 +119,11 @@ type Adaptor struct {
 	siteConnectorInfo *prometheus.GaugeVec
 	siteLinkErrors    *prometheus.CounterVec
 
+	siteProbeLatency    *prometheus.GaugeVec
+	siteProbeThroughput *prometheus.GaugeVec
+	siteProbeReachable  *prometheus.GaugeVec
+	siteProbeErrors     *prometheus.CounterVec
+
 	sites      map[siteInfo]prometheus.Gauge
 	routers    map[siteRouters]*gaugeMetricByID
 	links      map[siteLinks]*gaugeMetricByID
@@ -96,6 +131,8 @@ type Adaptor struct {
 	connectors map[string]*gaugeMetricByID
 	linkErrors map[siteLinkErrors]*counterMetricByItem
 
+	probeErrors map[siteProbes]*counterMetricByItem
+
 	pendingRouter map[string]map[string]vanflow.Record
 
 	routerSitesCache map[string]string
@@ -167,6 +204,16 @@ func routerInfo(router vanflow.RouterRecord) (siteRouters, bool) {
 	}, true
 }
 
+func probeInfo(probe vanflow.SiteProbeRecord) (siteProbes, bool) {
+	if probe.Parent == nil || probe.Peer == nil {
+		return siteProbes{}, false
+	}
+	return siteProbes{
+		SourceSiteID: *probe.Parent,
+		DestSiteID:   *probe.Peer,
+	}, true
+}
+
 func (a *Adaptor) linkInfo(link vanflow.LinkRecord) (info siteLinks, wants string, ok bool) {
 	if link.Parent == nil || link.Role == nil || link.Status == nil {
 		return siteLinks{}, "", false
@@ -311,6 +358,54 @@ func (a *Adaptor) Add(record vanflow.Record) {
 			a.connectors[site] = metric
 		}
 		metric.Ensure(record.ID)
+	case vanflow.SiteProbeRecord:
+		a.setProbe(record)
+	}
+}
+
+func (a *Adaptor) setProbe(probe vanflow.SiteProbeRecord) {
+	key, ok := probeInfo(probe)
+	if !ok {
+		return
+	}
+	labels := key.asLabels()
+	if probe.Latency != nil {
+		a.siteProbeLatency.With(labels).Set(float64(*probe.Latency) / 1e6)
+	}
+	if probe.OctetRate != nil {
+		a.siteProbeThroughput.With(labels).Set(float64(*probe.OctetRate))
+	}
+	if probe.Result != nil {
+		reachable := 0.0
+		if *probe.Result == "ok" {
+			reachable = 1.0
+		}
+		a.siteProbeReachable.With(labels).Set(reachable)
+	}
+	if errCount := probe.DownCount; errCount != nil {
+		counter, ok := a.probeErrors[key]
+		if !ok {
+			counter = &counterMetricByItem{
+				Items:   make(map[string]int),
+				Counter: a.siteProbeErrors.With(labels),
+			}
+			a.probeErrors[key] = counter
+		}
+		counter.Ensure(probe.ID, int(*errCount))
+	}
+}
+
+func (a *Adaptor) removeProbe(probe vanflow.SiteProbeRecord) {
+	key, ok := probeInfo(probe)
+	if !ok {
+		return
+	}
+	labels := key.asLabels()
+	a.siteProbeLatency.Delete(labels)
+	a.siteProbeThroughput.Delete(labels)
+	a.siteProbeReachable.Delete(labels)
+	if counter, ok := a.probeErrors[key]; ok {
+		counter.Remove(probe.ID)
 	}
 }
 
@@ -341,6 +436,8 @@ func (a *Adaptor) Update(prev, curr vanflow.Record) {
 		a.Add(curr)
 	case vanflow.ConnectorRecord:
 		a.Add(curr)
+	case vanflow.SiteProbeRecord:
+		a.Add(curr)
 	case vanflow.LinkRecord:
 		siteLink, wants, ok := a.linkInfo(record)
 		if !ok {
@@ -446,6 +543,8 @@ func (a *Adaptor) Remove(record vanflow.Record) {
 			return
 		}
 		metric.Remove(record.ID)
+	case vanflow.SiteProbeRecord:
+		a.removeProbe(record)
 	}
 }
 
@@ -531,3 +630,15 @@ func (i siteLinkErrors) asLabels() prometheus.Labels {
 		"role":    i.Role,
 	}
 }
+
+type siteProbes struct {
+	SourceSiteID string
+	DestSiteID   string
+}
+
+func (i siteProbes) asLabels() prometheus.Labels {
+	return prometheus.Labels{
+		"source_site_id": i.SourceSiteID,
+		"dest_site_id":   i.DestSiteID,
+	}
+}
@@ -230,6 +230,45 @@ func TestSiteLinkErrorMetrics(t *testing.T) {
 	assert.Equal(t, prom_testutil.ToFloat64(metrics.siteLinkErrors.WithLabelValues("s01", "inter-router")), 4.0)
 }
 
+func TestSiteProbeMetrics(t *testing.T) {
+	reg := prometheus.NewPedanticRegistry()
+	metrics := New(reg)
+
+	probe := vanflow.SiteProbeRecord{
+		BaseRecord: vanflow.NewBase("s01/s02"),
+		Parent:     ptrTo("s01"),
+		Peer:       ptrTo("s02"),
+		Result:     ptrTo("ok"),
+		Latency:    ptrTo(uint64(2500)),
+		DownCount:  ptrTo(uint64(0)),
+	}
+	metrics.Add(probe)
+	metrics.Add(vanflow.SiteProbeRecord{BaseRecord: vanflow.NewBase("s02/s01"), Peer: ptrTo("s01")})
+	assert.Equal(t, prom_testutil.CollectAndCount(reg, "skupper_site_probe_latency_seconds"), 1)
+	assert.Equal(t, prom_testutil.CollectAndCount(reg, "skupper_site_probe_throughput_bytes_per_second"), 0)
+	assert.Equal(t, prom_testutil.ToFloat64(metrics.siteProbeLatency.WithLabelValues("s01", "s02")), 0.0025)
+	assert.Equal(t, prom_testutil.ToFloat64(metrics.siteProbeReachable.WithLabelValues("s01", "s02")), 1.0)
+
+	next := probe
+	next.OctetRate = ptrTo(uint64(1048576))
+	metrics.Update(probe, next)
+	assert.Equal(t, prom_testutil.ToFloat64(metrics.siteProbeThroughput.WithLabelValues("s01", "s02")), 1048576.0)
+
+	probe = next
+	next.Result = ptrTo("unreachable")
+	next.DownCount = ptrTo(uint64(1))
+	metrics.Update(probe, next)
+	assert.Equal(t, prom_testutil.ToFloat64(metrics.siteProbeReachable.WithLabelValues("s01", "s02")), 0.0)
+	assert.Equal(t, prom_testutil.ToFloat64(metrics.siteProbeErrors.WithLabelValues("s01", "s02")), 1.0)
+	assert.Equal(t, prom_testutil.ToFloat64(metrics.siteProbeLatency.WithLabelValues("s01", "s02")), 0.0025)
+
+	metrics.Remove(next)
+	assert.Equal(t, prom_testutil.CollectAndCount(reg, "skupper_site_probe_latency_seconds"), 0)
+	assert.Equal(t, prom_testutil.CollectAndCount(reg, "skupper_site_probe_reachable"), 0)
+	// cannot decrease
+	assert.Equal(t, prom_testutil.ToFloat64(metrics.siteProbeErrors.WithLabelValues("s01", "s02")), 1.0)
+}
+
 func ptrTo[T any](s T) *T {
 	return &s
 }
@@ -106,7 +106,7 @@ func TestAddresses(t *testing.T) {
 		t.Run("", func(t *testing.T) {
 			stor.Replace(tc.Records)
 			graph.(reset).Reset()
-			resp, err := c.ServicesWithResponse(context.TODO(), withParameters(tc.Parameters))
+			resp, err := c.ServicesWithResponse(context.TODO(), nil, withParameters(tc.Parameters))
 			assert.Check(t, err)
 			if tc.ExpectOK {
 				assert.Equal(t, resp.StatusCode(), 200)
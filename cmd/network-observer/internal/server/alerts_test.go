@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/alerts"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+type testAlerts []alerts.Alert
+
+func (a testAlerts) Alerts() []alerts.Alert {
+	return a
+}
+
+func TestAlerts(t *testing.T) {
+	tlog := slog.Default()
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	graph := collector.NewGraph(stor)
+	now := time.Now()
+	provider := testAlerts{
+		{ID: "a1", Rule: "link-down", Severity: "critical", State: alerts.StateFiring,
+			SubjectID: "link-1", SubjectName: "east/to-west", Summary: "link east/to-west is down",
+			StartTime: now.Add(-5 * time.Minute), FiringTime: now.Add(-3 * time.Minute)},
+		{ID: "a2", Rule: "unmatched", Severity: "warning", State: alerts.StatePending,
+			SubjectID: "listener-1", SubjectName: "db", Summary: "listener db has no connector for routing key \"db\"",
+			StartTime: now.Add(-time.Minute)},
+		{ID: "a3", Rule: "link-down", Severity: "critical", State: alerts.StateResolved,
+			SubjectID: "link-2", SubjectName: "east/to-north", Summary: "link east/to-north is down",
+			StartTime: now.Add(-50 * time.Minute), FiringTime: now.Add(-48 * time.Minute), EndTime: now.Add(-40 * time.Minute)},
+	}
+	srv, c := requireTestClient(t, NewWithOptions(tlog, stor, graph, Options{Alerts: provider}))
+	defer srv.Close()
+
+	testcases := []collectionTestCase[api.AlertRecord]{
+		{
+			ExpectOK:    true,
+			ExpectCount: 2,
+			ExpectResults: func(t *testing.T, results []api.AlertRecord) {
+				assert.DeepEqual(t, results[0], api.AlertRecord{
+					Identity:    "a1",
+					Rule:        "link-down",
+					Severity:    "critical",
+					State:       api.AlertStateFiring,
+					SubjectId:   "link-1",
+					SubjectName: "east/to-west",
+					Summary:     "link east/to-west is down",
+					StartTime:   uint64(now.Add(-5 * time.Minute).UnixMicro()),
+					FiringTime:  ptrTo(uint64(now.Add(-3 * time.Minute).UnixMicro())),
+				})
+			},
+		}, {
+			Parameters:  map[string][]string{"timeRangeStart": {"0"}},
+			ExpectOK:    true,
+			ExpectCount: 3,
+		}, {
+			Parameters:  map[string][]string{"filter": {"state == pending"}},
+			ExpectOK:    true,
+			ExpectCount: 1,
+			ExpectResults: func(t *testing.T, results []api.AlertRecord) {
+				assert.Equal(t, results[0].Identity, "a2")
+			},
+		}, {
+			Parameters:  map[string][]string{"filter": {"rule == link-down"}, "timeRangeStart": {"0"}},
+			ExpectOK:    true,
+			ExpectCount: 2,
+		}, {
+			Parameters: map[string][]string{"flavour": {"mint"}},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run("", func(t *testing.T) {
+			resp, err := c.AlertsWithResponse(context.TODO(), nil, withParameters(tc.Parameters))
+			assert.Check(t, err)
+			if !tc.ExpectOK {
+				assert.Equal(t, resp.StatusCode(), 400)
+				return
+			}
+			assert.Equal(t, resp.StatusCode(), 200)
+			assert.Equal(t, resp.JSON200.Count, int64(tc.ExpectCount))
+			assert.Equal(t, len(resp.JSON200.Results), tc.ExpectCount)
+			if tc.ExpectResults != nil {
+				tc.ExpectResults(t, resp.JSON200.Results)
+			}
+		})
+	}
+
+	t.Run("without alerting", func(t *testing.T) {
+		srv, c := requireTestClient(t, New(tlog, stor, graph))
+		defer srv.Close()
+		resp, err := c.AlertsWithResponse(context.TODO(), nil)
+		assert.Check(t, err)
+		assert.Equal(t, resp.StatusCode(), 200)
+		assert.Equal(t, resp.JSON200.Count, int64(0))
+		assert.Assert(t, resp.JSON200.Results != nil)
+	})
+}
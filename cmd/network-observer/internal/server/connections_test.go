@@ -148,7 +148,7 @@ func TestConnections(t *testing.T) {
 			stor.Replace(tc.Records)
 			flowStor.Replace(tc.Flows)
 			graph.(reset).Reset()
-			resp, err := c.ConnectionsWithResponse(context.TODO(), withParameters(tc.Parameters))
+			resp, err := c.ConnectionsWithResponse(context.TODO(), nil, withParameters(tc.Parameters))
 			assert.Check(t, err)
 			if tc.ExpectOK {
 				assert.Equal(t, resp.StatusCode(), 200)
@@ -20,6 +20,21 @@ func TestConnectors(t *testing.T) {
 	defer srv.Close()
 	testcases := []collectionTestCase[api.ConnectorRecord]{
 		{ExpectOK: true},
+		{
+			Records: wrapRecords(
+				vanflow.ConnectorRecord{BaseRecord: vanflow.NewBase("c1"), Address: ptrTo("trombone"), DestPort: ptrTo("8080")},
+				vanflow.ConnectorRecord{BaseRecord: vanflow.NewBase("c2"), Address: ptrTo("trombone"), DestPort: ptrTo("9090")},
+				vanflow.ConnectorRecord{BaseRecord: vanflow.NewBase("c3"), Address: ptrTo("tuba"), DestPort: ptrTo("8080")},
+				vanflow.ListenerRecord{BaseRecord: vanflow.NewBase("l1"), Address: ptrTo("trombone")},
+			),
+			Parameters:           map[string][]string{"filter": {`routingKey == trombone and destPort == 8080`}},
+			ExpectOK:             true,
+			ExpectCount:          1,
+			ExpectTimeRangeCount: 1,
+			ExpectResults: func(t *testing.T, results []api.ConnectorRecord) {
+				assert.Equal(t, results[0].Identity, "c1")
+			},
+		},
 		{
 			ExpectOK: true,
 			Records: wrapRecords( // totally empty
@@ -101,7 +116,7 @@ func TestConnectors(t *testing.T) {
 		t.Run("", func(t *testing.T) {
 			stor.Replace(tc.Records)
 			graph.(reset).Reset()
-			resp, err := c.ConnectorsWithResponse(context.TODO(), withParameters(tc.Parameters))
+			resp, err := c.ConnectorsWithResponse(context.TODO(), nil, withParameters(tc.Parameters))
 			assert.Check(t, err)
 			if tc.ExpectOK {
 				assert.Equal(t, resp.StatusCode(), 200)
@@ -0,0 +1,337 @@
+package server
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+)
+
+// exportFormat is the encoding used by the bulk export endpoints.
+type exportFormat string
+
+const (
+	exportFormatNDJSON  exportFormat = "ndjson"
+	exportFormatCSV     exportFormat = "csv"
+	exportFormatParquet exportFormat = "parquet"
+)
+
+// exportFlushInterval is the number of records written between flushes of
+// the response to the client.
+const exportFlushInterval = 256
+
+func (f exportFormat) contentType() string {
+	switch f {
+	case exportFormatCSV:
+		return "text/csv"
+	case exportFormatParquet:
+		return "application/vnd.apache.parquet"
+	default:
+		return "application/x-ndjson"
+	}
+}
+
+func parseExportFormat(s string) (exportFormat, error) {
+	switch f := exportFormat(strings.ToLower(s)); f {
+	case "":
+		return exportFormatNDJSON, nil
+	case exportFormatNDJSON, exportFormatCSV, exportFormatParquet:
+		return f, nil
+	default:
+		return "", fmt.Errorf("invalid format %q: expected one of ndjson, csv or parquet", s)
+	}
+}
+
+// exportColumn is a single column of an export schema, named for the json
+// property it is derived from.
+type exportColumn struct {
+	Name  string
+	Index []int
+}
+
+// exportColumns returns the columns for record type T: one per json
+// property, ordered by name so that the schema is stable irrespective of
+// field order in the generated types.
+func exportColumns[T any]() []exportColumn {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	columns := make([]exportColumn, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		columns = append(columns, exportColumn{Name: name, Index: sf.Index})
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		return columns[i].Name < columns[j].Name
+	})
+	return columns
+}
+
+// exportWriter encodes a stream of records.
+type exportWriter[T any] interface {
+	Write(record T) error
+	// Flush writes any buffered records through to the underlying writer.
+	Flush() error
+	// Close flushes buffered records and writes any trailing metadata.
+	Close() error
+}
+
+func newExportWriter[T any](format exportFormat, w io.Writer) (exportWriter[T], error) {
+	switch format {
+	case exportFormatNDJSON:
+		return &ndjsonExportWriter[T]{enc: json.NewEncoder(w)}, nil
+	case exportFormatCSV:
+		return newCSVExportWriter[T](w), nil
+	case exportFormatParquet:
+		return newParquetExportWriter[T](w), nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+type ndjsonExportWriter[T any] struct {
+	enc *json.Encoder
+}
+
+func (w *ndjsonExportWriter[T]) Write(record T) error {
+	return w.enc.Encode(record)
+}
+
+func (w *ndjsonExportWriter[T]) Flush() error { return nil }
+func (w *ndjsonExportWriter[T]) Close() error { return nil }
+
+type csvExportWriter[T any] struct {
+	columns       []exportColumn
+	out           *csv.Writer
+	headerWritten bool
+	row           []string
+}
+
+func newCSVExportWriter[T any](w io.Writer) *csvExportWriter[T] {
+	columns := exportColumns[T]()
+	return &csvExportWriter[T]{
+		columns: columns,
+		out:     csv.NewWriter(w),
+		row:     make([]string, len(columns)),
+	}
+}
+
+func (w *csvExportWriter[T]) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+	for i, c := range w.columns {
+		w.row[i] = c.Name
+	}
+	return w.out.Write(w.row)
+}
+
+func (w *csvExportWriter[T]) Write(record T) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	val := reflect.ValueOf(record)
+	for i, c := range w.columns {
+		w.row[i] = csvValue(val.FieldByIndex(c.Index))
+	}
+	return w.out.Write(w.row)
+}
+
+func (w *csvExportWriter[T]) Flush() error {
+	w.out.Flush()
+	return w.out.Error()
+}
+
+func (w *csvExportWriter[T]) Close() error {
+	// always emit the header so that an empty export is still valid csv
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// csvValue formats a field for csv output. Nil pointers are written as
+// empty cells and lists as their elements joined by "|", matching the
+// format of router traces.
+func csvValue(val reflect.Value) string {
+	if val.Kind() == reflect.Pointer {
+		if val.IsNil() {
+			return ""
+		}
+		val = val.Elem()
+	}
+	switch val.Kind() {
+	case reflect.String:
+		return val.String()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(val.Uint(), 10)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(val.Int(), 10)
+	case reflect.Bool:
+		return strconv.FormatBool(val.Bool())
+	case reflect.Slice:
+		parts := make([]string, val.Len())
+		for i := range parts {
+			parts[i] = csvValue(val.Index(i))
+		}
+		return strings.Join(parts, "|")
+	default:
+		return fmt.Sprint(val.Interface())
+	}
+}
+
+// parquetExportRowGroupSize bounds the number of records buffered in memory
+// before a row group is written out.
+const parquetExportRowGroupSize = 4096
+
+type parquetExportWriter[T any] struct {
+	rowType reflect.Type
+	out     *parquet.Writer
+}
+
+func newParquetExportWriter[T any](w io.Writer) *parquetExportWriter[T] {
+	rowType := parquetRowType[T]()
+	schema := parquet.SchemaOf(reflect.New(rowType).Interface())
+	return &parquetExportWriter[T]{
+		rowType: rowType,
+		out:     parquet.NewWriter(w, schema, parquet.MaxRowsPerRowGroup(parquetExportRowGroupSize)),
+	}
+}
+
+// parquetRowType returns a struct type identical to T other than in its
+// field tags, which name each parquet column for its json property.
+func parquetRowType[T any]() reflect.Type {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	fields := make([]reflect.StructField, typ.NumField())
+	for i := range fields {
+		sf := typ.Field(i)
+		fields[i] = reflect.StructField{
+			Name: sf.Name,
+			Type: sf.Type,
+			Tag:  `parquet:"-"`,
+		}
+	}
+	for _, c := range exportColumns[T]() {
+		fields[c.Index[0]].Tag = reflect.StructTag(fmt.Sprintf(`parquet:%q`, c.Name))
+	}
+	return reflect.StructOf(fields)
+}
+
+func (w *parquetExportWriter[T]) Write(record T) error {
+	return w.out.Write(reflect.ValueOf(record).Convert(w.rowType).Interface())
+}
+
+// Flush is a no-op for parquet: records are buffered until a full row group
+// of parquetExportRowGroupSize has accumulated, at which point the writer
+// emits it.
+func (w *parquetExportWriter[T]) Flush() error { return nil }
+
+func (w *parquetExportWriter[T]) Close() error {
+	return w.out.Close()
+}
+
+// recordMatcher returns a predicate matching records against the filter
+// and time range query parameters in the same way as filterAndOrderResults.
+func recordMatcher[T api.Record](r *http.Request) (func(T) bool, error) {
+	qp := getQueryParams(r)
+	filterFields := make(map[string]fieldIndex[T], len(qp.FilterFields))
+	for path := range qp.FilterFields {
+		m, err := indexerForField[T](path)
+		if err != nil {
+			var example T
+			return nil, fmt.Errorf("invalid filter parameter %q for record type %T", path, example)
+		}
+		filterFields[path] = m
+	}
+	node, err := requestFilter(r)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := compileRequestFilter[T](node)
+	if err != nil {
+		return nil, err
+	}
+	outsideTimeRange := timeRangeFilter(qp.State, qp.TimeRangeOperation, qp.TimeRangeStart, qp.TimeRangeEnd)
+	return func(item T) bool {
+		if !filter(item) {
+			return false
+		}
+		for path, values := range qp.FilterFields {
+			if !filterFields[path].MatchesFilter(item, values) {
+				return false
+			}
+		}
+		return !outsideTimeRange(item)
+	}, nil
+}
+
+// handleExport streams the records mapped from entries that match the
+// request's filters, flushing periodically so that the full result set is
+// never held in memory.
+func handleExport[T api.Record](w http.ResponseWriter, r *http.Request, entries []store.Entry, provider func(store.Entry) (T, bool)) error {
+	query := r.URL.Query()
+	format, err := parseExportFormat(query.Get("format"))
+	if err != nil {
+		return encodeResponse(w, http.StatusBadRequest, api.ErrorBadRequest{Message: err.Error()})
+	}
+	query.Del("format")
+	filterRequest := r.Clone(r.Context())
+	filterRequest.URL.RawQuery = query.Encode()
+	matches, err := recordMatcher[T](filterRequest)
+	if err != nil {
+		return encodeResponse(w, http.StatusBadRequest, api.ErrorBadRequest{Message: err.Error()})
+	}
+
+	writer, err := newExportWriter[T](format, w)
+	if err != nil {
+		return err
+	}
+	flusher, _ := w.(http.Flusher)
+	w.Header().Set("Content-Type", format.contentType())
+	w.WriteHeader(http.StatusOK)
+
+	written := 0
+	for _, entry := range entries {
+		if err := r.Context().Err(); err != nil {
+			return err
+		}
+		record, ok := provider(entry)
+		if !ok || !matches(record) {
+			continue
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("export write error: %s", err)
+		}
+		written++
+		if written%exportFlushInterval == 0 {
+			if err := writer.Flush(); err != nil {
+				return fmt.Errorf("export write error: %s", err)
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("export write error: %s", err)
+	}
+	return nil
+}
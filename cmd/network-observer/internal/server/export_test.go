@@ -0,0 +1,208 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestExportConnections(t *testing.T) {
+	tlog := slog.Default()
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	flowStor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	graph := collector.NewGraph(stor)
+	srv, c := requireTestClient(t, New(tlog, stor, graph))
+	defer srv.Close()
+
+	t0 := time.Now().Add(-2 * time.Hour)
+	stor.Replace(wrapRecords(
+		vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-a"), Name: ptrTo("site a")},
+		vanflow.RouterRecord{BaseRecord: vanflow.NewBase("router-a-1"), Name: ptrTo("router a.1"), Parent: ptrTo("site-a")},
+		collector.ConnectionRecord{
+			ID:           "flow:1",
+			Source:       collector.NamedReference{ID: "p1", Name: "p:1"},
+			Dest:         collector.NamedReference{ID: "p2", Name: "p:2"},
+			SourceSite:   collector.NamedReference{ID: "site-a", Name: "site a"},
+			DestSite:     collector.NamedReference{ID: "site-a", Name: "site a"},
+			SourceRouter: collector.NamedReference{ID: "router-a-1", Name: "router a.1"},
+			DestRouter:   collector.NamedReference{ID: "router-a-1", Name: "router a.1"},
+			Protocol:     "tcp",
+			RoutingKey:   "soup",
+			FlowStore:    flowStor,
+		},
+		collector.ConnectionRecord{ID: "flow:2", RoutingKey: "salad", Protocol: "tcp", FlowStore: flowStor},
+		collector.ConnectionRecord{ID: "flow:3", RoutingKey: "soup", Protocol: "tcp", FlowStore: flowStor},
+	))
+	flowStor.Replace(wrapRecords(
+		vanflow.TransportBiflowRecord{
+			BaseRecord: vanflow.NewBase("flow:1", t0.Add(time.Minute), t0.Add(2*time.Minute)),
+			Trace:      ptrTo("router a.1"),
+			Octets:     ptrTo(uint64(33)),
+			SourceHost: ptrTo("source.local"),
+		},
+		vanflow.TransportBiflowRecord{BaseRecord: vanflow.NewBase("flow:2", t0.Add(time.Minute))},
+		// started before the requested time range
+		vanflow.TransportBiflowRecord{BaseRecord: vanflow.NewBase("flow:3", t0.Add(-time.Hour), t0.Add(-time.Minute))},
+	))
+	graph.(reset).Reset()
+
+	expected := api.ConnectionRecord{
+		Identity:          "flow:1",
+		StartTime:         uint64(t0.Add(time.Minute).UnixMicro()),
+		EndTime:           uint64(t0.Add(2 * time.Minute).UnixMicro()),
+		Duration:          ptrTo(uint64(time.Minute / time.Microsecond)),
+		RoutingKey:        "soup",
+		SourceHost:        "source.local",
+		SourceProcessId:   "p1",
+		DestProcessId:     "p2",
+		SourceProcessName: "p:1",
+		DestProcessName:   "p:2",
+		SourceSiteName:    "site a",
+		DestSiteName:      "site a",
+		SourceSiteId:      "site-a",
+		DestSiteId:        "site-a",
+		Protocol:          "tcp",
+		OctetCount:        33,
+		TraceRouters:      []string{"router a.1"},
+		TraceSites:        []string{"site a"},
+	}
+	baseParams := map[string][]string{
+		"timeRangeStart": {fmt.Sprint(t0.UnixMicro())},
+		"routingKey":     {"soup"},
+	}
+
+	testcases := []struct {
+		Format            string
+		ExpectContentType string
+		Decode            func(t *testing.T, body []byte) []api.ConnectionRecord
+	}{
+		{
+			Format:            "",
+			ExpectContentType: "application/x-ndjson",
+			Decode:            decodeNDJSON,
+		}, {
+			Format:            "ndjson",
+			ExpectContentType: "application/x-ndjson",
+			Decode:            decodeNDJSON,
+		}, {
+			Format:            "csv",
+			ExpectContentType: "text/csv",
+			Decode: func(t *testing.T, body []byte) []api.ConnectionRecord {
+				rows, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+				assert.Assert(t, err)
+				assert.Equal(t, len(rows), 2)
+				columns := exportColumns[api.ConnectionRecord]()
+				record := map[string]string{}
+				for i, c := range columns {
+					assert.Equal(t, rows[0][i], c.Name)
+					record[c.Name] = rows[1][i]
+				}
+				assert.Equal(t, record["identity"], "flow:1")
+				assert.Equal(t, record["octetCount"], "33")
+				assert.Equal(t, record["duration"], fmt.Sprint(uint64(time.Minute/time.Microsecond)))
+				assert.Equal(t, record["componentPairId"], "")
+				assert.Equal(t, record["traceSites"], "site a")
+				return []api.ConnectionRecord{expected}
+			},
+		}, {
+			Format:            "parquet",
+			ExpectContentType: "application/vnd.apache.parquet",
+			Decode: func(t *testing.T, body []byte) []api.ConnectionRecord {
+				file, err := parquet.OpenFile(bytes.NewReader(body), int64(len(body)))
+				assert.Assert(t, err)
+				var names []string
+				for _, f := range file.Schema().Fields() {
+					names = append(names, f.Name())
+				}
+				var expectNames []string
+				for _, c := range exportColumns[api.ConnectionRecord]() {
+					expectNames = append(expectNames, c.Name)
+				}
+				assert.DeepEqual(t, names, expectNames)
+
+				rowType := parquetRowType[api.ConnectionRecord]()
+				reader := parquet.NewReader(file)
+				var results []api.ConnectionRecord
+				for {
+					row := reflect.New(rowType)
+					if err := reader.Read(row.Interface()); err == io.EOF {
+						break
+					} else {
+						assert.Assert(t, err)
+					}
+					results = append(results, row.Elem().Convert(reflect.TypeOf(api.ConnectionRecord{})).Interface().(api.ConnectionRecord))
+				}
+				return results
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.Format, func(t *testing.T) {
+			params := map[string][]string{}
+			for k, v := range baseParams {
+				params[k] = v
+			}
+			if tc.Format != "" {
+				params["format"] = []string{tc.Format}
+			}
+			resp, err := c.ExportConnectionsWithResponse(context.TODO(), nil, withParameters(params))
+			assert.Assert(t, err)
+			assert.Equal(t, resp.StatusCode(), 200)
+			assert.Equal(t, resp.HTTPResponse.Header.Get("Content-Type"), tc.ExpectContentType)
+			assert.DeepEqual(t, tc.Decode(t, resp.Body), []api.ConnectionRecord{expected})
+		})
+	}
+
+	t.Run("invalid format", func(t *testing.T) {
+		resp, err := c.ExportConnectionsWithResponse(context.TODO(), nil, withParameters(map[string][]string{"format": {"xml"}}))
+		assert.Assert(t, err)
+		assert.Equal(t, resp.StatusCode(), 400)
+	})
+	t.Run("invalid filter", func(t *testing.T) {
+		resp, err := c.ExportConnectionsWithResponse(context.TODO(), nil, withParameters(map[string][]string{"notAField": {"x"}}))
+		assert.Assert(t, err)
+		assert.Equal(t, resp.StatusCode(), 400)
+	})
+}
+
+func TestExportApplicationflowsEmpty(t *testing.T) {
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	srv, c := requireTestClient(t, New(slog.Default(), stor, collector.NewGraph(stor)))
+	defer srv.Close()
+
+	resp, err := c.ExportApplicationflowsWithResponse(context.TODO(), nil, withParameters(map[string][]string{"format": {"csv"}}))
+	assert.Assert(t, err)
+	assert.Equal(t, resp.StatusCode(), 200)
+	rows, err := csv.NewReader(bytes.NewReader(resp.Body)).ReadAll()
+	assert.Assert(t, err)
+	assert.Equal(t, len(rows), 1)
+	assert.Equal(t, len(rows[0]), len(exportColumns[api.ApplicationFlowRecord]()))
+}
+
+func decodeNDJSON(t *testing.T, body []byte) []api.ConnectionRecord {
+	t.Helper()
+	var results []api.ConnectionRecord
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		var record api.ConnectionRecord
+		assert.Assert(t, json.Unmarshal(scanner.Bytes(), &record))
+		results = append(results, record)
+	}
+	assert.Assert(t, scanner.Err())
+	return results
+}
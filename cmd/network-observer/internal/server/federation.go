@@ -0,0 +1,487 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+)
+
+const (
+	apiPrefix    = "/api/v2alpha1/"
+	networksPath = apiPrefix + "networks"
+	networkParam = "network"
+)
+
+// Network is a skupper network observed by its own collector and served by
+// its own API handler.
+type Network struct {
+	Name           string
+	RouterEndpoint string
+	Handler        http.Handler
+}
+
+// NewFederation returns a handler serving the API for several networks.
+//
+// Requests with a network query parameter, or with a path identity
+// qualified as network:identity, are served by that network alone.
+// Collection requests are otherwise served by every network and the
+// results merged, sorted and paginated. When more than one network is
+// observed every record is tagged with its network and the identities it
+// holds are qualified so that they remain unambiguous across networks.
+func NewFederation(logger *slog.Logger, networks []Network) http.Handler {
+	f := &federation{
+		logger:   logger,
+		networks: networks,
+		byName:   make(map[string]Network, len(networks)),
+		qualify:  len(networks) > 1,
+	}
+	for _, network := range networks {
+		f.byName[network.Name] = network
+	}
+	return f
+}
+
+type federation struct {
+	logger   *slog.Logger
+	networks []Network
+	byName   map[string]Network
+	qualify  bool
+}
+
+func (f *federation) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == networksPath {
+		f.listNetworks(w, r)
+		return
+	}
+	query := r.URL.Query()
+	if query.Has(networkParam) {
+		name := query.Get(networkParam)
+		network, ok := f.byName[name]
+		if !ok {
+			f.writeError(w, r, http.StatusNotFound, fmt.Sprintf("network %q not found", name))
+			return
+		}
+		f.serveNetwork(w, r, network)
+		return
+	}
+	if !f.qualify {
+		f.networks[0].Handler.ServeHTTP(w, r)
+		return
+	}
+	if id, ok := pathID(r.URL.Path); ok {
+		if network, _, ok := f.splitID(id); ok {
+			f.serveNetwork(w, r, network)
+			return
+		}
+		f.serveAny(w, r)
+		return
+	}
+	if isExport(r.URL.Path) {
+		f.writeError(w, r, http.StatusBadRequest, "the network parameter is required to export records when more than one network is observed")
+		return
+	}
+	f.serveAll(w, r)
+}
+
+func (f *federation) listNetworks(w http.ResponseWriter, r *http.Request) {
+	results := make([]api.NetworkRecord, 0, len(f.networks))
+	for _, network := range f.networks {
+		results = append(results, api.NetworkRecord{
+			Name:           network.Name,
+			RouterEndpoint: network.RouterEndpoint,
+		})
+	}
+	response := api.NetworkListResponse{
+		Count:          int64(len(results)),
+		TimeRangeCount: int64(len(results)),
+		Results:        results,
+	}
+	if err := encodeResponse(w, http.StatusOK, response); err != nil {
+		f.logWriteError(r, err)
+	}
+}
+
+// serveNetwork serves a request from a single network.
+func (f *federation) serveNetwork(w http.ResponseWriter, r *http.Request, network Network) {
+	req := f.networkRequest(r, network)
+	if !f.qualify || isExport(r.URL.Path) {
+		// exports are streamed as they are without tagging records
+		network.Handler.ServeHTTP(w, req)
+		return
+	}
+	resp := f.record(network, req)
+	if err := resp.qualify(network.Name); err != nil {
+		f.logger.Error("failed to qualify response records", slog.String("network", network.Name), slog.Any("error", err))
+	}
+	resp.writeTo(w)
+}
+
+// serveAny serves a request for an unqualified identity from the one
+// network that knows of it.
+func (f *federation) serveAny(w http.ResponseWriter, r *http.Request) {
+	var (
+		found    *bufferedResponse
+		notFound *bufferedResponse
+		matches  []string
+	)
+	for _, network := range f.networks {
+		resp := f.record(network, f.networkRequest(r, network))
+		if resp.status != http.StatusOK {
+			if notFound == nil {
+				notFound = resp
+			}
+			continue
+		}
+		if err := resp.qualify(network.Name); err != nil {
+			f.logger.Error("failed to qualify response records", slog.String("network", network.Name), slog.Any("error", err))
+		}
+		found = resp
+		matches = append(matches, network.Name)
+	}
+	switch len(matches) {
+	case 0:
+		notFound.writeTo(w)
+	case 1:
+		found.writeTo(w)
+	default:
+		f.writeError(w, r, http.StatusConflict,
+			fmt.Sprintf("identity is ambiguous: found in networks %s. Qualify it as network:identity or set the network parameter", strings.Join(matches, ", ")))
+	}
+}
+
+// serveAll serves a collection request from every network, merging the
+// results.
+func (f *federation) serveAll(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	offset, limit := -1, -1
+	if v, err := strconv.Atoi(query.Get("offset")); err == nil {
+		offset = v
+	}
+	if v, err := strconv.Atoi(query.Get("limit")); err == nil {
+		limit = v
+	}
+	var (
+		results        []map[string]any
+		timeRangeCount int64
+	)
+	for _, network := range f.networks {
+		req := f.networkRequest(r, network)
+		// each network must return enough records to fill the requested
+		// page once merged with the others
+		q := req.URL.Query()
+		q.Del("offset")
+		if limit >= 0 {
+			q.Set("limit", strconv.Itoa(max(offset, 0)+limit))
+		}
+		req.URL.RawQuery = q.Encode()
+
+		resp := f.record(network, req)
+		if resp.status != http.StatusOK {
+			resp.writeTo(w)
+			return
+		}
+		page, err := resp.collection()
+		if err != nil {
+			f.writeError(w, r, http.StatusBadRequest, "the network parameter is required for this request when more than one network is observed")
+			return
+		}
+		for _, record := range page.Results {
+			qualifyRecord(record, network.Name)
+		}
+		results = append(results, page.Results...)
+		timeRangeCount += page.TimeRangeCount
+	}
+
+	field, descending := sortOrder(query.Get("sortBy"))
+	sort.SliceStable(results, func(i, j int) bool {
+		d := compareJSON(lookupField(results[i], field), lookupField(results[j], field))
+		if descending {
+			return d > 0
+		}
+		return d < 0
+	})
+	start, end := paginate(offset, limit, len(results))
+	results = results[start:end]
+	if results == nil {
+		results = []map[string]any{}
+	}
+	response := collectionPage{
+		Count:          int64(len(results)),
+		TimeRangeCount: timeRangeCount,
+		Results:        results,
+	}
+	if err := encodeResponse(w, http.StatusOK, response); err != nil {
+		f.logWriteError(r, err)
+	}
+}
+
+// networkRequest returns a copy of r for network with the network
+// parameter removed and identities qualified with the network name
+// unqualified.
+func (f *federation) networkRequest(r *http.Request, network Network) *http.Request {
+	req := r.Clone(r.Context())
+	query := req.URL.Query()
+	query.Del(networkParam)
+	for key, values := range query {
+		if !isIDField(key) {
+			continue
+		}
+		for i, value := range values {
+			values[i] = unqualify(value, network.Name)
+		}
+	}
+	req.URL.RawQuery = query.Encode()
+	if id, ok := pathID(req.URL.Path); ok {
+		parts := strings.Split(strings.TrimPrefix(req.URL.Path, apiPrefix), "/")
+		parts[1] = unqualify(id, network.Name)
+		req.URL.Path = apiPrefix + strings.Join(parts, "/")
+		req.URL.RawPath = ""
+	}
+	return req
+}
+
+func (f *federation) record(network Network, r *http.Request) *bufferedResponse {
+	resp := &bufferedResponse{header: make(http.Header)}
+	network.Handler.ServeHTTP(resp, r)
+	if resp.status == 0 {
+		resp.status = http.StatusOK
+	}
+	return resp
+}
+
+// splitID returns the network an identity is qualified with.
+func (f *federation) splitID(id string) (Network, string, bool) {
+	name, rest, ok := strings.Cut(id, ":")
+	if !ok {
+		return Network{}, id, false
+	}
+	network, ok := f.byName[name]
+	return network, rest, ok
+}
+
+func (f *federation) writeError(w http.ResponseWriter, r *http.Request, status int, message string) {
+	code := "ErrBadRequest"
+	switch status {
+	case http.StatusNotFound:
+		code = "ErrNotFound"
+	case http.StatusConflict:
+		code = "ErrConflict"
+	}
+	if err := encodeResponse(w, status, api.ErrorResponse{Code: code, Message: message}); err != nil {
+		f.logWriteError(r, err)
+	}
+}
+
+func (f *federation) logWriteError(r *http.Request, err error) {
+	requestLogger(f.logger, r).Error("failed to write response", slog.Any("error", err))
+}
+
+// pathID returns the identity in a request path such as
+// /api/v2alpha1/sites/{id}/processes.
+func pathID(path string) (string, bool) {
+	if !strings.HasPrefix(path, apiPrefix) {
+		return "", false
+	}
+	parts := strings.Split(strings.TrimPrefix(path, apiPrefix), "/")
+	if len(parts) < 2 || parts[1] == "" || parts[1] == "export" {
+		return "", false
+	}
+	id, err := url.PathUnescape(parts[1])
+	if err != nil {
+		return parts[1], true
+	}
+	return id, true
+}
+
+func isExport(path string) bool {
+	return strings.HasSuffix(path, "/export")
+}
+
+// isIDField reports whether a record field or query parameter holds a
+// record identity.
+func isIDField(name string) bool {
+	return name == "identity" || strings.HasSuffix(name, "Id")
+}
+
+func qualify(id, network string) string {
+	return network + ":" + id
+}
+
+func unqualify(id, network string) string {
+	return strings.TrimPrefix(id, network+":")
+}
+
+// qualifyRecord tags a record with its network and qualifies the
+// identities it holds.
+func qualifyRecord(record map[string]any, network string) {
+	if _, ok := record["identity"]; !ok {
+		return
+	}
+	for key, value := range record {
+		if id, ok := value.(string); ok && id != "" && isIDField(key) {
+			record[key] = qualify(id, network)
+		}
+	}
+	record["network"] = network
+}
+
+type collectionPage struct {
+	Count          int64            `json:"count"`
+	TimeRangeCount int64            `json:"timeRangeCount"`
+	Results        []map[string]any `json:"results"`
+}
+
+func decodeJSON(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// keep integers such as byte counts exact
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// sortOrder parses a sortBy parameter the same way getQueryParams does.
+func sortOrder(sortBy string) (string, bool) {
+	field, direction, ok := cutLast(sortBy, ".")
+	if !ok || (direction != "asc" && direction != "desc") {
+		return "identity", false
+	}
+	return field, direction == "desc"
+}
+
+func cutLast(s, sep string) (string, string, bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
+
+func lookupField(record map[string]any, path string) any {
+	var value any = record
+	for _, part := range strings.Split(path, ".") {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = obj[part]
+	}
+	return value
+}
+
+// compareJSON orders decoded JSON values, with missing values first.
+func compareJSON(x, y any) int {
+	switch {
+	case x == nil && y == nil:
+		return 0
+	case x == nil:
+		return -1
+	case y == nil:
+		return 1
+	}
+	switch x := x.(type) {
+	case json.Number:
+		if y, ok := y.(json.Number); ok {
+			xf, _ := x.Float64()
+			yf, _ := y.Float64()
+			switch {
+			case xf < yf:
+				return -1
+			case xf > yf:
+				return 1
+			}
+			return 0
+		}
+	case string:
+		if y, ok := y.(string); ok {
+			return strings.Compare(x, y)
+		}
+	case bool:
+		if y, ok := y.(bool); ok {
+			switch {
+			case x == y:
+				return 0
+			case !x:
+				return -1
+			}
+			return 1
+		}
+	}
+	return strings.Compare(fmt.Sprint(x), fmt.Sprint(y))
+}
+
+// bufferedResponse holds the response of a network's handler so that it
+// can be inspected and rewritten.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	if b.status == 0 {
+		b.status = status
+	}
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	b.WriteHeader(http.StatusOK)
+	return b.body.Write(p)
+}
+
+func (b *bufferedResponse) collection() (collectionPage, error) {
+	var page collectionPage
+	err := decodeJSON(b.body.Bytes(), &page)
+	return page, err
+}
+
+// qualify rewrites the records in a successful JSON response, whether it
+// holds a single record or a collection.
+func (b *bufferedResponse) qualify(network string) error {
+	if b.status != http.StatusOK || !strings.HasPrefix(b.header.Get("Content-Type"), "application/json") {
+		return nil
+	}
+	var response map[string]any
+	if err := decodeJSON(b.body.Bytes(), &response); err != nil {
+		return err
+	}
+	switch results := response["results"].(type) {
+	case map[string]any:
+		qualifyRecord(results, network)
+	case []any:
+		for _, result := range results {
+			if record, ok := result.(map[string]any); ok {
+				qualifyRecord(record, network)
+			}
+		}
+	default:
+		return nil
+	}
+	data, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	b.body.Reset()
+	b.body.Write(data)
+	b.body.WriteByte('\n')
+	return nil
+}
+
+func (b *bufferedResponse) writeTo(w http.ResponseWriter) {
+	for key, values := range b.header {
+		w.Header()[key] = values
+	}
+	w.Header().Del("Content-Length")
+	w.WriteHeader(b.status)
+	w.Write(b.body.Bytes())
+}
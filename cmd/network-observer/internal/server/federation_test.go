@@ -0,0 +1,216 @@
+package server
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+func testNetwork(t *testing.T, name string, records ...vanflow.Record) Network {
+	t.Helper()
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	for _, record := range records {
+		stor.Add(record, store.SourceRef{ID: "test"})
+	}
+	graph := collector.NewGraph(stor)
+	return Network{
+		Name:           name,
+		RouterEndpoint: "amqps://" + name,
+		Handler: api.HandlerWithOptions(New(slog.Default(), stor, graph), api.GorillaServerOptions{
+			BaseRouter: mux.NewRouter(),
+		}),
+	}
+}
+
+type federationResponse struct {
+	Count          int64           `json:"count"`
+	TimeRangeCount int64           `json:"timeRangeCount"`
+	Results        json.RawMessage `json:"results"`
+	Message        string          `json:"message"`
+	records        []map[string]any
+}
+
+func getFederated(t *testing.T, handler http.Handler, target string) (int, federationResponse) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+	var response federationResponse
+	if w.Header().Get("Content-Type") == "application/json" {
+		assert.Assert(t, json.Unmarshal(w.Body.Bytes(), &response))
+	}
+	if len(response.Results) > 0 && response.Results[0] == '[' {
+		assert.Assert(t, json.Unmarshal(response.Results, &response.records))
+	} else if len(response.Results) > 0 && response.Results[0] == '{' {
+		var record map[string]any
+		assert.Assert(t, json.Unmarshal(response.Results, &record))
+		response.records = []map[string]any{record}
+	}
+	return w.Code, response
+}
+
+func identities(records []map[string]any) []string {
+	ids := []string{}
+	for _, record := range records {
+		ids = append(ids, record["identity"].(string))
+	}
+	return ids
+}
+
+func TestFederation(t *testing.T) {
+	prod := testNetwork(t, "prod",
+		vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-a"), Name: ptrTo("a")},
+		vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-c"), Name: ptrTo("c")},
+		vanflow.SiteRecord{BaseRecord: vanflow.NewBase("shared"), Name: ptrTo("e")},
+		vanflow.RouterRecord{BaseRecord: vanflow.NewBase("router-a"), Parent: ptrTo("site-a")},
+	)
+	staging := testNetwork(t, "staging",
+		vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-b"), Name: ptrTo("b")},
+		vanflow.SiteRecord{BaseRecord: vanflow.NewBase("shared"), Name: ptrTo("d")},
+	)
+	handler := NewFederation(slog.Default(), []Network{prod, staging})
+
+	testCases := []struct {
+		Name           string
+		Target         string
+		ExpectStatus   int
+		ExpectIDs      []string
+		ExpectCount    int64
+		ExpectMessage  string
+		ExpectNetworks []string
+	}{
+		{
+			Name:         "merged collection",
+			Target:       "/api/v2alpha1/sites?sortBy=name.asc",
+			ExpectStatus: http.StatusOK,
+			ExpectIDs:    []string{"prod:site-a", "staging:site-b", "prod:site-c", "staging:shared", "prod:shared"},
+			ExpectCount:  5,
+		},
+		{
+			Name:         "merged page",
+			Target:       "/api/v2alpha1/sites?sortBy=name.desc&offset=1&limit=2",
+			ExpectStatus: http.StatusOK,
+			ExpectIDs:    []string{"staging:shared", "prod:site-c"},
+			ExpectCount:  5,
+		},
+		{
+			Name:         "network parameter",
+			Target:       "/api/v2alpha1/sites?network=staging",
+			ExpectStatus: http.StatusOK,
+			ExpectIDs:    []string{"staging:shared", "staging:site-b"},
+			ExpectCount:  2,
+		},
+		{
+			Name:          "unknown network",
+			Target:        "/api/v2alpha1/sites?network=partner",
+			ExpectStatus:  http.StatusNotFound,
+			ExpectMessage: `network "partner" not found`,
+		},
+		{
+			Name:         "qualified identity",
+			Target:       "/api/v2alpha1/sites/staging:shared",
+			ExpectStatus: http.StatusOK,
+			ExpectIDs:    []string{"staging:shared"},
+		},
+		{
+			Name:         "unique unqualified identity",
+			Target:       "/api/v2alpha1/sites/site-b",
+			ExpectStatus: http.StatusOK,
+			ExpectIDs:    []string{"staging:site-b"},
+		},
+		{
+			Name:          "ambiguous unqualified identity",
+			Target:        "/api/v2alpha1/sites/shared",
+			ExpectStatus:  http.StatusConflict,
+			ExpectMessage: "identity is ambiguous: found in networks prod, staging. Qualify it as network:identity or set the network parameter",
+		},
+		{
+			Name:         "unknown identity",
+			Target:       "/api/v2alpha1/sites/site-z",
+			ExpectStatus: http.StatusNotFound,
+		},
+		{
+			Name:         "qualified sub collection",
+			Target:       "/api/v2alpha1/sites/prod:site-a/routers",
+			ExpectStatus: http.StatusOK,
+			ExpectIDs:    []string{"prod:router-a"},
+			ExpectCount:  1,
+		},
+		{
+			Name:         "qualified filter",
+			Target:       "/api/v2alpha1/routers?siteId=prod:site-a",
+			ExpectStatus: http.StatusOK,
+			ExpectIDs:    []string{"prod:router-a"},
+			ExpectCount:  1,
+		},
+		{
+			Name:          "export requires network",
+			Target:        "/api/v2alpha1/connections/export",
+			ExpectStatus:  http.StatusBadRequest,
+			ExpectMessage: "the network parameter is required to export records when more than one network is observed",
+		},
+		{
+			Name:         "bad request from a network",
+			Target:       "/api/v2alpha1/sites?sortBy=bogus.asc",
+			ExpectStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			status, response := getFederated(t, handler, tc.Target)
+			assert.Equal(t, status, tc.ExpectStatus)
+			if tc.ExpectMessage != "" {
+				assert.Equal(t, response.Message, tc.ExpectMessage)
+			}
+			if tc.ExpectIDs != nil {
+				assert.DeepEqual(t, identities(response.records), tc.ExpectIDs)
+				for _, record := range response.records {
+					network, _, _ := strings.Cut(record["identity"].(string), ":")
+					assert.Equal(t, record["network"], network)
+				}
+			}
+			if tc.ExpectCount > 0 {
+				assert.Equal(t, response.TimeRangeCount, tc.ExpectCount)
+			}
+		})
+	}
+}
+
+func TestFederationNetworks(t *testing.T) {
+	handler := NewFederation(slog.Default(), []Network{testNetwork(t, "prod"), testNetwork(t, "staging")})
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v2alpha1/networks", nil))
+	assert.Equal(t, w.Code, http.StatusOK)
+	var response api.NetworkListResponse
+	assert.Assert(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.DeepEqual(t, response, api.NetworkListResponse{
+		Count:          2,
+		TimeRangeCount: 2,
+		Results: []api.NetworkRecord{
+			{Name: "prod", RouterEndpoint: "amqps://prod"},
+			{Name: "staging", RouterEndpoint: "amqps://staging"},
+		},
+	})
+}
+
+func TestFederationSingleNetwork(t *testing.T) {
+	handler := NewFederation(slog.Default(), []Network{
+		testNetwork(t, "default", vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-a")}),
+	})
+	for _, target := range []string{"/api/v2alpha1/sites", "/api/v2alpha1/sites?network=default", "/api/v2alpha1/sites/site-a"} {
+		status, response := getFederated(t, handler, target)
+		assert.Equal(t, status, http.StatusOK)
+		assert.DeepEqual(t, identities(response.records), []string{"site-a"})
+		_, tagged := response.records[0]["network"]
+		assert.Assert(t, !tagged, "records from a single network are not tagged")
+	}
+}
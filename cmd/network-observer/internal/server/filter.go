@@ -0,0 +1,720 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+)
+
+// A filter expression selects records by their fields, for example:
+//
+//	protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-")
+//	not destSiteId in ("abc", "def")
+//	name ~ "^backend-[0-9]+$"
+//
+// Fields are referenced by their names in the API schema, with nested
+// fields separated by dots. Comparisons with a list field match when any
+// element of the list matches, and comparisons with a field that has no
+// value never match.
+type filterNode any
+
+type filterAnd []filterNode
+type filterOr []filterNode
+
+type filterNot struct {
+	node filterNode
+}
+
+type filterComparison struct {
+	path   string
+	op     string
+	values []filterLiteral
+}
+
+type filterLiteral struct {
+	text string
+	pos  int
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenEOF filterTokenKind = iota
+	filterTokenIdent
+	filterTokenString
+	filterTokenNumber
+	filterTokenOperator
+	filterTokenLParen
+	filterTokenRParen
+	filterTokenComma
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+	pos  int
+}
+
+func (t filterToken) String() string {
+	if t.kind == filterTokenEOF {
+		return "end of filter"
+	}
+	return fmt.Sprintf("%q at position %d", t.text, t.pos)
+}
+
+// keyword returns the lower case form of an identifier that is one of
+// the reserved words of the language.
+func (t filterToken) keyword() string {
+	if t.kind != filterTokenIdent {
+		return ""
+	}
+	switch word := strings.ToLower(t.text); word {
+	case "and", "or", "not", "in":
+		return word
+	}
+	return ""
+}
+
+var filterOperators = []string{"==", "!=", "<=", ">=", "^=", "&&", "||", "=", "<", ">", "~", "!"}
+
+func tokenizeFilter(input string) ([]filterToken, error) {
+	var tokens []filterToken
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, filterToken{filterTokenLParen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, filterToken{filterTokenRParen, ")", i})
+			i++
+		case c == ',':
+			tokens = append(tokens, filterToken{filterTokenComma, ",", i})
+			i++
+		case c == '"' || c == '\'':
+			end := i + 1
+			for ; end < len(input) && input[end] != c; end++ {
+				if input[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(input) {
+				return nil, fmt.Errorf("unterminated string at position %d", i)
+			}
+			text, err := unquoteFilterString(input[i+1:end], c)
+			if err != nil {
+				return nil, fmt.Errorf("invalid string at position %d: %s", i, err)
+			}
+			tokens = append(tokens, filterToken{filterTokenString, text, i})
+			i = end + 1
+		case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+			end := i + 1
+			for end < len(input) && isFilterNumberChar(input[end], input[end-1]) {
+				end++
+			}
+			// not necessarily a valid number: unquoted values such
+			// as IP addresses are only rejected if compared with a
+			// numeric field
+			tokens = append(tokens, filterToken{filterTokenNumber, input[i:end], i})
+			i = end
+		case isFilterIdentChar(rune(c)):
+			end := i + 1
+			for end < len(input) && (isFilterIdentChar(rune(input[end])) || input[end] == '.' || input[end] == '-' || unicode.IsDigit(rune(input[end]))) {
+				end++
+			}
+			tokens = append(tokens, filterToken{filterTokenIdent, input[i:end], i})
+			i = end
+		default:
+			matched := false
+			for _, op := range filterOperators {
+				if strings.HasPrefix(input[i:], op) {
+					tokens = append(tokens, filterToken{filterTokenOperator, op, i})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+			}
+		}
+	}
+	return append(tokens, filterToken{kind: filterTokenEOF, pos: len(input)}), nil
+}
+
+func unquoteFilterString(s string, quote byte) (string, error) {
+	if quote == '"' {
+		return strconv.Unquote(`"` + s + `"`)
+	}
+	return strings.ReplaceAll(s, `\'`, `'`), nil
+}
+
+func isFilterIdentChar(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func isFilterNumberChar(c byte, prev byte) bool {
+	switch {
+	case c >= '0' && c <= '9', c == '.', c == 'e', c == 'E':
+		return true
+	case c == '-' || c == '+':
+		return prev == 'e' || prev == 'E'
+	}
+	return false
+}
+
+type filterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+// parseFilter parses a filter expression, returning an error that
+// identifies the position of any problem in the expression.
+func parseFilter(input string) (filterNode, error) {
+	tokens, err := tokenizeFilter(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{tokens: tokens}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if next := p.peek(); next.kind != filterTokenEOF {
+		return nil, fmt.Errorf("unexpected %s", next)
+	}
+	return node, nil
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() filterToken {
+	t := p.tokens[p.pos]
+	if t.kind != filterTokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	var nodes filterOr
+	for {
+		node, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+		if t := p.peek(); t.keyword() != "or" && !(t.kind == filterTokenOperator && t.text == "||") {
+			break
+		}
+		p.next()
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return nodes, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	var nodes filterAnd
+	for {
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+		if t := p.peek(); t.keyword() != "and" && !(t.kind == filterTokenOperator && t.text == "&&") {
+			break
+		}
+		p.next()
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return nodes, nil
+}
+
+func (p *filterParser) parseUnary() (filterNode, error) {
+	t := p.peek()
+	switch {
+	case t.keyword() == "not" || (t.kind == filterTokenOperator && t.text == "!"):
+		p.next()
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return filterNot{node: node}, nil
+	case t.kind == filterTokenLParen:
+		p.next()
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != filterTokenRParen {
+			return nil, fmt.Errorf("expected \")\" but found %s", closing)
+		}
+		return node, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (filterNode, error) {
+	field := p.next()
+	if field.kind != filterTokenIdent || field.keyword() != "" {
+		return nil, fmt.Errorf("expected a field name but found %s", field)
+	}
+	op := p.next()
+	if op.keyword() == "in" {
+		values, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		return filterComparison{path: field.text, op: "in", values: values}, nil
+	}
+	if op.kind != filterTokenOperator || op.text == "&&" || op.text == "||" || op.text == "!" {
+		return nil, fmt.Errorf("expected a comparison operator after field %q but found %s", field.text, op)
+	}
+	value, err := p.parseLiteral()
+	if err != nil {
+		return nil, err
+	}
+	opText := op.text
+	if opText == "=" {
+		opText = "=="
+	}
+	return filterComparison{path: field.text, op: opText, values: []filterLiteral{value}}, nil
+}
+
+func (p *filterParser) parseList() ([]filterLiteral, error) {
+	if open := p.next(); open.kind != filterTokenLParen {
+		return nil, fmt.Errorf("expected \"(\" to start a list but found %s", open)
+	}
+	var values []filterLiteral
+	for {
+		value, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		switch t := p.next(); t.kind {
+		case filterTokenComma:
+			continue
+		case filterTokenRParen:
+			return values, nil
+		default:
+			return nil, fmt.Errorf("expected \",\" or \")\" in list but found %s", t)
+		}
+	}
+}
+
+func (p *filterParser) parseLiteral() (filterLiteral, error) {
+	t := p.next()
+	switch t.kind {
+	case filterTokenString, filterTokenNumber:
+	case filterTokenIdent:
+		if t.keyword() != "" {
+			return filterLiteral{}, fmt.Errorf("expected a value but found %s", t)
+		}
+	default:
+		return filterLiteral{}, fmt.Errorf("expected a value but found %s", t)
+	}
+	return filterLiteral{text: t.text, pos: t.pos}, nil
+}
+
+// filterField locates a possibly nested field of a record type.
+type filterField struct {
+	name string
+	// path holds the index of the field at each level of nesting
+	path [][]int
+	typ  reflect.Type
+}
+
+func resolveFilterField[T any](path string) (filterField, error) {
+	field := filterField{name: path}
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	for _, part := range strings.Split(path, ".") {
+		for typ.Kind() == reflect.Pointer {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			return field, fmt.Errorf("field %q cannot be used in %q: %s has no fields", part, path, typ)
+		}
+		sf, ok := schemaField(typ, part)
+		if !ok {
+			return field, fmt.Errorf("unknown field %q", path)
+		}
+		field.path = append(field.path, sf.Index)
+		typ = sf.Type
+	}
+	field.typ = typ
+	return field, nil
+}
+
+// schemaField finds a struct field by its name in the API schema,
+// falling back to the go field name.
+func schemaField(typ reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		if tag, _, _ := strings.Cut(sf.Tag.Get("json"), ","); tag == name {
+			return sf, true
+		}
+	}
+	return typ.FieldByNameFunc(func(s string) bool {
+		return strings.EqualFold(s, name)
+	})
+}
+
+// values returns the values of the field in the supplied record,
+// flattening lists and omitting missing values.
+func (f filterField) values(record reflect.Value) []reflect.Value {
+	current := []reflect.Value{record}
+	for _, index := range f.path {
+		var next []reflect.Value
+		for _, v := range current {
+			for v.Kind() == reflect.Pointer {
+				if v.IsNil() {
+					break
+				}
+				v = v.Elem()
+			}
+			if v.Kind() != reflect.Struct {
+				continue
+			}
+			next = append(next, v.FieldByIndex(index))
+		}
+		current = next
+	}
+	var out []reflect.Value
+	var flatten func(v reflect.Value)
+	flatten = func(v reflect.Value) {
+		switch v.Kind() {
+		case reflect.Pointer:
+			if !v.IsNil() {
+				flatten(v.Elem())
+			}
+		case reflect.Slice:
+			for i := 0; i < v.Len(); i++ {
+				flatten(v.Index(i))
+			}
+		default:
+			out = append(out, v)
+		}
+	}
+	for _, v := range current {
+		flatten(v)
+	}
+	return out
+}
+
+type filterValueKind int
+
+const (
+	filterString filterValueKind = iota
+	filterNumber
+	filterBool
+)
+
+// kind returns the kind of the scalar values held by the field.
+func (f filterField) kind() (filterValueKind, error) {
+	typ := f.typ
+	for typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice {
+		typ = typ.Elem()
+	}
+	switch typ.Kind() {
+	case reflect.String:
+		return filterString, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return filterNumber, nil
+	case reflect.Bool:
+		return filterBool, nil
+	}
+	return 0, fmt.Errorf("field %q of type %s cannot be filtered", f.name, typ)
+}
+
+// compileFilter validates a filter expression against the fields of
+// the record type T and returns a predicate matching records that
+// satisfy it.
+func compileFilter[T any](node filterNode) (func(T) bool, error) {
+	match, err := compileFilterNode[T](node)
+	if err != nil {
+		return nil, err
+	}
+	return func(record T) bool {
+		return match(reflect.ValueOf(record))
+	}, nil
+}
+
+func compileFilterNode[T any](node filterNode) (func(reflect.Value) bool, error) {
+	switch n := node.(type) {
+	case filterAnd:
+		children, err := compileFilterNodes[T](n)
+		if err != nil {
+			return nil, err
+		}
+		return func(v reflect.Value) bool {
+			for _, child := range children {
+				if !child(v) {
+					return false
+				}
+			}
+			return true
+		}, nil
+	case filterOr:
+		children, err := compileFilterNodes[T](n)
+		if err != nil {
+			return nil, err
+		}
+		return func(v reflect.Value) bool {
+			for _, child := range children {
+				if child(v) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case filterNot:
+		child, err := compileFilterNode[T](n.node)
+		if err != nil {
+			return nil, err
+		}
+		return func(v reflect.Value) bool {
+			return !child(v)
+		}, nil
+	case filterComparison:
+		return compileFilterComparison[T](n)
+	}
+	return nil, fmt.Errorf("unsupported filter expression %T", node)
+}
+
+func compileFilterNodes[T any](nodes []filterNode) ([]func(reflect.Value) bool, error) {
+	var children []func(reflect.Value) bool
+	for _, node := range nodes {
+		child, err := compileFilterNode[T](node)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+	return children, nil
+}
+
+func compileFilterComparison[T any](c filterComparison) (func(reflect.Value) bool, error) {
+	field, err := resolveFilterField[T](c.path)
+	if err != nil {
+		return nil, err
+	}
+	kind, err := field.kind()
+	if err != nil {
+		return nil, err
+	}
+	var test func(reflect.Value) bool
+	switch kind {
+	case filterString:
+		test, err = stringComparison(field, c)
+	case filterNumber:
+		test, err = numberComparison(field, c)
+	case filterBool:
+		test, err = boolComparison(field, c)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return func(record reflect.Value) bool {
+		for _, v := range field.values(record) {
+			if test(v) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func unsupportedOperator(field filterField, c filterComparison, kind string) error {
+	return fmt.Errorf("operator %q cannot be used with %s field %q", c.op, kind, field.name)
+}
+
+func stringComparison(field filterField, c filterComparison) (func(reflect.Value) bool, error) {
+	value := c.values[0].text
+	var compare func(string) bool
+	switch c.op {
+	case "==":
+		compare = func(s string) bool { return s == value }
+	case "!=":
+		compare = func(s string) bool { return s != value }
+	case "<":
+		compare = func(s string) bool { return s < value }
+	case "<=":
+		compare = func(s string) bool { return s <= value }
+	case ">":
+		compare = func(s string) bool { return s > value }
+	case ">=":
+		compare = func(s string) bool { return s >= value }
+	case "^=":
+		compare = func(s string) bool { return strings.HasPrefix(s, value) }
+	case "~":
+		re, err := regexp.Compile(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q for field %q: %s", value, field.name, err)
+		}
+		compare = re.MatchString
+	case "in":
+		set := make(map[string]bool, len(c.values))
+		for _, v := range c.values {
+			set[v.text] = true
+		}
+		compare = func(s string) bool { return set[s] }
+	default:
+		return nil, unsupportedOperator(field, c, "string")
+	}
+	return func(v reflect.Value) bool {
+		if atStr, ok := v.Interface().(api.AtmarkDelimitedString); ok {
+			for _, part := range atStr.Parts() {
+				if compare(part) {
+					return true
+				}
+			}
+			return false
+		}
+		return compare(v.String())
+	}, nil
+}
+
+func numberComparison(field filterField, c filterComparison) (func(reflect.Value) bool, error) {
+	values := make([]float64, len(c.values))
+	for i, literal := range c.values {
+		n, err := strconv.ParseFloat(literal.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q at position %d is not a number as required by field %q", literal.text, literal.pos, field.name)
+		}
+		values[i] = n
+	}
+	value := values[0]
+	var compare func(float64) bool
+	switch c.op {
+	case "==":
+		compare = func(n float64) bool { return n == value }
+	case "!=":
+		compare = func(n float64) bool { return n != value }
+	case "<":
+		compare = func(n float64) bool { return n < value }
+	case "<=":
+		compare = func(n float64) bool { return n <= value }
+	case ">":
+		compare = func(n float64) bool { return n > value }
+	case ">=":
+		compare = func(n float64) bool { return n >= value }
+	case "in":
+		compare = func(n float64) bool {
+			for _, value := range values {
+				if n == value {
+					return true
+				}
+			}
+			return false
+		}
+	default:
+		return nil, unsupportedOperator(field, c, "numeric")
+	}
+	return func(v reflect.Value) bool {
+		switch {
+		case v.CanInt():
+			return compare(float64(v.Int()))
+		case v.CanUint():
+			return compare(float64(v.Uint()))
+		case v.CanFloat():
+			return compare(v.Float())
+		}
+		return false
+	}, nil
+}
+
+func boolComparison(field filterField, c filterComparison) (func(reflect.Value) bool, error) {
+	values := make([]bool, len(c.values))
+	for i, literal := range c.values {
+		b, err := strconv.ParseBool(literal.text)
+		if err != nil {
+			return nil, fmt.Errorf("value %q at position %d is not a boolean as required by field %q", literal.text, literal.pos, field.name)
+		}
+		values[i] = b
+	}
+	switch c.op {
+	case "==", "in":
+		return func(v reflect.Value) bool {
+			for _, b := range values {
+				if v.Bool() == b {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case "!=":
+		return func(v reflect.Value) bool { return v.Bool() != values[0] }, nil
+	}
+	return nil, unsupportedOperator(field, c, "boolean")
+}
+
+// filterEqualities returns the values that a field must equal for any
+// record to satisfy the filter, if the filter constrains the field in
+// that way. Such constraints allow the candidate records to be found
+// through an index rather than by scanning all records.
+func filterEqualities(node filterNode, path string) ([]string, bool) {
+	switch n := node.(type) {
+	case filterAnd:
+		for _, child := range n {
+			if values, ok := filterEqualities(child, path); ok {
+				return values, true
+			}
+		}
+	case filterComparison:
+		if n.path != path || (n.op != "==" && n.op != "in") {
+			return nil, false
+		}
+		values := make([]string, len(n.values))
+		for i, v := range n.values {
+			values[i] = v.text
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+// compileRequestFilter returns a predicate for records of type T
+// matching the filter expression parsed from a request by
+// requestFilter, which matches all records if there is no expression.
+func compileRequestFilter[T any](node filterNode) (func(T) bool, error) {
+	if node == nil {
+		return func(T) bool { return true }, nil
+	}
+	match, err := compileFilter[T](node)
+	if err != nil {
+		var example T
+		return nil, fmt.Errorf("invalid filter for %s: %s", reflect.TypeOf(example).Name(), err)
+	}
+	return match, nil
+}
+
+// requestFilter returns the parsed filter expression from the request,
+// if any.
+func requestFilter(r *http.Request) (filterNode, error) {
+	expr := r.URL.Query().Get("filter")
+	if strings.TrimSpace(expr) == "" {
+		return nil, nil
+	}
+	node, err := parseFilter(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %s", err)
+	}
+	return node, nil
+}
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+func TestFilterMatching(t *testing.T) {
+	connections := []api.ConnectionRecord{
+		{
+			Identity:       "c1",
+			Protocol:       "tcp",
+			OctetCount:     2_000_000,
+			SourceSiteName: "east-1",
+			DestHost:       "10.0.0.1",
+			RoutingKey:     "backend",
+			TraceSites:     []string{"east-1", "west-1"},
+		}, {
+			Identity:       "c2",
+			Protocol:       "tcp",
+			OctetCount:     512,
+			SourceSiteName: "west-1",
+			DestHost:       "10.0.0.2",
+			RoutingKey:     "backend-v2",
+			Duration:       ptrTo(uint64(1500)),
+		}, {
+			Identity:       "c3",
+			Protocol:       "http1",
+			OctetCount:     1_000_000,
+			SourceSiteName: "east-2",
+			DestHost:       "10.0.0.3",
+			RoutingKey:     "frontend",
+			ListenerError:  ptrTo("connection refused"),
+		},
+	}
+	testCases := []struct {
+		filter   string
+		expected []string
+	}{
+		{filter: `protocol == tcp`, expected: []string{"c1", "c2"}},
+		{filter: `protocol = "tcp"`, expected: []string{"c1", "c2"}},
+		{filter: `protocol != tcp`, expected: []string{"c3"}},
+		{filter: `octetCount > 1e6`, expected: []string{"c1"}},
+		{filter: `octetCount >= 1e6`, expected: []string{"c1", "c3"}},
+		{filter: `octetCount < 1000 or octetCount == 1000000`, expected: []string{"c2", "c3"}},
+		{filter: `octetCount >= 512 and octetCount <= 1e6`, expected: []string{"c2", "c3"}},
+		{filter: `sourceSiteName ^= "east-"`, expected: []string{"c1", "c3"}},
+		{filter: `routingKey ~ "^backend(-v[0-9]+)?$"`, expected: []string{"c1", "c2"}},
+		{filter: `routingKey in (frontend, 'backend-v2')`, expected: []string{"c2", "c3"}},
+		{filter: `not routingKey in (frontend, backend-v2)`, expected: []string{"c1"}},
+		{filter: `!(protocol == tcp) || destHost == 10.0.0.1`, expected: []string{"c1", "c3"}},
+		{filter: `protocol == tcp && (sourceSiteName ^= west or octetCount > 1e6)`, expected: []string{"c1", "c2"}},
+		{filter: `traceSites == west-1`, expected: []string{"c1"}},
+		{filter: `duration > 1000`, expected: []string{"c2"}},
+		{filter: `not duration > 1000`, expected: []string{"c1", "c3"}},
+		{filter: `listenerError ~ refused`, expected: []string{"c3"}},
+		{filter: `PROTOCOL == tcp AND OctetCount < 1000`, expected: []string{"c2"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.filter, func(t *testing.T) {
+			node, err := parseFilter(tc.filter)
+			assert.Assert(t, err)
+			match, err := compileFilter[api.ConnectionRecord](node)
+			assert.Assert(t, err)
+			var actual []string
+			for _, c := range connections {
+				if match(c) {
+					actual = append(actual, c.Identity)
+				}
+			}
+			assert.DeepEqual(t, actual, tc.expected)
+		})
+	}
+}
+
+func TestFilterErrors(t *testing.T) {
+	testCases := []struct {
+		filter string
+		err    string
+	}{
+		{filter: `protocol ==`, err: `invalid filter: expected a value but found end of filter`},
+		{filter: `protocol tcp`, err: `invalid filter: expected a comparison operator after field "protocol" but found "tcp" at position 9`},
+		{filter: `(protocol == tcp`, err: `invalid filter: expected ")" but found end of filter`},
+		{filter: `protocol == tcp)`, err: `invalid filter: unexpected ")" at position 15`},
+		{filter: `protocol == "tcp`, err: `invalid filter: unterminated string at position 12`},
+		{filter: `protocol == tcp and`, err: `invalid filter: expected a field name but found end of filter`},
+		{filter: `routingKey in frontend`, err: `invalid filter: expected "(" to start a list but found "frontend" at position 14`},
+		{filter: `routingKey in (a b)`, err: `invalid filter: expected "," or ")" in list but found "b" at position 17`},
+		{filter: `protocol == tcp ; drop`, err: `invalid filter: unexpected character ';' at position 16`},
+		{filter: `flavour == mint`, err: `invalid filter for ConnectionRecord: unknown field "flavour"`},
+		{filter: `protocol.name == tcp`, err: `invalid filter for ConnectionRecord: field "name" cannot be used in "protocol.name": string has no fields`},
+		{filter: `octetCount > lots`, err: `invalid filter for ConnectionRecord: value "lots" at position 13 is not a number as required by field "octetCount"`},
+		{filter: `octetCount ~ 1`, err: `invalid filter for ConnectionRecord: operator "~" cannot be used with numeric field "octetCount"`},
+		{filter: `routingKey ~ "("`, err: "invalid filter for ConnectionRecord: invalid regular expression \"(\" for field \"routingKey\": error parsing regexp: missing closing ): `(`"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.filter, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/?filter="+url.QueryEscape(tc.filter), nil)
+			node, err := requestFilter(r)
+			if err == nil {
+				_, err = compileRequestFilter[api.ConnectionRecord](node)
+			}
+			assert.Error(t, err, tc.err)
+		})
+	}
+}
+
+func TestFilterEqualities(t *testing.T) {
+	testCases := []struct {
+		filter   string
+		field    string
+		expected []string
+		ok       bool
+	}{
+		{filter: `name == a`, field: "name", expected: []string{"a"}, ok: true},
+		{filter: `name in (a, b) and protocol == tcp`, field: "name", expected: []string{"a", "b"}, ok: true},
+		{filter: `protocol == tcp and name == a`, field: "name", expected: []string{"a"}, ok: true},
+		{filter: `name == a or name == b`, field: "name"},
+		{filter: `not name == a`, field: "name"},
+		{filter: `name ^= a`, field: "name"},
+		{filter: `protocol == tcp`, field: "name"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.filter, func(t *testing.T) {
+			node, err := parseFilter(tc.filter)
+			assert.Assert(t, err)
+			values, ok := filterEqualities(node, tc.field)
+			assert.Equal(t, ok, tc.ok)
+			assert.DeepEqual(t, values, tc.expected)
+		})
+	}
+}
+
+func TestFilterParameter(t *testing.T) {
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	srv, c := requireTestClient(t, New(slog.Default(), stor, collector.NewGraph(stor)))
+	defer srv.Close()
+	stor.Replace(wrapRecords(
+		vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-1"), Name: ptrTo("east")},
+		vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-2"), Name: ptrTo("west")},
+	))
+
+	resp, err := c.SitesWithResponse(context.TODO(), &api.SitesParams{Filter: ptrTo("name == west")})
+	assert.Assert(t, err)
+	assert.Equal(t, resp.StatusCode(), 200)
+	assert.Equal(t, resp.JSON200.Count, int64(1))
+	assert.Equal(t, resp.JSON200.Results[0].Identity, "site-2")
+
+	resp, err = c.SitesWithResponse(context.TODO(), &api.SitesParams{Filter: ptrTo("name ==")})
+	assert.Assert(t, err)
+	assert.Equal(t, resp.StatusCode(), 400)
+}
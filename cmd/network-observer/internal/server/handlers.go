@@ -3,6 +3,7 @@ package server
 import (
 	"net/http"
 
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/alerts"
 	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
 	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
 	"github.com/skupperproject/skupper/cmd/network-observer/internal/server/views"
@@ -13,15 +14,44 @@ import (
 var _ api.ServerInterface = (*server)(nil)
 
 // (GET /api/v2alpha1/connections)
-func (s *server) Connections(w http.ResponseWriter, r *http.Request) {
+func (s *server) Connections(w http.ResponseWriter, r *http.Request, _ api.ConnectionsParams) {
 	results := views.NewConnectionsSliceProvider(s.records)(listByType[collector.ConnectionRecord](s.records))
 	if err := handleCollection(w, r, &api.ConnectionListResponse{}, results); err != nil {
 		s.logWriteError(r, err)
 	}
 }
 
+// (GET /api/v2alpha1/alerts)
+func (s *server) Alerts(w http.ResponseWriter, r *http.Request, _ api.AlertsParams) {
+	var entries []alerts.Alert
+	if s.alerts != nil {
+		entries = s.alerts.Alerts()
+	}
+	if err := handleCollection(w, r, &api.AlertListResponse{}, views.Alerts(entries)); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
+// (GET /api/v2alpha1/logs)
+func (s *server) Logs(w http.ResponseWriter, r *http.Request, _ api.LogsParams) {
+	all := func(collector.LogEntry) bool { return true }
+	if err := handleLogs(w, r, s.logs, func() bool { return true }, all); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
+// (GET /api/v2alpha1/networks)
+//
+// Networks are listed by the federation handler in front of the servers
+// for each network. A server on its own knows of no networks.
+func (s *server) Networks(w http.ResponseWriter, r *http.Request) {
+	if err := encodeResponse(w, http.StatusOK, api.NetworkListResponse{Results: []api.NetworkRecord{}}); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
 // (GET /api/v2alpha1/services/{id}/connections)
-func (s *server) ConnectionsByService(w http.ResponseWriter, r *http.Request, id string) {
+func (s *server) ConnectionsByService(w http.ResponseWriter, r *http.Request, id string, _ api.ConnectionsByServiceParams) {
 	getExemplar := fetchAndMap(s.records, func(a collector.AddressRecord) store.Entry {
 		return store.Entry{Record: collector.ConnectionRecord{RoutingKey: a.Name, Protocol: a.Protocol}}
 	}, id)
@@ -32,15 +62,45 @@ func (s *server) ConnectionsByService(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-func (s *server) Applicationflows(w http.ResponseWriter, r *http.Request) {
+func (s *server) Applicationflows(w http.ResponseWriter, r *http.Request, _ api.ApplicationflowsParams) {
 	results := views.NewRequestSliceProvider(s.records)(listByType[collector.RequestRecord](s.records))
 	if err := handleCollection(w, r, &api.ApplicationFlowResponse{}, results); err != nil {
 		s.logWriteError(r, err)
 	}
 }
 
+// (GET /api/v2alpha1/connections/export)
+func (s *server) ExportConnections(w http.ResponseWriter, r *http.Request, _ api.ExportConnectionsParams) {
+	provider := views.NewConnectionsProvider(s.records)
+	entries := listByType[collector.ConnectionRecord](s.records)
+	if err := handleExport(w, r, entries, func(e store.Entry) (api.ConnectionRecord, bool) {
+		record, ok := e.Record.(collector.ConnectionRecord)
+		if !ok {
+			return api.ConnectionRecord{}, false
+		}
+		return provider(record)
+	}); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
+// (GET /api/v2alpha1/applicationflows/export)
+func (s *server) ExportApplicationflows(w http.ResponseWriter, r *http.Request, _ api.ExportApplicationflowsParams) {
+	provider := views.NewRequestProvider(s.records)
+	entries := listByType[collector.RequestRecord](s.records)
+	if err := handleExport(w, r, entries, func(e store.Entry) (api.ApplicationFlowRecord, bool) {
+		record, ok := e.Record.(collector.RequestRecord)
+		if !ok {
+			return api.ApplicationFlowRecord{}, false
+		}
+		return provider(record)
+	}); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
 // (GET /api/v2alpha1/services)
-func (s *server) Services(w http.ResponseWriter, r *http.Request) {
+func (s *server) Services(w http.ResponseWriter, r *http.Request, _ api.ServicesParams) {
 	results := views.NewServiceSliceProvider(s.records, s.graph)(listByType[collector.AddressRecord](s.records))
 	if err := handleCollection(w, r, &api.ServiceListResponse{}, results); err != nil {
 		s.logWriteError(r, err)
@@ -56,7 +116,7 @@ func (s *server) ServiceByID(w http.ResponseWriter, r *http.Request, id string)
 }
 
 // (GET /api/v2alpha1/services/{id}/processes)
-func (s *server) ProcessesByService(w http.ResponseWriter, r *http.Request, id string) {
+func (s *server) ProcessesByService(w http.ResponseWriter, r *http.Request, id string, _ api.ProcessesByServiceParams) {
 	//todo(ck) find a way to more directly index this
 	anode := s.graph.Address(id)
 	if !anode.IsKnown() {
@@ -83,7 +143,7 @@ func (s *server) ProcessesByService(w http.ResponseWriter, r *http.Request, id s
 }
 
 // (GET /api/v2alpha1/services/{id}/processes)
-func (s *server) ProcessPairsByService(w http.ResponseWriter, r *http.Request, id string) {
+func (s *server) ProcessPairsByService(w http.ResponseWriter, r *http.Request, id string, _ api.ProcessPairsByServiceParams) {
 	//todo(ck) find a way to more directly index this
 	addr, ok := s.graph.Address(id).GetRecord()
 	if !ok {
@@ -123,9 +183,19 @@ func (s *server) ProcessPairsByService(w http.ResponseWriter, r *http.Request, i
 }
 
 // (GET /api/v2alpha1/connectors)
-func (s *server) Connectors(w http.ResponseWriter, r *http.Request) {
-	results := views.NewConnectorSliceProvider(s.graph)(listByType[vanflow.ConnectorRecord](s.records))
-	if err := handleCollection(w, r, &api.ConnectorListResponse{}, results); err != nil {
+func (s *server) Connectors(w http.ResponseWriter, r *http.Request, _ api.ConnectorsParams) {
+	filter, err := requestFilter(r)
+	if err != nil {
+		if err := handleBadRequest(w, err); err != nil {
+			s.logWriteError(r, err)
+		}
+		return
+	}
+	results := views.NewConnectorSliceProvider(s.graph)(listByTypeFiltered[vanflow.ConnectorRecord](s.records, filter,
+		byName(func(name *string) vanflow.Record { return vanflow.ConnectorRecord{Name: name} }),
+		byRoutingKey(func(address *string) vanflow.Record { return vanflow.ConnectorRecord{Address: address} }),
+	))
+	if err := handleFilteredCollection(w, r, filter, &api.ConnectorListResponse{}, results); err != nil {
 		s.logWriteError(r, err)
 	}
 }
@@ -157,9 +227,19 @@ func (s *server) HostsByID(w http.ResponseWriter, r *http.Request, id string) {
 }
 
 // (GET /api/v2alpha1/listeners)
-func (s *server) Listeners(w http.ResponseWriter, r *http.Request) {
-	results := views.NewListenerSliceProvider(s.graph)(listByType[vanflow.ListenerRecord](s.records))
-	if err := handleCollection(w, r, &api.ListenerListResponse{}, results); err != nil {
+func (s *server) Listeners(w http.ResponseWriter, r *http.Request, _ api.ListenersParams) {
+	filter, err := requestFilter(r)
+	if err != nil {
+		if err := handleBadRequest(w, err); err != nil {
+			s.logWriteError(r, err)
+		}
+		return
+	}
+	results := views.NewListenerSliceProvider(s.graph)(listByTypeFiltered[vanflow.ListenerRecord](s.records, filter,
+		byName(func(name *string) vanflow.Record { return vanflow.ListenerRecord{Name: name} }),
+		byRoutingKey(func(address *string) vanflow.Record { return vanflow.ListenerRecord{Address: address} }),
+	))
+	if err := handleFilteredCollection(w, r, filter, &api.ListenerListResponse{}, results); err != nil {
 		s.logWriteError(r, err)
 	}
 }
@@ -173,9 +253,18 @@ func (s *server) ListenerByID(w http.ResponseWriter, r *http.Request, id string)
 }
 
 // (GET /api/v2alpha1/processes)
-func (s *server) Processes(w http.ResponseWriter, r *http.Request) {
-	results := views.NewProcessSliceProvider(s.records, s.graph)(listByType[vanflow.ProcessRecord](s.records))
-	if err := handleCollection(w, r, &api.ProcessListResponse{}, results); err != nil {
+func (s *server) Processes(w http.ResponseWriter, r *http.Request, _ api.ProcessesParams) {
+	filter, err := requestFilter(r)
+	if err != nil {
+		if err := handleBadRequest(w, err); err != nil {
+			s.logWriteError(r, err)
+		}
+		return
+	}
+	results := views.NewProcessSliceProvider(s.records, s.graph)(listByTypeFiltered[vanflow.ProcessRecord](s.records, filter,
+		byName(func(name *string) vanflow.Record { return vanflow.ProcessRecord{Name: name} }),
+	))
+	if err := handleFilteredCollection(w, r, filter, &api.ProcessListResponse{}, results); err != nil {
 		s.logWriteError(r, err)
 	}
 }
@@ -189,7 +278,7 @@ func (s *server) ProcessById(w http.ResponseWriter, r *http.Request, id string)
 }
 
 // (GET /api/v2alpha1/componentpairs)
-func (s *server) Componentpairs(w http.ResponseWriter, r *http.Request) {
+func (s *server) Componentpairs(w http.ResponseWriter, r *http.Request, _ api.ComponentpairsParams) {
 	results := views.NewComponentPairSliceProvider()(listByType[collector.ProcGroupPairRecord](s.records))
 	if err := handleCollection(w, r, &api.FlowAggregateListResponse{}, results); err != nil {
 		s.logWriteError(r, err)
@@ -205,9 +294,18 @@ func (s *server) ComponentpairByID(w http.ResponseWriter, r *http.Request, id st
 }
 
 // (GET /api/v2alpha1/components)
-func (s *server) Components(w http.ResponseWriter, r *http.Request) {
-	results := views.NewComponentSliceProvider(s.records)(listByType[collector.ProcessGroupRecord](s.records))
-	if err := handleCollection(w, r, &api.ComponentListResponse{}, results); err != nil {
+func (s *server) Components(w http.ResponseWriter, r *http.Request, _ api.ComponentsParams) {
+	filter, err := requestFilter(r)
+	if err != nil {
+		if err := handleBadRequest(w, err); err != nil {
+			s.logWriteError(r, err)
+		}
+		return
+	}
+	results := views.NewComponentSliceProvider(s.records)(listByTypeFiltered[collector.ProcessGroupRecord](s.records, filter,
+		byName(func(name *string) vanflow.Record { return collector.ProcessGroupRecord{Name: *name} }),
+	))
+	if err := handleFilteredCollection(w, r, filter, &api.ComponentListResponse{}, results); err != nil {
 		s.logWriteError(r, err)
 	}
 }
@@ -221,7 +319,7 @@ func (s *server) ComponentByID(w http.ResponseWriter, r *http.Request, id string
 }
 
 // (GET /api/v2alpha1/processpairs)
-func (s *server) Processpairs(w http.ResponseWriter, r *http.Request) {
+func (s *server) Processpairs(w http.ResponseWriter, r *http.Request, _ api.ProcesspairsParams) {
 	results := views.NewProcessPairSliceProvider(s.graph)(listByType[collector.ProcPairRecord](s.records))
 	if err := handleCollection(w, r, &api.FlowAggregateListResponse{}, results); err != nil {
 		s.logWriteError(r, err)
@@ -237,7 +335,7 @@ func (s *server) ProcesspairByID(w http.ResponseWriter, r *http.Request, id stri
 }
 
 // (GET /api/v2alpha1/routeraccess)
-func (s *server) Routeraccess(w http.ResponseWriter, r *http.Request) {
+func (s *server) Routeraccess(w http.ResponseWriter, r *http.Request, _ api.RouteraccessParams) {
 	results := views.RouterAccessList(listByType[vanflow.RouterAccessRecord](s.records))
 	if err := handleCollection(w, r, &api.RouterAccessListResponse{}, results); err != nil {
 		s.logWriteError(r, err)
@@ -253,9 +351,18 @@ func (s *server) RouteraccessByID(w http.ResponseWriter, r *http.Request, id str
 }
 
 // (GET /api/v2alpha1/routerlinks)
-func (s *server) Routerlinks(w http.ResponseWriter, r *http.Request) {
-	results := views.NewRotuerLinkSliceProvider(s.graph)(listByType[vanflow.LinkRecord](s.records))
-	if err := handleCollection(w, r, &api.RouterLinkListResponse{}, results); err != nil {
+func (s *server) Routerlinks(w http.ResponseWriter, r *http.Request, _ api.RouterlinksParams) {
+	filter, err := requestFilter(r)
+	if err != nil {
+		if err := handleBadRequest(w, err); err != nil {
+			s.logWriteError(r, err)
+		}
+		return
+	}
+	results := views.NewRotuerLinkSliceProvider(s.graph)(listByTypeFiltered[vanflow.LinkRecord](s.records, filter,
+		byName(func(name *string) vanflow.Record { return vanflow.LinkRecord{Name: name} }),
+	))
+	if err := handleFilteredCollection(w, r, filter, &api.RouterLinkListResponse{}, results); err != nil {
 		s.logWriteError(r, err)
 	}
 }
@@ -269,9 +376,18 @@ func (s *server) RouterlinkByID(w http.ResponseWriter, r *http.Request, id strin
 }
 
 // (GET /api/v2alpha1/routers)
-func (s *server) Routers(w http.ResponseWriter, r *http.Request) {
-	results := views.Routers(listByType[vanflow.RouterRecord](s.records))
-	if err := handleCollection(w, r, &api.RouterListResponse{}, results); err != nil {
+func (s *server) Routers(w http.ResponseWriter, r *http.Request, _ api.RoutersParams) {
+	filter, err := requestFilter(r)
+	if err != nil {
+		if err := handleBadRequest(w, err); err != nil {
+			s.logWriteError(r, err)
+		}
+		return
+	}
+	results := views.Routers(listByTypeFiltered[vanflow.RouterRecord](s.records, filter,
+		byName(func(name *string) vanflow.Record { return vanflow.RouterRecord{Name: name} }),
+	))
+	if err := handleFilteredCollection(w, r, filter, &api.RouterListResponse{}, results); err != nil {
 		s.logWriteError(r, err)
 	}
 }
@@ -284,8 +400,16 @@ func (s *server) RouterByID(w http.ResponseWriter, r *http.Request, id string) {
 	}
 }
 
+// (GET /api/v2alpha1/routers/{id}/logs)
+func (s *server) LogsByRouter(w http.ResponseWriter, r *http.Request, id string, _ api.LogsByRouterParams) {
+	byRouter := func(entry collector.LogEntry) bool { return entry.RouterID == id }
+	if err := handleLogs(w, r, s.logs, exists[vanflow.RouterRecord](s.records, id), byRouter); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
 // (GET /api/v2alpha1/sitepairs)
-func (s *server) Sitepairs(w http.ResponseWriter, r *http.Request) {
+func (s *server) Sitepairs(w http.ResponseWriter, r *http.Request, _ api.SitepairsParams) {
 	results := views.NewSitePairSliceProvider(s.graph)(listByType[collector.SitePairRecord](s.records))
 	if err := handleCollection(w, r, &api.FlowAggregateListResponse{}, results); err != nil {
 		s.logWriteError(r, err)
@@ -296,10 +420,40 @@ func (s *server) Sitepairs(w http.ResponseWriter, r *http.Request) {
 func (s *server) SitepairByID(w http.ResponseWriter, r *http.Request, id string) {
 }
 
+// (GET /api/v2alpha1/sitepairs/{id}/history)
+func (s *server) HistoryBySitepair(w http.ResponseWriter, r *http.Request, id string) {
+	if err := handleHistory(w, r, s.history, id, exists[collector.SitePairRecord](s.records, id)); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
+// (GET /api/v2alpha1/processpairs/{id}/history)
+func (s *server) HistoryByProcesspair(w http.ResponseWriter, r *http.Request, id string) {
+	if err := handleHistory(w, r, s.history, id, exists[collector.ProcPairRecord](s.records, id)); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
+// (GET /api/v2alpha1/services/{id}/history)
+func (s *server) HistoryByService(w http.ResponseWriter, r *http.Request, id string) {
+	if err := handleHistory(w, r, s.history, id, exists[collector.AddressRecord](s.records, id)); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
 // (GET /api/v2alpha1/sites)
-func (s *server) Sites(w http.ResponseWriter, r *http.Request) {
-	results := views.NewSiteSliceProvider(s.graph)(listByType[vanflow.SiteRecord](s.records))
-	if err := handleCollection(w, r, &api.SiteListResponse{}, results); err != nil {
+func (s *server) Sites(w http.ResponseWriter, r *http.Request, _ api.SitesParams) {
+	filter, err := requestFilter(r)
+	if err != nil {
+		if err := handleBadRequest(w, err); err != nil {
+			s.logWriteError(r, err)
+		}
+		return
+	}
+	results := views.NewSiteSliceProvider(s.graph)(listByTypeFiltered[vanflow.SiteRecord](s.records, filter,
+		byName(func(name *string) vanflow.Record { return vanflow.SiteRecord{Name: name} }),
+	))
+	if err := handleFilteredCollection(w, r, filter, &api.SiteListResponse{}, results); err != nil {
 		s.logWriteError(r, err)
 	}
 }
@@ -321,7 +475,7 @@ func (s *server) HostsBySite(w http.ResponseWriter, r *http.Request, id string)
 }
 
 // (GET /api/v2alpha1/sites/{id}/processes)
-func (s *server) ProcessesBySite(w http.ResponseWriter, r *http.Request, id string) {
+func (s *server) ProcessesBySite(w http.ResponseWriter, r *http.Request, id string, _ api.ProcessesBySiteParams) {
 	exemplar := store.Entry{Record: vanflow.ProcessRecord{Parent: &id}}
 	results := views.NewProcessSliceProvider(s.records, s.graph)(index(s.records, collector.IndexByTypeParent, exemplar))
 	if err := handleCollection(w, r, &api.ProcessListResponse{}, results); err != nil {
@@ -329,8 +483,16 @@ func (s *server) ProcessesBySite(w http.ResponseWriter, r *http.Request, id stri
 	}
 }
 
+// (GET /api/v2alpha1/sites/{id}/logs)
+func (s *server) LogsBySite(w http.ResponseWriter, r *http.Request, id string, _ api.LogsBySiteParams) {
+	bySite := func(entry collector.LogEntry) bool { return entry.SiteID == id }
+	if err := handleLogs(w, r, s.logs, exists[vanflow.SiteRecord](s.records, id), bySite); err != nil {
+		s.logWriteError(r, err)
+	}
+}
+
 // (GET /api/v2alpha1/sites/{id}/routers)
-func (s *server) RoutersBySite(w http.ResponseWriter, r *http.Request, id string) {
+func (s *server) RoutersBySite(w http.ResponseWriter, r *http.Request, id string, _ api.RoutersBySiteParams) {
 	exemplar := store.Entry{Record: vanflow.RouterRecord{Parent: &id}}
 	results := views.Routers(index(s.records, collector.IndexByTypeParent, exemplar))
 	if err := handleCollection(w, r, &api.RouterListResponse{}, results); err != nil {
@@ -1,9 +1,12 @@
 package server
 
 import (
+	"slices"
 	"sort"
 	"strings"
 
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/server/views"
 	"github.com/skupperproject/skupper/pkg/vanflow"
 	"github.com/skupperproject/skupper/pkg/vanflow/store"
 )
@@ -43,6 +46,63 @@ func listByType[T vanflow.Record](stor store.Interface) []store.Entry {
 	return ordered(stor.Index(store.TypeIndex, store.Entry{Record: r}))
 }
 
+// indexLookup describes how records whose API field equals a value can
+// be found through a store index.
+type indexLookup struct {
+	field    string
+	index    string
+	exemplar func(value string) store.Entry
+}
+
+// listByTypeFiltered returns the entries of type T, narrowed through the
+// first of the lookups whose field the parsed request filter restricts
+// to a set of values. The entries returned may include some that do not
+// match the filter, which must still be applied to the API records.
+func listByTypeFiltered[T vanflow.Record](stor store.Interface, filter filterNode, lookups ...indexLookup) []store.Entry {
+	if filter == nil {
+		return listByType[T](stor)
+	}
+	for _, lookup := range lookups {
+		values, ok := filterEqualities(filter, lookup.field)
+		if !ok || slices.ContainsFunc(values, views.IsUnknown) {
+			continue
+		}
+		var entries []store.Entry
+		seen := map[string]bool{}
+		for _, value := range values {
+			for _, entry := range stor.Index(lookup.index, lookup.exemplar(value)) {
+				if _, ok := entry.Record.(T); !ok || seen[entry.Record.Identity()] {
+					continue
+				}
+				seen[entry.Record.Identity()] = true
+				entries = append(entries, entry)
+			}
+		}
+		return ordered(entries)
+	}
+	return listByType[T](stor)
+}
+
+func byName(exemplar func(name *string) vanflow.Record) indexLookup {
+	return indexLookup{
+		field: "name",
+		index: collector.IndexByTypeName,
+		exemplar: func(value string) store.Entry {
+			return store.Entry{Record: exemplar(&value)}
+		},
+	}
+}
+
+func byRoutingKey(exemplar func(address *string) vanflow.Record) indexLookup {
+	return indexLookup{
+		field: "routingKey",
+		index: collector.IndexByAddress,
+		exemplar: func(value string) store.Entry {
+			return store.Entry{Record: exemplar(&value)}
+		},
+	}
+}
+
 func index(stor store.Interface, index string, exemplar store.Entry) []store.Entry {
 	return ordered(stor.Index(index, exemplar))
 }
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+type testHistory map[string][]collector.HistoryBucket
+
+func (h testHistory) Buckets(id string, resolution string) ([]collector.HistoryBucket, bool) {
+	if resolution != "1m" && resolution != "1h" {
+		return nil, false
+	}
+	return h[id+"/"+resolution], true
+}
+
+func TestHistoryByProcesspair(t *testing.T) {
+	tlog := slog.Default()
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	graph := collector.NewGraph(stor)
+	now := time.Now().Truncate(time.Minute)
+	history := testHistory{
+		"pp1/1m": {
+			{Start: now.Add(-time.Hour), End: now.Add(-59 * time.Minute), Octets: 1},
+			{Start: now.Add(-2 * time.Minute), End: now.Add(-time.Minute), Octets: 10, Opened: 1},
+			{Start: now.Add(-time.Minute), End: now, Octets: 20, OctetsReverse: 5, Closed: 1},
+		},
+		"pp1/1h": {
+			{Start: now.Truncate(time.Hour), End: now.Truncate(time.Hour).Add(time.Hour), Octets: 31},
+		},
+	}
+	srv, c := requireTestClient(t, NewWithOptions(tlog, stor, graph, Options{History: history}))
+	defer srv.Close()
+
+	testcases := []struct {
+		ID            string
+		Records       []store.Entry
+		Parameters    map[string][]string
+		ExpectStatus  int
+		ExpectResults func(t *testing.T, results []api.HistoryRecord)
+	}{
+		{ID: "pp1", ExpectStatus: 404},
+		{
+			ID:           "pp1",
+			Records:      wrapRecords(collector.ProcPairRecord{ID: "pp1"}),
+			ExpectStatus: 200,
+			ExpectResults: func(t *testing.T, results []api.HistoryRecord) {
+				assert.Equal(t, len(results), 2)
+				assert.Equal(t, results[0].OctetCount, uint64(10))
+				assert.Equal(t, results[0].OpenedCount, uint64(1))
+				assert.Equal(t, results[1].OctetCount, uint64(20))
+				assert.Equal(t, results[1].OctetReverseCount, uint64(5))
+				assert.Equal(t, results[1].ClosedCount, uint64(1))
+				assert.Equal(t, results[1].Resolution, api.HistoryResolution1m)
+				assert.Equal(t, results[1].EndTime, uint64(now.UnixMicro()))
+			},
+		},
+		{
+			ID:      "pp1",
+			Records: wrapRecords(collector.ProcPairRecord{ID: "pp1"}),
+			Parameters: map[string][]string{
+				"timeRangeStart": {"0"},
+			},
+			ExpectStatus: 200,
+			ExpectResults: func(t *testing.T, results []api.HistoryRecord) {
+				assert.Equal(t, len(results), 3)
+			},
+		},
+		{
+			ID:      "pp1",
+			Records: wrapRecords(collector.ProcPairRecord{ID: "pp1"}),
+			Parameters: map[string][]string{
+				"resolution": {"1h"},
+			},
+			ExpectStatus: 200,
+			ExpectResults: func(t *testing.T, results []api.HistoryRecord) {
+				assert.Equal(t, len(results), 1)
+				assert.Equal(t, results[0].OctetCount, uint64(31))
+				assert.Equal(t, results[0].Resolution, api.HistoryResolution1h)
+			},
+		},
+		{
+			ID:      "pp1",
+			Records: wrapRecords(collector.ProcPairRecord{ID: "pp1"}),
+			Parameters: map[string][]string{
+				"resolution": {"1d"},
+			},
+			ExpectStatus: 400,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run("", func(t *testing.T) {
+			stor.Replace(tc.Records)
+			resp, err := c.HistoryByProcesspairWithResponse(context.TODO(), tc.ID, withParameters(tc.Parameters))
+			assert.Check(t, err)
+			assert.Equal(t, resp.StatusCode(), tc.ExpectStatus)
+			if tc.ExpectResults != nil {
+				assert.Equal(t, resp.JSON200.Count, int64(len(resp.JSON200.Results)))
+				tc.ExpectResults(t, resp.JSON200.Results)
+			}
+		})
+	}
+}
@@ -86,7 +86,7 @@ func TestRouterlinks(t *testing.T) {
 		t.Run("", func(t *testing.T) {
 			stor.Replace(tc.Records)
 			graph.(reset).Reset()
-			resp, err := c.RouterlinksWithResponse(context.TODO(), withParameters(tc.Parameters))
+			resp, err := c.RouterlinksWithResponse(context.TODO(), nil, withParameters(tc.Parameters))
 			assert.Check(t, err)
 			if tc.ExpectOK {
 				assert.Equal(t, resp.StatusCode(), 200)
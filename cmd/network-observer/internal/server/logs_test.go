@@ -0,0 +1,128 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+	"gotest.tools/v3/assert"
+)
+
+type testLogs []collector.LogEntry
+
+func (l testLogs) Entries() []collector.LogEntry {
+	return l
+}
+
+func TestLogs(t *testing.T) {
+	tlog := slog.Default()
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
+	graph := collector.NewGraph(stor)
+	now := time.Now()
+	logs := testLogs{
+		{ID: "l1", Time: now.Add(-time.Hour), Severity: "error", Module: "ROUTER_CORE", Text: "old",
+			RouterID: "router-a", RouterName: "east-router", SiteID: "site-a", SiteName: "east"},
+		{ID: "l2", Time: now.Add(-2 * time.Minute), Severity: "error", Module: "ROUTER_CORE", Text: "Link connection failed: TLS handshake",
+			SourceFile: "src/server.c", SourceLine: 42,
+			RouterID: "router-a", RouterName: "east-router", SiteID: "site-a", SiteName: "east"},
+		{ID: "l3", Time: now.Add(-time.Minute), Severity: "warning", Module: "TCP_ADAPTOR", Text: "connection refused",
+			RouterID: "router-b", RouterName: "west-router", SiteID: "site-b", SiteName: "west"},
+		{ID: "l4", Time: now.Add(-time.Minute), Severity: "info", Text: "unattributed"},
+	}
+	srv, c := requireTestClient(t, NewWithOptions(tlog, stor, graph, Options{Logs: logs}))
+	defer srv.Close()
+
+	stor.Replace(wrapRecords(
+		vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-a"), Name: ptrTo("east")},
+		vanflow.RouterRecord{BaseRecord: vanflow.NewBase("router-a"), Name: ptrTo("east-router"), Parent: ptrTo("site-a")},
+	))
+
+	testcases := []collectionTestCase[api.LogRecord]{
+		{
+			ExpectOK:    true,
+			ExpectCount: 3,
+			ExpectResults: func(t *testing.T, results []api.LogRecord) {
+				assert.DeepEqual(t, results[0], api.LogRecord{
+					Identity:   "l2",
+					StartTime:  uint64(now.Add(-2 * time.Minute).UnixMicro()),
+					EndTime:    uint64(now.Add(-2 * time.Minute).UnixMicro()),
+					Severity:   api.LogSeverityError,
+					Module:     "ROUTER_CORE",
+					Text:       "Link connection failed: TLS handshake",
+					SourceFile: ptrTo("src/server.c"),
+					SourceLine: ptrTo(uint64(42)),
+					RouterId:   "router-a",
+					RouterName: "east-router",
+					SiteId:     "site-a",
+					SiteName:   "east",
+				})
+				assert.Equal(t, results[2].Identity, "l4")
+				assert.Equal(t, results[2].RouterId, "unknown")
+			},
+		}, {
+			Parameters:  map[string][]string{"timeRangeStart": {"0"}},
+			ExpectOK:    true,
+			ExpectCount: 4,
+		}, {
+			Parameters:  map[string][]string{"severity": {"error"}},
+			ExpectOK:    true,
+			ExpectCount: 1,
+		}, {
+			Parameters:  map[string][]string{"module": {"TCP_ADAPTOR"}, "siteId": {"site-b"}},
+			ExpectOK:    true,
+			ExpectCount: 1,
+			ExpectResults: func(t *testing.T, results []api.LogRecord) {
+				assert.Equal(t, results[0].Identity, "l3")
+			},
+		}, {
+			Parameters:  map[string][]string{"filter": {"severity in (error, warning) and routerName ^= west"}},
+			ExpectOK:    true,
+			ExpectCount: 1,
+		}, {
+			Parameters: map[string][]string{"flavour": {"mint"}},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run("", func(t *testing.T) {
+			resp, err := c.LogsWithResponse(context.TODO(), nil, withParameters(tc.Parameters))
+			assert.Check(t, err)
+			if !tc.ExpectOK {
+				assert.Equal(t, resp.StatusCode(), 400)
+				return
+			}
+			assert.Equal(t, resp.StatusCode(), 200)
+			assert.Equal(t, resp.JSON200.Count, int64(tc.ExpectCount))
+			assert.Equal(t, len(resp.JSON200.Results), tc.ExpectCount)
+			if tc.ExpectResults != nil {
+				tc.ExpectResults(t, resp.JSON200.Results)
+			}
+		})
+	}
+
+	t.Run("by site", func(t *testing.T) {
+		resp, err := c.LogsBySiteWithResponse(context.TODO(), "site-a", nil, withParameters(map[string][]string{"timeRangeStart": {"0"}}))
+		assert.Check(t, err)
+		assert.Equal(t, resp.StatusCode(), 200)
+		assert.Equal(t, resp.JSON200.Count, int64(2))
+
+		resp, err = c.LogsBySiteWithResponse(context.TODO(), "site-b", nil)
+		assert.Check(t, err)
+		assert.Equal(t, resp.StatusCode(), 404)
+	})
+	t.Run("by router", func(t *testing.T) {
+		resp, err := c.LogsByRouterWithResponse(context.TODO(), "router-a", nil)
+		assert.Check(t, err)
+		assert.Equal(t, resp.StatusCode(), 200)
+		assert.Equal(t, resp.JSON200.Count, int64(1))
+		assert.Equal(t, resp.JSON200.Results[0].Identity, "l2")
+
+		resp, err = c.LogsByRouterWithResponse(context.TODO(), "router-b", nil)
+		assert.Check(t, err)
+		assert.Equal(t, resp.StatusCode(), 404)
+	})
+}
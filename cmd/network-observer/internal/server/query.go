@@ -14,7 +14,7 @@ import (
 	"golang.org/x/text/language"
 )
 
-func filterAndOrderResults[T api.Record](r *http.Request, results []T) ([]T, int64, error) {
+func filterAndOrderResults[T api.Record](r *http.Request, filterExpr filterNode, results []T) ([]T, int64, error) {
 	var (
 		out            []T = results
 		isCopy         bool
@@ -31,14 +31,18 @@ func filterAndOrderResults[T api.Record](r *http.Request, results []T) ([]T, int
 		}
 		filterFields[path] = m
 	}
+	filter, err := compileRequestFilter[T](filterExpr)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	for i, item := range results {
-		matches := true
+		matches := filter(item)
 		for path, values := range qp.FilterFields {
-			if !filterFields[path].MatchesFilter(item, values) {
-				matches = false
+			if !matches {
 				break
 			}
+			matches = filterFields[path].MatchesFilter(item, values)
 		}
 		switch {
 		case matches && !isCopy:
@@ -88,6 +92,28 @@ func filterTime[T api.Record](all []T, state timeRangeState, op timeRangeRelatio
 		isCopy bool
 	)
 
+	shouldFilter := timeRangeFilter(state, op, rangeStart, rangeEnd)
+
+	for i, record := range all {
+		toRemove := shouldFilter(record)
+		switch {
+		case !toRemove && !isCopy:
+			continue
+		case isCopy && !toRemove:
+			out = append(out, record)
+		case toRemove && !isCopy:
+			isCopy = true
+			out = make([]T, 0, len(all)-1)
+			out = append(out, all[:i]...)
+		}
+	}
+
+	return out
+}
+
+// timeRangeFilter returns a predicate that is true for records that fall
+// outside of the time range and state requested.
+func timeRangeFilter(state timeRangeState, op timeRangeRelation, rangeStart, rangeEnd uint64) func(api.Record) bool {
 	shouldFilterOp := func(t api.Record) bool { return false }
 	switch op {
 	case intersects:
@@ -124,21 +150,7 @@ func filterTime[T api.Record](all []T, state timeRangeState, op timeRangeRelatio
 		}
 	}
 
-	for i, record := range all {
-		toRemove := shouldFilter(record)
-		switch {
-		case !toRemove && !isCopy:
-			continue
-		case isCopy && !toRemove:
-			out = append(out, record)
-		case toRemove && !isCopy:
-			isCopy = true
-			out = make([]T, 0, len(all)-1)
-			out = append(out, all[:i]...)
-		}
-	}
-
-	return out
+	return shouldFilter
 }
 
 type fieldIndex[T any] struct {
@@ -358,6 +370,8 @@ func getQueryParams(r *http.Request) queryParams {
 			default:
 				qp.TimeRangeOperation = intersects
 			}
+		case "filter":
+			// parsed separately as an expression by compileRequestFilter
 		case "state":
 			recordState := v[0]
 			switch recordState {
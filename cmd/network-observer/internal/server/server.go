@@ -7,16 +7,35 @@ import (
 	"log/slog"
 	"net/http"
 
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/alerts"
 	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
 	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/server/views"
+	"github.com/skupperproject/skupper/pkg/vanflow"
 	"github.com/skupperproject/skupper/pkg/vanflow/store"
 )
 
+// Options holds the optional sources of the network observer API.
+// Any of them may be left unset, in which case the endpoints that
+// depend on it report no results.
+type Options struct {
+	History collector.History
+	Logs    collector.Logs
+	Alerts  alerts.Provider
+}
+
 func New(logger *slog.Logger, records store.Interface, graph collector.Graph) api.ServerInterface {
+	return NewWithOptions(logger, records, graph, Options{})
+}
+
+func NewWithOptions(logger *slog.Logger, records store.Interface, graph collector.Graph, options Options) api.ServerInterface {
 	return &server{
 		logger:  logger,
 		records: records,
 		graph:   graph,
+		history: options.History,
+		logs:    options.Logs,
+		alerts:  options.Alerts,
 	}
 }
 
@@ -24,6 +43,9 @@ type server struct {
 	logger  *slog.Logger
 	records store.Interface
 	graph   collector.Graph
+	history collector.History
+	logs    collector.Logs
+	alerts  alerts.Provider
 }
 
 func (c *server) logWriteError(r *http.Request, err error) {
@@ -31,11 +53,21 @@ func (c *server) logWriteError(r *http.Request, err error) {
 }
 
 func handleCollection[T api.Record](w http.ResponseWriter, r *http.Request, response api.CollectionResponseSetter[T], records []T) error {
+	filter, err := requestFilter(r)
+	if err != nil {
+		return handleBadRequest(w, err)
+	}
+	return handleFilteredCollection(w, r, filter, response, records)
+}
+
+// handleFilteredCollection responds like handleCollection, applying a
+// filter already parsed from the request by requestFilter.
+func handleFilteredCollection[T api.Record](w http.ResponseWriter, r *http.Request, filter filterNode, response api.CollectionResponseSetter[T], records []T) error {
 	var (
 		out    any = response
 		status     = http.StatusOK
 	)
-	records, count, err := filterAndOrderResults(r, records)
+	records, count, err := filterAndOrderResults(r, filter, records)
 	if err != nil {
 		status = http.StatusBadRequest
 		out = api.ErrorBadRequest{
@@ -57,9 +89,13 @@ func handleSubCollection[T api.Record](w http.ResponseWriter, r *http.Request, r
 		status     = http.StatusOK
 	)
 
+	filter, err := requestFilter(r)
+	if err != nil {
+		return handleBadRequest(w, err)
+	}
 	if item, ok := getExemplar(); ok {
 		records := indexFunc(item)
-		records, count, err := filterAndOrderResults(r, records)
+		records, count, err := filterAndOrderResults(r, filter, records)
 		if err != nil {
 			status = http.StatusBadRequest
 			out = api.ErrorBadRequest{
@@ -80,6 +116,13 @@ func handleSubCollection[T api.Record](w http.ResponseWriter, r *http.Request, r
 	}
 	return nil
 }
+func handleBadRequest(w http.ResponseWriter, err error) error {
+	if err := encodeResponse(w, http.StatusBadRequest, api.ErrorBadRequest{Message: err.Error()}); err != nil {
+		return fmt.Errorf("response write error: %s", err)
+	}
+	return nil
+}
+
 func handleSingle[T any](w http.ResponseWriter, _ *http.Request, response api.ResponseSetter[T], getter func() (T, bool)) error {
 	var (
 		out    any = response
@@ -100,6 +143,65 @@ func handleSingle[T any](w http.ResponseWriter, _ *http.Request, response api.Re
 	return nil
 }
 
+func handleHistory(w http.ResponseWriter, r *http.Request, history collector.History, id string, exists func() bool) error {
+	var (
+		out    any
+		status = http.StatusOK
+	)
+	resolution := api.HistoryResolution1m
+	if v := r.URL.Query().Get("resolution"); v != "" {
+		resolution = api.HistoryResolutionType(v)
+	}
+	if history == nil || !exists() {
+		status = http.StatusNotFound
+		out = api.ErrorNotFound{
+			Code: "ErrNotFound",
+		}
+	} else if buckets, ok := history.Buckets(id, string(resolution)); !ok {
+		status = http.StatusBadRequest
+		out = api.ErrorBadRequest{
+			Message: fmt.Sprintf("invalid resolution %q", resolution),
+		}
+	} else {
+		return handleCollection(w, r, &api.HistoryListResponse{}, views.History(id, resolution, buckets))
+	}
+	if err := encodeResponse(w, status, out); err != nil {
+		return fmt.Errorf("response write error: %s", err)
+	}
+	return nil
+}
+
+func handleLogs(w http.ResponseWriter, r *http.Request, logs collector.Logs, exists func() bool, include func(collector.LogEntry) bool) error {
+	if !exists() {
+		if err := encodeResponse(w, http.StatusNotFound, api.ErrorNotFound{
+			Code: "ErrNotFound",
+		}); err != nil {
+			return fmt.Errorf("response write error: %s", err)
+		}
+		return nil
+	}
+	var entries []collector.LogEntry
+	if logs != nil {
+		for _, entry := range logs.Entries() {
+			if include(entry) {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	return handleCollection(w, r, &api.LogListResponse{}, views.Logs(entries))
+}
+
+func exists[V vanflow.Record](stor store.Interface, id string) func() bool {
+	return func() bool {
+		entry, ok := stor.Get(id)
+		if !ok {
+			return false
+		}
+		_, ok = entry.Record.(V)
+		return ok
+	}
+}
+
 func encodeResponse(w http.ResponseWriter, status int, v interface{}) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
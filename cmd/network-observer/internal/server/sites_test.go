@@ -15,7 +15,7 @@ import (
 
 func TestSites(t *testing.T) {
 	tlog := slog.Default()
-	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{})
+	stor := store.NewSyncMapStore(store.SyncMapStoreConfig{Indexers: collector.RecordIndexers()})
 	graph := collector.NewGraph(stor)
 	srv, c := requireTestClient(t, New(tlog, stor, graph))
 	defer srv.Close()
@@ -65,13 +65,49 @@ func TestSites(t *testing.T) {
 			Parameters:  map[string][]string{"fizz": {"baz", "buz"}},
 			ExpectError: "invalid filter",
 		},
+		{
+			Records: wrapRecords(
+				vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-1"), Name: ptrTo("east"), Platform: ptrTo("kubernetes")},
+				vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-2"), Name: ptrTo("west"), Platform: ptrTo("podman")},
+				vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-3"), Name: ptrTo("north"), Platform: ptrTo("kubernetes")},
+				vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-4")},
+			),
+			ExpectOK:             true,
+			ExpectCount:          1,
+			ExpectTimeRangeCount: 1,
+			ExpectResults: func(t *testing.T, results []api.SiteRecord) {
+				assert.Equal(t, results[0].Identity, "site-3")
+			},
+			Parameters: map[string][]string{"filter": {`name in (east, north) and not platform == podman and identity != site-1`}},
+		},
+		{
+			Records: wrapRecords(
+				vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-1"), Name: ptrTo("east")},
+				vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-2")},
+			),
+			ExpectOK:             true,
+			ExpectCount:          1,
+			ExpectTimeRangeCount: 1,
+			ExpectResults: func(t *testing.T, results []api.SiteRecord) {
+				assert.Equal(t, results[0].Identity, "site-2")
+			},
+			Parameters: map[string][]string{"filter": {`name == unknown`}},
+		},
+		{
+			Parameters:  map[string][]string{"filter": {`name ==`}},
+			ExpectError: "invalid filter: expected a value but found end of filter",
+		},
+		{
+			Parameters:  map[string][]string{"filter": {`routerCount > some`}},
+			ExpectError: `invalid filter for SiteRecord: value "some" at position 14 is not a number as required by field "routerCount"`,
+		},
 	}
 
 	for _, tc := range testcases {
 		t.Run("", func(t *testing.T) {
 			stor.Replace(tc.Records)
 			graph.(reset).Reset()
-			resp, err := c.SitesWithResponse(context.TODO(), withParameters(tc.Parameters))
+			resp, err := c.SitesWithResponse(context.TODO(), nil, withParameters(tc.Parameters))
 			assert.Check(t, err)
 			if tc.ExpectOK {
 				assert.Equal(t, resp.StatusCode(), 200)
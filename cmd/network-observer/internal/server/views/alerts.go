@@ -0,0 +1,37 @@
+package views
+
+import (
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/alerts"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+)
+
+// Alerts maps alerts to api records. An alert starts when its condition
+// was first seen to hold and ends when it resolves.
+func Alerts(entries []alerts.Alert) []api.AlertRecord {
+	results := make([]api.AlertRecord, 0, len(entries))
+	for _, entry := range entries {
+		results = append(results, Alert(entry))
+	}
+	return results
+}
+
+func Alert(entry alerts.Alert) api.AlertRecord {
+	out := api.AlertRecord{
+		Identity:    entry.ID,
+		Rule:        entry.Rule,
+		Severity:    entry.Severity,
+		State:       api.AlertStateType(entry.State),
+		SubjectId:   entry.SubjectID,
+		SubjectName: entry.SubjectName,
+		Summary:     entry.Summary,
+		StartTime:   uint64(entry.StartTime.UnixMicro()),
+	}
+	if !entry.FiringTime.IsZero() {
+		firingTime := uint64(entry.FiringTime.UnixMicro())
+		out.FiringTime = &firingTime
+	}
+	if !entry.EndTime.IsZero() {
+		out.EndTime = uint64(entry.EndTime.UnixMicro())
+	}
+	return out
+}
@@ -0,0 +1,29 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+)
+
+// History maps the history buckets of a series to api records. Record
+// identities are ordered chronologically so that the default sort returns
+// buckets oldest first.
+func History(id string, resolution api.HistoryResolutionType, buckets []collector.HistoryBucket) []api.HistoryRecord {
+	results := make([]api.HistoryRecord, 0, len(buckets))
+	for _, b := range buckets {
+		start := uint64(b.Start.UnixMicro())
+		results = append(results, api.HistoryRecord{
+			Identity:          fmt.Sprintf("%s@%s@%020d", id, resolution, start),
+			StartTime:         start,
+			EndTime:           uint64(b.End.UnixMicro()),
+			Resolution:        resolution,
+			OctetCount:        b.Octets,
+			OctetReverseCount: b.OctetsReverse,
+			OpenedCount:       b.Opened,
+			ClosedCount:       b.Closed,
+		})
+	}
+	return results
+}
@@ -0,0 +1,55 @@
+package views
+
+import (
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
+)
+
+// Logs maps router log entries to api records. Log messages happen at a
+// point in time, so each record starts and ends at the time it was logged.
+func Logs(entries []collector.LogEntry) []api.LogRecord {
+	results := make([]api.LogRecord, 0, len(entries))
+	for _, entry := range entries {
+		results = append(results, Log(entry))
+	}
+	return results
+}
+
+func Log(entry collector.LogEntry) api.LogRecord {
+	out := defaultLog(entry.ID)
+	out.StartTime = uint64(entry.Time.UnixMicro())
+	out.EndTime = out.StartTime
+	out.Module = entry.Module
+	out.Text = entry.Text
+	if entry.Severity != "" {
+		out.Severity = api.LogSeverityType(entry.Severity)
+	}
+	if entry.SourceFile != "" {
+		sourceFile, sourceLine := entry.SourceFile, entry.SourceLine
+		out.SourceFile, out.SourceLine = &sourceFile, &sourceLine
+	}
+	if entry.RouterID != "" {
+		out.RouterId = entry.RouterID
+	}
+	if entry.RouterName != "" {
+		out.RouterName = entry.RouterName
+	}
+	if entry.SiteID != "" {
+		out.SiteId = entry.SiteID
+	}
+	if entry.SiteName != "" {
+		out.SiteName = entry.SiteName
+	}
+	return out
+}
+
+func defaultLog(id string) api.LogRecord {
+	return api.LogRecord{
+		Identity:   id,
+		Severity:   api.LogSeverityUnknown,
+		RouterId:   unknownStr,
+		RouterName: unknownStr,
+		SiteId:     unknownStr,
+		SiteName:   unknownStr,
+	}
+}
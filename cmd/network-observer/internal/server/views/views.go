@@ -14,6 +14,12 @@ import (
 
 const unknownStr = "unknown"
 
+// IsUnknown returns true if the value is that given to record fields
+// that have not been reported.
+func IsUnknown(value string) bool {
+	return value == unknownStr
+}
+
 func NewSitePairSliceProvider(graph collector.Graph) func(entries []store.Entry) []api.FlowAggregateRecord {
 	provider := NewSitePairProvider(graph)
 	return func(entries []store.Entry) []api.FlowAggregateRecord {
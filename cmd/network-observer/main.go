@@ -17,6 +17,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/skupperproject/skupper/cmd/network-observer/internal/alerts"
 	"github.com/skupperproject/skupper/cmd/network-observer/internal/api"
 	"github.com/skupperproject/skupper/cmd/network-observer/internal/cmd"
 	"github.com/skupperproject/skupper/cmd/network-observer/internal/collector"
@@ -42,9 +43,9 @@ func run(cfg Config) error {
 		return fmt.Errorf("could not load spec filesystem: %s", err)
 	}
 
-	sessionConfig, err := configureSession(cfg.RouterTLS)
+	networks, err := loadNetworks(cfg)
 	if err != nil {
-		return fmt.Errorf("failed to load router tls configuration: %s", err)
+		return fmt.Errorf("failed to load networks: %s", err)
 	}
 
 	flowLogger := func(vanflow.RecordMessage) {}
@@ -61,19 +62,86 @@ func run(cfg Config) error {
 		return fmt.Errorf("unknown logging profile: %s", cfg.VanflowLoggingProfile)
 	}
 
-	collector := collector.New(
-		logger.With(slog.String("component", "collector")),
-		session.NewContainerFactory(cfg.RouterURL, sessionConfig),
-		reg,
-		cfg.FlowRecordTTL,
-		flowLogger,
-	)
+	exporters, err := configureExporters(cfg.Export, logger.With(slog.String("component", "export")))
+	if err != nil {
+		return fmt.Errorf("failed to configure record export: %s", err)
+	}
 
-	collectorAPI := server.New(
-		logger.With(slog.String("component", "api")),
-		collector.Records,
-		collector.GetGraph(),
-	)
+	var alertConfig *alerts.Config
+	if cfg.AlertRulesFile != "" {
+		config, err := alerts.LoadConfig(cfg.AlertRulesFile)
+		if err != nil {
+			return err
+		}
+		alertConfig = &config
+	}
+
+	// each network is observed by its own collector and served by its own
+	// API handler behind a federation handler
+	federated := len(networks) > 1
+	collectors := make([]*collector.Collector, 0, len(networks))
+	var alertEngines []*alerts.Engine
+	apiNetworks := make([]server.Network, 0, len(networks))
+	for _, network := range networks {
+		sessionConfig, err := configureSession(network.RouterTLS)
+		if err != nil {
+			return fmt.Errorf("failed to load router tls configuration for network %s: %s", network.Name, err)
+		}
+		networkLogger := logger
+		var registerer prometheus.Registerer = reg
+		exportNetwork := ""
+		if federated {
+			networkLogger = logger.With(slog.String("network", network.Name))
+			registerer = prometheus.WrapRegistererWith(prometheus.Labels{"network": network.Name}, reg)
+			exportNetwork = network.Name
+		}
+
+		networkCollector := collector.New(
+			networkLogger.With(slog.String("component", "collector")),
+			session.NewContainerFactory(network.RouterURL, sessionConfig),
+			registerer,
+			cfg.FlowRecordTTL,
+			flowLogger,
+		)
+		for _, exporter := range exporters {
+			networkCollector.AddStoreHandlers(exporter.NetworkStoreHandlers(exportNetwork))
+		}
+		collectors = append(collectors, networkCollector)
+
+		var alertProvider alerts.Provider
+		if alertConfig != nil {
+			engine, err := alerts.New(
+				networkLogger.With(slog.String("component", "alerts")),
+				exportNetwork,
+				networkCollector.Records,
+				networkCollector.GetGraph(),
+				*alertConfig,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to configure alerts for network %s: %s", network.Name, err)
+			}
+			alertEngines = append(alertEngines, engine)
+			alertProvider = engine
+		}
+
+		collectorAPI := server.NewWithOptions(
+			networkLogger.With(slog.String("component", "api")),
+			networkCollector.Records,
+			networkCollector.GetGraph(),
+			server.Options{
+				History: networkCollector.GetHistory(),
+				Logs:    networkCollector.GetLogs(),
+				Alerts:  alertProvider,
+			},
+		)
+		apiNetworks = append(apiNetworks, server.Network{
+			Name:           network.Name,
+			RouterEndpoint: network.RouterURL,
+			Handler: api.HandlerWithOptions(collectorAPI, api.GorillaServerOptions{
+				BaseRouter: mux.NewRouter().StrictSlash(true),
+			}),
+		})
+	}
 
 	var mux = mux.NewRouter().StrictSlash(true)
 	promSubrouter := mux.PathPrefix("/api/v2alpha1/internal/prom")
@@ -83,18 +151,21 @@ func run(cfg Config) error {
 	if cfg.CORSAllowAll {
 		apiMux.Use(handlers.CORS())
 	}
-	api.HandlerWithOptions(collectorAPI, api.GorillaServerOptions{
-		BaseRouter: apiMux,
-	})
+	if cfg.EnableConsole {
+		// add unspec'd api routes
+		apiMux.Path("/api/v2alpha1/user").Handler(handleGetUser())
+		apiMux.Path("/api/v2alpha1/logout").Handler(handleUserLogout())
+	}
+	apiMux.PathPrefix("/api/v2alpha1/").Handler(server.NewFederation(
+		logger.With(slog.String("component", "api")),
+		apiNetworks,
+	))
 
 	if cfg.EnableConsole {
 		promAPI, err := parsePrometheusAPI(cfg.PrometheusAPI)
 		if err != nil {
 			return fmt.Errorf("error parsing prometheus-api as URL: %s", err)
 		}
-		// add unspec'd api routes
-		apiMux.Path("/api/v2alpha1/user").Handler(handleGetUser())
-		apiMux.Path("/api/v2alpha1/logout").Handler(handleUserLogout())
 		promSubrouter.Handler(handleProxyPrometheusAPI("/api/v2alpha1/internal/prom", promAPI))
 
 		apiMux.PathPrefix("/").Handler(handleSecuredConsoleAssets(cfg.ConsoleLocation))
@@ -178,13 +249,33 @@ func run(cfg Config) error {
 		})
 	}
 
-	g.Go(func() error {
-		logger.Debug("Starting Network Observer Collector")
-		if err := collector.Run(runCtx); err != nil {
-			return fmt.Errorf("collector error: %w", err)
-		}
-		return nil
-	})
+	for _, exporter := range exporters {
+		g.Go(func() error {
+			if err := exporter.Run(runCtx); err != nil {
+				return fmt.Errorf("record export error: %w", err)
+			}
+			return nil
+		})
+	}
+
+	for _, networkCollector := range collectors {
+		g.Go(func() error {
+			logger.Debug("Starting Network Observer Collector")
+			if err := networkCollector.Run(runCtx); err != nil {
+				return fmt.Errorf("collector error: %w", err)
+			}
+			return nil
+		})
+	}
+
+	for _, engine := range alertEngines {
+		g.Go(func() error {
+			if err := engine.Run(runCtx); err != nil {
+				return fmt.Errorf("alerts error: %w", err)
+			}
+			return nil
+		})
+	}
 
 	if err := g.Wait(); err != nil && !errors.Is(err, ctx.Err()) {
 		return err
@@ -203,6 +294,7 @@ func main() {
 	flags.StringVar(&cfg.RouterTLS.Key, "router-tls-key", "", "Path to the client key for the router endpoint")
 	flags.StringVar(&cfg.RouterTLS.CA, "router-tls-ca", "", "Path to the CA certificate file for the router endpoint")
 	flags.BoolVar(&cfg.RouterTLS.SkipVerify, "router-tls-insecure", false, "Set to skip verification of the router certificate and host name")
+	flags.StringVar(&cfg.NetworksFile, "networks", "", "Path to a YAML file listing several named networks to observe, each with its own router endpoint and TLS configuration. Overrides the router-* flags")
 
 	flags.StringVar(&cfg.APIListenAddress, "listen", ":8080", "The address that the API Server will listen on")
 	flags.BoolVar(&cfg.APIEnableAccessLogs, "enable-access-logs", false, "Enable access logging for the API Server")
@@ -219,6 +311,31 @@ func main() {
 
 	flags.StringVar(&cfg.VanflowLoggingProfile, "vanflow-logging-profile", "silent", "Controls low level vanflow record logging. Options are silent, minimal, moderate and all")
 
+	flags.StringVar(&cfg.Export.File, "export-file", "", "Path of a file to export vanflow records to as JSON lines. The file is rotated as it grows")
+	flags.StringVar(&cfg.Export.KafkaBrokers, "export-kafka-brokers", "", "Comma separated list of Kafka brokers to export vanflow records to")
+	flags.StringVar(&cfg.Export.KafkaTopic, "export-kafka-topic", "skupper-vanflow", "Kafka topic vanflow records are exported to")
+	flags.BoolVar(&cfg.Export.KafkaTLSEnabled, "export-kafka-tls", false, "Connect to the Kafka brokers using TLS. Implied by the other export-kafka-tls-* flags")
+	flags.StringVar(&cfg.Export.KafkaTLS.CA, "export-kafka-tls-ca", "", "Path to the CA certificate file for the Kafka brokers")
+	flags.StringVar(&cfg.Export.KafkaTLS.Cert, "export-kafka-tls-cert", "", "Path to the client certificate for the Kafka brokers")
+	flags.StringVar(&cfg.Export.KafkaTLS.Key, "export-kafka-tls-key", "", "Path to the client key for the Kafka brokers")
+	flags.BoolVar(&cfg.Export.KafkaTLS.SkipVerify, "export-kafka-tls-insecure", false, "Set to skip verification of the Kafka broker certificates and host names")
+	flags.StringVar(&cfg.Export.KafkaSASLMechanism, "export-kafka-sasl-mechanism", "", "SASL mechanism used to authenticate with the Kafka brokers. Options are PLAIN, SCRAM-SHA-256 and SCRAM-SHA-512")
+	flags.StringVar(&cfg.Export.KafkaUsername, "export-kafka-username", "", "User name used to authenticate with the Kafka brokers")
+	flags.StringVar(&cfg.Export.KafkaPasswordFile, "export-kafka-password-file", "", "Path to a file holding the password used to authenticate with the Kafka brokers")
+	flags.StringVar(&cfg.Export.NATSURL, "export-nats-url", "", "URL of a NATS server to export vanflow records to")
+	flags.StringVar(&cfg.Export.NATSSubject, "export-nats-subject", "vanflow", "Prefix of the NATS subjects vanflow records are exported to")
+	flags.StringVar(&cfg.Export.NATSTLS.CA, "export-nats-tls-ca", "", "Path to the CA certificate file for the NATS server")
+	flags.StringVar(&cfg.Export.NATSTLS.Cert, "export-nats-tls-cert", "", "Path to the client certificate for the NATS server")
+	flags.StringVar(&cfg.Export.NATSTLS.Key, "export-nats-tls-key", "", "Path to the client key for the NATS server")
+	flags.BoolVar(&cfg.Export.NATSTLS.SkipVerify, "export-nats-tls-insecure", false, "Set to skip verification of the NATS server certificate and host name")
+	flags.StringVar(&cfg.Export.NATSCredentialsFile, "export-nats-credentials", "", "Path to a NATS credentials file used to authenticate with the NATS server")
+	flags.StringVar(&cfg.Export.NATSUsername, "export-nats-username", "", "User name used to authenticate with the NATS server")
+	flags.StringVar(&cfg.Export.NATSPasswordFile, "export-nats-password-file", "", "Path to a file holding the password used to authenticate with the NATS server")
+	flags.StringVar(&cfg.Export.NATSTokenFile, "export-nats-token-file", "", "Path to a file holding a token used to authenticate with the NATS server")
+	flags.StringVar(&cfg.Export.Backpressure, "export-backpressure", "drop", "What to do with exported records when a sink falls behind. Options are drop and block")
+
+	flags.StringVar(&cfg.AlertRulesFile, "alert-rules", "", "Path to a YAML file of alerting rules and the webhook, slack and email receivers to notify")
+
 	flags.Parse(os.Args[1:])
 	if *isVersion {
 		fmt.Println(version.Version)
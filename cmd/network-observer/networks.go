@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+
+	"sigs.k8s.io/yaml"
+)
+
+// defaultNetwork names the single network observed when no networks file is
+// given.
+const defaultNetwork = "default"
+
+// networkNamePattern limits network names to DNS labels so that they can
+// qualify record identities and label metrics.
+var networkNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// NetworkSpec describes a skupper network observed through a router
+// endpoint.
+type NetworkSpec struct {
+	Name      string
+	RouterURL string
+	RouterTLS TLSSpec
+}
+
+type networksFile struct {
+	Networks []struct {
+		Name           string `json:"name"`
+		RouterEndpoint string `json:"routerEndpoint"`
+		TLS            struct {
+			CA       string `json:"ca"`
+			Cert     string `json:"cert"`
+			Key      string `json:"key"`
+			Insecure bool   `json:"insecure"`
+		} `json:"tls"`
+	} `json:"networks"`
+}
+
+// loadNetworks returns the networks to observe: those listed in the
+// networks file, or the single network reached through the router endpoint
+// flags when there is none.
+func loadNetworks(cfg Config) ([]NetworkSpec, error) {
+	if cfg.NetworksFile == "" {
+		return []NetworkSpec{{
+			Name:      defaultNetwork,
+			RouterURL: cfg.RouterURL,
+			RouterTLS: cfg.RouterTLS,
+		}}, nil
+	}
+	data, err := os.ReadFile(cfg.NetworksFile)
+	if err != nil {
+		return nil, err
+	}
+	var file networksFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid networks file: %s", err)
+	}
+	return parseNetworks(file)
+}
+
+func parseNetworks(file networksFile) ([]NetworkSpec, error) {
+	if len(file.Networks) == 0 {
+		return nil, fmt.Errorf("invalid networks file: no networks listed")
+	}
+	names := make(map[string]bool, len(file.Networks))
+	networks := make([]NetworkSpec, 0, len(file.Networks))
+	for _, network := range file.Networks {
+		if !networkNamePattern.MatchString(network.Name) {
+			return nil, fmt.Errorf("invalid network name %q: must consist of lower case alphanumeric characters or '-'", network.Name)
+		}
+		if names[network.Name] {
+			return nil, fmt.Errorf("network %q listed more than once", network.Name)
+		}
+		names[network.Name] = true
+		if network.RouterEndpoint == "" {
+			return nil, fmt.Errorf("network %q has no routerEndpoint", network.Name)
+		}
+		networks = append(networks, NetworkSpec{
+			Name:      network.Name,
+			RouterURL: network.RouterEndpoint,
+			RouterTLS: TLSSpec{
+				CA:         network.TLS.CA,
+				Cert:       network.TLS.Cert,
+				Key:        network.TLS.Key,
+				SkipVerify: network.TLS.Insecure,
+			},
+		})
+	}
+	return networks, nil
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadNetworks(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		File        string
+		Expected    []NetworkSpec
+		ExpectError string
+	}{
+		{
+			Name: "flags",
+			Expected: []NetworkSpec{{
+				Name:      "default",
+				RouterURL: "amqps://skupper-router-local",
+				RouterTLS: TLSSpec{CA: "/etc/ca.crt"},
+			}},
+		}, {
+			Name: "networks file",
+			File: `
+networks:
+- name: prod
+  routerEndpoint: amqps://prod-router:5671
+  tls:
+    ca: /etc/prod/ca.crt
+    cert: /etc/prod/tls.crt
+    key: /etc/prod/tls.key
+- name: partner-1
+  routerEndpoint: amqp://partner-router:5672
+  tls:
+    insecure: true
+`,
+			Expected: []NetworkSpec{
+				{
+					Name:      "prod",
+					RouterURL: "amqps://prod-router:5671",
+					RouterTLS: TLSSpec{CA: "/etc/prod/ca.crt", Cert: "/etc/prod/tls.crt", Key: "/etc/prod/tls.key"},
+				}, {
+					Name:      "partner-1",
+					RouterURL: "amqp://partner-router:5672",
+					RouterTLS: TLSSpec{SkipVerify: true},
+				},
+			},
+		}, {
+			Name:        "empty",
+			File:        "networks: []\n",
+			ExpectError: "invalid networks file: no networks listed",
+		}, {
+			Name:        "invalid name",
+			File:        "networks:\n- name: Prod\n  routerEndpoint: amqps://prod\n",
+			ExpectError: `invalid network name "Prod": must consist of lower case alphanumeric characters or '-'`,
+		}, {
+			Name:        "duplicate name",
+			File:        "networks:\n- name: prod\n  routerEndpoint: amqps://a\n- name: prod\n  routerEndpoint: amqps://b\n",
+			ExpectError: `network "prod" listed more than once`,
+		}, {
+			Name:        "missing endpoint",
+			File:        "networks:\n- name: prod\n",
+			ExpectError: `network "prod" has no routerEndpoint`,
+		}, {
+			Name:        "unknown field",
+			File:        "networks:\n- name: prod\n  endpoint: amqps://a\n",
+			ExpectError: `invalid networks file: error unmarshaling JSON: while decoding JSON: json: unknown field "endpoint"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			cfg := Config{
+				RouterURL: "amqps://skupper-router-local",
+				RouterTLS: TLSSpec{CA: "/etc/ca.crt"},
+			}
+			if tc.File != "" {
+				cfg.NetworksFile = filepath.Join(t.TempDir(), "networks.yaml")
+				if err := os.WriteFile(cfg.NetworksFile, []byte(tc.File), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			networks, err := loadNetworks(cfg)
+			if tc.ExpectError != "" {
+				if err == nil || err.Error() != tc.ExpectError {
+					t.Fatalf("expected error %q but got %v", tc.ExpectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(networks) != len(tc.Expected) {
+				t.Fatalf("expected %d networks but got %d", len(tc.Expected), len(networks))
+			}
+			for i := range networks {
+				if networks[i] != tc.Expected[i] {
+					t.Errorf("expected %+v but got %+v", tc.Expected[i], networks[i])
+				}
+			}
+		})
+	}
+}
@@ -1,13 +1,14 @@
 package common
 
 var (
-	LinkAccessTypes = []string{"route", "loadbalancer", "default"}
-	OutputTypes     = []string{"json", "yaml"}
-	ListenerTypes   = []string{"tcp"}
-	ConnectorTypes  = []string{"tcp"}
-	WorkloadTypes   = []string{"deployment", "service", "daemonset", "statefulset"}
-	WaitStatusTypes = []string{"ready", "configured", "none"}
-	BundleTypes     = []string{"tarball", "shell-script"}
+	LinkAccessTypes   = []string{"route", "loadbalancer", "default"}
+	OutputTypes       = []string{"json", "yaml"}
+	StatusOutputTypes = []string{"json", "yaml", "wide"}
+	ListenerTypes     = []string{"tcp"}
+	ConnectorTypes    = []string{"tcp"}
+	WorkloadTypes     = []string{"deployment", "service", "daemonset", "statefulset"}
+	WaitStatusTypes   = []string{"ready", "configured", "none"}
+	BundleTypes       = []string{"tarball", "shell-script"}
 )
 
 const (
@@ -24,6 +24,13 @@ for other Kubernetes flavors, loadbalancer is the default.`
 	FlagDescOutput    = "print resources to the console instead of submitting them to the Skupper controller. Choices: json, yaml"
 	FlagVerboseOutput = "print verbose output to the console. Choices: json, yaml"
 
+	FlagDescStatusOutput  = "print status in the given format. Choices: json, yaml, wide"
+	FlagDescNetworkOutput = "print the network status in the given format. Choices: json, yaml"
+	FlagNameWatch         = "watch"
+	FlagDescWatch         = "after printing the current status, keep printing resources as they change"
+	FlagNameAllNamespaces = "all-namespaces"
+	FlagDescAllNamespaces = "print status of resources across all namespaces"
+
 	FlagNameTlsCredentials     = "tls-credentials"
 	FlagDescTlsCredentials     = "the name of a Kubernetes secret containing the generated or externally-supplied TLS credentials."
 	FlagNameCost               = "cost"
@@ -37,6 +44,10 @@ for other Kubernetes flavors, loadbalancer is the default.`
 	FlagNameLinkHost           = "host"
 	FlagDescNameLinkHost       = "Endpoint Host"
 
+	FlagNameAllowedSource      = "allowed-source"
+	FlagDescAllowedSource      = "Only redeem an access token for this grant from the given address or CIDR range. Can be repeated."
+	FlagNameExpectedSite       = "expected-site"
+	FlagDescExpectedSite       = "Only redeem an access token for this grant from the site with the given name."
 	FlagNameRedemptionsAllowed = "redemptions-allowed"
 	FlagDescRedemptionsAllowed = "The number of times an access token for this grant can be redeemed."
 	FlagNameExpirationWindow   = "expiration-window"
@@ -52,6 +63,7 @@ for other Kubernetes flavors, loadbalancer is the default.`
 	FlagDescIncludeNotRead      = "If true, include server pods that are not in the ready state."
 	FlagNameSelector            = "selector"
 	FlagDescSelector            = "A Kubernetes label selector for specifying target server pods."
+	FlagDescContainerSelector   = "A label selector for specifying target server containers attached to the skupper network."
 	FlagNameWorkload            = "workload"
 	FlagDescWorkload            = "A Kubernetes resource name that identifies a workload expressed like resource-type/resource-name. Expected resource types: service, daemonset, deployment, and statefulset."
 
@@ -82,6 +94,13 @@ for other Kubernetes flavors, loadbalancer is the default.`
 	FlagNameType  = "type"
 	FlagDescType  = "The bundle type to be produced. Choices: tarball, shell-script"
 
+	FlagNameParameter             = "param"
+	FlagDescParameter             = "Declare a parameter that can be overridden when the bundle is installed, as name[=default]. Supported parameters: link-access-host, link-access-inter-router-port, link-access-edge-port, listener-host, router-log-level. Can be repeated."
+	FlagNameEncryptRecipient      = "encrypt-recipient"
+	FlagDescEncryptRecipient      = "Encrypt the private keys in the bundle to the given age recipient (X25519 public key). Can be repeated."
+	FlagNameEncryptPassphraseFile = "encrypt-passphrase-file"
+	FlagDescEncryptPassphraseFile = "Encrypt the private keys in the bundle with the passphrase read from the given file."
+
 	FlagDescUninstallForce = "option to override even with sites present"
 
 	FlagNameHA = "enable-ha"
@@ -89,6 +108,38 @@ for other Kubernetes flavors, loadbalancer is the default.`
 
 	FlagNameFileName = "filename"
 	FlagDescFileName = "The name of the file with custom resources"
+
+	FlagNameEndpoint    = "endpoint"
+	FlagDescTopEndpoint = "URL of the network observer API. On Kubernetes, the network observer is reached through a port-forward when not set."
+	FlagNameInterval    = "interval"
+	FlagDescTopInterval = "The period of time between refreshes of the dashboard."
+	FlagNameSort        = "sort"
+	FlagDescTopSort     = "The order of the routing keys and process pairs. Choices: bytes, rate, name"
+	FlagNameFilter      = "filter"
+	FlagDescTopFilter   = "Only display the entries containing the given text."
+	FlagNameTopRows     = "rows"
+	FlagDescTopRows     = "The number of routing keys, process pairs and errors displayed."
+
+	FlagNameSince                 = "since"
+	FlagDescObserveSince          = "Only list records active within the given period of time before now, for example 1h. The network observer defaults to the last 15 minutes."
+	FlagNameLimit                 = "limit"
+	FlagDescObserveLimit          = "The maximum number of records listed. All matching records are listed when 0."
+	FlagNameState                 = "state"
+	FlagNameNetwork               = "network"
+	FlagDescObserveNetwork        = "Only list records from the named network when the network observer observes more than one."
+	FlagDescObserveState          = "Only list records that are active or terminated. Choices: all, active, terminated"
+	FlagNamePairType              = "type"
+	FlagDescObservePairType       = "The kind of pair listed. Choices: site, process, component"
+	FlagDescObserveFilter         = "Only list records matching the filter expression, for example \"protocol == tcp and octetCount > 1e6\"."
+	FlagDescObserveOutput         = "print output in the format: json, yaml or wide"
+	FlagNameToken                 = "token"
+	FlagDescObserveToken          = "Bearer token sent to the network observer API."
+	FlagNameUser                  = "user"
+	FlagDescObserveUser           = "Credentials sent to the network observer API using basic authentication, as username:password."
+	FlagNameCACert                = "ca-cert"
+	FlagDescObserveCACert         = "Path of a PEM file of the certificates used to verify the network observer API."
+	FlagNameInsecureSkipTLSVerify = "insecure-skip-tls-verify"
+	FlagDescObserveInsecure       = "Do not verify the certificate of the network observer API."
 )
 
 type CommandSiteCreateFlags struct {
@@ -114,7 +165,9 @@ type CommandSiteDeleteFlags struct {
 }
 
 type CommandSiteStatusFlags struct {
-	Output string
+	Output        string
+	Watch         bool
+	AllNamespaces bool
 }
 
 type CommandSiteGenerateFlags struct {
@@ -146,7 +199,9 @@ type CommandLinkDeleteFlags struct {
 }
 
 type CommandLinkStatusFlags struct {
-	Output string
+	Output        string
+	Watch         bool
+	AllNamespaces bool
 }
 
 type CommandTokenIssueFlags struct {
@@ -155,6 +210,8 @@ type CommandTokenIssueFlags struct {
 	ExpirationWindow   time.Duration
 	RedemptionsAllowed int
 	Cost               string
+	AllowedSources     []string
+	ExpectedSite       string
 }
 
 type CommandTokenRedeemFlags struct {
@@ -192,7 +249,9 @@ type CommandConnectorDeleteFlags struct {
 }
 
 type CommandConnectorStatusFlags struct {
-	Output string
+	Output        string
+	Watch         bool
+	AllNamespaces bool
 }
 
 type CommandConnectorGenerateFlags struct {
@@ -226,7 +285,9 @@ type CommandListenerUpdateFlags struct {
 }
 
 type CommandListenerStatusFlags struct {
-	Output string
+	Output        string
+	Watch         bool
+	AllNamespaces bool
 }
 
 type CommandListenerDeleteFlags struct {
@@ -249,19 +310,53 @@ type CommandVersionFlags struct {
 type CommandDebugFlags struct {
 }
 
+type CommandNetworkStatusFlags struct {
+	Output string
+}
+
 type CommandSystemUninstallFlags struct {
 	Force bool
 }
 
 type CommandSystemGenerateBundleFlags struct {
-	Input string
-	Type  string
+	Input                 string
+	Type                  string
+	EncryptRecipients     []string
+	EncryptPassphraseFile string
+	Parameters            []string
 }
 
 type CommandSystemApplyFlags struct {
 	Filename string
+	Timeout  time.Duration
+	Wait     string
 }
 
 type CommandSystemDeleteFlags struct {
 	Filename string
+	Timeout  time.Duration
+	Wait     bool
+}
+
+type CommandObserveFlags struct {
+	Endpoint              string
+	Output                string
+	Filter                string
+	Since                 time.Duration
+	Limit                 int
+	State                 string
+	Network               string
+	PairType              string
+	Token                 string
+	User                  string
+	CACert                string
+	InsecureSkipTLSVerify bool
+}
+
+type CommandTopFlags struct {
+	Endpoint string
+	Interval time.Duration
+	Sort     string
+	Filter   string
+	Rows     int
 }
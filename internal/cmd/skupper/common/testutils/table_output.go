@@ -0,0 +1,17 @@
+package testutils
+
+import (
+	"strings"
+)
+
+// TableLines returns the lines of a table printed by a command, with
+// the cells of each line separated by a single space so that its
+// content can be checked regardless of alignment. Empty cells are
+// dropped.
+func TableLines(output string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSuffix(output, "\n"), "\n") {
+		lines = append(lines, strings.Join(strings.Fields(line), " "))
+	}
+	return lines
+}
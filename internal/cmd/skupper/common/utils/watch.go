@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WatchContext returns a context that is cancelled when the command is
+// interrupted, ending a watch.
+func WatchContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
+
+// WatchResources runs an informer over the resources returned by the
+// given ListerWatcher, calling onChange with each resource as it is
+// added, updated or deleted until the context is done. The deleted
+// argument is true when the resource has been deleted. Resources that
+// exist when the watch begins are reported as added.
+func WatchResources[T runtime.Object](ctx context.Context, lw cache.ListerWatcher, objType T, onChange func(resource T, deleted bool)) error {
+	informer := cache.NewSharedIndexInformer(lw, objType, 0, cache.Indexers{})
+	notify := func(obj interface{}, deleted bool) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		if resource, ok := obj.(T); ok {
+			onChange(resource, deleted)
+		}
+	}
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			notify(obj, false)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			notify(newObj, false)
+		},
+		DeleteFunc: func(obj interface{}) {
+			notify(obj, true)
+		},
+	})
+	if err != nil {
+		return err
+	}
+	go informer.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) && ctx.Err() == nil {
+		return fmt.Errorf("failed to sync resources")
+	}
+	<-ctx.Done()
+	return nil
+}
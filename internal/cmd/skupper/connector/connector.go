@@ -57,6 +57,7 @@ skupper connector create backend 8080 --workload deployment/backend`,
 		cmd.Flags().StringVar(&cmdFlags.Wait, common.FlagNameWait, "configured", common.FlagDescWait)
 		cmd.Flags().StringVar(&cmdFlags.Host, common.FlagNameHost, "", common.FlagDescHost)
 	} else {
+		cmd.Flags().StringVar(&cmdFlags.Selector, common.FlagNameSelector, "", common.FlagDescContainerSelector)
 		cmd.Flags().StringVar(&cmdFlags.Host, common.FlagNameHost, "localhost", common.FlagDescHost)
 	}
 
@@ -73,16 +74,19 @@ func CmdConnectorStatusFactory(configuredPlatform common.Platform) *cobra.Comman
 	nonKubeCommand := nonkube.NewCmdConnectorStatus()
 
 	cmdConnectorStatusDesc := common.SkupperCmdDescription{
-		Use:     "status <name>",
-		Short:   "get status of connectors",
-		Long:    "Display status of all connectors or a specific connector",
-		Example: "skupper connector status backend",
+		Use:   "status <name>",
+		Short: "get status of connectors",
+		Long:  "Display status of all connectors or a specific connector",
+		Example: `skupper connector status backend
+skupper connector status --all-namespaces -o wide`,
 	}
 
 	cmd := common.ConfigureCobraCommand(configuredPlatform, cmdConnectorStatusDesc, kubeCommand, nonKubeCommand)
 
 	cmdFlags := common.CommandConnectorStatusFlags{}
-	cmd.Flags().StringVarP(&cmdFlags.Output, common.FlagNameConnectorStatusOutput, "o", "", common.FlagDescConnectorStatusOutput)
+	cmd.Flags().StringVarP(&cmdFlags.Output, common.FlagNameOutput, "o", "", common.FlagDescStatusOutput)
+	cmd.Flags().BoolVarP(&cmdFlags.Watch, common.FlagNameWatch, "w", false, common.FlagDescWatch)
+	cmd.Flags().BoolVarP(&cmdFlags.AllNamespaces, common.FlagNameAllNamespaces, "A", false, common.FlagDescAllNamespaces)
 
 	kubeCommand.CobraCmd = cmd
 	kubeCommand.Flags = &cmdFlags
@@ -120,6 +124,7 @@ func CmdConnectorUpdateFactory(configuredPlatform common.Platform) *cobra.Comman
 		cmd.Flags().StringVar(&cmdFlags.Wait, common.FlagNameWait, "configured", common.FlagDescWait)
 		cmd.Flags().StringVar(&cmdFlags.Host, common.FlagNameHost, "", common.FlagDescHost)
 	} else {
+		cmd.Flags().StringVar(&cmdFlags.Selector, common.FlagNameSelector, "", common.FlagDescContainerSelector)
 		cmd.Flags().StringVar(&cmdFlags.Host, common.FlagNameHost, "localhost", common.FlagDescHost)
 	}
 
@@ -182,6 +187,7 @@ skupper connector generate backend 8080 --workload deployment/backend`,
 		cmd.Flags().StringVar(&cmdFlags.Workload, common.FlagNameWorkload, "", common.FlagDescWorkload)
 		cmd.Flags().StringVar(&cmdFlags.Host, common.FlagNameHost, "", common.FlagDescHost)
 	} else {
+		cmd.Flags().StringVar(&cmdFlags.Selector, common.FlagNameSelector, "", common.FlagDescContainerSelector)
 		cmd.Flags().StringVar(&cmdFlags.Host, common.FlagNameHost, "localhost", common.FlagDescHost)
 	}
 
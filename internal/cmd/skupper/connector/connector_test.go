@@ -54,6 +54,8 @@ func TestCmdConnectorFactory(t *testing.T) {
 			name: "CmdConnectorStatusFactory",
 			expectedFlagsWithDefaultValue: map[string]interface{}{
 				common.FlagNameConnectorStatusOutput: "",
+				common.FlagNameWatch:                 "false",
+				common.FlagNameAllNamespaces:         "false",
 			},
 			command: CmdConnectorStatusFactory(common.PlatformKubernetes),
 		},
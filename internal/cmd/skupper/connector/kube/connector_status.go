@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"text/tabwriter"
 
@@ -11,20 +12,29 @@ import (
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
 
 	"github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/utils/formatter"
 	"github.com/skupperproject/skupper/internal/utils/validator"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2alpha1"
 	"github.com/spf13/cobra"
 	k8serrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
 )
 
 type CmdConnectorStatus struct {
-	client    skupperv2alpha1.SkupperV2alpha1Interface
-	CobraCmd  *cobra.Command
-	Flags     *common.CommandConnectorStatusFlags
-	namespace string
-	name      string
-	output    string
+	client        skupperv2alpha1.SkupperV2alpha1Interface
+	CobraCmd      *cobra.Command
+	Flags         *common.CommandConnectorStatusFlags
+	namespace     string
+	name          string
+	output        string
+	watch         bool
+	allNamespaces bool
+	watchCtx      context.Context
+	out           io.Writer
 }
 
 func NewCmdConnectorStatus() *CmdConnectorStatus {
@@ -43,7 +53,7 @@ func (cmd *CmdConnectorStatus) NewClient(cobraCommand *cobra.Command, args []str
 func (cmd *CmdConnectorStatus) ValidateInput(args []string) error {
 	var validationErrors []error
 	resourceStringValidator := validator.NewResourceStringValidator()
-	outputTypeValidator := validator.NewOptionValidator(common.OutputTypes)
+	outputTypeValidator := validator.NewOptionValidator(common.StatusOutputTypes)
 
 	// Check if Connector CRD is installed
 	_, err := cmd.client.Connectors(cmd.namespace).List(context.TODO(), metav1.ListOptions{})
@@ -68,8 +78,12 @@ func (cmd *CmdConnectorStatus) ValidateInput(args []string) error {
 		}
 	}
 
+	if cmd.Flags != nil && cmd.Flags.AllNamespaces && cmd.name != "" {
+		validationErrors = append(validationErrors, fmt.Errorf("a connector name cannot be specified with --%s", common.FlagNameAllNamespaces))
+	}
+
 	// Validate that there is a connector with this name in the namespace
-	if cmd.name != "" {
+	if cmd.name != "" && (cmd.Flags == nil || !cmd.Flags.Watch) {
 		connector, err := cmd.client.Connectors(cmd.namespace).Get(context.TODO(), cmd.name, metav1.GetOptions{})
 		if connector == nil || k8serrs.IsNotFound(err) {
 			validationErrors = append(validationErrors, fmt.Errorf("connector %s does not exist in namespace %s", cmd.name, cmd.namespace))
@@ -88,38 +102,31 @@ func (cmd *CmdConnectorStatus) ValidateInput(args []string) error {
 	return errors.Join(validationErrors...)
 }
 func (cmd *CmdConnectorStatus) Run() error {
+	if cmd.watch {
+		return cmd.watchConnectors()
+	}
 	if cmd.name == "" {
-		resources, err := cmd.client.Connectors(cmd.namespace).List(context.TODO(), metav1.ListOptions{})
+		namespace := cmd.namespace
+		if cmd.allNamespaces {
+			namespace = metav1.NamespaceAll
+		}
+		resources, err := cmd.client.Connectors(namespace).List(context.TODO(), metav1.ListOptions{})
 		if err != nil || resources == nil || len(resources.Items) == 0 {
 			fmt.Println("No connectors found")
 			return err
 		}
-		if cmd.output != "" {
-			for _, resource := range resources.Items {
-				encodedOutput, err := utils.Encode(cmd.output, resource)
-				if err != nil {
-					return err
-				}
-				fmt.Println(encodedOutput)
-			}
-		} else {
-			tw := tabwriter.NewWriter(os.Stdout, 8, 8, 1, '\t', tabwriter.TabIndent)
-			_, _ = fmt.Fprintln(tw, fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s",
-				"NAME", "STATUS", "ROUTING-KEY", "SELECTOR", "HOST", "PORT", "HAS MATCHING LISTENER", "MESSAGE"))
-			for _, resource := range resources.Items {
-				fmt.Fprintln(tw, fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%d\t%t\t%s",
-					resource.Name, resource.Status.StatusType, resource.Spec.RoutingKey,
-					resource.Spec.Selector, resource.Spec.Host, resource.Spec.Port, resource.Status.HasMatchingListener, resource.Status.Message))
-			}
-			_ = tw.Flush()
+		var connectors []*v2alpha1.Connector
+		for i := range resources.Items {
+			connectors = append(connectors, &resources.Items[i])
 		}
+		return formatter.NewResourcePrinter(formatter.ConnectorTable, cmd.output, cmd.allNamespaces).Print(connectors)
 	} else {
 		resource, err := cmd.client.Connectors(cmd.namespace).Get(context.TODO(), cmd.name, metav1.GetOptions{})
 		if err != nil || resource == nil {
 			fmt.Println("No connectors found")
 			return err
 		}
-		if cmd.output != "" {
+		if formatter.IsEncodedOutput(cmd.output) {
 			encodedOutput, err := utils.Encode(cmd.output, resource)
 			if err != nil {
 				return err
@@ -136,5 +143,38 @@ func (cmd *CmdConnectorStatus) Run() error {
 
 	return nil
 }
-func (cmd *CmdConnectorStatus) InputToOptions()  {}
+func (cmd *CmdConnectorStatus) watchConnectors() error {
+	namespace := cmd.namespace
+	if cmd.allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+	ctx := cmd.watchCtx
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = utils.WatchContext()
+		defer cancel()
+	}
+	printer := formatter.NewResourcePrinter(formatter.ConnectorTable, cmd.output, cmd.allNamespaces)
+	printer.Writer = cmd.out
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return cmd.client.Connectors(namespace).List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return cmd.client.Connectors(namespace).Watch(ctx, options)
+		},
+	}
+	return utils.WatchResources(ctx, lw, &v2alpha1.Connector{}, func(connector *v2alpha1.Connector, deleted bool) {
+		if cmd.name == "" || connector.Name == cmd.name {
+			_ = printer.PrintWatched(connector, deleted)
+		}
+	})
+}
+
+func (cmd *CmdConnectorStatus) InputToOptions() {
+	if cmd.Flags != nil {
+		cmd.watch = cmd.Flags.Watch
+		cmd.allNamespaces = cmd.Flags.AllNamespaces
+	}
+}
 func (cmd *CmdConnectorStatus) WaitUntil() error { return nil }
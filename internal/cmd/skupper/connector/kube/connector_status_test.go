@@ -1,7 +1,10 @@
 package kube
 
 import (
+	"bytes"
+	"context"
 	"testing"
+	"time"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
@@ -82,7 +85,7 @@ func TestCmdConnectorStatus_ValidateInput(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "output type is not valid: value not-supported not allowed. It should be one of this options: [json yaml]",
+			expectedError: "output type is not valid: value not-supported not allowed. It should be one of this options: [json yaml wide]",
 		},
 		{
 			name:  "good output status",
@@ -112,6 +115,18 @@ func TestCmdConnectorStatus_ValidateInput(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name:          "connector name with all namespaces",
+			args:          []string{"my-connector"},
+			flags:         common.CommandConnectorStatusFlags{AllNamespaces: true},
+			expectedError: "a connector name cannot be specified with --all-namespaces\nconnector my-connector does not exist in namespace test",
+		},
+		{
+			name:          "watch for a connector not yet created",
+			args:          []string{"my-connector"},
+			flags:         common.CommandConnectorStatusFlags{Watch: true, Output: "wide"},
+			expectedError: "",
+		},
 	}
 
 	for _, test := range testTable {
@@ -136,6 +151,7 @@ func TestCmdConnectorStatus_Run(t *testing.T) {
 		skupperObjects      []runtime.Object
 		skupperErrorMessage string
 		errorMessage        string
+		expectedOutput      []string
 	}
 
 	testTable := []test{
@@ -355,6 +371,40 @@ func TestCmdConnectorStatus_Run(t *testing.T) {
 			},
 			errorMessage: "format bad-value not supported",
 		},
+		{
+			name:  "returns connectors across all namespaces",
+			flags: common.CommandConnectorStatusFlags{AllNamespaces: true, Output: "wide"},
+			skupperObjects: []runtime.Object{
+				&v2alpha1.Connector{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "my-connector",
+						Namespace: "test",
+					},
+				},
+				&v2alpha1.Connector{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "my-connector",
+						Namespace: "other",
+					},
+				},
+			},
+		},
+		{
+			name:  "watches connectors until interrupted",
+			flags: common.CommandConnectorStatusFlags{Watch: true},
+			expectedOutput: []string{
+				"NAME STATUS ROUTING-KEY SELECTOR HOST PORT HAS MATCHING LISTENER MESSAGE",
+				"my-connector 0 false",
+			},
+			skupperObjects: []runtime.Object{
+				&v2alpha1.Connector{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "my-connector",
+						Namespace: "test",
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range testTable {
@@ -364,6 +414,12 @@ func TestCmdConnectorStatus_Run(t *testing.T) {
 		cmd.name = test.connectorName
 		cmd.Flags = &test.flags
 		cmd.output = cmd.Flags.Output
+		cmd.InputToOptions()
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		cmd.watchCtx = ctx
+		var out bytes.Buffer
+		cmd.out = &out
 		cmd.namespace = "test"
 
 		t.Run(test.name, func(t *testing.T) {
@@ -374,6 +430,9 @@ func TestCmdConnectorStatus_Run(t *testing.T) {
 			} else {
 				assert.Check(t, err == nil)
 			}
+			if test.expectedOutput != nil {
+				assert.DeepEqual(t, testutils.TableLines(out.String()), test.expectedOutput)
+			}
 		})
 	}
 }
@@ -95,6 +95,23 @@ func TestNonKubeCmdConnectorCreate_ValidateInput(t *testing.T) {
 			args:  []string{"my-connector-host", "8080"},
 			flags: &common.CommandConnectorCreateFlags{},
 		},
+		{
+			name:  "selector replaces default host",
+			args:  []string{"my-connector-selector", "8080"},
+			flags: &common.CommandConnectorCreateFlags{Selector: "app=backend"},
+		},
+		{
+			name:          "selector and host are both configured",
+			args:          []string{"my-connector-selector", "8080"},
+			flags:         &common.CommandConnectorCreateFlags{Selector: "app=backend", Host: "1.2.3.4"},
+			expectedError: "If selector is configured, cannot configure host",
+		},
+		{
+			name:          "selector is not valid",
+			args:          []string{"my-connector-selector", "8080"},
+			flags:         &common.CommandConnectorCreateFlags{Selector: "app=back end"},
+			expectedError: "selector is not valid: value does not match this regular expression: ^[A-Za-z0-9=:./-]+$",
+		},
 		{
 
 			name:  "kubernetes flags are not valid on this platform",
@@ -149,6 +166,7 @@ func TestNonKubeCmdConnectorCreate_InputToOptions(t *testing.T) {
 		Connectorname          string
 		expectedTlsCredentials string
 		expectedHost           string
+		expectedSelector       string
 		expectedRoutingKey     string
 		expectedConnectorType  string
 	}
@@ -213,6 +231,21 @@ func TestNonKubeCmdConnectorCreate_InputToOptions(t *testing.T) {
 			expectedConnectorType:  "tcp",
 			expectedNamespace:      "test",
 		},
+		{
+			name:      "test4",
+			namespace: "test",
+			flags: common.CommandConnectorCreateFlags{
+				RoutingKey:    "backend",
+				Host:          "localhost",
+				Selector:      "app=backend",
+				ConnectorType: "tcp",
+			},
+			expectedHost:          "",
+			expectedSelector:      "app=backend",
+			expectedRoutingKey:    "backend",
+			expectedConnectorType: "tcp",
+			expectedNamespace:     "test",
+		},
 	}
 
 	for _, test := range testTable {
@@ -228,6 +261,7 @@ func TestNonKubeCmdConnectorCreate_InputToOptions(t *testing.T) {
 			assert.Check(t, cmd.routingKey == test.expectedRoutingKey)
 			assert.Check(t, cmd.tlsCredentials == test.expectedTlsCredentials)
 			assert.Check(t, cmd.host == test.expectedHost)
+			assert.Check(t, cmd.selector == test.expectedSelector)
 			assert.Check(t, cmd.connectorType == test.expectedConnectorType)
 			assert.Check(t, cmd.namespace == test.expectedNamespace)
 		})
@@ -24,6 +24,7 @@ type CmdConnectorGenerate struct {
 	port             int
 	output           string
 	host             string
+	selector         string
 	routingKey       string
 	connectorType    string
 	tlsCredentials   string
@@ -57,6 +58,7 @@ func (cmd *CmdConnectorGenerate) ValidateInput(args []string) error {
 	connectorTypeValidator := validator.NewOptionValidator(common.ConnectorTypes)
 	outputTypeValidator := validator.NewOptionValidator(common.OutputTypes)
 	hostStringValidator := validator.NewHostStringValidator()
+	selectorStringValidator := validator.NewSelectorStringValidator()
 
 	// Validate arguments name and port
 	if len(args) < 2 {
@@ -110,6 +112,15 @@ func (cmd *CmdConnectorGenerate) ValidateInput(args []string) error {
 			validationErrors = append(validationErrors, fmt.Errorf("host is not valid: a valid IP address or hostname is expected"))
 		}
 	}
+	if cmd.Flags.Selector != "" {
+		if cmd.hostConfigured() {
+			validationErrors = append(validationErrors, fmt.Errorf("If selector is configured, cannot configure host"))
+		}
+		ok, err := selectorStringValidator.Evaluate(cmd.Flags.Selector)
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("selector is not valid: %s", err))
+		}
+	}
 	if cmd.Flags.TlsCredentials != "" {
 		ok, err := resourceStringValidator.Evaluate(cmd.Flags.TlsCredentials)
 		if !ok {
@@ -132,7 +143,12 @@ func (cmd *CmdConnectorGenerate) InputToOptions() {
 		cmd.namespace = "default"
 	}
 
-	cmd.host = cmd.Flags.Host
+	// a selector replaces the default host
+	if cmd.Flags.Selector != "" {
+		cmd.selector = cmd.Flags.Selector
+	} else {
+		cmd.host = cmd.Flags.Host
+	}
 	cmd.connectorType = cmd.Flags.ConnectorType
 	cmd.tlsCredentials = cmd.Flags.TlsCredentials
 	cmd.output = cmd.Flags.Output
@@ -150,6 +166,7 @@ func (cmd *CmdConnectorGenerate) Run() error {
 		},
 		Spec: v2alpha1.ConnectorSpec{
 			Host:           cmd.host,
+			Selector:       cmd.selector,
 			Port:           cmd.port,
 			RoutingKey:     cmd.routingKey,
 			TlsCredentials: cmd.tlsCredentials,
@@ -164,3 +181,12 @@ func (cmd *CmdConnectorGenerate) Run() error {
 }
 
 func (cmd *CmdConnectorGenerate) WaitUntil() error { return nil }
+
+// hostConfigured indicates whether the host was explicitly provided,
+// rather than defaulted.
+func (cmd *CmdConnectorGenerate) hostConfigured() bool {
+	if cmd.CobraCmd != nil && cmd.CobraCmd.Flag(common.FlagNameHost) != nil {
+		return cmd.CobraCmd.Flag(common.FlagNameHost).Changed
+	}
+	return cmd.Flags.Host != ""
+}
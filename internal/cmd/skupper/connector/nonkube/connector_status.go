@@ -3,13 +3,16 @@ package nonkube
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"text/tabwriter"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
 	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
+	"github.com/skupperproject/skupper/internal/utils/formatter"
 	"github.com/skupperproject/skupper/internal/utils/validator"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +23,10 @@ type CmdConnectorStatus struct {
 	namespace        string
 	connectorName    string
 	output           string
+	watch            bool
+	allNamespaces    bool
+	stopCh           <-chan struct{}
+	out              io.Writer
 }
 
 func NewCmdConnectorStatus() *CmdConnectorStatus {
@@ -38,7 +45,7 @@ func (cmd *CmdConnectorStatus) ValidateInput(args []string) error {
 	var validationErrors []error
 	opts := fs.GetOptions{RuntimeFirst: true, LogWarning: false}
 	resourceStringValidator := validator.NewResourceStringValidator()
-	outputTypeValidator := validator.NewOptionValidator(common.OutputTypes)
+	outputTypeValidator := validator.NewOptionValidator(common.StatusOutputTypes)
 
 	// Validate arguments name if specified
 	if len(args) > 1 {
@@ -55,8 +62,11 @@ func (cmd *CmdConnectorStatus) ValidateInput(args []string) error {
 			}
 		}
 	}
+	if cmd.Flags.AllNamespaces && cmd.connectorName != "" {
+		validationErrors = append(validationErrors, fmt.Errorf("a connector name cannot be specified with --%s", common.FlagNameAllNamespaces))
+	}
 	// Validate that there is a connector with this name in the namespace
-	if cmd.connectorName != "" {
+	if cmd.connectorName != "" && !cmd.Flags.Watch {
 		connector, err := cmd.connectorHandler.Get(cmd.connectorName, opts)
 		if connector == nil || err != nil {
 			validationErrors = append(validationErrors, fmt.Errorf("connector %s does not exist in namespace %s", cmd.connectorName, cmd.namespace))
@@ -77,38 +87,31 @@ func (cmd *CmdConnectorStatus) ValidateInput(args []string) error {
 
 func (cmd *CmdConnectorStatus) Run() error {
 	opts := fs.GetOptions{RuntimeFirst: true, LogWarning: true}
+	if cmd.watch {
+		return cmd.watchConnectors()
+	}
 	if cmd.connectorName == "" {
-		resources, err := cmd.connectorHandler.List()
+		var resources []*v2alpha1.Connector
+		var err error
+		if cmd.allNamespaces {
+			resources, err = fs.ListAllNamespaces(func(namespace string) ([]*v2alpha1.Connector, error) {
+				return fs.NewConnectorHandler(namespace).List()
+			})
+		} else {
+			resources, err = cmd.connectorHandler.List()
+		}
 		if err != nil || resources == nil || len(resources) == 0 {
 			fmt.Println("No connectors found")
 			return err
 		}
-		if cmd.output != "" {
-			for _, resource := range resources {
-				encodedOutput, err := utils.Encode(cmd.output, resource)
-				if err != nil {
-					return err
-				}
-				fmt.Println(encodedOutput)
-			}
-		} else {
-			tw := tabwriter.NewWriter(os.Stdout, 8, 8, 1, '\t', tabwriter.TabIndent)
-			_, _ = fmt.Fprintln(tw, fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s",
-				"NAME", "STATUS", "ROUTING-KEY", "SELECTOR", "HOST", "PORT", "HAS MATCHING LISTENER", "MESSAGE"))
-			for _, resource := range resources {
-				fmt.Fprintln(tw, fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%d\t%t\t%s",
-					resource.Name, resource.Status.StatusType, resource.Spec.RoutingKey,
-					resource.Spec.Selector, resource.Spec.Host, resource.Spec.Port, resource.Status.HasMatchingListener, resource.Status.Message))
-			}
-			_ = tw.Flush()
-		}
+		return formatter.NewResourcePrinter(formatter.ConnectorTable, cmd.output, cmd.allNamespaces).Print(resources)
 	} else {
 		resource, err := cmd.connectorHandler.Get(cmd.connectorName, opts)
 		if err != nil || resource == nil {
 			fmt.Println("No connectors found")
 			return err
 		}
-		if cmd.output != "" {
+		if formatter.IsEncodedOutput(cmd.output) {
 			encodedOutput, err := utils.Encode(cmd.output, resource)
 			if err != nil {
 				return err
@@ -126,5 +129,31 @@ func (cmd *CmdConnectorStatus) Run() error {
 	return nil
 }
 
-func (cmd *CmdConnectorStatus) InputToOptions()  {}
+func (cmd *CmdConnectorStatus) watchConnectors() error {
+	namespaces := []string{cmd.namespace}
+	if cmd.allNamespaces {
+		var err error
+		if namespaces, err = fs.ListNamespaces(); err != nil {
+			return err
+		}
+	}
+	stopCh := cmd.stopCh
+	if stopCh == nil {
+		ctx, cancel := utils.WatchContext()
+		defer cancel()
+		stopCh = ctx.Done()
+	}
+	printer := formatter.NewResourcePrinter(formatter.ConnectorTable, cmd.output, cmd.allNamespaces)
+	printer.Writer = cmd.out
+	return fs.WatchRuntimeResources(namespaces, common.Connectors, func(connector *v2alpha1.Connector, deleted bool) {
+		if cmd.connectorName == "" || connector.Name == cmd.connectorName {
+			_ = printer.PrintWatched(connector, deleted)
+		}
+	}, stopCh)
+}
+
+func (cmd *CmdConnectorStatus) InputToOptions() {
+	cmd.watch = cmd.Flags.Watch
+	cmd.allNamespaces = cmd.Flags.AllNamespaces
+}
 func (cmd *CmdConnectorStatus) WaitUntil() error { return nil }
@@ -1,10 +1,12 @@
 package nonkube
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
@@ -66,7 +68,7 @@ func TestCmdConnectorStatus_ValidateInput(t *testing.T) {
 			name:          "bad output status",
 			args:          []string{"my-connector"},
 			flags:         &common.CommandConnectorStatusFlags{Output: "not-supported"},
-			expectedError: "output type is not valid: value not-supported not allowed. It should be one of this options: [json yaml]",
+			expectedError: "output type is not valid: value not-supported not allowed. It should be one of this options: [json yaml wide]",
 		},
 		{
 			name:          "good output status",
@@ -74,6 +76,18 @@ func TestCmdConnectorStatus_ValidateInput(t *testing.T) {
 			flags:         &common.CommandConnectorStatusFlags{Output: "json"},
 			expectedError: "",
 		},
+		{
+			name:          "connector name with all namespaces",
+			args:          []string{"my-connector"},
+			flags:         &common.CommandConnectorStatusFlags{AllNamespaces: true},
+			expectedError: "a connector name cannot be specified with --all-namespaces",
+		},
+		{
+			name:          "watch a connector not yet created",
+			args:          []string{"new-connector"},
+			flags:         &common.CommandConnectorStatusFlags{Watch: true, Output: "wide"},
+			expectedError: "",
+		},
 	}
 
 	//Add a temp file so connector exists for status tests
@@ -119,10 +133,11 @@ func TestCmdConnectorStatus_ValidateInput(t *testing.T) {
 
 func TestCmdConnectorStatus_Run(t *testing.T) {
 	type test struct {
-		name          string
-		connectorName string
-		flags         common.CommandConnectorStatusFlags
-		errorMessage  string
+		name           string
+		connectorName  string
+		flags          common.CommandConnectorStatusFlags
+		errorMessage   string
+		expectedOutput []string
 	}
 
 	if os.Getuid() == 0 {
@@ -166,6 +181,15 @@ func TestCmdConnectorStatus_Run(t *testing.T) {
 			flags:         common.CommandConnectorStatusFlags{Output: "bad-value"},
 			errorMessage:  "format bad-value not supported",
 		},
+		{
+			name:          "runs ok, watches 1 connector",
+			connectorName: "my-connector",
+			flags:         common.CommandConnectorStatusFlags{Watch: true},
+			expectedOutput: []string{
+				"NAME STATUS ROUTING-KEY SELECTOR HOST PORT HAS MATCHING LISTENER MESSAGE",
+				"my-connector backend-8080 1.2.3.4 8080 false",
+			},
+		},
 	}
 
 	//Add a temp file so connector exists for status tests
@@ -242,6 +266,12 @@ func TestCmdConnectorStatus_Run(t *testing.T) {
 		command.connectorName = test.connectorName
 		command.Flags = &test.flags
 		command.output = command.Flags.Output
+		command.watch = test.flags.Watch
+		stopCh := make(chan struct{})
+		time.AfterFunc(200*time.Millisecond, func() { close(stopCh) })
+		command.stopCh = stopCh
+		var out bytes.Buffer
+		command.out = &out
 
 		t.Run(test.name, func(t *testing.T) {
 			err := command.Run()
@@ -250,6 +280,9 @@ func TestCmdConnectorStatus_Run(t *testing.T) {
 			} else {
 				assert.NilError(t, err)
 			}
+			if test.expectedOutput != nil {
+				assert.DeepEqual(t, testutils.TableLines(out.String()), test.expectedOutput)
+			}
 		})
 	}
 }
@@ -16,6 +16,7 @@ import (
 type ConnectorUpdates struct {
 	routingKey     string
 	host           string
+	selector       string
 	connectorType  string
 	port           int
 	tlsCredentials string
@@ -48,6 +49,7 @@ func (cmd *CmdConnectorUpdate) ValidateInput(args []string) error {
 	numberValidator := validator.NewNumberValidator()
 	connectorTypeValidator := validator.NewOptionValidator(common.ConnectorTypes)
 	hostStringValidator := validator.NewHostStringValidator()
+	selectorStringValidator := validator.NewSelectorStringValidator()
 
 	if cmd.CobraCmd != nil && cmd.CobraCmd.Flag(common.FlagNameContext) != nil && cmd.CobraCmd.Flag(common.FlagNameContext).Value.String() != "" {
 		fmt.Println("Warning: --context flag is not supported on this platform")
@@ -81,6 +83,7 @@ func (cmd *CmdConnectorUpdate) ValidateInput(args []string) error {
 		} else {
 			// save existing values
 			cmd.newSettings.host = connector.Spec.Host
+			cmd.newSettings.selector = connector.Spec.Selector
 			cmd.newSettings.port = connector.Spec.Port
 			cmd.newSettings.connectorType = connector.Spec.Type
 			cmd.newSettings.tlsCredentials = connector.Spec.TlsCredentials
@@ -120,6 +123,18 @@ func (cmd *CmdConnectorUpdate) ValidateInput(args []string) error {
 			validationErrors = append(validationErrors, fmt.Errorf("host is not valid: a valid IP address or hostname is expected"))
 		} else {
 			cmd.newSettings.host = cmd.Flags.Host
+			cmd.newSettings.selector = ""
+		}
+	}
+	if cmd.Flags.Selector != "" {
+		ok, err := selectorStringValidator.Evaluate(cmd.Flags.Selector)
+		if cmd.Flags.Host != "localhost" {
+			validationErrors = append(validationErrors, fmt.Errorf("If selector is configured, cannot configure host"))
+		} else if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("selector is not valid: %s", err))
+		} else {
+			cmd.newSettings.selector = cmd.Flags.Selector
+			cmd.newSettings.host = ""
 		}
 	}
 	if cmd.Flags.TlsCredentials != "" {
@@ -151,6 +166,7 @@ func (cmd *CmdConnectorUpdate) Run() error {
 		},
 		Spec: v2alpha1.ConnectorSpec{
 			Host:           cmd.newSettings.host,
+			Selector:       cmd.newSettings.selector,
 			Port:           cmd.newSettings.port,
 			RoutingKey:     cmd.newSettings.routingKey,
 			TlsCredentials: cmd.newSettings.tlsCredentials,
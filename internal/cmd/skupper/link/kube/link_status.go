@@ -4,26 +4,33 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
-	"text/tabwriter"
+	"io"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
 	"github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/utils/formatter"
 	"github.com/skupperproject/skupper/internal/utils/validator"
 	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2alpha1"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
 )
 
 type CmdLinkStatus struct {
-	Client    skupperv2alpha1.SkupperV2alpha1Interface
-	CobraCmd  *cobra.Command
-	Flags     *common.CommandLinkStatusFlags
-	Namespace string
-	output    string
-	linkName  string
+	Client        skupperv2alpha1.SkupperV2alpha1Interface
+	CobraCmd      *cobra.Command
+	Flags         *common.CommandLinkStatusFlags
+	Namespace     string
+	output        string
+	linkName      string
+	watch         bool
+	allNamespaces bool
+	watchCtx      context.Context
+	out           io.Writer
 }
 
 func NewCmdLinkStatus() *CmdLinkStatus {
@@ -41,7 +48,7 @@ func (cmd *CmdLinkStatus) NewClient(cobraCommand *cobra.Command, args []string)
 
 func (cmd *CmdLinkStatus) ValidateInput(args []string) error {
 	var validationErrors []error
-	outputTypeValidator := validator.NewOptionValidator(common.OutputTypes)
+	outputTypeValidator := validator.NewOptionValidator(common.StatusOutputTypes)
 
 	// Check if CRDs are installed
 	_, err := cmd.Client.Links(cmd.Namespace).List(context.TODO(), metav1.ListOptions{})
@@ -50,13 +57,15 @@ func (cmd *CmdLinkStatus) ValidateInput(args []string) error {
 		return errors.Join(validationErrors...)
 	}
 
-	siteList, err := cmd.Client.Sites(cmd.Namespace).List(context.TODO(), metav1.ListOptions{})
-	if err != nil {
-		validationErrors = append(validationErrors, utils.HandleMissingCrds(err))
-		return errors.Join(validationErrors...)
-	}
-	if siteList == nil || len(siteList.Items) == 0 {
-		validationErrors = append(validationErrors, fmt.Errorf("there is no skupper site available"))
+	if !cmd.Flags.AllNamespaces {
+		siteList, err := cmd.Client.Sites(cmd.Namespace).List(context.TODO(), metav1.ListOptions{})
+		if err != nil {
+			validationErrors = append(validationErrors, utils.HandleMissingCrds(err))
+			return errors.Join(validationErrors...)
+		}
+		if siteList == nil || len(siteList.Items) == 0 {
+			validationErrors = append(validationErrors, fmt.Errorf("there is no skupper site available"))
+		}
 	}
 
 	if len(args) > 1 {
@@ -74,14 +83,24 @@ func (cmd *CmdLinkStatus) ValidateInput(args []string) error {
 		cmd.linkName = args[0]
 	}
 
+	if cmd.Flags.AllNamespaces && cmd.linkName != "" {
+		validationErrors = append(validationErrors, fmt.Errorf("a link name cannot be specified with --%s", common.FlagNameAllNamespaces))
+	}
+
 	return errors.Join(validationErrors...)
 }
 
 func (cmd *CmdLinkStatus) InputToOptions() {
 	cmd.output = cmd.Flags.Output
+	cmd.watch = cmd.Flags.Watch
+	cmd.allNamespaces = cmd.Flags.AllNamespaces
 }
 func (cmd *CmdLinkStatus) Run() error {
 
+	if cmd.watch {
+		return cmd.watchLinks()
+	}
+
 	if cmd.linkName != "" {
 
 		selectedLink, err := cmd.Client.Links(cmd.Namespace).Get(context.TODO(), cmd.linkName, metav1.GetOptions{})
@@ -89,7 +108,7 @@ func (cmd *CmdLinkStatus) Run() error {
 			return err
 		}
 
-		if cmd.output != "" {
+		if formatter.IsEncodedOutput(cmd.output) {
 			return printEncodedOuptut(cmd.output, selectedLink)
 		} else {
 			displaySingleLink(selectedLink)
@@ -97,7 +116,11 @@ func (cmd *CmdLinkStatus) Run() error {
 
 	} else {
 
-		linkList, err := cmd.Client.Links(cmd.Namespace).List(context.TODO(), metav1.ListOptions{})
+		namespace := cmd.Namespace
+		if cmd.allNamespaces {
+			namespace = metav1.NamespaceAll
+		}
+		linkList, err := cmd.Client.Links(namespace).List(context.TODO(), metav1.ListOptions{})
 		if err != nil {
 			return err
 		}
@@ -107,24 +130,45 @@ func (cmd *CmdLinkStatus) Run() error {
 			return nil
 		}
 
-		if cmd.output != "" {
-			for _, link := range linkList.Items {
-				err := printEncodedOuptut(cmd.output, &link)
-
-				if err != nil {
-					return err
-				}
-			}
-		} else {
-			displayLinkList(linkList.Items)
+		var links []*v2alpha1.Link
+		for i := range linkList.Items {
+			links = append(links, &linkList.Items[i])
 		}
-
+		return formatter.NewResourcePrinter(formatter.LinkTable, cmd.output, cmd.allNamespaces).Print(links)
 	}
 
 	return nil
 }
 func (cmd *CmdLinkStatus) WaitUntil() error { return nil }
 
+func (cmd *CmdLinkStatus) watchLinks() error {
+	namespace := cmd.Namespace
+	if cmd.allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+	ctx := cmd.watchCtx
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = utils.WatchContext()
+		defer cancel()
+	}
+	printer := formatter.NewResourcePrinter(formatter.LinkTable, cmd.output, cmd.allNamespaces)
+	printer.Writer = cmd.out
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return cmd.Client.Links(namespace).List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return cmd.Client.Links(namespace).Watch(ctx, options)
+		},
+	}
+	return utils.WatchResources(ctx, lw, &v2alpha1.Link{}, func(link *v2alpha1.Link, deleted bool) {
+		if cmd.linkName == "" || link.Name == cmd.linkName {
+			_ = printer.PrintWatched(link, deleted)
+		}
+	})
+}
+
 func printEncodedOuptut(outputType string, link *v2alpha1.Link) error {
 	encodedOutput, err := utils.Encode(outputType, link)
 	fmt.Println(encodedOutput)
@@ -135,17 +179,8 @@ func displaySingleLink(link *v2alpha1.Link) {
 	fmt.Printf("%s\t: %s\n", "Name", link.Name)
 	fmt.Printf("%s\t: %s\n", "Status", link.Status.StatusType)
 	fmt.Printf("%s\t: %d\n", "Cost", link.Spec.Cost)
-	fmt.Printf("%s\t: %s\n", "Message", link.Status.Message)
-}
-
-func displayLinkList(linkList []v2alpha1.Link) {
-	writer := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', tabwriter.AlignRight)
-	fmt.Fprintln(writer, "NAME\tSTATUS\tCOST\tMESSAGE")
-
-	for _, link := range linkList {
-		fmt.Fprintf(writer, "%s\t%s\t%d\t%s", link.Name, link.Status.StatusType, link.Spec.Cost, link.Status.Message)
-		fmt.Fprintln(writer)
+	if link.Status.EffectiveCost != 0 {
+		fmt.Printf("%s\t: %d\n", "Effective cost", link.Status.EffectiveCost)
 	}
-
-	writer.Flush()
+	fmt.Printf("%s\t: %s\n", "Message", link.Status.Message)
 }
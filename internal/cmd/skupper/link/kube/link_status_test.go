@@ -1,7 +1,10 @@
 package kube
 
 import (
+	"bytes"
+	"context"
 	"testing"
+	"time"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
@@ -76,7 +79,13 @@ func TestCmdLinkStatus_ValidateInput(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "output type is not valid: value not-valid not allowed. It should be one of this options: [json yaml]",
+			expectedError: "output type is not valid: value not-valid not allowed. It should be one of this options: [json yaml wide]",
+		},
+		{
+			name:          "link name with all namespaces",
+			args:          []string{"my-link"},
+			flags:         common.CommandLinkStatusFlags{AllNamespaces: true},
+			expectedError: "a link name cannot be specified with --all-namespaces",
 		},
 	}
 
@@ -101,12 +110,16 @@ func TestCmdLinkStatus_InputToOptions(t *testing.T) {
 		assert.Assert(t, err)
 
 		cmd.Flags = &common.CommandLinkStatusFlags{
-			Output: "json",
+			Output:        "json",
+			Watch:         true,
+			AllNamespaces: true,
 		}
 
 		cmd.InputToOptions()
 
 		assert.Check(t, cmd.output == "json")
+		assert.Check(t, cmd.watch)
+		assert.Check(t, cmd.allNamespaces)
 
 	})
 
@@ -120,6 +133,9 @@ func TestCmdLinkStatus_Run(t *testing.T) {
 		errorMessage        string
 		linkName            string
 		output              string
+		allNamespaces       bool
+		watch               bool
+		expectedOutput      []string
 	}
 
 	testTable := []test{
@@ -241,6 +257,42 @@ func TestCmdLinkStatus_Run(t *testing.T) {
 			output:       "unsupported",
 			errorMessage: "format unsupported not supported",
 		},
+		{
+			name: "runs ok showing the links of all namespaces",
+			skupperObjects: []runtime.Object{
+				&v2alpha1.Link{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "my-link",
+						Namespace: "test",
+					},
+				},
+				&v2alpha1.Link{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "my-link",
+						Namespace: "other",
+					},
+				},
+			},
+			output:        "wide",
+			allNamespaces: true,
+		},
+		{
+			name: "runs ok watching a link",
+			skupperObjects: []runtime.Object{
+				&v2alpha1.Link{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "my-link",
+						Namespace: "test",
+					},
+				},
+			},
+			linkName: "my-link",
+			watch:    true,
+			expectedOutput: []string{
+				"NAME STATUS COST MESSAGE",
+				"my-link 0",
+			},
+		},
 	}
 
 	for _, test := range testTable {
@@ -248,6 +300,13 @@ func TestCmdLinkStatus_Run(t *testing.T) {
 		assert.Assert(t, err)
 		cmd.linkName = test.linkName
 		cmd.output = test.output
+		cmd.allNamespaces = test.allNamespaces
+		cmd.watch = test.watch
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		cmd.watchCtx = ctx
+		var out bytes.Buffer
+		cmd.out = &out
 
 		t.Run(test.name, func(t *testing.T) {
 
@@ -257,6 +316,9 @@ func TestCmdLinkStatus_Run(t *testing.T) {
 			} else {
 				assert.Check(t, err == nil)
 			}
+			if test.expectedOutput != nil {
+				assert.DeepEqual(t, testutils.TableLines(out.String()), test.expectedOutput)
+			}
 		})
 	}
 }
@@ -107,15 +107,18 @@ func CmdLinkStatusFactory(configuredPlatform common.Platform) *cobra.Command {
 	nonKubeCommand := nonkube.NewCmdLinkStatus()
 
 	cmdLinkStatusDesc := common.SkupperCmdDescription{
-		Use:     "status",
-		Short:   "Display the status",
-		Long:    "Display the status of links in the current site.",
-		Example: "skupper link status",
+		Use:   "status",
+		Short: "Display the status",
+		Long:  "Display the status of links in the current site.",
+		Example: `skupper link status
+skupper link status --all-namespaces --watch`,
 	}
 
 	cmd := common.ConfigureCobraCommand(configuredPlatform, cmdLinkStatusDesc, kubeCommand, nonKubeCommand)
 	cmdFlags := common.CommandLinkStatusFlags{}
-	cmd.Flags().StringVarP(&cmdFlags.Output, common.FlagNameOutput, "o", "", common.FlagDescOutput)
+	cmd.Flags().StringVarP(&cmdFlags.Output, common.FlagNameOutput, "o", "", common.FlagDescStatusOutput)
+	cmd.Flags().BoolVarP(&cmdFlags.Watch, common.FlagNameWatch, "w", false, common.FlagDescWatch)
+	cmd.Flags().BoolVarP(&cmdFlags.AllNamespaces, common.FlagNameAllNamespaces, "A", false, common.FlagDescAllNamespaces)
 
 	kubeCommand.CobraCmd = cmd
 	kubeCommand.Flags = &cmdFlags
@@ -51,7 +51,9 @@ func TestCmdLinkFactory(t *testing.T) {
 		{
 			name: "CmdLinkStatusFactory",
 			expectedFlagsWithDefaultValue: map[string]interface{}{
-				common.FlagNameOutput: "",
+				common.FlagNameOutput:        "",
+				common.FlagNameWatch:         "false",
+				common.FlagNameAllNamespaces: "false",
 			},
 			command: CmdLinkStatusFactory(common.PlatformKubernetes),
 		},
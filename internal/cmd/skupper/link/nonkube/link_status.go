@@ -3,26 +3,30 @@ package nonkube
 import (
 	"errors"
 	"fmt"
-	"os"
-	"text/tabwriter"
+	"io"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
 	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
+	"github.com/skupperproject/skupper/internal/utils/formatter"
 	"github.com/skupperproject/skupper/internal/utils/validator"
 	"github.com/spf13/cobra"
 )
 
 type CmdLinkStatus struct {
-	linkHandler *fs.LinkHandler
-	siteHandler *fs.SiteHandler
-	CobraCmd    *cobra.Command
-	Flags       *common.CommandLinkStatusFlags
-	namespace   string
-	linkName    string
-	output      string
+	linkHandler   *fs.LinkHandler
+	siteHandler   *fs.SiteHandler
+	CobraCmd      *cobra.Command
+	Flags         *common.CommandLinkStatusFlags
+	namespace     string
+	linkName      string
+	output        string
+	watch         bool
+	allNamespaces bool
+	stopCh        <-chan struct{}
+	out           io.Writer
 }
 
 func NewCmdLinkStatus() *CmdLinkStatus {
@@ -41,6 +45,7 @@ func (cmd *CmdLinkStatus) NewClient(cobraCommand *cobra.Command, args []string)
 func (cmd *CmdLinkStatus) ValidateInput(args []string) error {
 	var validationErrors []error
 	resourceStringValidator := validator.NewResourceStringValidator()
+	outputTypeValidator := validator.NewOptionValidator(common.StatusOutputTypes)
 
 	// Validate arguments name if specified
 	if len(args) > 1 {
@@ -57,18 +62,33 @@ func (cmd *CmdLinkStatus) ValidateInput(args []string) error {
 			}
 		}
 	}
+
+	if cmd.Flags != nil && cmd.Flags.AllNamespaces && cmd.linkName != "" {
+		validationErrors = append(validationErrors, fmt.Errorf("a link name cannot be specified with --%s", common.FlagNameAllNamespaces))
+	}
+
+	if cmd.Flags != nil && cmd.Flags.Output != "" {
+		ok, err := outputTypeValidator.Evaluate(cmd.Flags.Output)
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("output type is not valid: %s", err))
+		}
+	}
 	return errors.Join(validationErrors...)
 }
 
 func (cmd *CmdLinkStatus) Run() error {
 
+	if cmd.watch {
+		return cmd.watchLinks()
+	}
+
 	if cmd.linkName != "" {
 		selectedLink, err := cmd.linkHandler.Get(cmd.linkName, fs.GetOptions{LogWarning: false, RuntimeFirst: true})
 		if err != nil {
 			return fmt.Errorf("There is no link resource in the namespace with the name %q", cmd.linkName)
 		}
 
-		if cmd.output != "" {
+		if formatter.IsEncodedOutput(cmd.output) {
 			return printEncodedOuptut(cmd.output, selectedLink)
 		} else {
 			displaySingleLink(selectedLink)
@@ -76,35 +96,57 @@ func (cmd *CmdLinkStatus) Run() error {
 
 	} else {
 
-		linkList, err := cmd.linkHandler.List(fs.GetOptions{LogWarning: false})
+		var linkList []*v2alpha1.Link
+		var err error
+		if cmd.allNamespaces {
+			linkList, err = fs.ListAllNamespaces(func(namespace string) ([]*v2alpha1.Link, error) {
+				return fs.NewLinkHandler(namespace).List(fs.GetOptions{LogWarning: false})
+			})
+		} else {
+			linkList, err = cmd.linkHandler.List(fs.GetOptions{LogWarning: false})
+		}
 		if err != nil {
 			return err
 		}
 
-		if linkList != nil && len(linkList) == 0 {
+		if len(linkList) == 0 {
 			fmt.Println("There are no link resources in the namespace")
 			return nil
 		}
 
-		if cmd.output != "" {
-			for _, link := range linkList {
-				err := printEncodedOuptut(cmd.output, link)
-
-				if err != nil {
-					return err
-				}
-			}
-		} else {
-			displayLinkList(linkList)
-		}
-
+		return formatter.NewResourcePrinter(formatter.LinkTable, cmd.output, cmd.allNamespaces).Print(linkList)
 	}
 
 	return nil
 }
 
+func (cmd *CmdLinkStatus) watchLinks() error {
+	namespaces := []string{cmd.namespace}
+	if cmd.allNamespaces {
+		var err error
+		if namespaces, err = fs.ListNamespaces(); err != nil {
+			return err
+		}
+	}
+	stopCh := cmd.stopCh
+	if stopCh == nil {
+		ctx, cancel := utils.WatchContext()
+		defer cancel()
+		stopCh = ctx.Done()
+	}
+	printer := formatter.NewResourcePrinter(formatter.LinkTable, cmd.output, cmd.allNamespaces)
+	printer.Writer = cmd.out
+	return fs.WatchRuntimeResources(namespaces, common.Links, func(link *v2alpha1.Link, deleted bool) {
+		if cmd.linkName == "" || link.Name == cmd.linkName {
+			_ = printer.PrintWatched(link, deleted)
+		}
+	}, stopCh)
+}
+
 func (cmd *CmdLinkStatus) InputToOptions() {
 	cmd.output = cmd.Flags.Output
+	cmd.watch = cmd.Flags.Watch
+	cmd.allNamespaces = cmd.Flags.AllNamespaces
 }
 func (cmd *CmdLinkStatus) WaitUntil() error { return nil }
 
@@ -120,15 +162,3 @@ func displaySingleLink(link *v2alpha1.Link) {
 	fmt.Printf("%s\t: %d\n", "Cost", link.Spec.Cost)
 	fmt.Printf("%s\t: %s\n", "Message", link.Status.Message)
 }
-
-func displayLinkList(linkList []*v2alpha1.Link) {
-	writer := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', tabwriter.AlignRight)
-	fmt.Fprintln(writer, "NAME\tSTATUS\tCOST\tMESSAGE")
-
-	for _, link := range linkList {
-		fmt.Fprintf(writer, "%s\t%s\t%d\t%s", link.Name, link.Status.StatusType, link.Spec.Cost, link.Status.Message)
-		fmt.Fprintln(writer)
-	}
-
-	writer.Flush()
-}
@@ -1,9 +1,11 @@
 package nonkube
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
@@ -61,6 +63,17 @@ func TestCmdLinkStatus_ValidateInput(t *testing.T) {
 			name:  "no args",
 			flags: &common.CommandLinkStatusFlags{},
 		},
+		{
+			name:          "link name with all namespaces",
+			args:          []string{"my-link"},
+			flags:         &common.CommandLinkStatusFlags{AllNamespaces: true},
+			expectedError: "a link name cannot be specified with --all-namespaces",
+		},
+		{
+			name:          "output type is not valid",
+			flags:         &common.CommandLinkStatusFlags{Output: "table"},
+			expectedError: "output type is not valid: value table not allowed. It should be one of this options: [json yaml wide]",
+		},
 	}
 
 	//Add a temp file so link exists for status tests
@@ -107,10 +120,11 @@ func TestCmdLinkStatus_ValidateInput(t *testing.T) {
 
 func TestCmdLinkStatus_Run(t *testing.T) {
 	type test struct {
-		name         string
-		linkName     string
-		flags        common.CommandLinkStatusFlags
-		errorMessage string
+		name           string
+		linkName       string
+		flags          common.CommandLinkStatusFlags
+		errorMessage   string
+		expectedOutput []string
 	}
 
 	if os.Getuid() == 0 {
@@ -154,6 +168,15 @@ func TestCmdLinkStatus_Run(t *testing.T) {
 			flags:        common.CommandLinkStatusFlags{Output: "bad-value"},
 			errorMessage: "format bad-value not supported",
 		},
+		{
+			name:     "runs ok, watches 1 link",
+			linkName: "my-link",
+			flags:    common.CommandLinkStatusFlags{Watch: true},
+			expectedOutput: []string{
+				"NAME STATUS COST MESSAGE",
+				"my-link 2",
+			},
+		},
 	}
 
 	//Add a temp file so link exists for status tests
@@ -272,6 +295,12 @@ func TestCmdLinkStatus_Run(t *testing.T) {
 	for _, test := range testTable {
 		command.linkName = test.linkName
 		command.Flags = &test.flags
+		command.watch = test.flags.Watch
+		stopCh := make(chan struct{})
+		time.AfterFunc(200*time.Millisecond, func() { close(stopCh) })
+		command.stopCh = stopCh
+		var out bytes.Buffer
+		command.out = &out
 
 		t.Run(test.name, func(t *testing.T) {
 			err := command.Run()
@@ -280,6 +309,9 @@ func TestCmdLinkStatus_Run(t *testing.T) {
 			} else {
 				assert.Check(t, err == nil)
 			}
+			if test.expectedOutput != nil {
+				assert.DeepEqual(t, testutils.TableLines(out.String()), test.expectedOutput)
+			}
 		})
 	}
 }
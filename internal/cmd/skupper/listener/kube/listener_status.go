@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"text/tabwriter"
 
@@ -11,20 +12,29 @@ import (
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
 
 	"github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/utils/formatter"
 	"github.com/skupperproject/skupper/internal/utils/validator"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2alpha1"
 	"github.com/spf13/cobra"
 	k8serrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
 )
 
 type CmdListenerStatus struct {
-	client    skupperv2alpha1.SkupperV2alpha1Interface
-	CobraCmd  *cobra.Command
-	Flags     *common.CommandListenerStatusFlags
-	namespace string
-	name      string
-	output    string
+	client        skupperv2alpha1.SkupperV2alpha1Interface
+	CobraCmd      *cobra.Command
+	Flags         *common.CommandListenerStatusFlags
+	namespace     string
+	name          string
+	output        string
+	watch         bool
+	allNamespaces bool
+	watchCtx      context.Context
+	out           io.Writer
 }
 
 func NewCmdListenerStatus() *CmdListenerStatus {
@@ -43,7 +53,7 @@ func (cmd *CmdListenerStatus) NewClient(cobraCommand *cobra.Command, args []stri
 func (cmd *CmdListenerStatus) ValidateInput(args []string) error {
 	var validationErrors []error
 	resourceStringValidator := validator.NewResourceStringValidator()
-	outputTypeValidator := validator.NewOptionValidator(common.OutputTypes)
+	outputTypeValidator := validator.NewOptionValidator(common.StatusOutputTypes)
 
 	// Check if Listener CRD is installed
 	_, err := cmd.client.Listeners(cmd.namespace).List(context.TODO(), metav1.ListOptions{})
@@ -68,8 +78,12 @@ func (cmd *CmdListenerStatus) ValidateInput(args []string) error {
 		}
 	}
 
+	if cmd.Flags != nil && cmd.Flags.AllNamespaces && cmd.name != "" {
+		validationErrors = append(validationErrors, fmt.Errorf("a listener name cannot be specified with --%s", common.FlagNameAllNamespaces))
+	}
+
 	// Validate that there is a listener with this name in the namespace
-	if cmd.name != "" {
+	if cmd.name != "" && (cmd.Flags == nil || !cmd.Flags.Watch) {
 		listener, err := cmd.client.Listeners(cmd.namespace).Get(context.TODO(), cmd.name, metav1.GetOptions{})
 		if err != nil || listener == nil {
 			validationErrors = append(validationErrors, fmt.Errorf("listener %s does not exist in namespace %s", cmd.name, cmd.namespace))
@@ -88,38 +102,31 @@ func (cmd *CmdListenerStatus) ValidateInput(args []string) error {
 	return errors.Join(validationErrors...)
 }
 func (cmd *CmdListenerStatus) Run() error {
+	if cmd.watch {
+		return cmd.watchListeners()
+	}
 	if cmd.name == "" {
-		resources, err := cmd.client.Listeners(cmd.namespace).List(context.TODO(), metav1.ListOptions{})
+		namespace := cmd.namespace
+		if cmd.allNamespaces {
+			namespace = metav1.NamespaceAll
+		}
+		resources, err := cmd.client.Listeners(namespace).List(context.TODO(), metav1.ListOptions{})
 		if err != nil || resources == nil || len(resources.Items) == 0 {
 			fmt.Println("No listeners found")
 			return err
 		}
-		if cmd.output != "" {
-			for _, resource := range resources.Items {
-				encodedOutput, err := utils.Encode(cmd.output, resource)
-				if err != nil {
-					return err
-				}
-				fmt.Println(encodedOutput)
-			}
-		} else {
-			tw := tabwriter.NewWriter(os.Stdout, 8, 8, 1, '\t', tabwriter.TabIndent)
-			_, _ = fmt.Fprintln(tw, fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s",
-				"NAME", "STATUS", "ROUTING-KEY", "HOST", "PORT", "MATCHING-CONNECTOR", "MESSAGE"))
-			for _, resource := range resources.Items {
-				fmt.Fprintln(tw, fmt.Sprintf("%s\t%s\t%s\t%s\t%d\t%t\t%s",
-					resource.Name, resource.Status.StatusType, resource.Spec.RoutingKey, resource.Spec.Host,
-					resource.Spec.Port, resource.Status.HasMatchingConnector, resource.Status.Message))
-			}
-			_ = tw.Flush()
+		var listeners []*v2alpha1.Listener
+		for i := range resources.Items {
+			listeners = append(listeners, &resources.Items[i])
 		}
+		return formatter.NewResourcePrinter(formatter.ListenerTable, cmd.output, cmd.allNamespaces).Print(listeners)
 	} else {
 		resource, err := cmd.client.Listeners(cmd.namespace).Get(context.TODO(), cmd.name, metav1.GetOptions{})
 		if err != nil || resource == nil || k8serrs.IsNotFound(err) {
 			fmt.Println("No listeners found")
 			return err
 		}
-		if cmd.output != "" {
+		if formatter.IsEncodedOutput(cmd.output) {
 			encodedOutput, err := utils.Encode(cmd.output, resource)
 			if err != nil {
 				return err
@@ -137,5 +144,38 @@ func (cmd *CmdListenerStatus) Run() error {
 	return nil
 }
 
-func (cmd *CmdListenerStatus) InputToOptions()  {}
+func (cmd *CmdListenerStatus) watchListeners() error {
+	namespace := cmd.namespace
+	if cmd.allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+	ctx := cmd.watchCtx
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = utils.WatchContext()
+		defer cancel()
+	}
+	printer := formatter.NewResourcePrinter(formatter.ListenerTable, cmd.output, cmd.allNamespaces)
+	printer.Writer = cmd.out
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return cmd.client.Listeners(namespace).List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return cmd.client.Listeners(namespace).Watch(ctx, options)
+		},
+	}
+	return utils.WatchResources(ctx, lw, &v2alpha1.Listener{}, func(listener *v2alpha1.Listener, deleted bool) {
+		if cmd.name == "" || listener.Name == cmd.name {
+			_ = printer.PrintWatched(listener, deleted)
+		}
+	})
+}
+
+func (cmd *CmdListenerStatus) InputToOptions() {
+	if cmd.Flags != nil {
+		cmd.watch = cmd.Flags.Watch
+		cmd.allNamespaces = cmd.Flags.AllNamespaces
+	}
+}
 func (cmd *CmdListenerStatus) WaitUntil() error { return nil }
@@ -1,7 +1,10 @@
 package kube
 
 import (
+	"bytes"
+	"context"
 	"testing"
+	"time"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
@@ -86,13 +89,25 @@ func TestCmdListenerStatus_ValidateInput(t *testing.T) {
 					},
 				},
 			},
-			expectedError: "output type is not valid: value not-supported not allowed. It should be one of this options: [json yaml]",
+			expectedError: "output type is not valid: value not-supported not allowed. It should be one of this options: [json yaml wide]",
 		},
 		{
 			name:          "good output status",
 			flags:         common.CommandListenerStatusFlags{Output: "json"},
 			expectedError: "",
 		},
+		{
+			name:          "listener name with all namespaces",
+			args:          []string{"my-listener"},
+			flags:         common.CommandListenerStatusFlags{AllNamespaces: true},
+			expectedError: "a listener name cannot be specified with --all-namespaces\nlistener my-listener does not exist in namespace test",
+		},
+		{
+			name:          "watch for a listener not yet created",
+			args:          []string{"my-listener"},
+			flags:         common.CommandListenerStatusFlags{Watch: true, Output: "wide"},
+			expectedError: "",
+		},
 	}
 
 	for _, test := range testTable {
@@ -117,6 +132,7 @@ func TestCmdListenerStatus_Run(t *testing.T) {
 		skupperObjects      []runtime.Object
 		skupperErrorMessage string
 		errorMessage        string
+		expectedOutput      []string
 	}
 
 	testTable := []test{
@@ -343,6 +359,40 @@ func TestCmdListenerStatus_Run(t *testing.T) {
 			},
 			errorMessage: "format bad-value not supported",
 		},
+		{
+			name:  "returns listeners across all namespaces",
+			flags: common.CommandListenerStatusFlags{AllNamespaces: true, Output: "wide"},
+			skupperObjects: []runtime.Object{
+				&v2alpha1.Listener{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "my-listener",
+						Namespace: "test",
+					},
+				},
+				&v2alpha1.Listener{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "my-listener",
+						Namespace: "other",
+					},
+				},
+			},
+		},
+		{
+			name:  "watches listeners until interrupted",
+			flags: common.CommandListenerStatusFlags{Watch: true},
+			expectedOutput: []string{
+				"NAME STATUS ROUTING-KEY HOST PORT MATCHING-CONNECTOR MESSAGE",
+				"my-listener 0 false",
+			},
+			skupperObjects: []runtime.Object{
+				&v2alpha1.Listener{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "my-listener",
+						Namespace: "test",
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range testTable {
@@ -351,6 +401,12 @@ func TestCmdListenerStatus_Run(t *testing.T) {
 		cmd.name = test.listenerName
 		cmd.Flags = &test.flags
 		cmd.output = cmd.Flags.Output
+		cmd.InputToOptions()
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		cmd.watchCtx = ctx
+		var out bytes.Buffer
+		cmd.out = &out
 		cmd.namespace = "test"
 
 		t.Run(test.name, func(t *testing.T) {
@@ -361,6 +417,9 @@ func TestCmdListenerStatus_Run(t *testing.T) {
 			} else {
 				assert.Check(t, err == nil)
 			}
+			if test.expectedOutput != nil {
+				assert.DeepEqual(t, testutils.TableLines(out.String()), test.expectedOutput)
+			}
 		})
 	}
 }
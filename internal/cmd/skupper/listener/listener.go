@@ -102,17 +102,20 @@ func CmdListenerStatusFactory(configuredPlatform common.Platform) *cobra.Command
 	nonKubeCommand := nonkube.NewCmdListenerStatus()
 
 	cmdListenerStatusDesc := common.SkupperCmdDescription{
-		Use:     "status <name>",
-		Short:   "get status of listeners",
-		Long:    "Display status of all listeners or a specific listener",
-		Example: "skupper listener status backend",
+		Use:   "status <name>",
+		Short: "get status of listeners",
+		Long:  "Display status of all listeners or a specific listener",
+		Example: `skupper listener status backend
+skupper listener status --all-namespaces -o wide`,
 	}
 
 	cmd := common.ConfigureCobraCommand(configuredPlatform, cmdListenerStatusDesc, kubeCommand, nonKubeCommand)
 
 	cmdFlags := common.CommandListenerStatusFlags{}
 
-	cmd.Flags().StringVarP(&cmdFlags.Output, common.FlagNameOutput, "o", "", common.FlagDescOutput)
+	cmd.Flags().StringVarP(&cmdFlags.Output, common.FlagNameOutput, "o", "", common.FlagDescStatusOutput)
+	cmd.Flags().BoolVarP(&cmdFlags.Watch, common.FlagNameWatch, "w", false, common.FlagDescWatch)
+	cmd.Flags().BoolVarP(&cmdFlags.AllNamespaces, common.FlagNameAllNamespaces, "A", false, common.FlagDescAllNamespaces)
 
 	kubeCommand.CobraCmd = cmd
 	kubeCommand.Flags = &cmdFlags
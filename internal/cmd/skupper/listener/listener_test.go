@@ -47,7 +47,9 @@ func TestCmdListenerFactory(t *testing.T) {
 		{
 			name: "CmdListenerStatusFactory",
 			expectedFlagsWithDefaultValue: map[string]interface{}{
-				common.FlagNameOutput: "",
+				common.FlagNameOutput:        "",
+				common.FlagNameWatch:         "false",
+				common.FlagNameAllNamespaces: "false",
 			},
 			command: CmdListenerStatusFactory(common.PlatformKubernetes),
 		},
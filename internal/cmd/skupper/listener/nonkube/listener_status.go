@@ -3,6 +3,7 @@ package nonkube
 import (
 	"errors"
 	"fmt"
+	"io"
 	k8serrs "k8s.io/apimachinery/pkg/api/errors"
 	"os"
 	"text/tabwriter"
@@ -10,7 +11,9 @@ import (
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
 	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
+	"github.com/skupperproject/skupper/internal/utils/formatter"
 	"github.com/skupperproject/skupper/internal/utils/validator"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	"github.com/spf13/cobra"
 )
 
@@ -21,6 +24,10 @@ type CmdListenerStatus struct {
 	namespace       string
 	listenerName    string
 	output          string
+	watch           bool
+	allNamespaces   bool
+	stopCh          <-chan struct{}
+	out             io.Writer
 }
 
 func NewCmdListenerStatus() *CmdListenerStatus {
@@ -39,7 +46,7 @@ func (cmd *CmdListenerStatus) ValidateInput(args []string) error {
 	var validationErrors []error
 	opts := fs.GetOptions{RuntimeFirst: true, LogWarning: false}
 	resourceStringValidator := validator.NewResourceStringValidator()
-	outputTypeValidator := validator.NewOptionValidator(common.OutputTypes)
+	outputTypeValidator := validator.NewOptionValidator(common.StatusOutputTypes)
 
 	// Validate arguments name if specified
 	if len(args) > 1 {
@@ -56,8 +63,11 @@ func (cmd *CmdListenerStatus) ValidateInput(args []string) error {
 			}
 		}
 	}
+	if cmd.Flags.AllNamespaces && cmd.listenerName != "" {
+		validationErrors = append(validationErrors, fmt.Errorf("a listener name cannot be specified with --%s", common.FlagNameAllNamespaces))
+	}
 	// Validate that there is a listener with this name in the namespace
-	if cmd.listenerName != "" {
+	if cmd.listenerName != "" && !cmd.Flags.Watch {
 		listener, err := cmd.listenerHandler.Get(cmd.listenerName, opts)
 		if listener == nil || err != nil {
 			validationErrors = append(validationErrors, fmt.Errorf("listener %s does not exist in namespace %s", cmd.listenerName, cmd.namespace))
@@ -78,38 +88,31 @@ func (cmd *CmdListenerStatus) ValidateInput(args []string) error {
 
 func (cmd *CmdListenerStatus) Run() error {
 	opts := fs.GetOptions{RuntimeFirst: true, LogWarning: true}
+	if cmd.watch {
+		return cmd.watchListeners()
+	}
 	if cmd.listenerName == "" {
-		resources, err := cmd.listenerHandler.List()
+		var resources []*v2alpha1.Listener
+		var err error
+		if cmd.allNamespaces {
+			resources, err = fs.ListAllNamespaces(func(namespace string) ([]*v2alpha1.Listener, error) {
+				return fs.NewListenerHandler(namespace).List()
+			})
+		} else {
+			resources, err = cmd.listenerHandler.List()
+		}
 		if err != nil || resources == nil || len(resources) == 0 {
 			fmt.Println("No listeners found")
 			return err
 		}
-		if cmd.output != "" {
-			for _, resource := range resources {
-				encodedOutput, err := utils.Encode(cmd.output, resource)
-				if err != nil {
-					return err
-				}
-				fmt.Println(encodedOutput)
-			}
-		} else {
-			tw := tabwriter.NewWriter(os.Stdout, 8, 8, 1, '\t', tabwriter.TabIndent)
-			_, _ = fmt.Fprintln(tw, fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t%s",
-				"NAME", "STATUS", "ROUTING-KEY", "HOST", "PORT", "MATCHING-CONNECTOR", "MESSAGE"))
-			for _, resource := range resources {
-				fmt.Fprintln(tw, fmt.Sprintf("%s\t%s\t%s\t%s\t%d\t%t\t%s",
-					resource.Name, resource.Status.StatusType, resource.Spec.RoutingKey, resource.Spec.Host,
-					resource.Spec.Port, resource.Status.HasMatchingConnector, resource.Status.Message))
-			}
-			_ = tw.Flush()
-		}
+		return formatter.NewResourcePrinter(formatter.ListenerTable, cmd.output, cmd.allNamespaces).Print(resources)
 	} else {
 		resource, err := cmd.listenerHandler.Get(cmd.listenerName, opts)
 		if err != nil || resource == nil || k8serrs.IsNotFound(err) {
 			fmt.Println("No listeners found")
 			return err
 		}
-		if cmd.output != "" {
+		if formatter.IsEncodedOutput(cmd.output) {
 			encodedOutput, err := utils.Encode(cmd.output, resource)
 			if err != nil {
 				return err
@@ -127,5 +130,31 @@ func (cmd *CmdListenerStatus) Run() error {
 	return nil
 }
 
-func (cmd *CmdListenerStatus) InputToOptions()  {}
+func (cmd *CmdListenerStatus) watchListeners() error {
+	namespaces := []string{cmd.namespace}
+	if cmd.allNamespaces {
+		var err error
+		if namespaces, err = fs.ListNamespaces(); err != nil {
+			return err
+		}
+	}
+	stopCh := cmd.stopCh
+	if stopCh == nil {
+		ctx, cancel := utils.WatchContext()
+		defer cancel()
+		stopCh = ctx.Done()
+	}
+	printer := formatter.NewResourcePrinter(formatter.ListenerTable, cmd.output, cmd.allNamespaces)
+	printer.Writer = cmd.out
+	return fs.WatchRuntimeResources(namespaces, common.Listeners, func(listener *v2alpha1.Listener, deleted bool) {
+		if cmd.listenerName == "" || listener.Name == cmd.listenerName {
+			_ = printer.PrintWatched(listener, deleted)
+		}
+	}, stopCh)
+}
+
+func (cmd *CmdListenerStatus) InputToOptions() {
+	cmd.watch = cmd.Flags.Watch
+	cmd.allNamespaces = cmd.Flags.AllNamespaces
+}
 func (cmd *CmdListenerStatus) WaitUntil() error { return nil }
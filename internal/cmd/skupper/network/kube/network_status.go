@@ -0,0 +1,103 @@
+package kube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/skupperproject/skupper/api/types"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/network"
+	"github.com/skupperproject/skupper/internal/utils/formatter"
+	"github.com/skupperproject/skupper/internal/utils/validator"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2alpha1"
+	"github.com/spf13/cobra"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type CmdNetworkStatus struct {
+	Client     skupperv2alpha1.SkupperV2alpha1Interface
+	KubeClient kubernetes.Interface
+	CobraCmd   *cobra.Command
+	Flags      *common.CommandNetworkStatusFlags
+	Namespace  string
+	output     string
+}
+
+func NewCmdNetworkStatus() *CmdNetworkStatus {
+
+	skupperCmd := CmdNetworkStatus{}
+
+	return &skupperCmd
+}
+
+func (cmd *CmdNetworkStatus) NewClient(cobraCommand *cobra.Command, args []string) {
+	cli, err := client.NewClient(cobraCommand.Flag("namespace").Value.String(), cobraCommand.Flag("context").Value.String(), cobraCommand.Flag("kubeconfig").Value.String())
+	utils.HandleError(utils.GenericError, err)
+
+	cmd.Client = cli.GetSkupperClient().SkupperV2alpha1()
+	cmd.KubeClient = cli.GetKubeClient()
+	cmd.Namespace = cli.Namespace
+}
+
+func (cmd *CmdNetworkStatus) ValidateInput(args []string) error {
+	var validationErrors []error
+	outputTypeValidator := validator.NewOptionValidator(common.OutputTypes)
+
+	if len(args) > 0 {
+		validationErrors = append(validationErrors, fmt.Errorf("this command does not need any arguments"))
+	}
+
+	if cmd.Flags != nil && cmd.Flags.Output != "" {
+		ok, err := outputTypeValidator.Evaluate(cmd.Flags.Output)
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("output type is not valid: %s", err))
+		}
+	}
+
+	return errors.Join(validationErrors...)
+}
+
+func (cmd *CmdNetworkStatus) InputToOptions() {
+	if cmd.Flags != nil {
+		cmd.output = cmd.Flags.Output
+	}
+}
+
+func (cmd *CmdNetworkStatus) Run() error {
+	cm, err := cmd.KubeClient.CoreV1().ConfigMaps(cmd.Namespace).Get(context.TODO(), types.NetworkStatusConfigMapName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return fmt.Errorf("there is no network status in namespace %q: is there a site?", cmd.Namespace)
+	} else if err != nil {
+		return err
+	}
+	if cm.Data["NetworkStatus"] == "" {
+		return fmt.Errorf("the network status is not yet available in namespace %q", cmd.Namespace)
+	}
+	status, err := network.UnmarshalSkupperStatus(cm.Data)
+	if err != nil {
+		return fmt.Errorf("the network status in namespace %q could not be read: %s", cmd.Namespace, err)
+	}
+
+	if cmd.output != "" {
+		encodedOutput, err := utils.Encode(cmd.output, status)
+		if err != nil {
+			return err
+		}
+		fmt.Println(encodedOutput)
+		return nil
+	}
+
+	var currentSite string
+	siteList, err := cmd.Client.Sites(cmd.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err == nil && len(siteList.Items) > 0 {
+		currentSite = siteList.Items[0].GetSiteId()
+	}
+	return formatter.PrintNetworkStatus(currentSite, status, "", false)
+}
+
+func (cmd *CmdNetworkStatus) WaitUntil() error { return nil }
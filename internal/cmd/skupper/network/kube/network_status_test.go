@@ -0,0 +1,165 @@
+package kube
+
+import (
+	"testing"
+
+	"github.com/skupperproject/skupper/api/types"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	fakeclient "github.com/skupperproject/skupper/internal/kube/client/fake"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const networkStatus = `{"addresses":[],"siteStatus":[{"site":{"identity":"site-a","name":"west","namespace":"test","platform":"kubernetes"},"routerStatus":[],"probes":[{"peer":"site-b","status":"ok","latency":1500,"throughput":2048}]},{"site":{"identity":"site-b","name":"east","namespace":"east","platform":"podman"},"routerStatus":[]}]}`
+
+func TestCmdNetworkStatus_ValidateInput(t *testing.T) {
+	type test struct {
+		name          string
+		args          []string
+		flags         *common.CommandNetworkStatusFlags
+		expectedError string
+	}
+
+	testTable := []test{
+		{
+			name:  "no arguments",
+			flags: &common.CommandNetworkStatusFlags{},
+		},
+		{
+			name:          "arguments were specified",
+			args:          []string{"something"},
+			flags:         &common.CommandNetworkStatusFlags{},
+			expectedError: "this command does not need any arguments",
+		},
+		{
+			name:          "output format is not valid",
+			flags:         &common.CommandNetworkStatusFlags{Output: "wide"},
+			expectedError: "output type is not valid: value wide not allowed. It should be one of this options: [json yaml]",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command, err := newCmdNetworkStatusWithMocks("test", nil, nil, "")
+			assert.Assert(t, err)
+			command.Flags = test.flags
+
+			err = command.ValidateInput(test.args)
+			if test.expectedError != "" {
+				assert.Error(t, err, test.expectedError)
+			} else {
+				assert.Assert(t, err)
+			}
+		})
+	}
+}
+
+func TestCmdNetworkStatus_InputToOptions(t *testing.T) {
+
+	t.Run("input to options", func(t *testing.T) {
+
+		cmd, err := newCmdNetworkStatusWithMocks("test", nil, nil, "")
+		assert.Assert(t, err)
+
+		cmd.Flags = &common.CommandNetworkStatusFlags{Output: "yaml"}
+
+		cmd.InputToOptions()
+
+		assert.Check(t, cmd.output == "yaml")
+	})
+
+}
+
+func TestCmdNetworkStatus_Run(t *testing.T) {
+	type test struct {
+		name         string
+		k8sObjects   []runtime.Object
+		output       string
+		errorMessage string
+	}
+
+	statusConfigMap := func(data string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      types.NetworkStatusConfigMapName,
+				Namespace: "test",
+			},
+			Data: map[string]string{
+				"NetworkStatus": data,
+			},
+		}
+	}
+
+	testTable := []test{
+		{
+			name:         "there is no network status",
+			errorMessage: "there is no network status in namespace \"test\": is there a site?",
+		},
+		{
+			name:         "network status is empty",
+			k8sObjects:   []runtime.Object{statusConfigMap("")},
+			errorMessage: "the network status is not yet available in namespace \"test\"",
+		},
+		{
+			name:         "network status is not valid",
+			k8sObjects:   []runtime.Object{statusConfigMap("{")},
+			errorMessage: "the network status in namespace \"test\" could not be read: unexpected end of JSON input",
+		},
+		{
+			name:       "network status is printed",
+			k8sObjects: []runtime.Object{statusConfigMap(networkStatus)},
+		},
+		{
+			name:       "network status is printed as yaml",
+			k8sObjects: []runtime.Object{statusConfigMap(networkStatus)},
+			output:     "yaml",
+		},
+	}
+
+	for _, test := range testTable {
+		cmd, err := newCmdNetworkStatusWithMocks("test", test.k8sObjects, nil, "")
+		assert.Assert(t, err)
+		cmd.output = test.output
+
+		t.Run(test.name, func(t *testing.T) {
+			err := cmd.Run()
+			if test.errorMessage != "" {
+				assert.Error(t, err, test.errorMessage)
+			} else {
+				assert.Assert(t, err)
+			}
+		})
+	}
+}
+
+func TestCmdNetworkStatus_WaitUntil(t *testing.T) {
+
+	t.Run("wait until", func(t *testing.T) {
+
+		cmd, err := newCmdNetworkStatusWithMocks("test", nil, nil, "")
+		assert.Assert(t, err)
+
+		result := cmd.WaitUntil()
+		assert.Check(t, result == nil)
+	})
+
+}
+
+// --- helper methods
+
+func newCmdNetworkStatusWithMocks(namespace string, k8sObjects []runtime.Object, skupperObjects []runtime.Object, fakeSkupperError string) (*CmdNetworkStatus, error) {
+
+	client, err := fakeclient.NewFakeClient(namespace, k8sObjects, skupperObjects, fakeSkupperError)
+	if err != nil {
+		return nil, err
+	}
+	cmdNetworkStatus := &CmdNetworkStatus{
+		Client:     client.GetSkupperClient().SkupperV2alpha1(),
+		KubeClient: client.GetKubeClient(),
+		Namespace:  namespace,
+	}
+
+	return cmdNetworkStatus, nil
+}
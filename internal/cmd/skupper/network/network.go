@@ -0,0 +1,48 @@
+package network
+
+import (
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/network/kube"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/network/nonkube"
+	"github.com/skupperproject/skupper/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdNetwork() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "network",
+		Short:   "Display information about the sites of the network",
+		Long:    "Display information about the sites that make up the application network",
+		Example: "skupper network status",
+	}
+	platform := common.Platform(config.GetPlatform())
+	cmd.AddCommand(CmdNetworkStatusFactory(platform))
+
+	return cmd
+}
+
+func CmdNetworkStatusFactory(configuredPlatform common.Platform) *cobra.Command {
+	kubeCommand := kube.NewCmdNetworkStatus()
+	nonKubeCommand := nonkube.NewCmdNetworkStatus()
+
+	cmdNetworkStatusDesc := common.SkupperCmdDescription{
+		Use:   "status",
+		Short: "Display the status of the sites in the network",
+		Long: `Display the sites of the network as seen from the current site, the sites they are linked to and,
+when site probing is enabled, the latency and throughput measured from each site to the others`,
+		Example: `skupper network status
+skupper network status -o yaml`,
+	}
+
+	cmd := common.ConfigureCobraCommand(configuredPlatform, cmdNetworkStatusDesc, kubeCommand, nonKubeCommand)
+
+	cmdFlags := common.CommandNetworkStatusFlags{}
+	cmd.Flags().StringVarP(&cmdFlags.Output, common.FlagNameOutput, "o", "", common.FlagDescNetworkOutput)
+
+	kubeCommand.CobraCmd = cmd
+	kubeCommand.Flags = &cmdFlags
+	nonKubeCommand.CobraCmd = cmd
+	nonKubeCommand.Flags = &cmdFlags
+
+	return cmd
+}
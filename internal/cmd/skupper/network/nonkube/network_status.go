@@ -0,0 +1,96 @@
+package nonkube
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/skupperproject/skupper/api/types"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/internal/network"
+	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
+	"github.com/skupperproject/skupper/internal/utils/formatter"
+	"github.com/skupperproject/skupper/internal/utils/validator"
+	"github.com/spf13/cobra"
+)
+
+type CmdNetworkStatus struct {
+	configMapHandler *fs.ConfigMapHandler
+	siteHandler      *fs.SiteHandler
+	CobraCmd         *cobra.Command
+	Flags            *common.CommandNetworkStatusFlags
+	namespace        string
+	output           string
+}
+
+func NewCmdNetworkStatus() *CmdNetworkStatus {
+	return &CmdNetworkStatus{}
+}
+
+func (cmd *CmdNetworkStatus) NewClient(cobraCommand *cobra.Command, args []string) {
+	if cmd.CobraCmd != nil && cmd.CobraCmd.Flag(common.FlagNameNamespace) != nil && cmd.CobraCmd.Flag(common.FlagNameNamespace).Value.String() != "" {
+		cmd.namespace = cmd.CobraCmd.Flag(common.FlagNameNamespace).Value.String()
+	}
+
+	cmd.configMapHandler = fs.NewConfigMapHandler(cmd.namespace)
+	cmd.siteHandler = fs.NewSiteHandler(cmd.namespace)
+}
+
+func (cmd *CmdNetworkStatus) ValidateInput(args []string) error {
+	var validationErrors []error
+	outputTypeValidator := validator.NewOptionValidator(common.OutputTypes)
+
+	if len(args) > 0 {
+		validationErrors = append(validationErrors, fmt.Errorf("this command does not need any arguments"))
+	}
+
+	if cmd.Flags != nil && cmd.Flags.Output != "" {
+		ok, err := outputTypeValidator.Evaluate(cmd.Flags.Output)
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("output type is not valid: %s", err))
+		}
+	}
+
+	return errors.Join(validationErrors...)
+}
+
+func (cmd *CmdNetworkStatus) InputToOptions() {
+	if cmd.namespace == "" {
+		cmd.namespace = "default"
+	}
+	if cmd.Flags != nil {
+		cmd.output = cmd.Flags.Output
+	}
+}
+
+func (cmd *CmdNetworkStatus) Run() error {
+	cm, err := cmd.configMapHandler.Get(types.NetworkStatusConfigMapName, fs.GetOptions{RuntimeFirst: true})
+	if err != nil {
+		return fmt.Errorf("there is no network status in namespace %q: is the site running?", cmd.namespace)
+	}
+	if cm.Data["NetworkStatus"] == "" {
+		return fmt.Errorf("the network status is not yet available in namespace %q", cmd.namespace)
+	}
+	status, err := network.UnmarshalSkupperStatus(cm.Data)
+	if err != nil {
+		return fmt.Errorf("the network status in namespace %q could not be read: %s", cmd.namespace, err)
+	}
+
+	if cmd.output != "" {
+		encodedOutput, err := utils.Encode(cmd.output, status)
+		if err != nil {
+			return err
+		}
+		fmt.Println(encodedOutput)
+		return nil
+	}
+
+	var currentSite string
+	sites, err := cmd.siteHandler.List(fs.GetOptions{RuntimeFirst: true})
+	if err == nil && len(sites) > 0 {
+		currentSite = sites[0].GetSiteId()
+	}
+	return formatter.PrintNetworkStatus(currentSite, status, "", false)
+}
+
+func (cmd *CmdNetworkStatus) WaitUntil() error { return nil }
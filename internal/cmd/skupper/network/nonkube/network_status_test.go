@@ -0,0 +1,168 @@
+package nonkube
+
+import (
+	"os"
+	"testing"
+
+	"github.com/skupperproject/skupper/api/types"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const networkStatus = `{"addresses":[],"siteStatus":[{"site":{"identity":"site-a","name":"west","namespace":"test1","platform":"podman"},"routerStatus":[],"probes":[{"peer":"site-b","status":"unreachable","reason":"no reply within 30s","downCount":2}]},{"site":{"identity":"site-b","name":"east","namespace":"east","platform":"kubernetes"},"routerStatus":[]}]}`
+
+func TestCmdNetworkStatus_ValidateInput(t *testing.T) {
+	type test struct {
+		name          string
+		args          []string
+		flags         *common.CommandNetworkStatusFlags
+		expectedError string
+	}
+
+	testTable := []test{
+		{
+			name:  "no arguments",
+			flags: &common.CommandNetworkStatusFlags{},
+		},
+		{
+			name:          "arguments were specified",
+			args:          []string{"something"},
+			flags:         &common.CommandNetworkStatusFlags{},
+			expectedError: "this command does not need any arguments",
+		},
+		{
+			name:          "output format is not valid",
+			flags:         &common.CommandNetworkStatusFlags{Output: "table"},
+			expectedError: "output type is not valid: value table not allowed. It should be one of this options: [json yaml]",
+		},
+	}
+
+	for _, test := range testTable {
+		command := &CmdNetworkStatus{Flags: test.flags}
+		t.Run(test.name, func(t *testing.T) {
+			err := command.ValidateInput(test.args)
+			if test.expectedError != "" {
+				assert.Error(t, err, test.expectedError)
+			} else {
+				assert.Assert(t, err)
+			}
+		})
+	}
+}
+
+func TestCmdNetworkStatus_InputToOptions(t *testing.T) {
+	type test struct {
+		name              string
+		namespace         string
+		flags             common.CommandNetworkStatusFlags
+		expectedNamespace string
+		expectedOutput    string
+	}
+
+	testTable := []test{
+		{
+			name:              "default namespace",
+			expectedNamespace: "default",
+		},
+		{
+			name:              "namespace and output",
+			namespace:         "test1",
+			flags:             common.CommandNetworkStatusFlags{Output: "json"},
+			expectedNamespace: "test1",
+			expectedOutput:    "json",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			cmd := &CmdNetworkStatus{Flags: &test.flags, namespace: test.namespace}
+			cmd.InputToOptions()
+			assert.Equal(t, cmd.namespace, test.expectedNamespace)
+			assert.Equal(t, cmd.output, test.expectedOutput)
+		})
+	}
+}
+
+func TestCmdNetworkStatus_Run(t *testing.T) {
+	type test struct {
+		name         string
+		status       *string
+		output       string
+		errorMessage string
+	}
+
+	if os.Getuid() == 0 {
+		api.DefaultRootDataHome = t.TempDir()
+	} else {
+		t.Setenv("XDG_DATA_HOME", t.TempDir())
+	}
+
+	empty := ""
+	invalid := "{"
+	valid := networkStatus
+	testTable := []test{
+		{
+			name:         "there is no network status",
+			errorMessage: "there is no network status in namespace \"test1\": is the site running?",
+		},
+		{
+			name:         "network status is empty",
+			status:       &empty,
+			errorMessage: "the network status is not yet available in namespace \"test1\"",
+		},
+		{
+			name:         "network status is not valid",
+			status:       &invalid,
+			errorMessage: "the network status in namespace \"test1\" could not be read: unexpected end of JSON input",
+		},
+		{
+			name:   "network status is printed",
+			status: &valid,
+		},
+		{
+			name:   "network status is printed as json",
+			status: &valid,
+			output: "json",
+		},
+	}
+
+	for _, test := range testTable {
+		command := &CmdNetworkStatus{namespace: "test1", output: test.output}
+		command.configMapHandler = fs.NewConfigMapHandler(command.namespace)
+		command.siteHandler = fs.NewSiteHandler(command.namespace)
+		t.Run(test.name, func(t *testing.T) {
+			if test.status != nil {
+				cm := corev1.ConfigMap{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      types.NetworkStatusConfigMapName,
+						Namespace: command.namespace,
+					},
+					Data: map[string]string{
+						"NetworkStatus": *test.status,
+					},
+				}
+				assert.Assert(t, command.configMapHandler.Add(cm, true))
+				defer command.configMapHandler.Delete(cm.Name, true)
+			}
+			err := command.Run()
+			if test.errorMessage != "" {
+				assert.Error(t, err, test.errorMessage)
+			} else {
+				assert.Assert(t, err)
+			}
+		})
+	}
+}
+
+func TestCmdNetworkStatus_WaitUntil(t *testing.T) {
+	command := &CmdNetworkStatus{}
+	assert.Assert(t, command.WaitUntil())
+}
@@ -0,0 +1,90 @@
+package kube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/observe/query"
+	"github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/kube/observer"
+	observerclient "github.com/skupperproject/skupper/pkg/networkobserver/client"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type CmdObserve struct {
+	KubeClient  kubernetes.Interface
+	CobraCmd    *cobra.Command
+	Flags       *common.CommandObserveFlags
+	Namespace   string
+	Resource    query.Resource
+	options     query.Options
+	config      observerclient.Config
+	out         io.Writer
+	portForward func(ctx context.Context, pod *corev1.Pod) (string, error)
+}
+
+func NewCmdObserve(resource query.Resource) *CmdObserve {
+
+	skupperCmd := CmdObserve{
+		Resource: resource,
+		out:      os.Stdout,
+	}
+
+	return &skupperCmd
+}
+
+func (cmd *CmdObserve) NewClient(cobraCommand *cobra.Command, args []string) {
+	cli, err := client.NewClient(cobraCommand.Flag("namespace").Value.String(), cobraCommand.Flag("context").Value.String(), cobraCommand.Flag("kubeconfig").Value.String())
+	utils.HandleError(utils.GenericError, err)
+
+	cmd.KubeClient = cli.GetKubeClient()
+	cmd.Namespace = cli.Namespace
+	cmd.portForward = func(ctx context.Context, pod *corev1.Pod) (string, error) {
+		return observer.ForwardPort(ctx, cli.Rest, cli.Kube, pod)
+	}
+}
+
+func (cmd *CmdObserve) ValidateInput(args []string) error {
+	var validationErrors []error
+
+	if len(args) > 0 {
+		validationErrors = append(validationErrors, fmt.Errorf("this command does not need any arguments"))
+	}
+	validationErrors = append(validationErrors, query.ValidateFlags(cmd.Flags)...)
+
+	return errors.Join(validationErrors...)
+}
+
+func (cmd *CmdObserve) InputToOptions() {
+	cmd.options, cmd.config = query.FromFlags(cmd.Resource, cmd.Flags)
+}
+
+func (cmd *CmdObserve) Run() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if cmd.config.Endpoint == "" {
+		pod, err := observer.FindPod(ctx, cmd.KubeClient, cmd.Namespace)
+		if err != nil {
+			return err
+		}
+		cmd.config.Endpoint, err = cmd.portForward(ctx, pod)
+		if err != nil {
+			return err
+		}
+	}
+	obs, err := observerclient.NewObserver(cmd.config)
+	if err != nil {
+		return err
+	}
+	return query.Run(ctx, obs, cmd.options, cmd.out)
+}
+
+func (cmd *CmdObserve) WaitUntil() error { return nil }
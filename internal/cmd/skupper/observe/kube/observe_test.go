@@ -0,0 +1,155 @@
+package kube
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/observe/query"
+	fakeclient "github.com/skupperproject/skupper/internal/kube/client/fake"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCmdObserve_ValidateInput(t *testing.T) {
+	type test struct {
+		name          string
+		args          []string
+		flags         *common.CommandObserveFlags
+		expectedError string
+	}
+
+	testTable := []test{
+		{
+			name:          "arguments are not accepted",
+			args:          []string{"something"},
+			flags:         &common.CommandObserveFlags{},
+			expectedError: "this command does not need any arguments",
+		},
+		{
+			name:          "endpoint is not valid",
+			flags:         &common.CommandObserveFlags{Endpoint: "observer:8080"},
+			expectedError: "endpoint is not valid: \"observer:8080\"",
+		},
+		{
+			name:          "state is not valid",
+			flags:         &common.CommandObserveFlags{State: "closed"},
+			expectedError: "state is not valid: value closed not allowed. It should be one of this options: [all active terminated]",
+		},
+		{
+			name:  "flags are valid",
+			flags: &common.CommandObserveFlags{Endpoint: "https://observer:8443", Output: "json", State: "terminated", Limit: 20},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := &CmdObserve{
+				Namespace: "test",
+				Flags:     test.flags,
+			}
+
+			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
+		})
+	}
+}
+
+func TestCmdObserve_InputToOptions(t *testing.T) {
+	command := &CmdObserve{
+		Resource: query.Pairs,
+		Flags:    &common.CommandObserveFlags{Output: "wide", PairType: "component", Token: "abc"},
+	}
+
+	command.InputToOptions()
+
+	assert.Equal(t, command.config.Endpoint, "")
+	assert.Equal(t, command.config.Token, "abc")
+	assert.Equal(t, command.options.Resource, query.Pairs)
+	assert.Equal(t, command.options.PairType, "component")
+	assert.Equal(t, command.options.Output, "wide")
+}
+
+func TestCmdObserve_Run(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 0, "timeRangeCount": 0, "results": []}`)
+	}))
+	defer server.Close()
+
+	observerPod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "network-observer-abc",
+			Namespace: "test",
+			Labels:    map[string]string{"app.kubernetes.io/name": "network-observer"},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	type test struct {
+		name           string
+		k8sObjects     []runtime.Object
+		endpoint       string
+		portForwardErr error
+		errorMessage   string
+	}
+
+	testTable := []test{
+		{
+			name:         "network observer is not running",
+			errorMessage: "the network observer is not running in namespace \"test\"",
+		},
+		{
+			name:           "port-forward fails",
+			k8sObjects:     []runtime.Object{observerPod},
+			portForwardErr: fmt.Errorf("failed to forward a port to the network observer: refused"),
+			errorMessage:   "failed to forward a port to the network observer: refused",
+		},
+		{
+			name:       "runs through port-forward",
+			k8sObjects: []runtime.Object{observerPod},
+		},
+		{
+			name:     "runs against the given endpoint",
+			endpoint: server.URL,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			var out bytes.Buffer
+			command := &CmdObserve{
+				Namespace: "test",
+				options:   query.Options{Resource: query.Sites},
+				out:       &out,
+			}
+			command.config.Endpoint = test.endpoint
+			command.portForward = func(ctx context.Context, pod *corev1.Pod) (string, error) {
+				assert.Equal(t, pod.Name, "network-observer-abc")
+				return server.URL, test.portForwardErr
+			}
+
+			fakeClient, err := fakeclient.NewFakeClient(command.Namespace, test.k8sObjects, nil, "")
+			assert.Assert(t, err)
+			command.KubeClient = fakeClient.GetKubeClient()
+
+			err = command.Run()
+			if test.errorMessage != "" {
+				assert.Error(t, err, test.errorMessage)
+			} else {
+				assert.Assert(t, err)
+				assert.Equal(t, out.String(), "No records found\n")
+			}
+		})
+	}
+}
+
+func TestCmdObserve_WaitUntil(t *testing.T) {
+	command := &CmdObserve{}
+	assert.Assert(t, command.WaitUntil())
+}
@@ -0,0 +1,67 @@
+package nonkube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/observe/query"
+	observerclient "github.com/skupperproject/skupper/pkg/networkobserver/client"
+	"github.com/spf13/cobra"
+)
+
+// defaultEndpoint is the address the network observer API listens on
+// when it runs alongside a local site.
+const defaultEndpoint = "http://127.0.0.1:8080"
+
+type CmdObserve struct {
+	CobraCmd *cobra.Command
+	Flags    *common.CommandObserveFlags
+	Resource query.Resource
+	options  query.Options
+	config   observerclient.Config
+	out      io.Writer
+}
+
+func NewCmdObserve(resource query.Resource) *CmdObserve {
+
+	skupperCmd := CmdObserve{
+		Resource: resource,
+		out:      os.Stdout,
+	}
+
+	return &skupperCmd
+}
+
+func (cmd *CmdObserve) NewClient(cobraCommand *cobra.Command, args []string) {}
+
+func (cmd *CmdObserve) ValidateInput(args []string) error {
+	var validationErrors []error
+
+	if len(args) > 0 {
+		validationErrors = append(validationErrors, fmt.Errorf("this command does not need any arguments"))
+	}
+	validationErrors = append(validationErrors, query.ValidateFlags(cmd.Flags)...)
+
+	return errors.Join(validationErrors...)
+}
+
+func (cmd *CmdObserve) InputToOptions() {
+	cmd.options, cmd.config = query.FromFlags(cmd.Resource, cmd.Flags)
+	if cmd.config.Endpoint == "" {
+		cmd.config.Endpoint = defaultEndpoint
+	}
+}
+
+func (cmd *CmdObserve) Run() error {
+	obs, err := observerclient.NewObserver(cmd.config)
+	if err != nil {
+		return err
+	}
+	return query.Run(context.Background(), obs, cmd.options, cmd.out)
+}
+
+func (cmd *CmdObserve) WaitUntil() error { return nil }
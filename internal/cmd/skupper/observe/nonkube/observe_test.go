@@ -0,0 +1,147 @@
+package nonkube
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/observe/query"
+	"gotest.tools/v3/assert"
+)
+
+func TestCmdObserve_ValidateInput(t *testing.T) {
+	type test struct {
+		name          string
+		args          []string
+		flags         *common.CommandObserveFlags
+		expectedError string
+	}
+
+	testTable := []test{
+		{
+			name:          "arguments are not accepted",
+			args:          []string{"something"},
+			flags:         &common.CommandObserveFlags{},
+			expectedError: "this command does not need any arguments",
+		},
+		{
+			name:          "endpoint is not valid",
+			flags:         &common.CommandObserveFlags{Endpoint: "127.0.0.1"},
+			expectedError: "endpoint is not valid: \"127.0.0.1\"",
+		},
+		{
+			name:          "output and limit are not valid",
+			flags:         &common.CommandObserveFlags{Output: "csv", Limit: -5},
+			expectedError: "output type is not valid: value csv not allowed. It should be one of this options: [json yaml wide]\nlimit is not valid: must not be negative",
+		},
+		{
+			name:  "flags are valid",
+			flags: &common.CommandObserveFlags{Endpoint: "http://127.0.0.1:8080", Output: "yaml", Filter: "protocol == tcp"},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := &CmdObserve{
+				Flags: test.flags,
+			}
+
+			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
+		})
+	}
+}
+
+func TestCmdObserve_InputToOptions(t *testing.T) {
+	type test struct {
+		name             string
+		flags            *common.CommandObserveFlags
+		expectedEndpoint string
+	}
+
+	testTable := []test{
+		{
+			name:             "default endpoint",
+			flags:            &common.CommandObserveFlags{},
+			expectedEndpoint: "http://127.0.0.1:8080",
+		},
+		{
+			name:             "given endpoint",
+			flags:            &common.CommandObserveFlags{Endpoint: "https://10.0.0.1:8443"},
+			expectedEndpoint: "https://10.0.0.1:8443",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := &CmdObserve{
+				Resource: query.Services,
+				Flags:    test.flags,
+			}
+
+			command.InputToOptions()
+
+			assert.Equal(t, command.config.Endpoint, test.expectedEndpoint)
+			assert.Equal(t, command.options.Resource, query.Services)
+		})
+	}
+}
+
+func TestCmdObserve_Run(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2alpha1/processes" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"count": 0, "timeRangeCount": 0, "results": []}`)
+	}))
+	defer server.Close()
+
+	type test struct {
+		name         string
+		resource     query.Resource
+		endpoint     string
+		errorMessage string
+	}
+
+	testTable := []test{
+		{
+			name:     "lists records",
+			resource: query.Processes,
+			endpoint: server.URL,
+		},
+		{
+			name:         "network observer responds with an error",
+			resource:     query.Services,
+			endpoint:     server.URL,
+			errorMessage: "network observer responded with 404 Not Found",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			var out bytes.Buffer
+			command := &CmdObserve{
+				options: query.Options{Resource: test.resource},
+				out:     &out,
+			}
+			command.config.Endpoint = test.endpoint
+
+			err := command.Run()
+			if test.errorMessage != "" {
+				assert.Error(t, err, test.errorMessage)
+			} else {
+				assert.Assert(t, err)
+				assert.Equal(t, out.String(), "No records found\n")
+			}
+		})
+	}
+}
+
+func TestCmdObserve_WaitUntil(t *testing.T) {
+	command := &CmdObserve{}
+	assert.Assert(t, command.WaitUntil())
+}
@@ -0,0 +1,90 @@
+package observe
+
+import (
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/observe/kube"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/observe/nonkube"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/observe/query"
+	"github.com/skupperproject/skupper/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdObserve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "observe",
+		Short: "Query the traffic and topology recorded by the network observer",
+		Long: `Query the sites, processes, services, connections and traffic pairs recorded by the network observer.
+
+On Kubernetes, the network observer of the current namespace is reached through a
+port-forward. Otherwise, the network observer is expected at http://127.0.0.1:8080.`,
+		Example: `skupper observe sites
+skupper observe connections --filter "routingKey == backend and octetCount > 1e6" --since 1h -o json`,
+	}
+	platform := common.Platform(config.GetPlatform())
+	cmd.AddCommand(CmdObserveFactory(platform, query.Sites, common.SkupperCmdDescription{
+		Use:     "sites",
+		Short:   "List the sites of the network",
+		Long:    "Display the sites of the network with their platform, version and number of routers.",
+		Example: "skupper observe sites -o wide",
+	}))
+	cmd.AddCommand(CmdObserveFactory(platform, query.Processes, common.SkupperCmdDescription{
+		Use:     "processes",
+		Short:   "List the processes that exchanged traffic through the network",
+		Long:    "Display the processes recorded by the network observer, the site and component they belong to and whether they are exposed as a service.",
+		Example: `skupper observe processes --filter "siteName == east"`,
+	}))
+	cmd.AddCommand(CmdObserveFactory(platform, query.Connections, common.SkupperCmdDescription{
+		Use:   "connections",
+		Short: "List the connections made through the network",
+		Long: `Display the connections made through the network, the processes and sites at either end,
+the bytes exchanged and any error reported by the listener or connector.`,
+		Example: `skupper observe connections --state active
+skupper observe connections --filter "connectorError ~ refused" --since 1h`,
+	}))
+	cmd.AddCommand(CmdObserveFactory(platform, query.Services, common.SkupperCmdDescription{
+		Use:     "services",
+		Short:   "List the services (routing keys) of the network",
+		Long:    "Display the routing keys of the network with the number of listeners and connectors for each.",
+		Example: "skupper observe services",
+	}))
+	cmd.AddCommand(CmdObserveFactory(platform, query.Pairs, common.SkupperCmdDescription{
+		Use:   "pairs",
+		Short: "List the pairs of sites, processes or components that exchanged traffic",
+		Long: `Display the pairs of sites, processes or components that exchanged traffic and the number of
+connections between them.`,
+		Example: `skupper observe pairs
+skupper observe pairs --type process --filter "protocol == tcp"`,
+	}))
+
+	return cmd
+}
+
+func CmdObserveFactory(configuredPlatform common.Platform, resource query.Resource, description common.SkupperCmdDescription) *cobra.Command {
+	kubeCommand := kube.NewCmdObserve(resource)
+	nonKubeCommand := nonkube.NewCmdObserve(resource)
+
+	cmd := common.ConfigureCobraCommand(configuredPlatform, description, kubeCommand, nonKubeCommand)
+
+	cmdFlags := common.CommandObserveFlags{}
+	cmd.Flags().StringVar(&cmdFlags.Endpoint, common.FlagNameEndpoint, "", common.FlagDescTopEndpoint)
+	cmd.Flags().StringVarP(&cmdFlags.Output, common.FlagNameOutput, "o", "", common.FlagDescObserveOutput)
+	cmd.Flags().StringVar(&cmdFlags.Filter, common.FlagNameFilter, "", common.FlagDescObserveFilter)
+	cmd.Flags().DurationVar(&cmdFlags.Since, common.FlagNameSince, 0, common.FlagDescObserveSince)
+	cmd.Flags().IntVar(&cmdFlags.Limit, common.FlagNameLimit, 0, common.FlagDescObserveLimit)
+	cmd.Flags().StringVar(&cmdFlags.State, common.FlagNameState, "", common.FlagDescObserveState)
+	cmd.Flags().StringVar(&cmdFlags.Network, common.FlagNameNetwork, "", common.FlagDescObserveNetwork)
+	cmd.Flags().StringVar(&cmdFlags.Token, common.FlagNameToken, "", common.FlagDescObserveToken)
+	cmd.Flags().StringVar(&cmdFlags.User, common.FlagNameUser, "", common.FlagDescObserveUser)
+	cmd.Flags().StringVar(&cmdFlags.CACert, common.FlagNameCACert, "", common.FlagDescObserveCACert)
+	cmd.Flags().BoolVar(&cmdFlags.InsecureSkipTLSVerify, common.FlagNameInsecureSkipTLSVerify, false, common.FlagDescObserveInsecure)
+	if resource == query.Pairs {
+		cmd.Flags().StringVar(&cmdFlags.PairType, common.FlagNamePairType, "site", common.FlagDescObservePairType)
+	}
+
+	kubeCommand.CobraCmd = cmd
+	kubeCommand.Flags = &cmdFlags
+	nonKubeCommand.CobraCmd = cmd
+	nonKubeCommand.Flags = &cmdFlags
+
+	return cmd
+}
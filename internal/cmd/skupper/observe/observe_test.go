@@ -0,0 +1,86 @@
+package observe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/observe/query"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gotest.tools/v3/assert"
+)
+
+func TestCmdObserveFactory(t *testing.T) {
+
+	type test struct {
+		name                          string
+		expectedFlagsWithDefaultValue map[string]interface{}
+		command                       *cobra.Command
+	}
+
+	commonFlags := map[string]interface{}{
+		common.FlagNameEndpoint:              "",
+		common.FlagNameOutput:                "",
+		common.FlagNameFilter:                "",
+		common.FlagNameSince:                 "0s",
+		common.FlagNameLimit:                 "0",
+		common.FlagNameState:                 "",
+		common.FlagNameNetwork:               "",
+		common.FlagNameToken:                 "",
+		common.FlagNameUser:                  "",
+		common.FlagNameCACert:                "",
+		common.FlagNameInsecureSkipTLSVerify: "false",
+	}
+	pairFlags := map[string]interface{}{
+		common.FlagNamePairType: "site",
+	}
+	for name, value := range commonFlags {
+		pairFlags[name] = value
+	}
+
+	testTable := []test{
+		{
+			name:                          "CmdObserveSitesFactory",
+			expectedFlagsWithDefaultValue: commonFlags,
+			command:                       CmdObserveFactory(common.PlatformKubernetes, query.Sites, common.SkupperCmdDescription{Use: "sites", Short: "sites", Long: "sites"}),
+		},
+		{
+			name:                          "CmdObservePairsFactory",
+			expectedFlagsWithDefaultValue: pairFlags,
+			command:                       CmdObserveFactory(common.PlatformPodman, query.Pairs, common.SkupperCmdDescription{Use: "pairs", Short: "pairs", Long: "pairs"}),
+		},
+	}
+
+	for _, test := range testTable {
+
+		var flagList []string
+		t.Run(test.name, func(t *testing.T) {
+
+			test.command.Flags().VisitAll(func(flag *pflag.Flag) {
+				flagList = append(flagList, flag.Name)
+				assert.Check(t, test.expectedFlagsWithDefaultValue[flag.Name] != nil, fmt.Sprintf("flag %q not expected", flag.Name))
+				assert.Check(t, test.expectedFlagsWithDefaultValue[flag.Name] == flag.DefValue, fmt.Sprintf("default value %q for flag %q not expected", flag.DefValue, flag.Name))
+			})
+
+			assert.Check(t, len(flagList) == len(test.expectedFlagsWithDefaultValue))
+
+			assert.Assert(t, test.command.PreRunE != nil)
+			assert.Assert(t, test.command.Run != nil)
+			assert.Assert(t, test.command.PostRun != nil)
+			assert.Assert(t, test.command.Use != "")
+			assert.Assert(t, test.command.Short != "")
+			assert.Assert(t, test.command.Long != "")
+		})
+	}
+}
+
+func TestNewCmdObserve(t *testing.T) {
+	cmd := NewCmdObserve()
+	var subcommands []string
+	for _, subcommand := range cmd.Commands() {
+		subcommands = append(subcommands, subcommand.Name())
+		assert.Assert(t, subcommand.Long != "", subcommand.Name())
+	}
+	assert.DeepEqual(t, subcommands, []string{"connections", "pairs", "processes", "services", "sites"})
+}
@@ -0,0 +1,88 @@
+package query
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/utils/validator"
+	"github.com/skupperproject/skupper/pkg/networkobserver/client"
+)
+
+// ValidateFlags returns the problems with the flags given to a skupper
+// observe command.
+func ValidateFlags(flags *common.CommandObserveFlags) []error {
+	var validationErrors []error
+	if flags == nil {
+		return nil
+	}
+	outputValidator := validator.NewOptionValidator(common.StatusOutputTypes)
+	stateValidator := validator.NewOptionValidator(States)
+	pairTypeValidator := validator.NewOptionValidator(PairTypes)
+
+	if flags.Endpoint != "" {
+		if endpoint, err := url.Parse(flags.Endpoint); err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+			validationErrors = append(validationErrors, fmt.Errorf("endpoint is not valid: %q", flags.Endpoint))
+		}
+	}
+	if flags.Output != "" {
+		if ok, err := outputValidator.Evaluate(flags.Output); !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("output type is not valid: %s", err))
+		}
+	}
+	if flags.State != "" {
+		if ok, err := stateValidator.Evaluate(flags.State); !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("state is not valid: %s", err))
+		}
+	}
+	if flags.PairType != "" {
+		if ok, err := pairTypeValidator.Evaluate(flags.PairType); !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("type is not valid: %s", err))
+		}
+	}
+	if flags.Since < 0 {
+		validationErrors = append(validationErrors, fmt.Errorf("since is not valid: must not be negative"))
+	}
+	if flags.Limit < 0 {
+		validationErrors = append(validationErrors, fmt.Errorf("limit is not valid: must not be negative"))
+	}
+	if flags.User != "" && !strings.Contains(flags.User, ":") {
+		validationErrors = append(validationErrors, fmt.Errorf("user is not valid: must be username:password"))
+	}
+	if flags.User != "" && flags.Token != "" {
+		validationErrors = append(validationErrors, fmt.Errorf("token and user cannot both be set"))
+	}
+	return validationErrors
+}
+
+// FromFlags returns the options and client configuration for the given
+// resource as selected by the flags of a skupper observe command.
+func FromFlags(resource Resource, flags *common.CommandObserveFlags) (Options, client.Config) {
+	options := Options{
+		Resource: resource,
+		PairType: flags.PairType,
+		Output:   flags.Output,
+		List: client.ListOptions{
+			Network: flags.Network,
+			Filter:  flags.Filter,
+			State:   client.State(flags.State),
+			Limit:   flags.Limit,
+		},
+	}
+	if flags.Since > 0 {
+		options.List.Start = now().Add(-flags.Since)
+	}
+	config := client.Config{
+		Endpoint:           flags.Endpoint,
+		CAFile:             flags.CACert,
+		InsecureSkipVerify: flags.InsecureSkipTLSVerify,
+		Token:              flags.Token,
+		Timeout:            30 * time.Second,
+	}
+	if flags.User != "" {
+		config.Username, config.Password, _ = strings.Cut(flags.User, ":")
+	}
+	return options, config
+}
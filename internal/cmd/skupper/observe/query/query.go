@@ -0,0 +1,224 @@
+// Package query lists records from the network observer API for the
+// skupper observe commands and displays them as a table, json or yaml.
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/top/dashboard"
+	"github.com/skupperproject/skupper/internal/utils/formatter"
+	"github.com/skupperproject/skupper/pkg/networkobserver/client"
+	"sigs.k8s.io/yaml"
+)
+
+// Resource is a collection of records displayed by skupper observe.
+type Resource string
+
+const (
+	Sites       Resource = "sites"
+	Processes   Resource = "processes"
+	Connections Resource = "connections"
+	Services    Resource = "services"
+	Pairs       Resource = "pairs"
+)
+
+var (
+	// PairTypes are the kinds of pair listed by skupper observe pairs.
+	PairTypes = []string{"site", "process", "component"}
+	// States are the choices for selecting records by whether they ended.
+	States = []string{string(client.StateAll), string(client.StateActive), string(client.StateTerminated)}
+)
+
+// Options select the records listed and how they are displayed.
+type Options struct {
+	Resource Resource
+	// PairType is the kind of pair listed for the Pairs resource.
+	PairType string
+	List     client.ListOptions
+	// Output is json, yaml, wide or empty for a table.
+	Output string
+}
+
+// Run lists the records selected by options and writes them to out.
+func Run(ctx context.Context, observer *client.Observer, options Options, out io.Writer) error {
+	switch options.Resource {
+	case Sites:
+		records, err := observer.Sites(ctx, options.List)
+		return display(out, options.Output, siteColumns, records, err)
+	case Processes:
+		records, err := observer.Processes(ctx, options.List)
+		return display(out, options.Output, processColumns, records, err)
+	case Connections:
+		records, err := observer.Connections(ctx, options.List)
+		return display(out, options.Output, connectionColumns, records, err)
+	case Services:
+		records, err := observer.Services(ctx, options.List)
+		return display(out, options.Output, serviceColumns, records, err)
+	case Pairs:
+		list := observer.SitePairs
+		switch options.PairType {
+		case "process":
+			list = observer.ProcessPairs
+		case "component":
+			list = observer.ComponentPairs
+		}
+		records, err := list(ctx, options.List)
+		return display(out, options.Output, pairColumns, records, err)
+	}
+	return fmt.Errorf("unknown resource %q", options.Resource)
+}
+
+func display[T any](out io.Writer, output string, columns []formatter.Column[T], records []T, err error) error {
+	if err != nil {
+		return err
+	}
+	switch output {
+	case formatter.OutputJson:
+		encoded, err := json.MarshalIndent(records, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(encoded))
+		return err
+	case formatter.OutputYaml:
+		encoded, err := yaml.Marshal(records)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprint(out, string(encoded))
+		return err
+	}
+	if len(records) == 0 {
+		_, err := fmt.Fprintln(out, "No records found")
+		return err
+	}
+	var visible []formatter.Column[T]
+	for _, column := range columns {
+		if !column.Wide || output == formatter.OutputWide {
+			visible = append(visible, column)
+		}
+	}
+	tw := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	headers := make([]string, 0, len(visible))
+	for _, column := range visible {
+		headers = append(headers, column.Header)
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	for _, record := range records {
+		values := make([]string, 0, len(visible))
+		for _, column := range visible {
+			values = append(values, column.Value(record))
+		}
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	return tw.Flush()
+}
+
+var siteColumns = []formatter.Column[client.SiteRecord]{
+	{Header: "NAME", Value: func(s client.SiteRecord) string { return s.Name }},
+	{Header: "PLATFORM", Value: func(s client.SiteRecord) string { return string(s.Platform) }},
+	{Header: "NAMESPACE", Value: func(s client.SiteRecord) string { return optional(s.Namespace) }},
+	{Header: "VERSION", Value: func(s client.SiteRecord) string { return s.Version }},
+	{Header: "ROUTERS", Value: func(s client.SiteRecord) string { return strconv.Itoa(s.RouterCount) }},
+	{Header: "AGE", Value: func(s client.SiteRecord) string { return age(s.StartTime, s.EndTime) }},
+	{Header: "ID", Wide: true, Value: func(s client.SiteRecord) string { return s.Identity }},
+}
+
+var processColumns = []formatter.Column[client.ProcessRecord]{
+	{Header: "NAME", Value: func(p client.ProcessRecord) string { return p.Name }},
+	{Header: "SITE", Value: func(p client.ProcessRecord) string { return p.SiteName }},
+	{Header: "COMPONENT", Value: func(p client.ProcessRecord) string { return p.ComponentName }},
+	{Header: "ADDRESS", Value: func(p client.ProcessRecord) string { return p.SourceHost }},
+	{Header: "BINDING", Value: func(p client.ProcessRecord) string { return string(p.Binding) }},
+	{Header: "HOST", Wide: true, Value: func(p client.ProcessRecord) string { return optional(p.HostName) }},
+	{Header: "IMAGE", Wide: true, Value: func(p client.ProcessRecord) string { return optional(p.ImageName) }},
+	{Header: "ID", Wide: true, Value: func(p client.ProcessRecord) string { return p.Identity }},
+}
+
+var connectionColumns = []formatter.Column[client.ConnectionRecord]{
+	{Header: "ROUTING-KEY", Value: func(c client.ConnectionRecord) string { return c.RoutingKey }},
+	{Header: "PROTOCOL", Value: func(c client.ConnectionRecord) string { return c.Protocol }},
+	{Header: "SOURCE", Value: func(c client.ConnectionRecord) string { return c.SourceProcessName + "@" + c.SourceSiteName }},
+	{Header: "DESTINATION", Value: func(c client.ConnectionRecord) string { return c.DestProcessName + "@" + c.DestSiteName }},
+	{Header: "SENT", Value: func(c client.ConnectionRecord) string { return dashboard.FormatBytes(c.OctetCount) }},
+	{Header: "RECEIVED", Value: func(c client.ConnectionRecord) string { return dashboard.FormatBytes(c.OctetReverseCount) }},
+	{Header: "DURATION", Value: func(c client.ConnectionRecord) string { return duration(c.StartTime, c.EndTime) }},
+	{Header: "ERROR", Value: func(c client.ConnectionRecord) string {
+		if c.ConnectorError != nil {
+			return *c.ConnectorError
+		}
+		return optional(c.ListenerError)
+	}},
+	{Header: "SOURCE-ADDRESS", Wide: true, Value: func(c client.ConnectionRecord) string { return c.SourceHost + ":" + c.SourcePort }},
+	{Header: "DESTINATION-ADDRESS", Wide: true, Value: func(c client.ConnectionRecord) string { return c.DestHost + ":" + c.DestPort }},
+	{Header: "ID", Wide: true, Value: func(c client.ConnectionRecord) string { return c.Identity }},
+}
+
+var serviceColumns = []formatter.Column[client.ServiceRecord]{
+	{Header: "ROUTING-KEY", Value: func(s client.ServiceRecord) string { return s.Name }},
+	{Header: "PROTOCOL", Value: func(s client.ServiceRecord) string { return s.Protocol }},
+	{Header: "LISTENERS", Value: func(s client.ServiceRecord) string { return strconv.Itoa(s.ListenerCount) }},
+	{Header: "CONNECTORS", Value: func(s client.ServiceRecord) string { return strconv.Itoa(s.ConnectorCount) }},
+	{Header: "BOUND", Value: func(s client.ServiceRecord) string { return strconv.FormatBool(s.IsBound) }},
+	{Header: "APPLICATION-PROTOCOLS", Wide: true, Value: func(s client.ServiceRecord) string {
+		return strings.Join(s.ObservedApplicationProtocols, ",")
+	}},
+	{Header: "ID", Wide: true, Value: func(s client.ServiceRecord) string { return s.Identity }},
+}
+
+var pairColumns = []formatter.Column[client.FlowAggregateRecord]{
+	{Header: "SOURCE", Value: func(p client.FlowAggregateRecord) string { return p.SourceName }},
+	{Header: "DESTINATION", Value: func(p client.FlowAggregateRecord) string { return p.DestinationName }},
+	{Header: "PROTOCOL", Value: func(p client.FlowAggregateRecord) string { return p.Protocol }},
+	{Header: "CONNECTIONS", Value: func(p client.FlowAggregateRecord) string { return strconv.FormatUint(p.RecordCount, 10) }},
+	{Header: "SOURCE-SITE", Wide: true, Value: func(p client.FlowAggregateRecord) string { return optional(p.SourceSiteName) }},
+	{Header: "DESTINATION-SITE", Wide: true, Value: func(p client.FlowAggregateRecord) string { return optional(p.DestinationSiteName) }},
+	{Header: "ID", Wide: true, Value: func(p client.FlowAggregateRecord) string { return p.Identity }},
+}
+
+// now is replaced in tests to give stable ages and durations.
+var now = time.Now
+
+func optional(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// age is the time since the record started, or "ended" once it has.
+func age(start, end uint64) string {
+	if end != 0 {
+		return "ended"
+	}
+	return round(now().Sub(time.UnixMicro(int64(start))))
+}
+
+// duration is the time between the start and end of the record, or until
+// now while it is active.
+func duration(start, end uint64) string {
+	if end == 0 {
+		return round(now().Sub(time.UnixMicro(int64(start))))
+	}
+	return round(time.UnixMicro(int64(end)).Sub(time.UnixMicro(int64(start))))
+}
+
+func round(d time.Duration) string {
+	switch {
+	case d < 0:
+		return "0s"
+	case d < time.Second:
+		return d.Round(time.Millisecond).String()
+	case d < time.Hour:
+		return d.Round(time.Second).String()
+	default:
+		return d.Round(time.Minute).String()
+	}
+}
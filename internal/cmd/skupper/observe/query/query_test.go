@@ -0,0 +1,221 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/pkg/networkobserver/client"
+	"gotest.tools/v3/assert"
+)
+
+func TestRun(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return t0 }
+	defer func() { now = time.Now }()
+	micros := func(d time.Duration) uint64 { return uint64(t0.Add(d).UnixMicro()) }
+
+	namespace := "east"
+	refused := "connection refused"
+	responses := map[string]any{
+		"/api/v2alpha1/sites": client.SiteListResponse{Results: []client.SiteRecord{
+			{Identity: "s1", Name: "east", Namespace: &namespace, Platform: "kubernetes", Version: "2.0.0", RouterCount: 1, StartTime: micros(-2 * time.Hour)},
+		}, TimeRangeCount: 1},
+		"/api/v2alpha1/processes": client.ProcessListResponse{Results: []client.ProcessRecord{}},
+		"/api/v2alpha1/connections": client.ConnectionListResponse{Results: []client.ConnectionRecord{
+			{Identity: "c1", RoutingKey: "backend", Protocol: "tcp", SourceProcessName: "frontend", SourceSiteName: "east",
+				DestProcessName: "backend", DestSiteName: "west", OctetCount: 2048, OctetReverseCount: 10,
+				StartTime: micros(-90 * time.Second), EndTime: micros(-30 * time.Second), ConnectorError: &refused},
+		}, TimeRangeCount: 1},
+		"/api/v2alpha1/services": client.ServiceListResponse{Results: []client.ServiceRecord{
+			{Identity: "a1", Name: "backend", Protocol: "tcp", ListenerCount: 2, ConnectorCount: 1, IsBound: true},
+		}, TimeRangeCount: 1},
+		"/api/v2alpha1/processpairs": client.FlowAggregateListResponse{Results: []client.FlowAggregateRecord{
+			{Identity: "pp1", SourceName: "frontend", DestinationName: "backend", Protocol: "tcp", RecordCount: 12},
+		}, TimeRangeCount: 1},
+	}
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+	observer, err := client.NewObserver(client.Config{Endpoint: server.URL})
+	assert.Assert(t, err)
+
+	type test struct {
+		name     string
+		options  Options
+		expected string
+		path     string
+		err      string
+	}
+	testTable := []test{
+		{
+			name:    "sites",
+			options: Options{Resource: Sites},
+			path:    "/api/v2alpha1/sites",
+			expected: `NAME  PLATFORM    NAMESPACE  VERSION  ROUTERS  AGE
+east  kubernetes  east       2.0.0    1        2h0m0s
+`,
+		},
+		{
+			name:    "sites wide",
+			options: Options{Resource: Sites, Output: "wide"},
+			path:    "/api/v2alpha1/sites",
+			expected: `NAME  PLATFORM    NAMESPACE  VERSION  ROUTERS  AGE     ID
+east  kubernetes  east       2.0.0    1        2h0m0s  s1
+`,
+		},
+		{
+			name:     "no processes",
+			options:  Options{Resource: Processes},
+			path:     "/api/v2alpha1/processes",
+			expected: "No records found\n",
+		},
+		{
+			name:    "connections",
+			options: Options{Resource: Connections},
+			path:    "/api/v2alpha1/connections",
+			expected: `ROUTING-KEY  PROTOCOL  SOURCE         DESTINATION   SENT     RECEIVED  DURATION  ERROR
+backend      tcp       frontend@east  backend@west  2.0 KiB  10 B      1m0s      connection refused
+`,
+		},
+		{
+			name:    "services as json",
+			options: Options{Resource: Services, Output: "json"},
+			path:    "/api/v2alpha1/services",
+			expected: `[
+  {
+    "connectorCount": 1,
+    "endTime": 0,
+    "hasListener": false,
+    "identity": "a1",
+    "isBound": true,
+    "listenerCount": 2,
+    "name": "backend",
+    "observedApplicationProtocols": null,
+    "protocol": "tcp",
+    "startTime": 0
+  }
+]
+`,
+		},
+		{
+			name:    "process pairs",
+			options: Options{Resource: Pairs, PairType: "process"},
+			path:    "/api/v2alpha1/processpairs",
+			expected: `SOURCE    DESTINATION  PROTOCOL  CONNECTIONS
+frontend  backend      tcp       12
+`,
+		},
+		{
+			name:    "site pairs unavailable",
+			options: Options{Resource: Pairs, PairType: "site"},
+			path:    "/api/v2alpha1/sitepairs",
+			err:     "network observer responded with 404 Not Found",
+		},
+	}
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			paths = nil
+			var out bytes.Buffer
+			err := Run(context.TODO(), observer, test.options, &out)
+			assert.DeepEqual(t, paths, []string{test.path})
+			if test.err != "" {
+				assert.Error(t, err, test.err)
+				return
+			}
+			assert.Assert(t, err)
+			assert.Equal(t, out.String(), test.expected)
+		})
+	}
+}
+
+func TestValidateFlags(t *testing.T) {
+	type test struct {
+		name          string
+		flags         *common.CommandObserveFlags
+		expectedError string
+	}
+	testTable := []test{
+		{
+			name:  "flags are valid",
+			flags: &common.CommandObserveFlags{Endpoint: "https://observer:8443", Output: "wide", State: "active", PairType: "process", Since: time.Hour, Limit: 10, User: "admin:secret"},
+		},
+		{
+			name:          "endpoint is not valid",
+			flags:         &common.CommandObserveFlags{Endpoint: "observer"},
+			expectedError: "endpoint is not valid: \"observer\"",
+		},
+		{
+			name:          "choices are not valid",
+			flags:         &common.CommandObserveFlags{Output: "table", State: "open", PairType: "router"},
+			expectedError: "output type is not valid: value table not allowed. It should be one of this options: [json yaml wide]\nstate is not valid: value open not allowed. It should be one of this options: [all active terminated]\ntype is not valid: value router not allowed. It should be one of this options: [site process component]",
+		},
+		{
+			name:          "numbers are not valid",
+			flags:         &common.CommandObserveFlags{Since: -time.Hour, Limit: -1},
+			expectedError: "since is not valid: must not be negative\nlimit is not valid: must not be negative",
+		},
+		{
+			name:          "credentials are not valid",
+			flags:         &common.CommandObserveFlags{User: "admin", Token: "abc"},
+			expectedError: "user is not valid: must be username:password\ntoken and user cannot both be set",
+		},
+	}
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			var messages []string
+			for _, err := range ValidateFlags(test.flags) {
+				messages = append(messages, err.Error())
+			}
+			assert.Equal(t, strings.Join(messages, "\n"), test.expectedError)
+		})
+	}
+}
+
+func TestFromFlags(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return t0 }
+	defer func() { now = time.Now }()
+
+	options, config := FromFlags(Connections, &common.CommandObserveFlags{
+		Endpoint: "https://observer:8443",
+		Output:   "json",
+		Filter:   "protocol == tcp",
+		Since:    time.Hour,
+		Limit:    5,
+		State:    "active",
+		User:     "admin:pa:ss",
+		CACert:   "/tmp/ca.crt",
+	})
+	assert.DeepEqual(t, options, Options{
+		Resource: Connections,
+		Output:   "json",
+		List: client.ListOptions{
+			Filter: "protocol == tcp",
+			Start:  t0.Add(-time.Hour),
+			State:  client.StateActive,
+			Limit:  5,
+		},
+	})
+	assert.DeepEqual(t, config, client.Config{
+		Endpoint: "https://observer:8443",
+		CAFile:   "/tmp/ca.crt",
+		Username: "admin",
+		Password: "pa:ss",
+		Timeout:  30 * time.Second,
+	})
+}
@@ -6,9 +6,12 @@ import (
 	"github.com/skupperproject/skupper/internal/cmd/skupper/debug"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/link"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/listener"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/network"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/observe"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/site"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/system"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/token"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/top"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/version"
 	"github.com/skupperproject/skupper/internal/config"
 	"github.com/spf13/cobra"
@@ -32,9 +35,12 @@ func NewSkupperRootCommand() *cobra.Command {
 	rootCmd.AddCommand(listener.NewCmdListener())
 	rootCmd.AddCommand(link.NewCmdLink())
 	rootCmd.AddCommand(connector.NewCmdConnector())
+	rootCmd.AddCommand(network.NewCmdNetwork())
 	rootCmd.AddCommand(version.NewCmdVersion())
 	rootCmd.AddCommand(debug.NewCmdDebug())
 	rootCmd.AddCommand(system.NewCmdSystem())
+	rootCmd.AddCommand(top.NewCmdTop())
+	rootCmd.AddCommand(observe.NewCmdObserve())
 
 	rootCmd.SetHelpCommand(&cobra.Command{Hidden: true})
 
@@ -4,23 +4,33 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"os"
-	"text/tabwriter"
+	"io"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
 
 	"github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/utils/formatter"
+	"github.com/skupperproject/skupper/internal/utils/validator"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2alpha1"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
 )
 
 type CmdSiteStatus struct {
-	Client    skupperv2alpha1.SkupperV2alpha1Interface
-	CobraCmd  *cobra.Command
-	Flags     *common.CommandSiteStatusFlags
-	Namespace string
+	Client        skupperv2alpha1.SkupperV2alpha1Interface
+	CobraCmd      *cobra.Command
+	Flags         *common.CommandSiteStatusFlags
+	Namespace     string
+	output        string
+	watch         bool
+	allNamespaces bool
+	watchCtx      context.Context
+	out           io.Writer
 }
 
 func NewCmdSiteStatus() *CmdSiteStatus {
@@ -43,33 +53,69 @@ func (cmd *CmdSiteStatus) ValidateInput(args []string) error {
 		return errors.New("this command does not need any arguments")
 	}
 
+	if cmd.Flags != nil && cmd.Flags.Output != "" {
+		outputTypeValidator := validator.NewOptionValidator(common.StatusOutputTypes)
+		ok, err := outputTypeValidator.Evaluate(cmd.Flags.Output)
+		if !ok {
+			return fmt.Errorf("output type is not valid: %s", err)
+		}
+	}
+
 	return nil
 }
 
-func (cmd *CmdSiteStatus) InputToOptions() {}
+func (cmd *CmdSiteStatus) InputToOptions() {
+	if cmd.Flags != nil {
+		cmd.output = cmd.Flags.Output
+		cmd.watch = cmd.Flags.Watch
+		cmd.allNamespaces = cmd.Flags.AllNamespaces
+	}
+}
+
 func (cmd *CmdSiteStatus) Run() error {
+	namespace := cmd.Namespace
+	if cmd.allNamespaces {
+		namespace = metav1.NamespaceAll
+	}
 
-	siteList, err := cmd.Client.Sites(cmd.Namespace).List(context.TODO(), metav1.ListOptions{})
+	siteList, err := cmd.Client.Sites(namespace).List(context.TODO(), metav1.ListOptions{})
 
 	if err != nil {
 		err = utils.HandleMissingCrds(err)
 		return err
 	}
 
+	printer := formatter.NewResourcePrinter(formatter.SiteTable, cmd.output, cmd.allNamespaces)
+	printer.Writer = cmd.out
+	if cmd.watch {
+		ctx := cmd.watchCtx
+		if ctx == nil {
+			var cancel context.CancelFunc
+			ctx, cancel = utils.WatchContext()
+			defer cancel()
+		}
+		lw := &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return cmd.Client.Sites(namespace).List(ctx, options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return cmd.Client.Sites(namespace).Watch(ctx, options)
+			},
+		}
+		return utils.WatchResources(ctx, lw, &v2alpha1.Site{}, func(site *v2alpha1.Site, deleted bool) {
+			_ = printer.PrintWatched(site, deleted)
+		})
+	}
+
 	if siteList != nil && len(siteList.Items) == 0 {
 		fmt.Println("There is no existing Skupper site resource")
 		return nil
 	}
 
-	writer := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', tabwriter.AlignRight)
-	fmt.Fprintln(writer, "NAME\tSTATUS\tMESSAGE")
-
-	for _, site := range siteList.Items {
-		fmt.Fprintf(writer, "%s\t%s\t%s", site.Name, site.Status.StatusType, site.Status.Message)
-		fmt.Fprintln(writer)
+	var sites []*v2alpha1.Site
+	for i := range siteList.Items {
+		sites = append(sites, &siteList.Items[i])
 	}
-
-	writer.Flush()
-	return nil
+	return printer.Print(sites)
 }
 func (cmd *CmdSiteStatus) WaitUntil() error { return nil }
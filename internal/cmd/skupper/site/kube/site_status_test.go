@@ -1,8 +1,12 @@
 package kube
 
 import (
+	"bytes"
+	"context"
 	"testing"
+	"time"
 
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
 	fakeclient "github.com/skupperproject/skupper/internal/kube/client/fake"
@@ -19,6 +23,7 @@ func TestCmdSiteStatus_ValidateInput(t *testing.T) {
 		k8sObjects     []runtime.Object
 		skupperObjects []runtime.Object
 		skupperError   string
+		flags          *common.CommandSiteStatusFlags
 		expectedError  string
 	}
 
@@ -31,12 +36,22 @@ func TestCmdSiteStatus_ValidateInput(t *testing.T) {
 			skupperError:   "",
 			expectedError:  "this command does not need any arguments",
 		},
+		{
+			name:          "output type is not valid",
+			flags:         &common.CommandSiteStatusFlags{Output: "table"},
+			expectedError: "output type is not valid: value table not allowed. It should be one of this options: [json yaml wide]",
+		},
+		{
+			name:  "wide output across all namespaces",
+			flags: &common.CommandSiteStatusFlags{Output: "wide", AllNamespaces: true, Watch: true},
+		},
 	}
 
 	for _, test := range testTable {
 		t.Run(test.name, func(t *testing.T) {
 			command := &CmdSiteStatus{
 				Namespace: "test",
+				Flags:     test.flags,
 			}
 
 			fakeSkupperClient, err := fakeclient.NewFakeClient(command.Namespace, test.k8sObjects, test.skupperObjects, test.skupperError)
@@ -55,6 +70,10 @@ func TestCmdSiteStatus_Run(t *testing.T) {
 		skupperObjects []runtime.Object
 		skupperError   string
 		errorMessage   string
+		output         string
+		allNamespaces  bool
+		watch          bool
+		expectedOutput []string
 	}
 
 	testTable := []test{
@@ -98,12 +117,68 @@ func TestCmdSiteStatus_Run(t *testing.T) {
 			skupperError:   "",
 			errorMessage:   "",
 		},
+		{
+			name:       "runs ok across all namespaces",
+			k8sObjects: nil,
+			skupperObjects: []runtime.Object{
+				&v2alpha1.Site{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "site-a",
+						Namespace: "test",
+					},
+				},
+				&v2alpha1.Site{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "site-b",
+						Namespace: "other",
+					},
+				},
+			},
+			output:        "wide",
+			allNamespaces: true,
+		},
+		{
+			name:       "watches until interrupted",
+			k8sObjects: nil,
+			skupperObjects: []runtime.Object{
+				&v2alpha1.Site{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "site-a",
+						Namespace: "test",
+					},
+				},
+			},
+			output: "yaml",
+			watch:  true,
+			expectedOutput: []string{
+				"metadata:",
+				"name: site-a",
+				"namespace: test",
+				"",
+			},
+		},
+		{
+			name:           "watch fails with missing CRD",
+			k8sObjects:     nil,
+			skupperObjects: nil,
+			skupperError:   utils.CrdErr,
+			errorMessage:   utils.CrdHelpErr,
+			watch:          true,
+		},
 	}
 
 	for _, test := range testTable {
 		command := &CmdSiteStatus{
-			Namespace: "test",
+			Namespace:     "test",
+			output:        test.output,
+			allNamespaces: test.allNamespaces,
+			watch:         test.watch,
 		}
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		command.watchCtx = ctx
+		var out bytes.Buffer
+		command.out = &out
 
 		fakeSkupperClient, err := fakeclient.NewFakeClient(command.Namespace, test.k8sObjects, test.skupperObjects, test.skupperError)
 		assert.Assert(t, err)
@@ -117,6 +192,9 @@ func TestCmdSiteStatus_Run(t *testing.T) {
 			} else {
 				assert.Check(t, err == nil)
 			}
+			if test.expectedOutput != nil {
+				assert.DeepEqual(t, testutils.TableLines(out.String()), test.expectedOutput)
+			}
 		})
 	}
 }
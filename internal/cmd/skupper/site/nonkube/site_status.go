@@ -3,23 +3,28 @@ package nonkube
 import (
 	"errors"
 	"fmt"
-	"os"
-	"text/tabwriter"
+	"io"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
 	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
+	"github.com/skupperproject/skupper/internal/utils/formatter"
 	"github.com/skupperproject/skupper/internal/utils/validator"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	"github.com/spf13/cobra"
 )
 
 type CmdSiteStatus struct {
-	siteHandler *fs.SiteHandler
-	CobraCmd    *cobra.Command
-	Flags       *common.CommandSiteStatusFlags
-	namespace   string
-	siteName    string
-	output      string
+	siteHandler   *fs.SiteHandler
+	CobraCmd      *cobra.Command
+	Flags         *common.CommandSiteStatusFlags
+	namespace     string
+	siteName      string
+	output        string
+	watch         bool
+	allNamespaces bool
+	stopCh        <-chan struct{}
+	out           io.Writer
 }
 
 func NewCmdSiteStatus() *CmdSiteStatus {
@@ -38,7 +43,7 @@ func (cmd *CmdSiteStatus) ValidateInput(args []string) error {
 	var validationErrors []error
 	opts := fs.GetOptions{RuntimeFirst: true, LogWarning: false}
 	resourceStringValidator := validator.NewResourceStringValidator()
-	outputTypeValidator := validator.NewOptionValidator(common.OutputTypes)
+	outputTypeValidator := validator.NewOptionValidator(common.StatusOutputTypes)
 
 	// Validate arguments name if specified
 	if len(args) > 1 {
@@ -55,8 +60,11 @@ func (cmd *CmdSiteStatus) ValidateInput(args []string) error {
 			}
 		}
 	}
+	if cmd.Flags != nil && cmd.Flags.AllNamespaces && cmd.siteName != "" {
+		validationErrors = append(validationErrors, fmt.Errorf("a site name cannot be specified with --%s", common.FlagNameAllNamespaces))
+	}
 	// Validate that there is a site with this name in the namespace
-	if cmd.siteName != "" {
+	if cmd.siteName != "" && (cmd.Flags == nil || !cmd.Flags.Watch) {
 		site, err := cmd.siteHandler.Get(cmd.siteName, opts)
 		if site == nil || err != nil {
 			validationErrors = append(validationErrors, fmt.Errorf("site %s does not exist", cmd.siteName))
@@ -76,35 +84,55 @@ func (cmd *CmdSiteStatus) ValidateInput(args []string) error {
 }
 
 func (cmd *CmdSiteStatus) Run() error {
-	opts := fs.GetOptions{LogWarning: true}
-	sites, err := cmd.siteHandler.List(opts)
-	if sites == nil || err != nil {
+	printer := formatter.NewResourcePrinter(formatter.SiteTable, cmd.output, cmd.allNamespaces)
+	printer.Writer = cmd.out
+	if cmd.watch {
+		return cmd.watchSites(printer)
+	}
+
+	var sites []*v2alpha1.Site
+	var err error
+	if cmd.allNamespaces {
+		sites, err = fs.ListAllNamespaces(func(namespace string) ([]*v2alpha1.Site, error) {
+			return fs.NewSiteHandler(namespace).List(fs.GetOptions{})
+		})
+	} else {
+		sites, err = cmd.siteHandler.List(fs.GetOptions{LogWarning: true})
+	}
+	if len(sites) == 0 || err != nil {
 		fmt.Println("There is no existing Skupper site resource")
 		return nil
 	}
 
-	if cmd.output != "" {
-		for _, site := range sites {
-			encodedOutput, err := utils.Encode(cmd.output, site)
-			if err != nil {
-				return err
-			}
-			fmt.Println(encodedOutput)
-		}
-	} else {
-		writer := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', tabwriter.AlignRight)
-		fmt.Fprintln(writer, "NAME\tSTATUS\tMESSAGE")
+	return printer.Print(sites)
+}
 
-		for _, site := range sites {
-			fmt.Fprintf(writer, "%s\t%s\t%s", site.Name, site.Status.StatusType, site.Status.Message)
-			fmt.Fprintln(writer)
+func (cmd *CmdSiteStatus) watchSites(printer *formatter.ResourcePrinter[*v2alpha1.Site]) error {
+	namespaces := []string{cmd.namespace}
+	if cmd.allNamespaces {
+		var err error
+		if namespaces, err = fs.ListNamespaces(); err != nil {
+			return err
 		}
-
-		writer.Flush()
 	}
+	stopCh := cmd.stopCh
+	if stopCh == nil {
+		ctx, cancel := utils.WatchContext()
+		defer cancel()
+		stopCh = ctx.Done()
+	}
+	return fs.WatchRuntimeResources(namespaces, common.Sites, func(site *v2alpha1.Site, deleted bool) {
+		if cmd.siteName == "" || site.Name == cmd.siteName {
+			_ = printer.PrintWatched(site, deleted)
+		}
+	}, stopCh)
+}
 
-	return nil
+func (cmd *CmdSiteStatus) InputToOptions() {
+	if cmd.Flags != nil {
+		cmd.watch = cmd.Flags.Watch
+		cmd.allNamespaces = cmd.Flags.AllNamespaces
+	}
 }
 
-func (cmd *CmdSiteStatus) InputToOptions()  {}
 func (cmd *CmdSiteStatus) WaitUntil() error { return nil }
@@ -1,9 +1,11 @@
 package nonkube
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
@@ -61,7 +63,7 @@ func TestCmdSiteStatus_ValidateInput(t *testing.T) {
 			name:          "bad output",
 			args:          []string{"my-site"},
 			flags:         &common.CommandSiteStatusFlags{Output: "yaml$"},
-			expectedError: "output type is not valid: value yaml$ not allowed. It should be one of this options: [json yaml]",
+			expectedError: "output type is not valid: value yaml$ not allowed. It should be one of this options: [json yaml wide]",
 		},
 		{
 			name:          "good flags",
@@ -69,6 +71,18 @@ func TestCmdSiteStatus_ValidateInput(t *testing.T) {
 			flags:         &common.CommandSiteStatusFlags{Output: "yaml"},
 			expectedError: "",
 		},
+		{
+			name:          "site name with all namespaces",
+			args:          []string{"my-site"},
+			flags:         &common.CommandSiteStatusFlags{AllNamespaces: true},
+			expectedError: "a site name cannot be specified with --all-namespaces",
+		},
+		{
+			name:          "watch a site not yet created",
+			args:          []string{"new-site"},
+			flags:         &common.CommandSiteStatusFlags{Watch: true, Output: "wide"},
+			expectedError: "",
+		},
 	}
 
 	//Add a temp file so site exists for status tests
@@ -113,10 +127,13 @@ func TestCmdSiteStatus_ValidateInput(t *testing.T) {
 
 func TestCmdSiteStatus_Run(t *testing.T) {
 	type test struct {
-		name         string
-		siteName     string
-		errorMessage string
-		output       string
+		name           string
+		siteName       string
+		errorMessage   string
+		output         string
+		allNamespaces  bool
+		watch          bool
+		expectedOutput []string
 	}
 
 	if os.Getuid() == 0 {
@@ -144,6 +161,30 @@ func TestCmdSiteStatus_Run(t *testing.T) {
 			siteName: "my-site",
 			output:   "yaml",
 		},
+		{
+			name:          "runs ok, returns sites of all namespaces",
+			output:        "wide",
+			allNamespaces: true,
+		},
+		{
+			name:     "runs ok, watches 1 site",
+			siteName: "my-site",
+			watch:    true,
+			expectedOutput: []string{
+				"NAME STATUS MESSAGE",
+				"my-site",
+			},
+		},
+		{
+			name:          "runs ok, watches sites of all namespaces",
+			output:        "wide",
+			allNamespaces: true,
+			watch:         true,
+			expectedOutput: []string{
+				"NAMESPACE NAME STATUS SITES-IN-NETWORK LINK-ACCESS HA MESSAGE",
+				"test3 my-site 0 route false",
+			},
+		},
 	}
 
 	//Add a temp file so site exists for status tests
@@ -188,6 +229,13 @@ func TestCmdSiteStatus_Run(t *testing.T) {
 	for _, test := range testTable {
 		command.siteName = test.siteName
 		command.output = test.output
+		command.allNamespaces = test.allNamespaces
+		command.watch = test.watch
+		stopCh := make(chan struct{})
+		time.AfterFunc(200*time.Millisecond, func() { close(stopCh) })
+		command.stopCh = stopCh
+		var out bytes.Buffer
+		command.out = &out
 
 		t.Run(test.name, func(t *testing.T) {
 			err := command.Run()
@@ -196,6 +244,9 @@ func TestCmdSiteStatus_Run(t *testing.T) {
 			} else {
 				assert.Check(t, err == nil)
 			}
+			if test.expectedOutput != nil {
+				assert.DeepEqual(t, testutils.TableLines(out.String()), test.expectedOutput)
+			}
 		})
 	}
 }
@@ -106,12 +106,16 @@ func CmdSiteStatusFactory(configuredPlatform common.Platform) *cobra.Command {
 		Use:   "status",
 		Short: "Get the site status",
 		Long:  `Display the current status of a site.`,
+		Example: `skupper site status --watch
+skupper site status --all-namespaces -o wide`,
 	}
 
 	cmd := common.ConfigureCobraCommand(configuredPlatform, cmdSiteStatusDesc, kubeCommand, nonKubeCommand)
 
 	cmdFlags := common.CommandSiteStatusFlags{}
-	cmd.Flags().StringVarP(&cmdFlags.Output, common.FlagNameOutput, "o", "", common.FlagDescConnectorStatusOutput)
+	cmd.Flags().StringVarP(&cmdFlags.Output, common.FlagNameOutput, "o", "", common.FlagDescStatusOutput)
+	cmd.Flags().BoolVarP(&cmdFlags.Watch, common.FlagNameWatch, "w", false, common.FlagDescWatch)
+	cmd.Flags().BoolVarP(&cmdFlags.AllNamespaces, common.FlagNameAllNamespaces, "A", false, common.FlagDescAllNamespaces)
 
 	kubeCommand.CobraCmd = cmd
 	kubeCommand.Flags = &cmdFlags
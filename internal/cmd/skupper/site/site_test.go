@@ -73,7 +73,9 @@ func TestCmdSiteFactory(t *testing.T) {
 		{
 			name: "CmdSiteStatusFactory",
 			expectedFlagsWithDefaultValue: map[string]interface{}{
-				common.FlagNameOutput: "",
+				common.FlagNameOutput:        "",
+				common.FlagNameWatch:         "false",
+				common.FlagNameAllNamespaces: "false",
 			},
 			command: CmdSiteStatusFactory(common.PlatformKubernetes),
 		},
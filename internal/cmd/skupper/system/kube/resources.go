@@ -0,0 +1,294 @@
+package kube
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
+	nonkubecommon "github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// resource is a single resource read from the input of system apply or
+// system delete, bound to the client through which it is managed.
+type resource struct {
+	kind string
+	name string
+	// apply creates the resource or, if it already exists, updates it,
+	// returning "created" or "configured" accordingly
+	apply  func(ctx context.Context) (string, error)
+	delete func(ctx context.Context) error
+	exists func(ctx context.Context) (bool, error)
+	// condition returns the status condition that indicates whether the
+	// resource has reached the supplied wait status, or nil if it has
+	// not yet been set
+	condition func(ctx context.Context, status string) (*metav1.Condition, error)
+	// waitConditions maps each wait status to the type of condition
+	// that signals it; resources without conditions are not waited on
+	waitConditions map[string]string
+}
+
+func (r *resource) String() string {
+	return r.kind + " " + r.name
+}
+
+func (r *resource) waitsFor(status string) bool {
+	return r.waitConditions[status] != ""
+}
+
+type resourceClient[T metav1.Object] interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (T, error)
+	Create(ctx context.Context, obj T, opts metav1.CreateOptions) (T, error)
+	Update(ctx context.Context, obj T, opts metav1.UpdateOptions) (T, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+}
+
+func newResource[T metav1.Object](kind string, obj T, client resourceClient[T], conditions func(T) []metav1.Condition, waitConditions map[string]string) *resource {
+	name := obj.GetName()
+	return &resource{
+		kind: kind,
+		name: name,
+		apply: func(ctx context.Context) (string, error) {
+			current, err := client.Get(ctx, name, metav1.GetOptions{})
+			if k8serrors.IsNotFound(err) {
+				_, err = client.Create(ctx, obj, metav1.CreateOptions{})
+				return "created", err
+			} else if err != nil {
+				return "", err
+			}
+			obj.SetResourceVersion(current.GetResourceVersion())
+			_, err = client.Update(ctx, obj, metav1.UpdateOptions{})
+			return "configured", err
+		},
+		delete: func(ctx context.Context) error {
+			return client.Delete(ctx, name, metav1.DeleteOptions{})
+		},
+		exists: func(ctx context.Context) (bool, error) {
+			_, err := client.Get(ctx, name, metav1.GetOptions{})
+			if k8serrors.IsNotFound(err) {
+				return false, nil
+			}
+			return err == nil, err
+		},
+		condition: func(ctx context.Context, status string) (*metav1.Condition, error) {
+			current, err := client.Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return meta.FindStatusCondition(conditions(current), waitConditions[status]), nil
+		},
+		waitConditions: waitConditions,
+	}
+}
+
+var (
+	configuredOrReady = map[string]string{
+		"configured": v2alpha1.CONDITION_TYPE_CONFIGURED,
+		"ready":      v2alpha1.CONDITION_TYPE_READY,
+	}
+	processedOrReady = map[string]string{
+		"configured": v2alpha1.CONDITION_TYPE_PROCESSED,
+		"ready":      v2alpha1.CONDITION_TYPE_READY,
+	}
+	redeemed = map[string]string{
+		"configured": v2alpha1.CONDITION_TYPE_REDEEMED,
+		"ready":      v2alpha1.CONDITION_TYPE_REDEEMED,
+	}
+	certificateReady = map[string]string{
+		"configured": v2alpha1.CONDITION_TYPE_READY,
+		"ready":      v2alpha1.CONDITION_TYPE_READY,
+	}
+)
+
+// resourcesFor returns the parsed resources in the order in which they
+// should be applied: secrets and certificates before the resources
+// that refer to them, and the site before the resources it hosts.
+// Deletion uses the reverse order.
+func resourcesFor(input *fs.InputFileResource, client skupperv2alpha1.SkupperV2alpha1Interface, kubeClient kubernetes.Interface, namespace string) []*resource {
+	var resources []*resource
+	for i := range input.Secret {
+		secret := &input.Secret[i]
+		secret.Namespace = namespace
+		resources = append(resources, newResource("Secret", secret, kubeClient.CoreV1().Secrets(namespace), func(*corev1.Secret) []metav1.Condition { return nil }, nil))
+	}
+	for i := range input.Certificate {
+		certificate := &input.Certificate[i]
+		certificate.Namespace = namespace
+		resources = append(resources, newResource("Certificate", certificate, client.Certificates(namespace), func(c *v2alpha1.Certificate) []metav1.Condition { return c.Status.Conditions }, certificateReady))
+	}
+	for i := range input.Site {
+		site := &input.Site[i]
+		resources = append(resources, newResource("Site", site, client.Sites(namespace), func(s *v2alpha1.Site) []metav1.Condition { return s.Status.Conditions }, configuredOrReady))
+	}
+	for i := range input.RouterAccess {
+		routerAccess := &input.RouterAccess[i]
+		resources = append(resources, newResource("RouterAccess", routerAccess, client.RouterAccesses(namespace), func(r *v2alpha1.RouterAccess) []metav1.Condition { return r.Status.Conditions }, configuredOrReady))
+	}
+	for i := range input.SecuredAccess {
+		securedAccess := &input.SecuredAccess[i]
+		resources = append(resources, newResource("SecuredAccess", securedAccess, client.SecuredAccesses(namespace), func(s *v2alpha1.SecuredAccess) []metav1.Condition { return s.Status.Conditions }, configuredOrReady))
+	}
+	for i := range input.Listener {
+		listener := &input.Listener[i]
+		resources = append(resources, newResource("Listener", listener, client.Listeners(namespace), func(l *v2alpha1.Listener) []metav1.Condition { return l.Status.Conditions }, configuredOrReady))
+	}
+	for i := range input.Connector {
+		connector := &input.Connector[i]
+		resources = append(resources, newResource("Connector", connector, client.Connectors(namespace), func(c *v2alpha1.Connector) []metav1.Condition { return c.Status.Conditions }, configuredOrReady))
+	}
+	for i := range input.Link {
+		link := &input.Link[i]
+		resources = append(resources, newResource("Link", link, client.Links(namespace), func(l *v2alpha1.Link) []metav1.Condition { return l.Status.Conditions }, configuredOrReady))
+	}
+	for i := range input.AccessGrant {
+		grant := &input.AccessGrant[i]
+		resources = append(resources, newResource("AccessGrant", grant, client.AccessGrants(namespace), func(g *v2alpha1.AccessGrant) []metav1.Condition { return g.Status.Conditions }, processedOrReady))
+	}
+	for i := range input.AccessToken {
+		token := &input.AccessToken[i]
+		resources = append(resources, newResource("AccessToken", token, client.AccessTokens(namespace), func(t *v2alpha1.AccessToken) []metav1.Condition { return t.Status.Conditions }, redeemed))
+	}
+	return resources
+}
+
+// validateInputFile checks that the file named by the -f flag can be
+// read, or that standard input is to be used.
+func validateInputFile(filename string, missing string) []error {
+	var validationErrors []error
+
+	if filename == "" {
+		validationErrors = append(validationErrors, errors.New(missing))
+		return validationErrors
+	}
+
+	if filename != "-" {
+		if !strings.HasSuffix(filename, ".yaml") && !strings.HasSuffix(filename, ".json") {
+			validationErrors = append(validationErrors, fmt.Errorf("The file has an unsupported extension, it should have one of the following: .yaml, .json"))
+		}
+
+		info, err := os.Stat(filename)
+		if os.IsNotExist(err) {
+			validationErrors = append(validationErrors, fmt.Errorf("The file %q does not exist", filename))
+		} else if err != nil {
+			validationErrors = append(validationErrors, fmt.Errorf("Error while accessing the file: %s", err))
+		}
+
+		if err == nil && info.IsDir() {
+			validationErrors = append(validationErrors, fmt.Errorf("The file %q is a directory", filename))
+		}
+	}
+
+	return validationErrors
+}
+
+// parseInput reads the resources from the named file, or from the
+// supplied reader if no file is named.
+func parseInput(file string, stdin io.Reader, namespace string) (*fs.InputFileResource, error) {
+	inputReader := stdin
+	if file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("Error while opening the file: %s", err)
+		}
+		defer f.Close()
+		inputReader = f
+	}
+	parsedInput := &fs.InputFileResource{}
+	if err := fs.ParseInput(namespace, bufio.NewReader(inputReader), parsedInput); err != nil {
+		return nil, fmt.Errorf("Failed parsing the custom resources: %s", err)
+	}
+	return parsedInput, nil
+}
+
+// validateResources applies the same validation used for non-kubernetes
+// sites to the parsed resources. Secrets referenced by links that are not
+// part of the input may already exist in the namespace.
+func validateResources(input *fs.InputFileResource, kubeClient kubernetes.Interface, namespace string) error {
+	siteState := &api.SiteState{
+		Listeners:       map[string]*v2alpha1.Listener{},
+		Connectors:      map[string]*v2alpha1.Connector{},
+		RouterAccesses:  map[string]*v2alpha1.RouterAccess{},
+		Grants:          map[string]*v2alpha1.AccessGrant{},
+		Links:           map[string]*v2alpha1.Link{},
+		Claims:          map[string]*v2alpha1.AccessToken{},
+		Certificates:    map[string]*v2alpha1.Certificate{},
+		SecuredAccesses: map[string]*v2alpha1.SecuredAccess{},
+		Secrets:         map[string]*corev1.Secret{},
+	}
+	if len(input.Site) > 1 {
+		return fmt.Errorf("only one site can be defined per namespace, found %d", len(input.Site))
+	} else if len(input.Site) == 1 {
+		siteState.Site = &input.Site[0]
+	}
+	for i := range input.Listener {
+		siteState.Listeners[input.Listener[i].Name] = &input.Listener[i]
+	}
+	for i := range input.Connector {
+		connector := &input.Connector[i]
+		if connector.Spec.Selector != "" && connector.Spec.Host == "" {
+			// connectors may select pods on kubernetes rather than
+			// naming a host
+			if err := validateSelectorConnector(connector); err != nil {
+				return err
+			}
+			continue
+		}
+		siteState.Connectors[connector.Name] = connector
+	}
+	for i := range input.RouterAccess {
+		siteState.RouterAccesses[input.RouterAccess[i].Name] = &input.RouterAccess[i]
+	}
+	for i := range input.AccessGrant {
+		siteState.Grants[input.AccessGrant[i].Name] = &input.AccessGrant[i]
+	}
+	for i := range input.AccessToken {
+		siteState.Claims[input.AccessToken[i].Name] = &input.AccessToken[i]
+	}
+	for i := range input.Certificate {
+		siteState.Certificates[input.Certificate[i].Name] = &input.Certificate[i]
+	}
+	for i := range input.SecuredAccess {
+		siteState.SecuredAccesses[input.SecuredAccess[i].Name] = &input.SecuredAccess[i]
+	}
+	for i := range input.Secret {
+		siteState.Secrets[input.Secret[i].Name] = &input.Secret[i]
+	}
+	for i := range input.Link {
+		link := &input.Link[i]
+		siteState.Links[link.Name] = link
+		if _, ok := siteState.Secrets[link.Spec.TlsCredentials]; ok || link.Spec.TlsCredentials == "" {
+			continue
+		}
+		secret, err := kubeClient.CoreV1().Secrets(namespace).Get(context.TODO(), link.Spec.TlsCredentials, metav1.GetOptions{})
+		if err == nil {
+			siteState.Secrets[secret.Name] = secret
+		}
+	}
+	validator := &nonkubecommon.SiteStateValidator{}
+	return validator.Validate(siteState)
+}
+
+func validateSelectorConnector(connector *v2alpha1.Connector) error {
+	if err := nonkubecommon.ValidateName(connector.Name); err != nil {
+		return fmt.Errorf("invalid connector name: %w", err)
+	}
+	if connector.Spec.Port == 0 {
+		return fmt.Errorf("connector port is required (connector: %q)", connector.Name)
+	}
+	if connector.Spec.RoutingKey == "" {
+		return fmt.Errorf("routingKey is missing for connector: %s", connector.Name)
+	}
+	return nil
+}
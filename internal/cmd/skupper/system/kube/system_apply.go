@@ -1,10 +1,18 @@
 package kube
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
+
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/utils/validator"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2alpha1"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -14,6 +22,10 @@ type CmdSystemApply struct {
 	CobraCmd   *cobra.Command
 	Namespace  string
 	Flags      *common.CommandSystemApplyFlags
+	file       string
+	timeout    time.Duration
+	status     string
+	applied    []*resource
 }
 
 func NewCmdSystemApply() *CmdSystemApply {
@@ -23,15 +35,134 @@ func NewCmdSystemApply() *CmdSystemApply {
 	return &skupperCmd
 }
 
-func (cmd *CmdSystemApply) NewClient(cobraCommand *cobra.Command, args []string) {}
+func (cmd *CmdSystemApply) NewClient(cobraCommand *cobra.Command, args []string) {
+	cli, err := client.NewClient(cobraCommand.Flag("namespace").Value.String(), cobraCommand.Flag("context").Value.String(), cobraCommand.Flag("kubeconfig").Value.String())
+	utils.HandleError(utils.GenericError, err)
+
+	cmd.Client = cli.GetSkupperClient().SkupperV2alpha1()
+	cmd.KubeClient = cli.Kube
+	cmd.Namespace = cli.Namespace
+}
+
+func (cmd *CmdSystemApply) ValidateInput(args []string) error {
+	var validationErrors []error
+	timeoutValidator := validator.NewTimeoutInSecondsValidator()
+	statusValidator := validator.NewOptionValidator(common.WaitStatusTypes)
+
+	if len(args) > 0 {
+		validationErrors = append(validationErrors, fmt.Errorf("This command does not accept arguments"))
+	}
+
+	filename := ""
+	if cmd.Flags != nil {
+		filename = cmd.Flags.Filename
+	}
+	validationErrors = append(validationErrors, validateInputFile(filename, "You need to provide a file to apply or use standard input.\n Example: cat site.yaml | skupper system apply -f -")...)
 
-func (cmd *CmdSystemApply) ValidateInput(args []string) error { return nil }
+	if cmd.Flags != nil && cmd.Flags.Timeout.String() != "" {
+		ok, err := timeoutValidator.Evaluate(cmd.Flags.Timeout)
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("timeout is not valid: %s", err))
+		}
+	}
 
-func (cmd *CmdSystemApply) InputToOptions() {}
+	if cmd.Flags != nil && cmd.Flags.Wait != "" {
+		ok, err := statusValidator.Evaluate(cmd.Flags.Wait)
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("status is not valid: %s", err))
+		}
+	}
+
+	return errors.Join(validationErrors...)
+}
+
+func (cmd *CmdSystemApply) InputToOptions() {
+	cmd.file = cmd.Flags.Filename
+	if cmd.Flags.Filename == "-" {
+		cmd.file = ""
+	}
+	cmd.timeout = cmd.Flags.Timeout
+	cmd.status = cmd.Flags.Wait
+}
 
 func (cmd *CmdSystemApply) Run() error {
-	fmt.Println("This command does not support kubernetes platforms.")
-	return nil
+	parsedInput, err := parseInput(cmd.file, cmd.CobraCmd.InOrStdin(), cmd.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if err := validateResources(parsedInput, cmd.KubeClient, cmd.Namespace); err != nil {
+		return fmt.Errorf("Invalid custom resources: %s", err)
+	}
+
+	var applyErrors []error
+	for _, resource := range resourcesFor(parsedInput, cmd.Client, cmd.KubeClient, cmd.Namespace) {
+		result, err := resource.apply(context.TODO())
+		if err != nil {
+			applyErrors = append(applyErrors, fmt.Errorf("%s could not be applied: %s", resource, err))
+			continue
+		}
+		cmd.applied = append(cmd.applied, resource)
+		fmt.Printf("%s %s\n", resource, result)
+	}
+
+	return errors.Join(applyErrors...)
 }
 
-func (cmd *CmdSystemApply) WaitUntil() error { return nil }
+func (cmd *CmdSystemApply) WaitUntil() error {
+
+	if cmd.status == "none" || cmd.status == "" {
+		return nil
+	}
+
+	var pending []*resource
+	for _, resource := range cmd.applied {
+		if resource.waitsFor(cmd.status) {
+			pending = append(pending, resource)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	waitTime := int(cmd.timeout.Seconds())
+	conditions := map[*resource]*metav1.Condition{}
+
+	err := utils.NewSpinnerWithTimeout("Waiting for resources to be "+cmd.status+"...", waitTime, func() error {
+		var waiting []*resource
+		for _, resource := range pending {
+			condition, err := resource.condition(context.TODO(), cmd.status)
+			if err != nil {
+				return err
+			}
+			conditions[resource] = condition
+			if condition == nil || condition.Status != metav1.ConditionTrue {
+				waiting = append(waiting, resource)
+			}
+		}
+		pending = waiting
+		if len(pending) > 0 {
+			return fmt.Errorf("%d resources are not yet %s", len(pending), cmd.status)
+		}
+		return nil
+	})
+
+	if err != nil {
+		var waitErrors []error
+		for _, resource := range pending {
+			condition := conditions[resource]
+			if condition != nil && condition.Status == metav1.ConditionFalse {
+				waitErrors = append(waitErrors, fmt.Errorf("%s is not yet %s: %s", resource, cmd.status, condition.Message))
+			} else {
+				waitErrors = append(waitErrors, fmt.Errorf("%s is not yet %s, check the status for more information", resource, cmd.status))
+			}
+		}
+		if len(waitErrors) == 0 {
+			return err
+		}
+		return errors.Join(waitErrors...)
+	}
+
+	fmt.Printf("Resources are %s.\n", cmd.status)
+	return nil
+}
@@ -0,0 +1,329 @@
+package kube
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	fakeclient "github.com/skupperproject/skupper/internal/kube/client/fake"
+	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const applyInput = `---
+apiVersion: skupper.io/v2alpha1
+kind: Site
+metadata:
+  name: west
+---
+apiVersion: skupper.io/v2alpha1
+kind: Listener
+metadata:
+  name: backend
+spec:
+  host: backend
+  port: 8080
+  routingKey: backend
+---
+apiVersion: skupper.io/v2alpha1
+kind: Connector
+metadata:
+  name: backend
+spec:
+  selector: app=backend
+  port: 8080
+  routingKey: backend
+`
+
+func TestCmdSystemApply_ValidateInput(t *testing.T) {
+	type test struct {
+		name          string
+		args          []string
+		flags         *common.CommandSystemApplyFlags
+		expectedError string
+	}
+
+	testTable := []test{
+		{
+			name:          "arguments are not accepted",
+			args:          []string{"something"},
+			flags:         &common.CommandSystemApplyFlags{Filename: "-", Timeout: time.Minute},
+			expectedError: "This command does not accept arguments",
+		},
+		{
+			name:          "flag file is not provided",
+			args:          []string{},
+			expectedError: "You need to provide a file to apply or use standard input.\n Example: cat site.yaml | skupper system apply -f -",
+		},
+		{
+			name:          "file does not exist",
+			flags:         &common.CommandSystemApplyFlags{Filename: "file-does-not-exist.json", Timeout: time.Minute},
+			expectedError: "The file \"file-does-not-exist.json\" does not exist",
+		},
+		{
+			name:          "provided file is not a file but a directory",
+			flags:         &common.CommandSystemApplyFlags{Filename: ".", Timeout: time.Minute},
+			expectedError: "The file has an unsupported extension, it should have one of the following: .yaml, .json\nThe file \".\" is a directory",
+		},
+		{
+			name:          "timeout is not valid",
+			flags:         &common.CommandSystemApplyFlags{Filename: "-", Timeout: time.Second * 0},
+			expectedError: "timeout is not valid: duration must not be less than 10s; got 0s",
+		},
+		{
+			name:          "wait status is not valid",
+			flags:         &common.CommandSystemApplyFlags{Filename: "-", Timeout: time.Minute, Wait: "created"},
+			expectedError: "status is not valid: value created not allowed. It should be one of this options: [ready configured none]",
+		},
+		{
+			name:  "standard input is used",
+			flags: &common.CommandSystemApplyFlags{Filename: "-", Timeout: time.Minute, Wait: "ready"},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+
+			command := &CmdSystemApply{Flags: test.flags}
+
+			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
+		})
+	}
+}
+
+func TestCmdSystemApply_Run(t *testing.T) {
+	type test struct {
+		name                string
+		input               string
+		k8sObjects          []runtime.Object
+		skupperObjects      []runtime.Object
+		skupperErrorMessage string
+		errorMessage        string
+		expectedApplied     []string
+	}
+
+	testTable := []test{
+		{
+			name:            "resources are created",
+			input:           applyInput,
+			expectedApplied: []string{"Site west", "Listener backend", "Connector backend"},
+		},
+		{
+			name: "invalid resources are rejected",
+			input: `apiVersion: skupper.io/v2alpha1
+kind: Listener
+metadata:
+  name: backend
+spec:
+  host: backend
+  port: 8080
+`,
+			errorMessage: "Invalid custom resources: routingKey is missing for listener: backend",
+		},
+		{
+			name: "link secret must exist",
+			input: `apiVersion: skupper.io/v2alpha1
+kind: Link
+metadata:
+  name: to-east
+spec:
+  tlsCredentials: to-east
+`,
+			errorMessage: "Invalid custom resources: unable to process links (no secrets found)",
+		},
+		{
+			name: "link secret exists in the namespace",
+			input: `apiVersion: skupper.io/v2alpha1
+kind: Link
+metadata:
+  name: to-east
+spec:
+  tlsCredentials: to-east
+`,
+			k8sObjects: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "to-east",
+						Namespace: "test",
+					},
+				},
+			},
+			expectedApplied: []string{"Link to-east"},
+		},
+		{
+			name:                "apply fails",
+			input:               applyInput,
+			skupperErrorMessage: "error",
+			errorMessage:        "Site west could not be applied: error\nListener backend could not be applied: error\nConnector backend could not be applied: error",
+		},
+		{
+			name:         "input parsing fails",
+			input:        "kind: [",
+			errorMessage: "Failed parsing the custom resources: error decoding file: error converting YAML to JSON: yaml: line 1: did not find expected node content",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			cmd, err := newCmdSystemApplyWithMocks("test", test.k8sObjects, test.skupperObjects, test.skupperErrorMessage)
+			assert.Assert(t, err)
+			cmd.CobraCmd.SetIn(strings.NewReader(test.input))
+
+			err = cmd.Run()
+			if test.errorMessage != "" {
+				assert.Error(t, err, test.errorMessage)
+			} else {
+				assert.Assert(t, err)
+			}
+			var applied []string
+			for _, resource := range cmd.applied {
+				applied = append(applied, resource.String())
+			}
+			assert.DeepEqual(t, applied, test.expectedApplied)
+		})
+	}
+
+	t.Run("existing resources are updated", func(t *testing.T) {
+		cmd, err := newCmdSystemApplyWithMocks("test", nil, []runtime.Object{
+			&v2alpha1.Listener{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      "backend",
+					Namespace: "test",
+				},
+				Spec: v2alpha1.ListenerSpec{
+					Host:       "backend",
+					Port:       9090,
+					RoutingKey: "backend",
+				},
+			},
+		}, "")
+		assert.Assert(t, err)
+		cmd.CobraCmd.SetIn(strings.NewReader(applyInput))
+		assert.Assert(t, cmd.Run())
+
+		listener, err := cmd.Client.Listeners("test").Get(context.TODO(), "backend", v1.GetOptions{})
+		assert.Assert(t, err)
+		assert.Equal(t, listener.Spec.Port, 8080)
+	})
+}
+
+func TestCmdSystemApply_WaitUntil(t *testing.T) {
+	type test struct {
+		name           string
+		status         string
+		skupperObjects []runtime.Object
+		expectError    string
+	}
+
+	configuredSite := &v2alpha1.Site{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "west",
+			Namespace: "test",
+		},
+		Status: v2alpha1.SiteStatus{
+			Status: v2alpha1.Status{
+				Conditions: []v1.Condition{
+					{
+						Type:   "Configured",
+						Status: "True",
+					},
+				},
+			},
+		},
+	}
+	failedSite := &v2alpha1.Site{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "west",
+			Namespace: "test",
+		},
+		Status: v2alpha1.SiteStatus{
+			Status: v2alpha1.Status{
+				Conditions: []v1.Condition{
+					{
+						Type:    "Configured",
+						Status:  "False",
+						Message: "failed",
+					},
+				},
+			},
+		},
+	}
+
+	testTable := []test{
+		{
+			name:           "site is configured",
+			status:         "configured",
+			skupperObjects: []runtime.Object{configuredSite},
+		},
+		{
+			name:           "site is not ready",
+			status:         "ready",
+			skupperObjects: []runtime.Object{configuredSite},
+			expectError:    "Site west is not yet ready, check the status for more information",
+		},
+		{
+			name:           "site failed",
+			status:         "configured",
+			skupperObjects: []runtime.Object{failedSite},
+			expectError:    "Site west is not yet configured: failed",
+		},
+		{
+			name:           "user does not want to wait",
+			status:         "none",
+			skupperObjects: []runtime.Object{failedSite},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			cmd, err := newCmdSystemApplyWithMocks("test", nil, test.skupperObjects, "")
+			assert.Assert(t, err)
+			cmd.timeout = time.Second
+			cmd.status = test.status
+			cmd.applied = resourcesFor(mustParse(t, applyInput), cmd.Client, cmd.KubeClient, "test")[:1]
+
+			err = cmd.WaitUntil()
+			if test.expectError != "" {
+				assert.Error(t, err, test.expectError)
+			} else {
+				assert.Assert(t, err)
+			}
+		})
+	}
+}
+
+// --- helper methods
+
+func newCmdSystemApplyWithMocks(namespace string, k8sObjects []runtime.Object, skupperObjects []runtime.Object, fakeSkupperError string) (*CmdSystemApply, error) {
+
+	// We make sure the interval is appropriate
+	utils.SetRetryProfile(utils.TestRetryProfile)
+	client, err := fakeclient.NewFakeClient(namespace, k8sObjects, skupperObjects, fakeSkupperError)
+	if err != nil {
+		return nil, err
+	}
+	cmdSystemApply := &CmdSystemApply{
+		Client:     client.GetSkupperClient().SkupperV2alpha1(),
+		KubeClient: client.GetKubeClient(),
+		CobraCmd:   &cobra.Command{},
+		Namespace:  namespace,
+	}
+
+	return cmdSystemApply, nil
+}
+
+func mustParse(t *testing.T, input string) *fs.InputFileResource {
+	t.Helper()
+	parsed, err := parseInput("", strings.NewReader(input), "test")
+	assert.Assert(t, err)
+	return parsed
+}
@@ -1,10 +1,19 @@
 package kube
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"time"
+
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/utils/validator"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2alpha1"
 	"github.com/spf13/cobra"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -14,6 +23,10 @@ type CmdSystemDelete struct {
 	CobraCmd   *cobra.Command
 	Namespace  string
 	Flags      *common.CommandSystemDeleteFlags
+	file       string
+	timeout    time.Duration
+	wait       bool
+	deleted    []*resource
 }
 
 func NewCmdSystemDelete() *CmdSystemDelete {
@@ -23,15 +36,114 @@ func NewCmdSystemDelete() *CmdSystemDelete {
 	return &skupperCmd
 }
 
-func (cmd *CmdSystemDelete) NewClient(cobraCommand *cobra.Command, args []string) {}
+func (cmd *CmdSystemDelete) NewClient(cobraCommand *cobra.Command, args []string) {
+	cli, err := client.NewClient(cobraCommand.Flag("namespace").Value.String(), cobraCommand.Flag("context").Value.String(), cobraCommand.Flag("kubeconfig").Value.String())
+	utils.HandleError(utils.GenericError, err)
+
+	cmd.Client = cli.GetSkupperClient().SkupperV2alpha1()
+	cmd.KubeClient = cli.Kube
+	cmd.Namespace = cli.Namespace
+}
+
+func (cmd *CmdSystemDelete) ValidateInput(args []string) error {
+	var validationErrors []error
+	timeoutValidator := validator.NewTimeoutInSecondsValidator()
+
+	if len(args) > 0 {
+		validationErrors = append(validationErrors, fmt.Errorf("This command does not accept arguments"))
+	}
 
-func (cmd *CmdSystemDelete) ValidateInput(args []string) error { return nil }
+	filename := ""
+	if cmd.Flags != nil {
+		filename = cmd.Flags.Filename
+	}
+	validationErrors = append(validationErrors, validateInputFile(filename, "You need to provide a file to delete custom resources or use standard input.\n Example: cat site.yaml | skupper system delete -f -")...)
 
-func (cmd *CmdSystemDelete) InputToOptions() {}
+	if cmd.Flags != nil && cmd.Flags.Timeout.String() != "" {
+		ok, err := timeoutValidator.Evaluate(cmd.Flags.Timeout)
+		if !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("timeout is not valid: %s", err))
+		}
+	}
+
+	return errors.Join(validationErrors...)
+}
+
+func (cmd *CmdSystemDelete) InputToOptions() {
+	cmd.file = cmd.Flags.Filename
+	if cmd.Flags.Filename == "-" {
+		cmd.file = ""
+	}
+	cmd.timeout = cmd.Flags.Timeout
+	cmd.wait = cmd.Flags.Wait
+}
 
 func (cmd *CmdSystemDelete) Run() error {
-	fmt.Println("This command does not support kubernetes platforms.")
-	return nil
+	parsedInput, err := parseInput(cmd.file, cmd.CobraCmd.InOrStdin(), cmd.Namespace)
+	if err != nil {
+		return err
+	}
+
+	// resources are deleted in the reverse of the order in which they
+	// are applied
+	resources := resourcesFor(parsedInput, cmd.Client, cmd.KubeClient, cmd.Namespace)
+	slices.Reverse(resources)
+
+	var deleteErrors []error
+	for _, resource := range resources {
+		err := resource.delete(context.TODO())
+		if k8serrors.IsNotFound(err) {
+			fmt.Printf("%s not found\n", resource)
+			continue
+		} else if err != nil {
+			deleteErrors = append(deleteErrors, fmt.Errorf("%s could not be deleted: %s", resource, err))
+			continue
+		}
+		cmd.deleted = append(cmd.deleted, resource)
+		fmt.Printf("%s deleted\n", resource)
+	}
+
+	return errors.Join(deleteErrors...)
 }
 
-func (cmd *CmdSystemDelete) WaitUntil() error { return nil }
+func (cmd *CmdSystemDelete) WaitUntil() error {
+
+	if !cmd.wait || len(cmd.deleted) == 0 {
+		return nil
+	}
+
+	waitTime := int(cmd.timeout.Seconds())
+	pending := cmd.deleted
+
+	err := utils.NewSpinnerWithTimeout("Waiting for deletion to complete...", waitTime, func() error {
+		var remaining []*resource
+		for _, resource := range pending {
+			exists, err := resource.exists(context.TODO())
+			if err != nil {
+				return err
+			}
+			if exists {
+				remaining = append(remaining, resource)
+			}
+		}
+		pending = remaining
+		if len(pending) > 0 {
+			return fmt.Errorf("%d resources are not yet deleted", len(pending))
+		}
+		return nil
+	})
+
+	if err != nil {
+		var waitErrors []error
+		for _, resource := range pending {
+			waitErrors = append(waitErrors, fmt.Errorf("%s not deleted yet, check the status for more information", resource))
+		}
+		if len(waitErrors) == 0 {
+			return err
+		}
+		return errors.Join(waitErrors...)
+	}
+
+	fmt.Println("Resources deleted.")
+	return nil
+}
@@ -0,0 +1,194 @@
+package kube
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	fakeclient "github.com/skupperproject/skupper/internal/kube/client/fake"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/spf13/cobra"
+	"gotest.tools/v3/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCmdSystemDelete_ValidateInput(t *testing.T) {
+	type test struct {
+		name          string
+		args          []string
+		flags         *common.CommandSystemDeleteFlags
+		expectedError string
+	}
+
+	testTable := []test{
+		{
+			name:          "arguments are not accepted",
+			args:          []string{"something"},
+			flags:         &common.CommandSystemDeleteFlags{Filename: "-", Timeout: time.Minute},
+			expectedError: "This command does not accept arguments",
+		},
+		{
+			name:          "flag file is not provided",
+			args:          []string{},
+			expectedError: "You need to provide a file to delete custom resources or use standard input.\n Example: cat site.yaml | skupper system delete -f -",
+		},
+		{
+			name:          "provided file has an unsupported extension",
+			flags:         &common.CommandSystemDeleteFlags{Filename: "file.txt", Timeout: time.Minute},
+			expectedError: "The file has an unsupported extension, it should have one of the following: .yaml, .json\nThe file \"file.txt\" does not exist",
+		},
+		{
+			name:          "timeout is not valid",
+			flags:         &common.CommandSystemDeleteFlags{Filename: "-", Timeout: time.Second * 0},
+			expectedError: "timeout is not valid: duration must not be less than 10s; got 0s",
+		},
+		{
+			name:  "standard input is used",
+			flags: &common.CommandSystemDeleteFlags{Filename: "-", Timeout: time.Minute, Wait: true},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+
+			command := &CmdSystemDelete{Flags: test.flags}
+
+			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
+		})
+	}
+}
+
+func TestCmdSystemDelete_Run(t *testing.T) {
+	type test struct {
+		name                string
+		skupperObjects      []runtime.Object
+		skupperErrorMessage string
+		errorMessage        string
+		expectedDeleted     []string
+	}
+
+	testTable := []test{
+		{
+			name: "existing resources are deleted in reverse order",
+			skupperObjects: []runtime.Object{
+				&v2alpha1.Site{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "west",
+						Namespace: "test",
+					},
+				},
+				&v2alpha1.Connector{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "backend",
+						Namespace: "test",
+					},
+				},
+			},
+			expectedDeleted: []string{"Connector backend", "Site west"},
+		},
+		{
+			name: "missing resources are skipped",
+		},
+		{
+			name:                "delete fails",
+			skupperErrorMessage: "error",
+			errorMessage:        "Connector backend could not be deleted: error\nListener backend could not be deleted: error\nSite west could not be deleted: error",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			cmd, err := newCmdSystemDeleteWithMocks("test", nil, test.skupperObjects, test.skupperErrorMessage)
+			assert.Assert(t, err)
+			cmd.CobraCmd.SetIn(strings.NewReader(applyInput))
+
+			err = cmd.Run()
+			if test.errorMessage != "" {
+				assert.Error(t, err, test.errorMessage)
+			} else {
+				assert.Assert(t, err)
+			}
+			var deleted []string
+			for _, resource := range cmd.deleted {
+				deleted = append(deleted, resource.String())
+			}
+			assert.DeepEqual(t, deleted, test.expectedDeleted)
+		})
+	}
+}
+
+func TestCmdSystemDelete_WaitUntil(t *testing.T) {
+	type test struct {
+		name           string
+		wait           bool
+		skupperObjects []runtime.Object
+		expectError    string
+	}
+
+	site := &v2alpha1.Site{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "west",
+			Namespace: "test",
+		},
+	}
+
+	testTable := []test{
+		{
+			name:        "site is deleted",
+			wait:        true,
+			expectError: "",
+		},
+		{
+			name:           "site is not deleted",
+			wait:           true,
+			skupperObjects: []runtime.Object{site},
+			expectError:    "Site west not deleted yet, check the status for more information",
+		},
+		{
+			name:           "site is not deleted but user does not want to wait",
+			wait:           false,
+			skupperObjects: []runtime.Object{site},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			cmd, err := newCmdSystemDeleteWithMocks("test", nil, test.skupperObjects, "")
+			assert.Assert(t, err)
+			cmd.timeout = time.Second
+			cmd.wait = test.wait
+			cmd.deleted = resourcesFor(mustParse(t, applyInput), cmd.Client, cmd.KubeClient, "test")[:1]
+
+			err = cmd.WaitUntil()
+			if test.expectError != "" {
+				assert.Error(t, err, test.expectError)
+			} else {
+				assert.Assert(t, err)
+			}
+		})
+	}
+}
+
+// --- helper methods
+
+func newCmdSystemDeleteWithMocks(namespace string, k8sObjects []runtime.Object, skupperObjects []runtime.Object, fakeSkupperError string) (*CmdSystemDelete, error) {
+
+	// We make sure the interval is appropriate
+	utils.SetRetryProfile(utils.TestRetryProfile)
+	client, err := fakeclient.NewFakeClient(namespace, k8sObjects, skupperObjects, fakeSkupperError)
+	if err != nil {
+		return nil, err
+	}
+	cmdSystemDelete := &CmdSystemDelete{
+		Client:     client.GetSkupperClient().SkupperV2alpha1(),
+		KubeClient: client.GetKubeClient(),
+		CobraCmd:   &cobra.Command{},
+		Namespace:  namespace,
+	}
+
+	return cmdSystemDelete, nil
+}
@@ -0,0 +1,31 @@
+package kube
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+type CmdSystemRenderBundleParameters struct {
+	CobraCmd *cobra.Command
+}
+
+func NewCmdSystemRenderBundleParameters() *CmdSystemRenderBundleParameters {
+
+	skupperCmd := CmdSystemRenderBundleParameters{}
+
+	return &skupperCmd
+}
+
+func (cmd *CmdSystemRenderBundleParameters) NewClient(cobraCommand *cobra.Command, args []string) {}
+
+func (cmd *CmdSystemRenderBundleParameters) ValidateInput(args []string) error { return nil }
+
+func (cmd *CmdSystemRenderBundleParameters) InputToOptions() {}
+
+func (cmd *CmdSystemRenderBundleParameters) Run() error {
+	fmt.Println("This command does not support kubernetes platforms.")
+	return nil
+}
+
+func (cmd *CmdSystemRenderBundleParameters) WaitUntil() error { return nil }
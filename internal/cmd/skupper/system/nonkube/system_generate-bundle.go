@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 type CmdSystemGenerateBundle struct {
@@ -23,6 +24,8 @@ type CmdSystemGenerateBundle struct {
 	Flags           *common.CommandSystemGenerateBundleFlags
 	ConfigBootstrap bootstrap.Config
 	BundleName      string
+	passphrase      string
+	parameters      internalbundle.Parameters
 }
 
 func NewCmdSystemGenerateBundle() *CmdSystemGenerateBundle {
@@ -80,6 +83,30 @@ func (cmd *CmdSystemGenerateBundle) ValidateInput(args []string) error {
 		}
 
 	}
+	if cmd.Flags != nil && len(cmd.Flags.Parameters) > 0 {
+		parameters, err := internalbundle.ParseParameters(cmd.Flags.Parameters)
+		if err != nil {
+			validationErrors = append(validationErrors, fmt.Errorf("Invalid bundle parameter: %v", err))
+		}
+		cmd.parameters = parameters
+	}
+	if cmd.Flags != nil && cmd.Flags.EncryptPassphraseFile != "" {
+		passphrase, err := os.ReadFile(cmd.Flags.EncryptPassphraseFile)
+		if err != nil {
+			validationErrors = append(validationErrors, fmt.Errorf("Unable to read the passphrase file: %v", err))
+		} else if cmd.passphrase = strings.TrimRight(string(passphrase), "\r\n"); cmd.passphrase == "" {
+			validationErrors = append(validationErrors, fmt.Errorf("The passphrase file is empty"))
+		}
+	}
+	if cmd.Flags != nil && (len(cmd.Flags.EncryptRecipients) > 0 || cmd.passphrase != "") {
+		encryption := &internalbundle.Encryption{
+			Recipients: cmd.Flags.EncryptRecipients,
+			Passphrase: cmd.passphrase,
+		}
+		if err := encryption.Validate(); err != nil {
+			validationErrors = append(validationErrors, fmt.Errorf("Invalid bundle encryption: %v", err))
+		}
+	}
 
 	return errors.Join(validationErrors...)
 
@@ -115,6 +142,15 @@ func (cmd *CmdSystemGenerateBundle) InputToOptions() {
 		IsBundle:       isBundle,
 		Platform:       selectedPlatform,
 	}
+	if len(cmd.parameters) > 0 {
+		configBootStrap.Parameters = cmd.parameters
+	}
+	if len(cmd.Flags.EncryptRecipients) > 0 || cmd.passphrase != "" {
+		configBootStrap.Encryption = &internalbundle.Encryption{
+			Recipients: cmd.Flags.EncryptRecipients,
+			Passphrase: cmd.passphrase,
+		}
+	}
 
 	cmd.ConfigBootstrap = configBootStrap
 
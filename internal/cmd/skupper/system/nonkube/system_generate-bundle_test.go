@@ -6,6 +6,7 @@ import (
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
 	"github.com/skupperproject/skupper/internal/config"
 	"github.com/skupperproject/skupper/internal/nonkube/bootstrap"
+	internalbundle "github.com/skupperproject/skupper/internal/nonkube/bundle"
 	"github.com/skupperproject/skupper/pkg/nonkube/api"
 	"gotest.tools/v3/assert"
 	"os"
@@ -55,6 +56,68 @@ func TestCmdSystemGenerateBundle_ValidateInput(t *testing.T) {
 				Input: "./",
 			},
 		},
+		{
+			name: "invalid-parameter",
+			args: []string{"bundle-name"},
+			flags: &common.CommandSystemGenerateBundleFlags{
+				Parameters: []string{"site-name=west"},
+			},
+			expectedError: "Invalid bundle parameter: unknown parameter \"site-name\" (supported parameters: [link-access-host link-access-inter-router-port link-access-edge-port listener-host router-log-level])",
+		},
+		{
+			name: "valid-parameters",
+			args: []string{"bundle-name"},
+			flags: &common.CommandSystemGenerateBundleFlags{
+				Parameters: []string{"listener-host", "router-log-level=info"},
+			},
+		},
+		{
+			name: "invalid-encrypt-recipient",
+			args: []string{"bundle-name"},
+			flags: &common.CommandSystemGenerateBundleFlags{
+				EncryptRecipients: []string{"age1invalid"},
+			},
+			expectedError: "Invalid bundle encryption: invalid recipient \"age1invalid\": malformed recipient \"age1invalid\": invalid character data part: s[0]=105",
+		},
+		{
+			name: "missing-passphrase-file",
+			args: []string{"bundle-name"},
+			flags: &common.CommandSystemGenerateBundleFlags{
+				EncryptPassphraseFile: "/invalid/passphrase",
+			},
+			expectedError: "Unable to read the passphrase file: open /invalid/passphrase: no such file or directory",
+		},
+		{
+			name: "empty-passphrase-file",
+			args: []string{"bundle-name"},
+			flags: &common.CommandSystemGenerateBundleFlags{
+				EncryptPassphraseFile: "testdata/empty-passphrase",
+			},
+			expectedError: "The passphrase file is empty",
+		},
+		{
+			name: "passphrase-and-recipient",
+			args: []string{"bundle-name"},
+			flags: &common.CommandSystemGenerateBundleFlags{
+				EncryptPassphraseFile: "testdata/passphrase",
+				EncryptRecipients:     []string{"age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p"},
+			},
+			expectedError: "Invalid bundle encryption: a passphrase cannot be combined with recipients",
+		},
+		{
+			name: "valid-encrypt-recipient",
+			args: []string{"bundle-name"},
+			flags: &common.CommandSystemGenerateBundleFlags{
+				EncryptRecipients: []string{"age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p"},
+			},
+		},
+		{
+			name: "valid-passphrase-file",
+			args: []string{"bundle-name"},
+			flags: &common.CommandSystemGenerateBundleFlags{
+				EncryptPassphraseFile: "testdata/passphrase",
+			},
+		},
 	}
 
 	for _, test := range testTable {
@@ -85,6 +148,8 @@ func TestCmdSystemGenerateBundle_InputToOptions(t *testing.T) {
 		expectedBundleStrategy string
 		expectedBundleName     string
 		expectedInputPath      string
+		expectedEncryption     bool
+		expectedParameters     internalbundle.Parameters
 	}
 
 	testTable := []test{
@@ -118,6 +183,34 @@ func TestCmdSystemGenerateBundle_InputToOptions(t *testing.T) {
 			expectedIsBundle:       true,
 			expectedBundleStrategy: "tarball",
 		},
+		{
+			name: "encrypted",
+			flags: common.CommandSystemGenerateBundleFlags{
+				Input:             "input-path",
+				Type:              "tarball",
+				EncryptRecipients: []string{"age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p"},
+			},
+			namespace:              "east",
+			platform:               "podman",
+			expectedNamespace:      "east",
+			expectedIsBundle:       true,
+			expectedBundleStrategy: "tarball",
+			expectedEncryption:     true,
+		},
+		{
+			name: "parameters",
+			flags: common.CommandSystemGenerateBundleFlags{
+				Input:      "input-path",
+				Type:       "tarball",
+				Parameters: []string{"listener-host"},
+			},
+			namespace:              "east",
+			platform:               "podman",
+			expectedNamespace:      "east",
+			expectedIsBundle:       true,
+			expectedBundleStrategy: "tarball",
+			expectedParameters:     internalbundle.Parameters{internalbundle.ParameterListenerHost: ""},
+		},
 	}
 
 	for _, test := range testTable {
@@ -128,6 +221,7 @@ func TestCmdSystemGenerateBundle_InputToOptions(t *testing.T) {
 
 			cmd := newCmdSystemGenerateBundleWithMocks(false, false)
 			cmd.Flags = &test.flags
+			cmd.parameters, _ = internalbundle.ParseParameters(test.flags.Parameters)
 			cmd.Namespace = test.namespace
 			cmd.BundleName = "bundle-name"
 
@@ -138,6 +232,8 @@ func TestCmdSystemGenerateBundle_InputToOptions(t *testing.T) {
 			assert.Check(t, cmd.ConfigBootstrap.IsBundle == test.expectedIsBundle)
 			assert.Check(t, strings.Contains(cmd.ConfigBootstrap.InputPath, cmd.Flags.Input))
 			assert.Check(t, string(cmd.ConfigBootstrap.Platform) == test.platform)
+			assert.Check(t, cmd.ConfigBootstrap.Encryption.Enabled() == test.expectedEncryption)
+			assert.DeepEqual(t, cmd.ConfigBootstrap.Parameters, test.expectedParameters)
 		})
 	}
 }
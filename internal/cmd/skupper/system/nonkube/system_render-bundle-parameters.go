@@ -0,0 +1,61 @@
+package nonkube
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/skupperproject/skupper/internal/config"
+	internalbundle "github.com/skupperproject/skupper/internal/nonkube/bundle"
+	"github.com/spf13/cobra"
+)
+
+// CmdSystemRenderBundleParameters applies the values of the parameters
+// declared by a bundle while it is installed. It is run by the
+// installation script through the skupper binary included in the
+// bundle.
+type CmdSystemRenderBundleParameters struct {
+	CobraCmd     *cobra.Command
+	Render       func(namespaceDir string, platform string, values []string) (internalbundle.Parameters, error)
+	namespaceDir string
+	values       []string
+	platform     string
+}
+
+func NewCmdSystemRenderBundleParameters() *CmdSystemRenderBundleParameters {
+
+	skupperCmd := CmdSystemRenderBundleParameters{}
+
+	return &skupperCmd
+}
+
+func (cmd *CmdSystemRenderBundleParameters) NewClient(cobraCommand *cobra.Command, args []string) {
+	cmd.Render = internalbundle.RenderParameters
+}
+
+func (cmd *CmdSystemRenderBundleParameters) ValidateInput(args []string) error {
+	if len(args) == 0 {
+		return errors.New("the directory of the namespace extracted from the bundle must be specified")
+	}
+	cmd.namespaceDir = args[0]
+	cmd.values = args[1:]
+	return nil
+}
+
+func (cmd *CmdSystemRenderBundleParameters) InputToOptions() {
+	cmd.platform = string(config.GetPlatform())
+}
+
+func (cmd *CmdSystemRenderBundleParameters) Run() error {
+	parameters, err := cmd.Render(cmd.namespaceDir, cmd.platform, cmd.values)
+	if err != nil {
+		return err
+	}
+	for _, parameter := range internalbundle.SupportedParameters {
+		if value, ok := parameters[parameter]; ok {
+			fmt.Printf("%s: %s\n", parameter, value)
+		}
+	}
+	return nil
+}
+
+func (cmd *CmdSystemRenderBundleParameters) WaitUntil() error { return nil }
@@ -0,0 +1,83 @@
+package nonkube
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	internalbundle "github.com/skupperproject/skupper/internal/nonkube/bundle"
+	"gotest.tools/v3/assert"
+)
+
+func TestCmdSystemRenderBundleParameters_ValidateInput(t *testing.T) {
+	type test struct {
+		name          string
+		args          []string
+		expectedError string
+	}
+
+	testTable := []test{
+		{
+			name:          "namespace-dir-is-required",
+			expectedError: "the directory of the namespace extracted from the bundle must be specified",
+		},
+		{
+			name: "namespace-dir-and-values",
+			args: []string{"./west", "listener-host=0.0.0.0"},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+
+			command := &CmdSystemRenderBundleParameters{}
+			command.CobraCmd = common.ConfigureCobraCommand(common.PlatformLinux, common.SkupperCmdDescription{}, command, nil)
+
+			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
+		})
+	}
+}
+
+func TestCmdSystemRenderBundleParameters_Run(t *testing.T) {
+	type test struct {
+		name          string
+		renderError   error
+		expectedError string
+	}
+
+	testTable := []test{
+		{
+			name: "runs-ok",
+		},
+		{
+			name:          "render-fails",
+			renderError:   errors.New("invalid parameter"),
+			expectedError: "invalid parameter",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := &CmdSystemRenderBundleParameters{}
+			var renderedDir string
+			var renderedValues []string
+			command.Render = func(namespaceDir string, platform string, values []string) (internalbundle.Parameters, error) {
+				renderedDir = namespaceDir
+				renderedValues = values
+				return internalbundle.Parameters{internalbundle.ParameterListenerHost: "0.0.0.0"}, test.renderError
+			}
+			assert.Assert(t, command.ValidateInput([]string{"./west", "listener-host=0.0.0.0"}))
+			command.InputToOptions()
+
+			err := command.Run()
+			if test.expectedError != "" {
+				assert.Error(t, err, test.expectedError)
+				return
+			}
+			assert.Assert(t, err)
+			assert.Equal(t, renderedDir, "./west")
+			assert.DeepEqual(t, renderedValues, []string{"listener-host=0.0.0.0"})
+		})
+	}
+}
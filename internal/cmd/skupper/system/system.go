@@ -1,6 +1,8 @@
 package system
 
 import (
+	"time"
+
 	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/system/kube"
 	"github.com/skupperproject/skupper/internal/cmd/skupper/system/nonkube"
@@ -35,6 +37,7 @@ approach, which is based on the new set of Custom Resource Definitions (CRDs).`,
 	cmd.AddCommand(CmdSystemGenerateBundleFactory(platform))
 	cmd.AddCommand(CmdSystemApplyFactory(platform))
 	cmd.AddCommand(CmdSystemDeleteFactory(platform))
+	cmd.AddCommand(CmdSystemRenderBundleParametersFactory(platform))
 
 	return cmd
 }
@@ -162,6 +165,9 @@ func CmdSystemGenerateBundleFactory(configuredPlatform common.Platform) *cobra.C
 
 	cmd.Flags().StringVar(&cmdFlags.Input, common.FlagNameInput, "", common.FlagDescInput)
 	cmd.Flags().StringVarP(&cmdFlags.Type, common.FlagNameType, "", "tarball", common.FlagDescType)
+	cmd.Flags().StringSliceVar(&cmdFlags.Parameters, common.FlagNameParameter, nil, common.FlagDescParameter)
+	cmd.Flags().StringSliceVar(&cmdFlags.EncryptRecipients, common.FlagNameEncryptRecipient, nil, common.FlagDescEncryptRecipient)
+	cmd.Flags().StringVar(&cmdFlags.EncryptPassphraseFile, common.FlagNameEncryptPassphraseFile, "", common.FlagDescEncryptPassphraseFile)
 
 	kubeCommand.CobraCmd = cmd
 	kubeCommand.Flags = &cmdFlags
@@ -173,7 +179,6 @@ func CmdSystemGenerateBundleFactory(configuredPlatform common.Platform) *cobra.C
 
 func CmdSystemApplyFactory(configuredPlatform common.Platform) *cobra.Command {
 
-	//This implementation will warn the user that the command is not available for Kubernetes environments.
 	kubeCommand := kube.NewCmdSystemApply()
 	nonKubeCommand := nonkube.NewCmdSystemApply()
 
@@ -182,7 +187,8 @@ func CmdSystemApplyFactory(configuredPlatform common.Platform) *cobra.Command {
 		Short: "Create or update resources using files or standard input.",
 		Long:  "Create or update resources using files or standard input.",
 		Example: `skupper system apply -f ~/my-site.yaml
-skupper link generate | skupper system apply -f -`,
+skupper link generate | skupper system apply -f -
+skupper system apply -f ~/my-site.yaml --wait ready`,
 	}
 
 	cmd := common.ConfigureCobraCommand(configuredPlatform, cmdSystemApplyDesc, kubeCommand, nonKubeCommand)
@@ -190,6 +196,10 @@ skupper link generate | skupper system apply -f -`,
 	cmdFlags := common.CommandSystemApplyFlags{}
 
 	cmd.Flags().StringVarP(&cmdFlags.Filename, common.FlagNameFileName, "f", "", common.FlagDescFileName)
+	if configuredPlatform == common.PlatformKubernetes {
+		cmd.Flags().DurationVar(&cmdFlags.Timeout, common.FlagNameTimeout, 60*time.Second, common.FlagDescTimeout)
+		cmd.Flags().StringVar(&cmdFlags.Wait, common.FlagNameWait, "configured", common.FlagDescWait)
+	}
 
 	kubeCommand.CobraCmd = cmd
 	kubeCommand.Flags = &cmdFlags
@@ -201,14 +211,14 @@ skupper link generate | skupper system apply -f -`,
 
 func CmdSystemDeleteFactory(configuredPlatform common.Platform) *cobra.Command {
 
-	//This implementation will warn the user that the command is not available for Kubernetes environments.
 	kubeCommand := kube.NewCmdSystemDelete()
 	nonKubeCommand := nonkube.NewCmdSystemDelete()
 
 	cmdSystemDeleteDesc := common.SkupperCmdDescription{
-		Use:   "delete",
-		Short: "Delete resources using files or standard input.",
-		Long:  "Delete resources using files or standard input.",
+		Use:     "delete",
+		Short:   "Delete resources using files or standard input.",
+		Long:    "Delete resources using files or standard input.",
+		Example: `skupper system delete -f ~/my-site.yaml`,
 	}
 
 	cmd := common.ConfigureCobraCommand(configuredPlatform, cmdSystemDeleteDesc, kubeCommand, nonKubeCommand)
@@ -216,6 +226,10 @@ func CmdSystemDeleteFactory(configuredPlatform common.Platform) *cobra.Command {
 	cmdFlags := common.CommandSystemDeleteFlags{}
 
 	cmd.Flags().StringVarP(&cmdFlags.Filename, common.FlagNameFileName, "f", "", common.FlagDescFileName)
+	if configuredPlatform == common.PlatformKubernetes {
+		cmd.Flags().DurationVar(&cmdFlags.Timeout, common.FlagNameTimeout, 60*time.Second, common.FlagDescTimeout)
+		cmd.Flags().BoolVar(&cmdFlags.Wait, common.FlagNameWait, true, common.FlagDescDeleteWait)
+	}
 
 	kubeCommand.CobraCmd = cmd
 	kubeCommand.Flags = &cmdFlags
@@ -224,3 +238,24 @@ func CmdSystemDeleteFactory(configuredPlatform common.Platform) *cobra.Command {
 
 	return cmd
 }
+
+func CmdSystemRenderBundleParametersFactory(configuredPlatform common.Platform) *cobra.Command {
+
+	//This implementation will warn the user that the command is not available for Kubernetes environments.
+	kubeCommand := kube.NewCmdSystemRenderBundleParameters()
+	nonKubeCommand := nonkube.NewCmdSystemRenderBundleParameters()
+
+	cmdSystemRenderBundleParametersDesc := common.SkupperCmdDescription{
+		Use:   "render-bundle-parameters <namespace-dir> [name=value ...]",
+		Short: "Apply the values of the parameters declared by a site bundle",
+		Long:  "Apply the values of the parameters declared by a site bundle to the site definition extracted from it. This command is run by the bundle installation script.",
+	}
+
+	cmd := common.ConfigureCobraCommand(configuredPlatform, cmdSystemRenderBundleParametersDesc, kubeCommand, nonKubeCommand)
+	cmd.Hidden = true
+
+	kubeCommand.CobraCmd = cmd
+	nonKubeCommand.CobraCmd = cmd
+
+	return cmd
+}
@@ -36,8 +36,11 @@ func TestCmdSystemFactory(t *testing.T) {
 		{
 			name: "CmdSystemGenerateBundleFactory",
 			expectedFlagsWithDefaultValue: map[string]interface{}{
-				common.FlagNameInput: "",
-				common.FlagNameType:  "tarball",
+				common.FlagNameInput:                 "",
+				common.FlagNameType:                  "tarball",
+				common.FlagNameParameter:             "[]",
+				common.FlagNameEncryptRecipient:      "[]",
+				common.FlagNameEncryptPassphraseFile: "",
 			},
 			command: CmdSystemGenerateBundleFactory(common.PlatformPodman),
 		},
@@ -57,16 +60,39 @@ func TestCmdSystemFactory(t *testing.T) {
 			name: "CmdSystemApplyFactory",
 			expectedFlagsWithDefaultValue: map[string]interface{}{
 				common.FlagNameFileName: "",
+				common.FlagNameTimeout:  "1m0s",
+				common.FlagNameWait:     "configured",
 			},
 			command: CmdSystemApplyFactory(common.PlatformKubernetes),
 		},
+		{
+			name: "CmdSystemApplyFactory non kube",
+			expectedFlagsWithDefaultValue: map[string]interface{}{
+				common.FlagNameFileName: "",
+			},
+			command: CmdSystemApplyFactory(common.PlatformPodman),
+		},
 		{
 			name: "CmdSystemDeleteFactory",
 			expectedFlagsWithDefaultValue: map[string]interface{}{
 				common.FlagNameFileName: "",
+				common.FlagNameTimeout:  "1m0s",
+				common.FlagNameWait:     "true",
 			},
 			command: CmdSystemDeleteFactory(common.PlatformKubernetes),
 		},
+		{
+			name: "CmdSystemDeleteFactory non kube",
+			expectedFlagsWithDefaultValue: map[string]interface{}{
+				common.FlagNameFileName: "",
+			},
+			command: CmdSystemDeleteFactory(common.PlatformPodman),
+		},
+		{
+			name:                          "CmdSystemRenderBundleParametersFactory",
+			expectedFlagsWithDefaultValue: map[string]interface{}{},
+			command:                       CmdSystemRenderBundleParametersFactory(common.PlatformPodman),
+		},
 	}
 
 	for _, test := range testTable {
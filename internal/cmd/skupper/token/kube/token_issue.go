@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -137,6 +138,16 @@ func (cmd *CmdTokenIssue) ValidateInput(args []string) error {
 		}
 	}
 
+	if cmd.Flags != nil {
+		for _, source := range cmd.Flags.AllowedSources {
+			if net.ParseIP(source) == nil {
+				if _, _, err := net.ParseCIDR(source); err != nil {
+					validationErrors = append(validationErrors, fmt.Errorf("allowed source is not valid: %q is neither an address nor a CIDR range", source))
+				}
+			}
+		}
+	}
+
 	selectedCost, err := strconv.Atoi(cmd.Flags.Cost)
 	if err != nil {
 		validationErrors = append(validationErrors, fmt.Errorf("link cost is not valid: %s", err))
@@ -163,6 +174,8 @@ func (cmd *CmdTokenIssue) Run() error {
 		Spec: v2alpha1.AccessGrantSpec{
 			RedemptionsAllowed: cmd.Flags.RedemptionsAllowed,
 			ExpirationWindow:   cmd.Flags.ExpirationWindow.String(),
+			AllowedSourceCIDRs: cmd.Flags.AllowedSources,
+			ExpectedSiteName:   cmd.Flags.ExpectedSite,
 		},
 	}
 
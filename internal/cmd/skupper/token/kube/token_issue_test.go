@@ -536,6 +536,51 @@ func TestCmdTokenIssue_ValidateInput(t *testing.T) {
 			},
 			expectedError: `link cost is not valid: strconv.Atoi: parsing "Not-valid": invalid syntax`,
 		},
+		{
+			name: "allowed source is not valid",
+			args: []string{"token.yaml"},
+			flags: common.CommandTokenIssueFlags{
+				ExpirationWindow:   15 * time.Minute,
+				RedemptionsAllowed: 1,
+				Timeout:            60 * time.Second,
+				Cost:               "1",
+				AllowedSources:     []string{"10.0.0.0/8", "192.0.2.10", "not-an-address"},
+			},
+			skupperObjects: []runtime.Object{
+				&v2alpha1.SiteList{
+					Items: []v2alpha1.Site{
+						{
+							ObjectMeta: v1.ObjectMeta{
+								Name:      "site1",
+								Namespace: "test",
+							},
+							Spec: v2alpha1.SiteSpec{
+								LinkAccess: "default",
+							},
+							Status: v2alpha1.SiteStatus{
+								Status: v2alpha1.Status{
+									Conditions: []v1.Condition{
+										{
+											Type:   "Configured",
+											Status: "True",
+										},
+										{
+											Type:   "Running",
+											Status: "True",
+										},
+										{
+											Type:   "Ready",
+											Status: "True",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: `allowed source is not valid: "not-an-address" is neither an address nor a CIDR range`,
+		},
 		{
 			name: "link access is not valid",
 			args: []string{"token.yaml"},
@@ -585,6 +630,8 @@ func TestCmdTokenIssue_ValidateInput(t *testing.T) {
 				RedemptionsAllowed: 1,
 				Timeout:            60 * time.Second,
 				Cost:               "5",
+				AllowedSources:     []string{"10.0.0.0/8", "2001:db8::/32"},
+				ExpectedSite:       "west",
 			},
 			skupperObjects: []runtime.Object{
 				&v2alpha1.SiteList{
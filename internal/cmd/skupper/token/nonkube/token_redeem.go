@@ -20,6 +20,7 @@ type CmdTokenRedeem struct {
 	Flags         *common.CommandTokenRedeemFlags
 	Namespace     string
 	siteName      string
+	siteHandler   *fs.SiteHandler
 	linkHandler   *fs.LinkHandler
 	secretHandler *fs.SecretHandler
 	fileName      string
@@ -35,6 +36,7 @@ func (cmd *CmdTokenRedeem) NewClient(cobraCommand *cobra.Command, args []string)
 		cmd.Namespace = cmd.CobraCmd.Flag(common.FlagNameNamespace).Value.String()
 	}
 
+	cmd.siteHandler = fs.NewSiteHandler(cmd.Namespace)
 	cmd.linkHandler = fs.NewLinkHandler(cmd.Namespace)
 	cmd.secretHandler = fs.NewSecretHandler(cmd.Namespace)
 }
@@ -91,9 +93,16 @@ func (cmd *CmdTokenRedeem) Run() error {
 	}
 	cmd.name = accessToken.Name
 
+	// the site name, when defined, lets grants restricted to an expected site be redeemed
+	if cmd.siteHandler != nil {
+		if sites, err := cmd.siteHandler.List(fs.GetOptions{}); err == nil && len(sites) > 0 {
+			cmd.siteName = sites[0].Name
+		}
+	}
+
 	// redeem the access token and store the secret and links into the input resources path
 	// to redeem the token we use the namespace as a subject to allow redeeming tokens without an active site.
-	decoder, err := nonkubecommon.RedeemAccessToken(&accessToken, cmd.Namespace)
+	decoder, err := nonkubecommon.RedeemAccessToken(&accessToken, cmd.Namespace, cmd.siteName)
 	if err != nil {
 		return err
 	}
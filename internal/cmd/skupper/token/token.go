@@ -35,10 +35,11 @@ func CmdTokenIssueFactory(configuredPlatform common.Platform) *cobra.Command {
 	nonKubeCommand := nonkube.NewCmdTokenIssue()
 
 	cmdTokenIssueDesc := common.SkupperCmdDescription{
-		Use:     "issue <fileName>",
-		Short:   "issue a token",
-		Long:    "Issue a token file redeemable for a link to the current site.",
-		Example: "skupper token issue ~/token1.yaml",
+		Use:   "issue <fileName>",
+		Short: "issue a token",
+		Long:  "Issue a token file redeemable for a link to the current site.",
+		Example: `skupper token issue ~/token1.yaml
+skupper token issue ~/token1.yaml --allowed-source 203.0.113.0/24 --expected-site west`,
 	}
 
 	cmd := common.ConfigureCobraCommand(configuredPlatform, cmdTokenIssueDesc, kubeCommand, nonKubeCommand)
@@ -49,6 +50,8 @@ func CmdTokenIssueFactory(configuredPlatform common.Platform) *cobra.Command {
 	cmd.Flags().DurationVar(&cmdFlags.ExpirationWindow, common.FlagNameExpirationWindow, 15*time.Minute, common.FlagDescExpirationWindow)
 	cmd.Flags().DurationVar(&cmdFlags.Timeout, common.FlagNameTimeout, 60*time.Second, common.FlagDescTimeout)
 	cmd.Flags().StringVar(&cmdFlags.Cost, common.FlagNameCost, "1", common.FlagDescCost)
+	cmd.Flags().StringSliceVar(&cmdFlags.AllowedSources, common.FlagNameAllowedSource, []string{}, common.FlagDescAllowedSource)
+	cmd.Flags().StringVar(&cmdFlags.ExpectedSite, common.FlagNameExpectedSite, "", common.FlagDescExpectedSite)
 
 	kubeCommand.CobraCmd = cmd
 	kubeCommand.Flags = &cmdFlags
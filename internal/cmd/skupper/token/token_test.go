@@ -26,6 +26,8 @@ func TestCmdTokenFactory(t *testing.T) {
 				common.FlagNameExpirationWindow:   "15m0s",
 				common.FlagNameRedemptionsAllowed: "1",
 				common.FlagNameCost:               "1",
+				common.FlagNameAllowedSource:      "[]",
+				common.FlagNameExpectedSite:       "",
 			},
 			command: CmdTokenIssueFactory(common.PlatformKubernetes),
 		},
@@ -0,0 +1,179 @@
+package dashboard
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	observerclient "github.com/skupperproject/skupper/pkg/networkobserver/client"
+	"golang.org/x/term"
+)
+
+const (
+	clearScreen = "\033[H\033[2J"
+
+	keyCtrlC     = 3
+	keyBackspace = 8
+	keyEnter     = 13
+	keyNewline   = 10
+	keyEscape    = 27
+	keyDelete    = 127
+)
+
+// Dashboard periodically renders a snapshot of the network retrieved
+// from the network observer. When its input is a terminal, keys change
+// the sort order and the filter of the view:
+//
+//	s    cycle through the sort keys
+//	/    edit the filter, applied with enter or discarded with escape
+//	q    quit
+type Dashboard struct {
+	Client   *observerclient.Observer
+	View     View
+	Interval time.Duration
+	Out      io.Writer
+	In       *os.File
+	Now      func() time.Time
+
+	snapshot *Snapshot
+	err      error
+	editing  bool
+	input    string
+}
+
+func NewDashboard(client *observerclient.Observer, view View, interval time.Duration) *Dashboard {
+	return &Dashboard{
+		Client:   client,
+		View:     view,
+		Interval: interval,
+		Out:      os.Stdout,
+		In:       os.Stdin,
+		Now:      time.Now,
+	}
+}
+
+// Run refreshes the dashboard until the context is done or the user
+// quits. It fails if the network observer cannot be reached when it
+// starts; later failures are displayed while the last snapshot is kept.
+func (d *Dashboard) Run(ctx context.Context) error {
+	if err := d.refresh(ctx); err != nil {
+		return err
+	}
+
+	raw := false
+	keys := make(chan byte)
+	if d.In != nil && term.IsTerminal(int(d.In.Fd())) {
+		state, err := term.MakeRaw(int(d.In.Fd()))
+		if err != nil {
+			return fmt.Errorf("failed to configure the terminal: %w", err)
+		}
+		defer term.Restore(int(d.In.Fd()), state)
+		raw = true
+		go readKeys(d.In, keys)
+	}
+
+	ticker := time.NewTicker(d.Interval)
+	defer ticker.Stop()
+	for {
+		if err := d.draw(raw); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			d.err = d.refresh(ctx)
+		case key := <-keys:
+			if d.HandleKey(key) {
+				return nil
+			}
+		}
+	}
+}
+
+func (d *Dashboard) refresh(ctx context.Context) error {
+	snapshot, err := Collect(ctx, d.Client, d.Now())
+	if err != nil {
+		return err
+	}
+	d.snapshot = snapshot
+	return nil
+}
+
+func (d *Dashboard) draw(raw bool) error {
+	var frame bytes.Buffer
+	frame.WriteString(clearScreen)
+	if err := d.View.Render(&frame, d.snapshot); err != nil {
+		return err
+	}
+	fmt.Fprintln(&frame)
+	if d.err != nil {
+		fmt.Fprintf(&frame, "error: %s\n", d.err)
+	}
+	if d.editing {
+		fmt.Fprintf(&frame, "filter: %s", d.input)
+	} else if raw {
+		fmt.Fprint(&frame, "s: sort  /: filter  q: quit")
+	}
+	output := frame.String()
+	if raw {
+		// the terminal does not return the cursor to the start of the
+		// line on a new line while in raw mode
+		output = strings.ReplaceAll(output, "\n", "\r\n")
+	}
+	_, err := io.WriteString(d.Out, output)
+	return err
+}
+
+// HandleKey updates the view with the given key, returning true when
+// the user asked to quit.
+func (d *Dashboard) HandleKey(key byte) bool {
+	if key == keyCtrlC {
+		return true
+	}
+	if d.editing {
+		switch key {
+		case keyEnter, keyNewline:
+			d.View.Filter = d.input
+			d.editing = false
+		case keyEscape:
+			d.editing = false
+		case keyBackspace, keyDelete:
+			if len(d.input) > 0 {
+				d.input = d.input[:len(d.input)-1]
+			}
+		default:
+			if key >= ' ' && key < keyDelete {
+				d.input += string(key)
+			}
+		}
+		return false
+	}
+	switch key {
+	case 'q':
+		return true
+	case 's':
+		d.View.Sort = d.View.NextSort()
+	case '/':
+		d.editing = true
+		d.input = d.View.Filter
+	}
+	return false
+}
+
+func readKeys(in io.Reader, keys chan<- byte) {
+	buffer := make([]byte, 16)
+	for {
+		n, err := in.Read(buffer)
+		if err != nil {
+			return
+		}
+		for _, key := range buffer[:n] {
+			keys <- key
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package dashboard
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	observerclient "github.com/skupperproject/skupper/pkg/networkobserver/client"
+	"gotest.tools/v3/assert"
+)
+
+var now = time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+func micros(d time.Duration) uint64 {
+	return uint64(now.Add(-d).UnixMicro())
+}
+
+func newObserver(t *testing.T, endpoint string) *observerclient.Observer {
+	t.Helper()
+	obs, err := observerclient.NewObserver(observerclient.Config{Endpoint: endpoint})
+	assert.Assert(t, err)
+	return obs
+}
+
+// newFakeObserver serves canned network observer responses, recording
+// the query of each request in queries when it is not nil.
+func newFakeObserver(t *testing.T, queries map[string]url.Values) *httptest.Server {
+	t.Helper()
+	responses := map[string]string{
+		"/api/v2alpha1/sites": `{"count": 2, "results": [
+			{"identity": "s2", "name": "west", "namespace": "west", "platform": "kubernetes", "version": "2.0.0", "routerCount": 1},
+			{"identity": "s1", "name": "east", "namespace": "east", "platform": "podman", "version": "2.0.0", "routerCount": 1}
+		]}`,
+		"/api/v2alpha1/routerlinks": `{"count": 1, "results": [
+			{"identity": "l1", "name": "east-link", "status": "up", "cost": 1, "sourceSiteName": "east", "destinationSiteName": "west", "octetCount": 1024, "octetReverseCount": 1024}
+		]}`,
+		"/api/v2alpha1/connections": `{"count": 4, "results": [
+			{"identity": "c1", "routingKey": "backend", "sourceProcessName": "frontend", "sourceSiteName": "east", "destProcessName": "backend", "destSiteName": "west",
+			 "octetCount": 3000, "octetReverseCount": 1000, "startTime": ` + itoa(micros(10*time.Minute)) + `, "endTime": ` + itoa(micros(9*time.Minute)) + `},
+			{"identity": "c2", "routingKey": "backend", "sourceProcessName": "frontend", "sourceSiteName": "east", "destProcessName": "backend", "destSiteName": "west",
+			 "octetCount": 500, "octetReverseCount": 500, "startTime": ` + itoa(micros(30*time.Second)) + `, "endTime": 0},
+			{"identity": "c3", "routingKey": "database", "sourceProcessName": "backend", "sourceSiteName": "west", "destProcessName": "postgres", "destSiteName": "east",
+			 "octetCount": 100, "octetReverseCount": 100, "startTime": ` + itoa(micros(20*time.Second)) + `, "endTime": 0},
+			{"identity": "c4", "routingKey": "database", "sourceProcessName": "backend", "sourceSiteName": "west", "destProcessName": "postgres", "destSiteName": "east",
+			 "octetCount": 0, "octetReverseCount": 0, "startTime": ` + itoa(micros(10*time.Second)) + `, "endTime": ` + itoa(micros(10*time.Second)) + `,
+			 "connectorError": "connection refused"}
+		]}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if queries != nil {
+			queries[r.URL.Path] = r.URL.Query()
+		}
+		response, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func itoa(n uint64) string {
+	return strconv.FormatUint(n, 10)
+}
+
+func sortTraffic(traffic []Traffic) []Traffic {
+	sort.Slice(traffic, func(i, j int) bool {
+		return traffic[i].Name < traffic[j].Name
+	})
+	return traffic
+}
+
+func TestCollect(t *testing.T) {
+	queries := map[string]url.Values{}
+	server := newFakeObserver(t, queries)
+
+	snapshot, err := Collect(context.Background(), newObserver(t, server.URL), now)
+	assert.Assert(t, err)
+
+	connections := queries["/api/v2alpha1/connections"]
+	limit, err := strconv.Atoi(connections.Get("limit"))
+	assert.Assert(t, err)
+	assert.Assert(t, limit > 0 && limit <= connectionLimit)
+	assert.Equal(t, connections.Get("sortBy"), "startTime.desc")
+
+	assert.Equal(t, len(snapshot.Sites), 2)
+	assert.Equal(t, snapshot.Sites[0].Name, "east")
+	assert.Equal(t, len(snapshot.Links), 1)
+	assert.DeepEqual(t, sortTraffic(snapshot.RoutingKeys), []Traffic{
+		{Name: "backend", Bytes: 5000, Connections: 2, Active: 1, Rate: 1},
+		{Name: "database", Bytes: 200, Connections: 2, Active: 1, Rate: 2},
+	})
+	assert.DeepEqual(t, sortTraffic(snapshot.ProcessPairs), []Traffic{
+		{Name: "backend@west", Peer: "postgres@east", Bytes: 200, Connections: 2, Active: 1, Rate: 2},
+		{Name: "frontend@east", Peer: "backend@west", Bytes: 5000, Connections: 2, Active: 1, Rate: 1},
+	})
+	assert.Equal(t, len(snapshot.Errors), 1)
+	assert.Equal(t, snapshot.Errors[0].Message, "connection refused")
+	assert.Equal(t, snapshot.Errors[0].Destination, "postgres@east")
+}
+
+func TestCollectError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	_, err := Collect(context.Background(), newObserver(t, server.URL), now)
+	assert.ErrorContains(t, err, "failed to retrieve sites: network observer responded with 401 Unauthorized")
+}
+
+func TestRender(t *testing.T) {
+	server := newFakeObserver(t, nil)
+	snapshot, err := Collect(context.Background(), newObserver(t, server.URL), now)
+	assert.Assert(t, err)
+
+	testTable := []struct {
+		name        string
+		view        View
+		expected    []string
+		notExpected []string
+		order       []string
+	}{
+		{
+			name: "sorted by bytes",
+			view: View{Sort: SortBytes, Rows: 10},
+			expected: []string{
+				"sort: bytes    filter: <none>",
+				"SITES (2)",
+				"LINKS (1)",
+				"east-link  east         west              up      1     2.0 KiB",
+				"connection refused",
+			},
+			order: []string{"frontend@east", "backend@west   postgres@east"},
+		},
+		{
+			name:  "sorted by rate",
+			view:  View{Sort: SortRate, Rows: 10},
+			order: []string{"database", "backend "},
+		},
+		{
+			name:  "sorted by name",
+			view:  View{Sort: SortName, Rows: 10},
+			order: []string{"backend@west   postgres@east", "frontend@east"},
+		},
+		{
+			name:        "filtered",
+			view:        View{Sort: SortBytes, Filter: "POSTGRES", Rows: 10},
+			expected:    []string{"filter: POSTGRES", "SITES (0)", "LINKS (0)", "connection refused"},
+			notExpected: []string{"frontend"},
+		},
+		{
+			name:        "limited rows",
+			view:        View{Sort: SortBytes, Rows: 1},
+			expected:    []string{"frontend@east"},
+			notExpected: []string{"postgres@east   200 B"},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			var out bytes.Buffer
+			assert.Assert(t, test.view.Render(&out, snapshot))
+			frame := out.String()
+			for _, expected := range test.expected {
+				assert.Assert(t, strings.Contains(frame, expected), "%q not found in:\n%s", expected, frame)
+			}
+			for _, notExpected := range test.notExpected {
+				assert.Assert(t, !strings.Contains(frame, notExpected), "%q found in:\n%s", notExpected, frame)
+			}
+			routingKeys := frame[strings.Index(frame, "ROUTING KEYS"):]
+			last := -1
+			for _, entry := range test.order {
+				index := strings.Index(routingKeys, entry)
+				assert.Assert(t, index > last, "%q out of order in:\n%s", entry, routingKeys)
+				last = index
+			}
+		})
+	}
+}
+
+func TestHandleKey(t *testing.T) {
+	d := &Dashboard{View: View{Sort: SortBytes}}
+
+	assert.Assert(t, !d.HandleKey('s'))
+	assert.Equal(t, d.View.Sort, SortRate)
+	assert.Assert(t, !d.HandleKey('s'))
+	assert.Assert(t, !d.HandleKey('s'))
+	assert.Equal(t, d.View.Sort, SortBytes)
+
+	for _, key := range []byte("/db") {
+		assert.Assert(t, !d.HandleKey(key))
+	}
+	assert.Equal(t, d.View.Filter, "")
+	assert.Assert(t, !d.HandleKey(keyDelete))
+	assert.Assert(t, !d.HandleKey('x'))
+	assert.Assert(t, !d.HandleKey('q'), "q is part of the filter while editing")
+	assert.Assert(t, !d.HandleKey(keyEnter))
+	assert.Equal(t, d.View.Filter, "dxq")
+
+	assert.Assert(t, !d.HandleKey('/'))
+	assert.Assert(t, !d.HandleKey('z'))
+	assert.Assert(t, !d.HandleKey(keyEscape))
+	assert.Equal(t, d.View.Filter, "dxq")
+
+	assert.Assert(t, d.HandleKey('q'))
+	assert.Assert(t, d.HandleKey(keyCtrlC))
+}
+
+func TestRun(t *testing.T) {
+	server := newFakeObserver(t, nil)
+	var out bytes.Buffer
+	d := NewDashboard(newObserver(t, server.URL), View{Sort: SortBytes, Rows: 10}, 50*time.Millisecond)
+	d.Out = &out
+	d.In = nil
+	d.Now = func() time.Time { return now }
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	assert.Assert(t, d.Run(ctx))
+
+	assert.Assert(t, strings.Count(out.String(), clearScreen) > 1, "dashboard not refreshed")
+	assert.Assert(t, strings.Contains(out.String(), "frontend@east"))
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, FormatBytes(0), "0 B")
+	assert.Equal(t, FormatBytes(1023), "1023 B")
+	assert.Equal(t, FormatBytes(1536), "1.5 KiB")
+	assert.Equal(t, FormatBytes(5*1024*1024*1024), "5.0 GiB")
+}
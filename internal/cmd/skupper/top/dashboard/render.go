@@ -0,0 +1,177 @@
+package dashboard
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	observerclient "github.com/skupperproject/skupper/pkg/networkobserver/client"
+)
+
+type SortKey string
+
+const (
+	SortBytes SortKey = "bytes"
+	SortRate  SortKey = "rate"
+	SortName  SortKey = "name"
+)
+
+var SortKeys = []string{string(SortBytes), string(SortRate), string(SortName)}
+
+// View holds the display options of the dashboard that can be changed
+// while it runs.
+type View struct {
+	Sort   SortKey
+	Filter string
+	// Rows limits the number of routing keys, process pairs and errors
+	// displayed.
+	Rows int
+}
+
+// NextSort returns the sort key following the current one.
+func (v View) NextSort() SortKey {
+	for i, key := range SortKeys {
+		if key == string(v.Sort) {
+			return SortKey(SortKeys[(i+1)%len(SortKeys)])
+		}
+	}
+	return SortBytes
+}
+
+// Render writes a frame of the dashboard displaying the given snapshot.
+func (v View) Render(w io.Writer, snapshot *Snapshot) error {
+	filter := v.Filter
+	if filter == "" {
+		filter = "<none>"
+	}
+	fmt.Fprintf(w, "skupper top - %s    sort: %s    filter: %s\n\n", snapshot.Time.Format("2006-01-02 15:04:05"), v.Sort, filter)
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	var sites []observerclient.SiteRecord
+	for _, site := range snapshot.Sites {
+		if v.matches(site.Name, stringValue(site.Namespace), string(site.Platform)) {
+			sites = append(sites, site)
+		}
+	}
+	fmt.Fprintf(tw, "SITES (%d)\n", len(sites))
+	fmt.Fprintln(tw, "NAME\tNAMESPACE\tPLATFORM\tVERSION\tROUTERS")
+	for _, site := range sites {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\n", site.Name, stringValue(site.Namespace), site.Platform, site.Version, site.RouterCount)
+	}
+
+	var links []observerclient.RouterLinkRecord
+	for _, link := range snapshot.Links {
+		if v.matches(link.Name, link.SourceSiteName, stringValue(link.DestinationSiteName)) {
+			links = append(links, link)
+		}
+	}
+	fmt.Fprintf(tw, "\nLINKS (%d)\n", len(links))
+	fmt.Fprintln(tw, "NAME\tSOURCE-SITE\tDESTINATION-SITE\tSTATUS\tCOST\tBYTES")
+	for _, link := range links {
+		cost := "-"
+		if link.Cost != nil {
+			cost = strconv.FormatUint(*link.Cost, 10)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", link.Name, link.SourceSiteName, stringValue(link.DestinationSiteName),
+			link.Status, cost, FormatBytes(link.OctetCount+link.OctetReverseCount))
+	}
+
+	fmt.Fprintln(tw, "\nROUTING KEYS")
+	fmt.Fprintln(tw, "ROUTING-KEY\tBYTES\tCONNECTIONS\tACTIVE\tCONN/MIN")
+	for _, traffic := range v.top(snapshot.RoutingKeys) {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\n", traffic.Name, FormatBytes(traffic.Bytes), traffic.Connections, traffic.Active, traffic.Rate)
+	}
+
+	fmt.Fprintln(tw, "\nPROCESS PAIRS")
+	fmt.Fprintln(tw, "SOURCE\tDESTINATION\tBYTES\tCONNECTIONS\tACTIVE\tCONN/MIN")
+	for _, traffic := range v.top(snapshot.ProcessPairs) {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\t%d\n", traffic.Name, traffic.Peer, FormatBytes(traffic.Bytes), traffic.Connections, traffic.Active, traffic.Rate)
+	}
+
+	fmt.Fprintln(tw, "\nRECENT ERRORS")
+	fmt.Fprintln(tw, "TIME\tROUTING-KEY\tSOURCE\tDESTINATION\tERROR")
+	var errorCount int
+	for _, connectionError := range snapshot.Errors {
+		if v.Rows > 0 && errorCount == v.Rows {
+			break
+		}
+		if !v.matches(connectionError.RoutingKey, connectionError.Source, connectionError.Destination, connectionError.Message) {
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", connectionError.Time.Format("15:04:05"), connectionError.RoutingKey,
+			connectionError.Source, connectionError.Destination, connectionError.Message)
+		errorCount++
+	}
+	return tw.Flush()
+}
+
+// top returns the entries matching the filter, in the order of the sort
+// key and limited to the number of rows of the view.
+func (v View) top(entries []Traffic) []Traffic {
+	var result []Traffic
+	for _, traffic := range entries {
+		if v.matches(traffic.Name, traffic.Peer) {
+			result = append(result, traffic)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		switch v.Sort {
+		case SortRate:
+			if a.Rate != b.Rate {
+				return a.Rate > b.Rate
+			}
+		case SortName:
+		default:
+			if a.Bytes != b.Bytes {
+				return a.Bytes > b.Bytes
+			}
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Peer < b.Peer
+	})
+	if v.Rows > 0 && len(result) > v.Rows {
+		result = result[:v.Rows]
+	}
+	return result
+}
+
+func (v View) matches(values ...string) bool {
+	if v.Filter == "" {
+		return true
+	}
+	filter := strings.ToLower(v.Filter)
+	for _, value := range values {
+		if strings.Contains(strings.ToLower(value), filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatBytes formats a number of bytes using binary units.
+func FormatBytes(bytes uint64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := uint64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,148 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	observerclient "github.com/skupperproject/skupper/pkg/networkobserver/client"
+)
+
+const (
+	// rateWindow is the period over which connection rates are measured.
+	rateWindow = time.Minute
+	// connectionLimit bounds the number of connections retrieved for a
+	// snapshot, keeping the most recently started.
+	connectionLimit = 2000
+)
+
+// Traffic aggregates the connections sharing a routing key or a pair
+// of processes.
+type Traffic struct {
+	Name        string
+	Peer        string
+	Bytes       uint64
+	Connections int
+	Active      int
+	// Rate is the number of connections opened during the last minute.
+	Rate int
+}
+
+// ConnectionError is a connection that failed at either of its ends.
+type ConnectionError struct {
+	Time        time.Time
+	RoutingKey  string
+	Source      string
+	Destination string
+	Message     string
+}
+
+// Snapshot is the state of the network displayed by a single frame of
+// the dashboard.
+type Snapshot struct {
+	Time         time.Time
+	Sites        []observerclient.SiteRecord
+	Links        []observerclient.RouterLinkRecord
+	RoutingKeys  []Traffic
+	ProcessPairs []Traffic
+	Errors       []ConnectionError
+}
+
+// Collect retrieves the records of the network from the network
+// observer and aggregates them into a snapshot taken at the given time.
+func Collect(ctx context.Context, client *observerclient.Observer, now time.Time) (*Snapshot, error) {
+	sites, err := client.Sites(ctx, observerclient.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve sites: %w", err)
+	}
+	links, err := client.RouterLinks(ctx, observerclient.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve router links: %w", err)
+	}
+	connections, err := client.Connections(ctx, observerclient.ListOptions{
+		SortBy: "startTime.desc",
+		Limit:  connectionLimit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve connections: %w", err)
+	}
+	return NewSnapshot(now, sites, links, connections), nil
+}
+
+func NewSnapshot(now time.Time, sites []observerclient.SiteRecord, links []observerclient.RouterLinkRecord, connections []observerclient.ConnectionRecord) *Snapshot {
+	snapshot := &Snapshot{
+		Time:  now,
+		Sites: sites,
+		Links: links,
+	}
+	sort.Slice(snapshot.Sites, func(i, j int) bool {
+		return snapshot.Sites[i].Name < snapshot.Sites[j].Name
+	})
+	sort.Slice(snapshot.Links, func(i, j int) bool {
+		return snapshot.Links[i].Name < snapshot.Links[j].Name
+	})
+
+	rateStart := uint64(now.Add(-rateWindow).UnixMicro())
+	routingKeys := map[string]*Traffic{}
+	processPairs := map[[2]string]*Traffic{}
+	for _, connection := range connections {
+		pair := [2]string{processName(connection.SourceProcessName, connection.SourceSiteName), processName(connection.DestProcessName, connection.DestSiteName)}
+		routingKey, ok := routingKeys[connection.RoutingKey]
+		if !ok {
+			routingKey = &Traffic{Name: connection.RoutingKey}
+			routingKeys[connection.RoutingKey] = routingKey
+		}
+		processPair, ok := processPairs[pair]
+		if !ok {
+			processPair = &Traffic{Name: pair[0], Peer: pair[1]}
+			processPairs[pair] = processPair
+		}
+		for _, traffic := range []*Traffic{routingKey, processPair} {
+			traffic.Bytes += connection.OctetCount + connection.OctetReverseCount
+			traffic.Connections++
+			if connection.EndTime == 0 {
+				traffic.Active++
+			}
+			if connection.StartTime >= rateStart {
+				traffic.Rate++
+			}
+		}
+		if message := connectionError(connection); message != "" {
+			snapshot.Errors = append(snapshot.Errors, ConnectionError{
+				Time:        time.UnixMicro(int64(connection.StartTime)),
+				RoutingKey:  connection.RoutingKey,
+				Source:      pair[0],
+				Destination: pair[1],
+				Message:     message,
+			})
+		}
+	}
+	for _, traffic := range routingKeys {
+		snapshot.RoutingKeys = append(snapshot.RoutingKeys, *traffic)
+	}
+	for _, traffic := range processPairs {
+		snapshot.ProcessPairs = append(snapshot.ProcessPairs, *traffic)
+	}
+	sort.SliceStable(snapshot.Errors, func(i, j int) bool {
+		return snapshot.Errors[i].Time.After(snapshot.Errors[j].Time)
+	})
+	return snapshot
+}
+
+func processName(process string, site string) string {
+	if site == "" {
+		return process
+	}
+	return process + "@" + site
+}
+
+func connectionError(connection observerclient.ConnectionRecord) string {
+	if connection.ConnectorError != nil && *connection.ConnectorError != "" {
+		return *connection.ConnectorError
+	}
+	if connection.ListenerError != nil && *connection.ListenerError != "" {
+		return *connection.ListenerError
+	}
+	return ""
+}
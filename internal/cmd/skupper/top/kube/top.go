@@ -0,0 +1,121 @@
+package kube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/top/dashboard"
+	"github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/kube/observer"
+	"github.com/skupperproject/skupper/internal/utils/validator"
+	observerclient "github.com/skupperproject/skupper/pkg/networkobserver/client"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type CmdTop struct {
+	KubeClient  kubernetes.Interface
+	CobraCmd    *cobra.Command
+	Flags       *common.CommandTopFlags
+	Namespace   string
+	endpoint    string
+	view        dashboard.View
+	interval    time.Duration
+	ctx         context.Context
+	portForward func(ctx context.Context, pod *corev1.Pod) (string, error)
+}
+
+func NewCmdTop() *CmdTop {
+
+	skupperCmd := CmdTop{}
+
+	return &skupperCmd
+}
+
+func (cmd *CmdTop) NewClient(cobraCommand *cobra.Command, args []string) {
+	cli, err := client.NewClient(cobraCommand.Flag("namespace").Value.String(), cobraCommand.Flag("context").Value.String(), cobraCommand.Flag("kubeconfig").Value.String())
+	utils.HandleError(utils.GenericError, err)
+
+	cmd.KubeClient = cli.GetKubeClient()
+	cmd.Namespace = cli.Namespace
+	cmd.portForward = func(ctx context.Context, pod *corev1.Pod) (string, error) {
+		return observer.ForwardPort(ctx, cli.Rest, cli.Kube, pod)
+	}
+}
+
+func (cmd *CmdTop) ValidateInput(args []string) error {
+	var validationErrors []error
+	sortValidator := validator.NewOptionValidator(dashboard.SortKeys)
+
+	if len(args) > 0 {
+		validationErrors = append(validationErrors, fmt.Errorf("this command does not need any arguments"))
+	}
+
+	if cmd.Flags != nil {
+		if cmd.Flags.Endpoint != "" {
+			if endpoint, err := url.Parse(cmd.Flags.Endpoint); err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+				validationErrors = append(validationErrors, fmt.Errorf("endpoint is not valid: %q", cmd.Flags.Endpoint))
+			}
+		}
+		if cmd.Flags.Interval <= 0 {
+			validationErrors = append(validationErrors, fmt.Errorf("interval is not valid: must be greater than zero"))
+		}
+		if ok, err := sortValidator.Evaluate(cmd.Flags.Sort); !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("sort is not valid: %s", err))
+		}
+		if cmd.Flags.Rows < 0 {
+			validationErrors = append(validationErrors, fmt.Errorf("rows is not valid: must not be negative"))
+		}
+	}
+
+	return errors.Join(validationErrors...)
+}
+
+func (cmd *CmdTop) InputToOptions() {
+	cmd.endpoint = cmd.Flags.Endpoint
+	cmd.interval = cmd.Flags.Interval
+	cmd.view = dashboard.View{
+		Sort:   dashboard.SortKey(cmd.Flags.Sort),
+		Filter: cmd.Flags.Filter,
+		Rows:   cmd.Flags.Rows,
+	}
+}
+
+func (cmd *CmdTop) Run() error {
+	ctx := cmd.ctx
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = utils.WatchContext()
+		defer cancel()
+	}
+
+	endpoint := cmd.endpoint
+	if endpoint == "" {
+		pod, err := cmd.observerPod(ctx)
+		if err != nil {
+			return err
+		}
+		endpoint, err = cmd.portForward(ctx, pod)
+		if err != nil {
+			return err
+		}
+	}
+
+	obs, err := observerclient.NewObserver(observerclient.Config{Endpoint: endpoint})
+	if err != nil {
+		return err
+	}
+	return dashboard.NewDashboard(obs, cmd.view, cmd.interval).Run(ctx)
+}
+
+func (cmd *CmdTop) WaitUntil() error { return nil }
+
+func (cmd *CmdTop) observerPod(ctx context.Context) (*corev1.Pod, error) {
+	return observer.FindPod(ctx, cmd.KubeClient, cmd.Namespace)
+}
@@ -0,0 +1,177 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/top/dashboard"
+	fakeclient "github.com/skupperproject/skupper/internal/kube/client/fake"
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCmdTop_ValidateInput(t *testing.T) {
+	type test struct {
+		name          string
+		args          []string
+		flags         *common.CommandTopFlags
+		expectedError string
+	}
+
+	testTable := []test{
+		{
+			name:          "arguments are not accepted",
+			args:          []string{"something"},
+			flags:         &common.CommandTopFlags{Interval: time.Second, Sort: "bytes"},
+			expectedError: "this command does not need any arguments",
+		},
+		{
+			name:          "endpoint is not valid",
+			flags:         &common.CommandTopFlags{Endpoint: "observer:8080", Interval: time.Second, Sort: "bytes"},
+			expectedError: "endpoint is not valid: \"observer:8080\"",
+		},
+		{
+			name:          "interval is not valid",
+			flags:         &common.CommandTopFlags{Interval: 0, Sort: "bytes"},
+			expectedError: "interval is not valid: must be greater than zero",
+		},
+		{
+			name:          "sort is not valid",
+			flags:         &common.CommandTopFlags{Interval: time.Second, Sort: "size"},
+			expectedError: "sort is not valid: value size not allowed. It should be one of this options: [bytes rate name]",
+		},
+		{
+			name:          "rows is not valid",
+			flags:         &common.CommandTopFlags{Interval: time.Second, Sort: "bytes", Rows: -1},
+			expectedError: "rows is not valid: must not be negative",
+		},
+		{
+			name:  "flags are valid",
+			flags: &common.CommandTopFlags{Endpoint: "http://127.0.0.1:8080", Interval: time.Second, Sort: "rate", Filter: "backend", Rows: 5},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := &CmdTop{
+				Namespace: "test",
+				Flags:     test.flags,
+			}
+
+			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
+		})
+	}
+}
+
+func TestCmdTop_InputToOptions(t *testing.T) {
+	command := &CmdTop{
+		Flags: &common.CommandTopFlags{Interval: 5 * time.Second, Sort: "rate", Filter: "backend", Rows: 5},
+	}
+
+	command.InputToOptions()
+
+	assert.Equal(t, command.endpoint, "")
+	assert.Equal(t, command.interval, 5*time.Second)
+	assert.DeepEqual(t, command.view, dashboard.View{Sort: dashboard.SortRate, Filter: "backend", Rows: 5})
+}
+
+func TestCmdTop_Run(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 0, "results": []}`)
+	}))
+	defer server.Close()
+
+	observerPod := &corev1.Pod{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "network-observer-abc",
+			Namespace: "test",
+			Labels:    map[string]string{"app.kubernetes.io/name": "network-observer"},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	type test struct {
+		name           string
+		k8sObjects     []runtime.Object
+		endpoint       string
+		portForwardErr error
+		errorMessage   string
+	}
+
+	testTable := []test{
+		{
+			name:         "network observer is not running",
+			errorMessage: "the network observer is not running in namespace \"test\"",
+		},
+		{
+			name: "network observer pod is pending",
+			k8sObjects: []runtime.Object{
+				&corev1.Pod{
+					ObjectMeta: v1.ObjectMeta{
+						Name:      "network-observer-abc",
+						Namespace: "test",
+						Labels:    map[string]string{"app.kubernetes.io/name": "network-observer"},
+					},
+					Status: corev1.PodStatus{Phase: corev1.PodPending},
+				},
+			},
+			errorMessage: "the network observer is not running in namespace \"test\"",
+		},
+		{
+			name:           "port-forward fails",
+			k8sObjects:     []runtime.Object{observerPod},
+			portForwardErr: fmt.Errorf("failed to forward a port to the network observer: refused"),
+			errorMessage:   "failed to forward a port to the network observer: refused",
+		},
+		{
+			name:       "runs through port-forward",
+			k8sObjects: []runtime.Object{observerPod},
+		},
+		{
+			name:     "runs against the given endpoint",
+			endpoint: server.URL,
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := &CmdTop{
+				Namespace: "test",
+				endpoint:  test.endpoint,
+				interval:  50 * time.Millisecond,
+				view:      dashboard.View{Sort: dashboard.SortBytes, Rows: 10},
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+			command.ctx = ctx
+			command.portForward = func(ctx context.Context, pod *corev1.Pod) (string, error) {
+				assert.Equal(t, pod.Name, "network-observer-abc")
+				return server.URL, test.portForwardErr
+			}
+
+			fakeClient, err := fakeclient.NewFakeClient(command.Namespace, test.k8sObjects, nil, "")
+			assert.Assert(t, err)
+			command.KubeClient = fakeClient.GetKubeClient()
+
+			err = command.Run()
+			if test.errorMessage != "" {
+				assert.Error(t, err, test.errorMessage)
+			} else {
+				assert.Assert(t, err)
+			}
+		})
+	}
+}
+
+func TestCmdTop_WaitUntil(t *testing.T) {
+	command := &CmdTop{}
+	assert.Assert(t, command.WaitUntil())
+}
@@ -0,0 +1,101 @@
+package nonkube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/top/dashboard"
+	"github.com/skupperproject/skupper/internal/utils/validator"
+	observerclient "github.com/skupperproject/skupper/pkg/networkobserver/client"
+	"github.com/spf13/cobra"
+)
+
+// defaultEndpoint is the address the network observer API listens on
+// when it runs alongside a local site.
+const defaultEndpoint = "http://127.0.0.1:8080"
+
+type CmdTop struct {
+	CobraCmd  *cobra.Command
+	Flags     *common.CommandTopFlags
+	namespace string
+	endpoint  string
+	view      dashboard.View
+	interval  time.Duration
+	ctx       context.Context
+}
+
+func NewCmdTop() *CmdTop {
+
+	skupperCmd := CmdTop{}
+
+	return &skupperCmd
+}
+
+func (cmd *CmdTop) NewClient(cobraCommand *cobra.Command, args []string) {
+	if cmd.CobraCmd != nil && cmd.CobraCmd.Flag(common.FlagNameNamespace) != nil && cmd.CobraCmd.Flag(common.FlagNameNamespace).Value.String() != "" {
+		cmd.namespace = cmd.CobraCmd.Flag(common.FlagNameNamespace).Value.String()
+	}
+}
+
+func (cmd *CmdTop) ValidateInput(args []string) error {
+	var validationErrors []error
+	sortValidator := validator.NewOptionValidator(dashboard.SortKeys)
+
+	if len(args) > 0 {
+		validationErrors = append(validationErrors, fmt.Errorf("this command does not need any arguments"))
+	}
+
+	if cmd.Flags != nil {
+		if cmd.Flags.Endpoint != "" {
+			if endpoint, err := url.Parse(cmd.Flags.Endpoint); err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+				validationErrors = append(validationErrors, fmt.Errorf("endpoint is not valid: %q", cmd.Flags.Endpoint))
+			}
+		}
+		if cmd.Flags.Interval <= 0 {
+			validationErrors = append(validationErrors, fmt.Errorf("interval is not valid: must be greater than zero"))
+		}
+		if ok, err := sortValidator.Evaluate(cmd.Flags.Sort); !ok {
+			validationErrors = append(validationErrors, fmt.Errorf("sort is not valid: %s", err))
+		}
+		if cmd.Flags.Rows < 0 {
+			validationErrors = append(validationErrors, fmt.Errorf("rows is not valid: must not be negative"))
+		}
+	}
+
+	return errors.Join(validationErrors...)
+}
+
+func (cmd *CmdTop) InputToOptions() {
+	cmd.endpoint = cmd.Flags.Endpoint
+	if cmd.endpoint == "" {
+		cmd.endpoint = defaultEndpoint
+	}
+	cmd.interval = cmd.Flags.Interval
+	cmd.view = dashboard.View{
+		Sort:   dashboard.SortKey(cmd.Flags.Sort),
+		Filter: cmd.Flags.Filter,
+		Rows:   cmd.Flags.Rows,
+	}
+}
+
+func (cmd *CmdTop) Run() error {
+	ctx := cmd.ctx
+	if ctx == nil {
+		var cancel context.CancelFunc
+		ctx, cancel = utils.WatchContext()
+		defer cancel()
+	}
+
+	obs, err := observerclient.NewObserver(observerclient.Config{Endpoint: cmd.endpoint})
+	if err != nil {
+		return err
+	}
+	return dashboard.NewDashboard(obs, cmd.view, cmd.interval).Run(ctx)
+}
+
+func (cmd *CmdTop) WaitUntil() error { return nil }
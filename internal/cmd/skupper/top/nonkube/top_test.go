@@ -0,0 +1,142 @@
+package nonkube
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/testutils"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/top/dashboard"
+	"gotest.tools/v3/assert"
+)
+
+func TestCmdTop_ValidateInput(t *testing.T) {
+	type test struct {
+		name          string
+		args          []string
+		flags         *common.CommandTopFlags
+		expectedError string
+	}
+
+	testTable := []test{
+		{
+			name:          "arguments are not accepted",
+			args:          []string{"something"},
+			flags:         &common.CommandTopFlags{Interval: time.Second, Sort: "bytes"},
+			expectedError: "this command does not need any arguments",
+		},
+		{
+			name:          "endpoint is not valid",
+			flags:         &common.CommandTopFlags{Endpoint: "127.0.0.1", Interval: time.Second, Sort: "bytes"},
+			expectedError: "endpoint is not valid: \"127.0.0.1\"",
+		},
+		{
+			name:          "interval and sort are not valid",
+			flags:         &common.CommandTopFlags{Interval: -time.Second, Sort: "size"},
+			expectedError: "interval is not valid: must be greater than zero\nsort is not valid: value size not allowed. It should be one of this options: [bytes rate name]",
+		},
+		{
+			name:  "flags are valid",
+			flags: &common.CommandTopFlags{Interval: time.Second, Sort: "name", Filter: "backend"},
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := &CmdTop{Flags: test.flags}
+
+			testutils.CheckValidateInput(t, command, test.expectedError, test.args)
+		})
+	}
+}
+
+func TestCmdTop_InputToOptions(t *testing.T) {
+	type test struct {
+		name             string
+		flags            *common.CommandTopFlags
+		expectedEndpoint string
+	}
+
+	testTable := []test{
+		{
+			name:             "default endpoint",
+			flags:            &common.CommandTopFlags{Interval: time.Second, Sort: "bytes"},
+			expectedEndpoint: "http://127.0.0.1:8080",
+		},
+		{
+			name:             "given endpoint",
+			flags:            &common.CommandTopFlags{Endpoint: "https://observer:8443", Interval: time.Second, Sort: "bytes"},
+			expectedEndpoint: "https://observer:8443",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := &CmdTop{Flags: test.flags}
+
+			command.InputToOptions()
+
+			assert.Equal(t, command.endpoint, test.expectedEndpoint)
+			assert.Equal(t, command.interval, test.flags.Interval)
+			assert.Equal(t, command.view.Sort, dashboard.SortKey(test.flags.Sort))
+		})
+	}
+}
+
+func TestCmdTop_Run(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"count": 0, "results": []}`)
+	}))
+	defer server.Close()
+	unavailable := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer unavailable.Close()
+
+	type test struct {
+		name         string
+		endpoint     string
+		errorMessage string
+	}
+
+	testTable := []test{
+		{
+			name:     "runs ok",
+			endpoint: server.URL,
+		},
+		{
+			name:         "network observer is unavailable",
+			endpoint:     unavailable.URL,
+			errorMessage: "failed to retrieve sites: network observer responded with 503 Service Unavailable",
+		},
+	}
+
+	for _, test := range testTable {
+		t.Run(test.name, func(t *testing.T) {
+			command := &CmdTop{
+				endpoint: test.endpoint,
+				interval: 50 * time.Millisecond,
+				view:     dashboard.View{Sort: dashboard.SortBytes, Rows: 10},
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+			command.ctx = ctx
+
+			err := command.Run()
+			if test.errorMessage != "" {
+				assert.Error(t, err, test.errorMessage)
+			} else {
+				assert.Assert(t, err)
+			}
+		})
+	}
+}
+
+func TestCmdTop_WaitUntil(t *testing.T) {
+	command := &CmdTop{}
+	assert.Assert(t, command.WaitUntil())
+}
@@ -0,0 +1,57 @@
+package top
+
+import (
+	"time"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/top/dashboard"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/top/kube"
+	"github.com/skupperproject/skupper/internal/cmd/skupper/top/nonkube"
+	"github.com/skupperproject/skupper/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdTop() *cobra.Command {
+
+	platform := common.Platform(config.GetPlatform())
+	cmd := CmdTopFactory(platform)
+
+	return cmd
+}
+
+func CmdTopFactory(configuredPlatform common.Platform) *cobra.Command {
+	kubeCommand := kube.NewCmdTop()
+	nonKubeCommand := nonkube.NewCmdTop()
+
+	cmdTopDesc := common.SkupperCmdDescription{
+		Use:   "top",
+		Short: "Display a live dashboard of the traffic in the network.",
+		Long: `Display the sites and links of the network, the busiest routing keys and process pairs
+by bytes and connection rate, and the most recent connection errors, as reported by
+the network observer and refreshed periodically.
+
+On Kubernetes, the network observer of the current namespace is reached through a
+port-forward. Otherwise, the network observer is expected at http://127.0.0.1:8080.
+
+While the dashboard runs, press s to change the sort order, / to edit the filter and q to quit.`,
+		Example: `skupper top
+skupper top --sort rate --filter backend
+skupper top --endpoint http://observer.example.com:8080 --interval 5s`,
+	}
+
+	cmd := common.ConfigureCobraCommand(configuredPlatform, cmdTopDesc, kubeCommand, nonKubeCommand)
+
+	cmdFlags := common.CommandTopFlags{}
+	cmd.Flags().StringVar(&cmdFlags.Endpoint, common.FlagNameEndpoint, "", common.FlagDescTopEndpoint)
+	cmd.Flags().DurationVar(&cmdFlags.Interval, common.FlagNameInterval, 2*time.Second, common.FlagDescTopInterval)
+	cmd.Flags().StringVar(&cmdFlags.Sort, common.FlagNameSort, string(dashboard.SortBytes), common.FlagDescTopSort)
+	cmd.Flags().StringVar(&cmdFlags.Filter, common.FlagNameFilter, "", common.FlagDescTopFilter)
+	cmd.Flags().IntVar(&cmdFlags.Rows, common.FlagNameTopRows, 10, common.FlagDescTopRows)
+
+	kubeCommand.CobraCmd = cmd
+	kubeCommand.Flags = &cmdFlags
+	nonKubeCommand.CobraCmd = cmd
+	nonKubeCommand.Flags = &cmdFlags
+
+	return cmd
+}
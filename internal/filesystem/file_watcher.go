@@ -61,6 +61,12 @@ func NewWatcher(attrs ...slog.Attr) (*FileWatcher, error) {
 	}, nil
 }
 
+// SetLogger replaces the logger used by the watcher, which is derived
+// from slog.Default otherwise.
+func (w *FileWatcher) SetLogger(logger *slog.Logger) {
+	w.logger = logger
+}
+
 func (w *FileWatcher) filterHandlers(name string) []FSChangeHandler {
 	w.handlerLock.RLock()
 	defer w.handlerLock.RUnlock()
@@ -41,6 +41,8 @@ func indexByTypeParent(e store.Entry) []string {
 		return optionalSingle(record.GetTypeMeta().String(), record.Parent)
 	case vanflow.ProcessRecord:
 		return optionalSingle(record.GetTypeMeta().String(), record.Parent)
+	case vanflow.SiteProbeRecord:
+		return optionalSingle(record.GetTypeMeta().String(), record.Parent)
 	default:
 		return nil
 	}
@@ -0,0 +1,408 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	amqp "github.com/Azure/go-amqp"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/eventsource"
+	"github.com/skupperproject/skupper/pkg/vanflow/session"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+)
+
+const (
+	// probeAddress is the multicast address every prober listens on for
+	// latency probes from the other sites in the network.
+	probeAddress = "mc/sfe.probes"
+
+	probeSubject      = "PROBE"
+	probeReplySubject = "PROBE_REPLY"
+
+	ProbeResultOK          = "ok"
+	ProbeResultUnreachable = "unreachable"
+
+	defaultProbeMaxMissed = 5
+)
+
+type ProberConfig struct {
+	// SiteID is the identity of the local site
+	SiteID string
+	// Interval between rounds of probes
+	Interval time.Duration
+	// ThroughputBytes is the size of the payload sent to each reachable
+	// site after it has answered a latency probe in order to estimate the
+	// throughput to it. Throughput is not measured when zero.
+	ThroughputBytes int
+	// MaxMissed is the number of consecutive rounds a site may leave
+	// unanswered before it is no longer reported. Defaults to 5.
+	MaxMissed int
+}
+
+// Prober periodically measures the round trip latency, and optionally the
+// throughput, from the local site to every other site reachable over the
+// router network. Each round a probe is sent to a multicast address that
+// the probers of all sites listen on, and each of them replies directly to
+// the prober that sent it. The results are published as SiteProbeRecords.
+type Prober struct {
+	cfg       ProberConfig
+	source    store.SourceRef
+	container session.Container
+	manager   *eventsource.Manager
+	records   store.Interface
+	logger    *slog.Logger
+	now       func() time.Time
+
+	mu      sync.Mutex
+	round   uint64
+	seq     uint64
+	pending map[uint64]pendingProbe
+	peers   map[string]*probedPeer
+	senders map[string]session.Sender
+}
+
+type pendingProbe struct {
+	sent  time.Time
+	round uint64
+	peer  string
+	bytes int
+}
+
+type probedPeer struct {
+	round   uint64
+	missed  int
+	latency time.Duration
+}
+
+// ParseProberConfig builds the prober configuration for a site from the
+// values of its probe-interval and probe-throughput-bytes settings. Probing
+// is disabled when no interval is set.
+func ParseProberConfig(siteID string, interval string, throughputBytes string) (ProberConfig, bool, error) {
+	cfg := ProberConfig{SiteID: siteID}
+	if interval == "" {
+		return cfg, false, nil
+	}
+	var err error
+	cfg.Interval, err = time.ParseDuration(interval)
+	if err != nil || cfg.Interval <= 0 {
+		return cfg, false, fmt.Errorf("invalid probe interval %q: must be a positive duration", interval)
+	}
+	if throughputBytes != "" {
+		cfg.ThroughputBytes, err = strconv.Atoi(throughputBytes)
+		if err != nil || cfg.ThroughputBytes < 0 {
+			return cfg, false, fmt.Errorf("invalid probe throughput bytes %q: must be a non-negative integer", throughputBytes)
+		}
+	}
+	return cfg, true, nil
+}
+
+func NewProber(factory session.ContainerFactory, cfg ProberConfig) *Prober {
+	if cfg.MaxMissed <= 0 {
+		cfg.MaxMissed = defaultProbeMaxMissed
+	}
+	sourceID := ProbeSourceID(cfg.SiteID)
+	source := store.SourceRef{
+		ID:      sourceID,
+		Version: "1",
+	}
+	records := store.NewSyncMapStore(store.SyncMapStoreConfig{})
+	container := factory.Create()
+	manager := eventsource.NewManager(container, eventsource.ManagerConfig{
+		Source: eventsource.Info{
+			ID:      sourceID,
+			Version: 1,
+			Type:    "PROBE",
+			Address: fmt.Sprintf("mc/sfe.%s", sourceID),
+			Direct:  fmt.Sprintf("sfe.%s", sourceID),
+		},
+		Stores: []store.Interface{records},
+
+		FlushDelay:       time.Millisecond * 100,
+		FlushBatchSize:   20,
+		UpdateBufferTime: time.Millisecond * 1000,
+		UpdateBatchSize:  10,
+	})
+	return &Prober{
+		cfg:       cfg,
+		source:    source,
+		container: container,
+		manager:   manager,
+		records:   records,
+		now:       time.Now,
+		pending:   make(map[uint64]pendingProbe),
+		peers:     make(map[string]*probedPeer),
+		senders:   make(map[string]session.Sender),
+		logger: slog.New(slog.Default().Handler()).With(
+			slog.String("component", "flow.prober"),
+			slog.String("site", cfg.SiteID),
+		),
+	}
+}
+
+// ProbeSourceID returns the ID of the event source publishing the probe
+// results of a site.
+func ProbeSourceID(siteID string) string {
+	return fmt.Sprintf("%s-probe", siteID)
+}
+
+// ProbeRecordID returns the ID of the record holding the results of
+// probing one site from another.
+func ProbeRecordID(siteID string, peerID string) string {
+	return fmt.Sprintf("%s/%s", siteID, peerID)
+}
+
+func directProbeAddress(siteID string) string {
+	return fmt.Sprintf("sfe.probes.%s", siteID)
+}
+
+func (p *Prober) Run(ctx context.Context) {
+	p.container.Start(ctx)
+	p.container.OnSessionError(func(err error) {
+		_, retryable := err.(session.RetryableError)
+		p.logger.Error("amqp session error", slog.Any("error", err), slog.Bool("retryable", retryable))
+	})
+	go p.manager.Run(ctx)
+	go p.receive(ctx, probeAddress)
+	go p.receive(ctx, directProbeAddress(p.cfg.SiteID))
+
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+	defer p.closeSenders()
+	for {
+		p.probe(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Prober) receive(ctx context.Context, address string) {
+	receiver := p.container.NewReceiver(address, session.ReceiverOptions{Credit: 256})
+	defer receiver.Close(context.Background())
+	for {
+		msg, err := receiver.Next(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			p.logger.Error("error receiving probe", slog.String("address", address), slog.Any("error", err))
+			continue
+		}
+		if err := receiver.Accept(ctx, msg); err != nil {
+			p.logger.Error("error accepting probe", slog.String("address", address), slog.Any("error", err))
+		}
+		p.handle(ctx, msg)
+	}
+}
+
+// probe starts a new round, first accounting for the sites that did not
+// answer the previous one.
+func (p *Prober) probe(ctx context.Context) {
+	p.mu.Lock()
+	p.round++
+	round := p.round
+	for peerID, peer := range p.peers {
+		if peer.round+1 >= round {
+			continue
+		}
+		peer.missed++
+		if peer.missed >= p.cfg.MaxMissed {
+			p.forget(peerID)
+			continue
+		}
+		p.update(peerID, func(record *vanflow.SiteProbeRecord) {
+			record.Round = &round
+			if peer.missed == 1 {
+				result := ProbeResultUnreachable
+				reason := fmt.Sprintf("no reply within %s", p.cfg.Interval)
+				downCount := dref(record.DownCount) + 1
+				record.Result, record.Reason, record.DownCount = &result, &reason, &downCount
+			}
+		})
+	}
+	for seq, pending := range p.pending {
+		if pending.round+1 < round {
+			delete(p.pending, seq)
+		}
+	}
+	p.mu.Unlock()
+
+	p.send(ctx, probeAddress, round, "", nil)
+}
+
+func (p *Prober) send(ctx context.Context, address string, round uint64, peer string, payload []byte) {
+	p.mu.Lock()
+	p.seq++
+	seq := p.seq
+	p.pending[seq] = pendingProbe{
+		sent:  p.now(),
+		round: round,
+		peer:  peer,
+		bytes: len(payload),
+	}
+	p.mu.Unlock()
+
+	msg := probeMessage(probeSubject, address, p.cfg.SiteID, seq)
+	replyTo := directProbeAddress(p.cfg.SiteID)
+	msg.Properties.ReplyTo = &replyTo
+	if len(payload) > 0 {
+		msg.Data = [][]byte{payload}
+	}
+	if err := p.sendMessage(ctx, address, msg); err != nil {
+		p.logger.Debug("error sending probe", slog.String("address", address), slog.Any("error", err))
+	}
+}
+
+func (p *Prober) handle(ctx context.Context, msg *amqp.Message) {
+	if msg.Properties == nil || msg.Properties.Subject == nil {
+		return
+	}
+	site, _ := msg.ApplicationProperties["site"].(string)
+	seq, _ := msg.ApplicationProperties["seq"].(uint64)
+	if site == "" || site == p.cfg.SiteID {
+		return
+	}
+	switch *msg.Properties.Subject {
+	case probeSubject:
+		if msg.Properties.ReplyTo == nil {
+			return
+		}
+		address := *msg.Properties.ReplyTo
+		if err := p.sendMessage(ctx, address, probeMessage(probeReplySubject, address, p.cfg.SiteID, seq)); err != nil {
+			p.logger.Debug("error replying to probe", slog.String("address", address), slog.Any("error", err))
+		}
+	case probeReplySubject:
+		p.handleReply(ctx, site, seq)
+	}
+}
+
+func (p *Prober) handleReply(ctx context.Context, peerID string, seq uint64) {
+	p.mu.Lock()
+	pending, ok := p.pending[seq]
+	if !ok || (pending.peer != "" && pending.peer != peerID) {
+		p.mu.Unlock()
+		return
+	}
+	elapsed := p.now().Sub(pending.sent)
+	if pending.peer != "" {
+		// reply to a throughput probe sent to this peer alone
+		delete(p.pending, seq)
+		peer, ok := p.peers[peerID]
+		if ok {
+			transfer := elapsed - peer.latency
+			if transfer <= 0 {
+				transfer = elapsed
+			}
+			rate := uint64(float64(pending.bytes) / transfer.Seconds())
+			p.update(peerID, func(record *vanflow.SiteProbeRecord) {
+				record.OctetRate = &rate
+			})
+		}
+		p.mu.Unlock()
+		return
+	}
+	peer, ok := p.peers[peerID]
+	if !ok {
+		peer = &probedPeer{}
+		p.peers[peerID] = peer
+	}
+	if pending.round < peer.round {
+		p.mu.Unlock()
+		return
+	}
+	peer.round, peer.missed, peer.latency = pending.round, 0, elapsed
+	latency := uint64(elapsed.Microseconds())
+	round := pending.round
+	p.update(peerID, func(record *vanflow.SiteProbeRecord) {
+		result := ProbeResultOK
+		record.Result, record.Reason, record.Latency, record.Round = &result, nil, &latency, &round
+	})
+	p.mu.Unlock()
+
+	if p.cfg.ThroughputBytes > 0 {
+		go p.send(ctx, directProbeAddress(peerID), pending.round, peerID, make([]byte, p.cfg.ThroughputBytes))
+	}
+}
+
+// update applies the change to the record of the given peer, creating it if
+// necessary, and publishes it. Must be called with the lock held.
+func (p *Prober) update(peerID string, change func(record *vanflow.SiteProbeRecord)) {
+	id := ProbeRecordID(p.cfg.SiteID, peerID)
+	var prev vanflow.Record
+	record := vanflow.SiteProbeRecord{
+		BaseRecord: vanflow.NewBase(id, p.now()),
+		Parent:     &p.cfg.SiteID,
+		Peer:       &peerID,
+	}
+	if entry, ok := p.records.Get(id); ok {
+		prev = entry.Record
+		record = entry.Record.(vanflow.SiteProbeRecord)
+	}
+	change(&record)
+	if prev == nil {
+		p.records.Add(record, p.source)
+	} else {
+		p.records.Update(record)
+	}
+	p.manager.PublishUpdate(eventsource.RecordUpdate{
+		Prev: prev,
+		Curr: record,
+	})
+}
+
+// forget stops reporting the given peer. Must be called with the lock held.
+func (p *Prober) forget(peerID string) {
+	delete(p.peers, peerID)
+	entry, ok := p.records.Delete(ProbeRecordID(p.cfg.SiteID, peerID))
+	if !ok {
+		return
+	}
+	p.logger.Info("no longer probing unreachable site", slog.String("peer", peerID))
+	terminal := entry.Record.(vanflow.SiteProbeRecord)
+	terminal.EndTime = &vanflow.Time{Time: p.now()}
+	p.manager.PublishUpdate(eventsource.RecordUpdate{
+		Prev: entry.Record,
+		Curr: terminal,
+	})
+}
+
+func (p *Prober) sendMessage(ctx context.Context, address string, msg *amqp.Message) error {
+	p.mu.Lock()
+	sender, ok := p.senders[address]
+	if !ok {
+		sender = p.container.NewSender(address, session.SenderOptions{})
+		p.senders[address] = sender
+	}
+	p.mu.Unlock()
+	sendCtx, cancel := context.WithTimeout(ctx, p.cfg.Interval)
+	defer cancel()
+	return sender.Send(sendCtx, msg)
+}
+
+func (p *Prober) closeSenders() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for address, sender := range p.senders {
+		sender.Close(context.Background())
+		delete(p.senders, address)
+	}
+}
+
+func probeMessage(subject string, to string, site string, seq uint64) *amqp.Message {
+	return &amqp.Message{
+		Properties: &amqp.MessageProperties{
+			Subject: &subject,
+			To:      &to,
+		},
+		ApplicationProperties: map[string]any{
+			"site": site,
+			"seq":  seq,
+		},
+	}
+}
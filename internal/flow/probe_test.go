@@ -0,0 +1,148 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/session"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/poll"
+)
+
+func TestProber(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	factory := session.NewMockContainerFactory()
+
+	newProber := func(siteID string, throughput int) *Prober {
+		return NewProber(factory, ProberConfig{
+			SiteID:          siteID,
+			Interval:        20 * time.Millisecond,
+			ThroughputBytes: throughput,
+			MaxMissed:       3,
+		})
+	}
+	proberA := newProber("site-a", 1024)
+	proberB := newProber("site-b", 0)
+	proberC := newProber("site-c", 0)
+
+	ctxC, cancelC := context.WithCancel(ctx)
+	go proberA.Run(ctx)
+	go proberB.Run(ctx)
+	go proberC.Run(ctxC)
+
+	getProbe := func(prober *Prober, peer string) (vanflow.SiteProbeRecord, bool) {
+		entry, ok := prober.records.Get(ProbeRecordID(prober.cfg.SiteID, peer))
+		if !ok {
+			return vanflow.SiteProbeRecord{}, false
+		}
+		return entry.Record.(vanflow.SiteProbeRecord), true
+	}
+	reachable := func(prober *Prober, peers ...string) func(poll.LogT) poll.Result {
+		return func(poll.LogT) poll.Result {
+			for _, peer := range peers {
+				record, ok := getProbe(prober, peer)
+				if !ok || dref(record.Result) != ProbeResultOK || record.Latency == nil || record.Round == nil {
+					return poll.Continue("%s has not yet probed %s", prober.cfg.SiteID, peer)
+				}
+				if prober.cfg.ThroughputBytes > 0 && record.OctetRate == nil {
+					return poll.Continue("%s has not yet measured the throughput to %s", prober.cfg.SiteID, peer)
+				}
+			}
+			return poll.Success()
+		}
+	}
+	poll.WaitOn(t, reachable(proberA, "site-b", "site-c"), poll.WithDelay(10*time.Millisecond), poll.WithTimeout(5*time.Second))
+	poll.WaitOn(t, reachable(proberB, "site-a", "site-c"), poll.WithDelay(10*time.Millisecond), poll.WithTimeout(5*time.Second))
+
+	record, _ := getProbe(proberA, "site-b")
+	assert.Equal(t, *record.Parent, "site-a")
+	assert.Equal(t, *record.Peer, "site-b")
+	assert.Assert(t, record.OctetRate != nil)
+	record, _ = getProbe(proberB, "site-a")
+	assert.Assert(t, record.OctetRate == nil)
+	_, ok := getProbe(proberA, "site-a")
+	assert.Assert(t, !ok, "a site should not probe itself")
+
+	cancelC()
+	poll.WaitOn(t, func(poll.LogT) poll.Result {
+		record, ok := getProbe(proberA, "site-c")
+		if !ok {
+			return poll.Error(fmt.Errorf("site-c was forgotten before being reported unreachable"))
+		}
+		if dref(record.Result) != ProbeResultUnreachable {
+			return poll.Continue("site-c is still reachable")
+		}
+		if down := dref(record.DownCount); down != 1 {
+			return poll.Error(fmt.Errorf("expected a down count of 1 but got %d", down))
+		}
+		return poll.Success()
+	}, poll.WithDelay(5*time.Millisecond), poll.WithTimeout(5*time.Second))
+	poll.WaitOn(t, func(poll.LogT) poll.Result {
+		if _, ok := getProbe(proberA, "site-c"); ok {
+			return poll.Continue("site-c is still reported")
+		}
+		return poll.Success()
+	}, poll.WithDelay(10*time.Millisecond), poll.WithTimeout(5*time.Second))
+	poll.WaitOn(t, reachable(proberA, "site-b"), poll.WithDelay(10*time.Millisecond), poll.WithTimeout(5*time.Second))
+}
+
+func TestParseProberConfig(t *testing.T) {
+	testCases := []struct {
+		name            string
+		interval        string
+		throughputBytes string
+		expected        ProberConfig
+		enabled         bool
+		err             string
+	}{
+		{
+			name:     "disabled",
+			expected: ProberConfig{SiteID: "site-a"},
+		},
+		{
+			name:     "latency only",
+			interval: "30s",
+			expected: ProberConfig{SiteID: "site-a", Interval: 30 * time.Second},
+			enabled:  true,
+		},
+		{
+			name:            "latency and throughput",
+			interval:        "1m",
+			throughputBytes: "65536",
+			expected:        ProberConfig{SiteID: "site-a", Interval: time.Minute, ThroughputBytes: 65536},
+			enabled:         true,
+		},
+		{
+			name:     "bad interval",
+			interval: "often",
+			err:      "invalid probe interval \"often\": must be a positive duration",
+		},
+		{
+			name:     "negative interval",
+			interval: "-1s",
+			err:      "invalid probe interval \"-1s\": must be a positive duration",
+		},
+		{
+			name:            "bad throughput bytes",
+			interval:        "30s",
+			throughputBytes: "lots",
+			err:             "invalid probe throughput bytes \"lots\": must be a non-negative integer",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, enabled, err := ParseProberConfig("site-a", tc.interval, tc.throughputBytes)
+			if tc.err != "" {
+				assert.Error(t, err, tc.err)
+				return
+			}
+			assert.Assert(t, err)
+			assert.Equal(t, enabled, tc.enabled)
+			assert.DeepEqual(t, cfg, tc.expected)
+		})
+	}
+}
@@ -30,6 +30,7 @@ var recordTypes []vanflow.Record = []vanflow.Record{
 	vanflow.ConnectorRecord{},
 	vanflow.ListenerRecord{},
 	vanflow.ProcessRecord{},
+	vanflow.SiteProbeRecord{},
 }
 
 type StatusSync struct {
@@ -279,6 +280,13 @@ func (s *StatusSync) build() network.NetworkStatusInfo {
 			siteInfo.RouterStatus = append(siteInfo.RouterStatus, routerInfo)
 		}
 
+		probeExemplar := store.Entry{Record: vanflow.SiteProbeRecord{
+			Parent: &siteID,
+		}}
+		for _, probeEnt := range sorted(s.records.Index(byTypeParent, probeExemplar)) {
+			siteInfo.Probes = append(siteInfo.Probes, asProbeInfo(probeEnt.Record.(vanflow.SiteProbeRecord)))
+		}
+
 		info.SiteStatus = append(info.SiteStatus, siteInfo)
 	}
 
@@ -369,6 +377,10 @@ func (s *StatusSync) recordChanged(prev, entry store.Entry) {
 		if isEndTimeSet(record.EndTime) {
 			s.records.Delete(record.Identity())
 		}
+	case vanflow.SiteProbeRecord:
+		if isEndTimeSet(record.EndTime) {
+			s.records.Delete(record.Identity())
+		}
 	}
 
 	s.notify()
@@ -512,6 +524,18 @@ func asListenerInfo(listener vanflow.ListenerRecord) network.ListenerInfo {
 	}
 }
 
+func asProbeInfo(probe vanflow.SiteProbeRecord) network.ProbeInfo {
+	return network.ProbeInfo{
+		Peer:       dref(probe.Peer),
+		Status:     dref(probe.Result),
+		Reason:     dref(probe.Reason),
+		Latency:    dref(probe.Latency),
+		Throughput: dref(probe.OctetRate),
+		DownCount:  dref(probe.DownCount),
+		Round:      dref(probe.Round),
+	}
+}
+
 func asSiteInfo(site vanflow.SiteRecord) network.SiteInfo {
 	return network.SiteInfo{
 		Identity:  site.ID,
@@ -67,6 +67,32 @@ func TestStatusSyncEndToEnd(t *testing.T) {
 				{Site: network.SiteInfo{Identity: "site-a"}},
 			}},
 		},
+		{
+			RecordsA: []vanflow.Record{
+				vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-a")},
+				vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-b")},
+			},
+			RecordsB: []vanflow.Record{
+				vanflow.SiteProbeRecord{
+					BaseRecord: vanflow.NewBase("site-a/site-b"), Parent: ptrTo("site-a"), Peer: ptrTo("site-b"),
+					Result: ptrTo("ok"), Latency: ptrTo(uint64(1500)), OctetRate: ptrTo(uint64(1048576)),
+				},
+				vanflow.SiteProbeRecord{
+					BaseRecord: vanflow.NewBase("site-b/site-a"), Parent: ptrTo("site-b"), Peer: ptrTo("site-a"),
+					Result: ptrTo("unreachable"), Reason: ptrTo("no reply within 30s"), Latency: ptrTo(uint64(1700)), DownCount: ptrTo(uint64(2)),
+				},
+			},
+			Expected: network.NetworkStatusInfo{SiteStatus: []network.SiteStatusInfo{
+				{
+					Site:   network.SiteInfo{Identity: "site-a"},
+					Probes: []network.ProbeInfo{{Peer: "site-b", Status: "ok", Latency: 1500, Throughput: 1048576}},
+				},
+				{
+					Site:   network.SiteInfo{Identity: "site-b"},
+					Probes: []network.ProbeInfo{{Peer: "site-a", Status: "unreachable", Reason: "no reply within 30s", Latency: 1700, DownCount: 2}},
+				},
+			}},
+		},
 		{
 			Rate: time.Millisecond,
 			RecordsA: []vanflow.Record{
@@ -132,6 +132,14 @@ func startFlowController(ctx context.Context, cli *internalclient.KubeClient) er
 			slog.Error("kube flow controller unexpectedly quit")
 		}
 	}()
+
+	proberConfig, enabled, err := flow.ParseProberConfig(siteID, os.Getenv("SKUPPER_PROBE_INTERVAL"), os.Getenv("SKUPPER_PROBE_THROUGHPUT_BYTES"))
+	if err != nil {
+		slog.Error("site probing disabled", slog.Any("error", err))
+	} else if enabled {
+		prober := flow.NewProber(session.NewContainerFactory("amqp://localhost:5672", session.ContainerConfig{ContainerID: "kube-flow-prober"}), proberConfig)
+		go prober.Run(ctx)
+	}
 	return nil
 }
 
@@ -169,6 +169,23 @@ func syncRouterConfig(agent *qdr.Agent, desired *qdr.RouterConfig) error {
 	if err := syncListeners(agent, desired); err != nil {
 		return err
 	}
+	if err := syncAddresses(agent, desired); err != nil {
+		return err
+	}
+	return nil
+}
+
+func syncAddresses(agent *qdr.Agent, desired *qdr.RouterConfig) error {
+	actual, err := agent.GetLocalAddresses()
+	if err != nil {
+		return fmt.Errorf("Error retrieving local addresses: %s", err)
+	}
+
+	if differences := qdr.AddressesDifference(actual, desired.Addresses); !differences.Empty() {
+		if err = agent.UpdateAddressConfig(differences); err != nil {
+			return fmt.Errorf("Error syncing addresses: %s", err)
+		}
+	}
 	return nil
 }
 
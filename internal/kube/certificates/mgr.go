@@ -18,6 +18,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/skupperproject/skupper/internal/certs"
+	"github.com/skupperproject/skupper/internal/kube/events"
+	"github.com/skupperproject/skupper/internal/kube/metrics"
 	"github.com/skupperproject/skupper/internal/kube/watchers"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 )
@@ -254,6 +256,10 @@ func (m *CertificateManagerImpl) secretDeleted(key string) error {
 
 func (m *CertificateManagerImpl) updateStatus(certificate *skupperv2alpha1.Certificate, err error) error {
 	if certificate.SetReady(err) {
+		if err != nil {
+			m.processor.GetEventRecorder().Eventf(certificate, corev1.EventTypeWarning, events.CertificateFailed,
+				"Certificate could not be generated: %s", err)
+		}
 		latest, err := m.processor.GetSkupperClient().SkupperV2alpha1().Certificates(certificate.Namespace).UpdateStatus(context.TODO(), certificate, metav1.UpdateOptions{})
 		if err != nil {
 			return err
@@ -268,19 +274,21 @@ func (m *CertificateManagerImpl) updateStatus(certificate *skupperv2alpha1.Certi
 
 func (m *CertificateManagerImpl) updateSecret(key string, certificate *skupperv2alpha1.Certificate, secret *corev1.Secret) error {
 	changed := false
+	regenerated := false
 	controlled := isSecretControlled(secret)
 	if !isSecretCorrect(certificate, secret) {
 		if !controlled {
 			return errors.New("Secret exists but is not controlled by skupper")
 		}
 
-		regenerated, err := m.generateSecret(certificate)
+		generated, err := m.generateSecret(certificate)
 		if err != nil {
 			log.Printf("Error generating Secret %s/%s for Certificate %s", certificate.Namespace, secret.Name, key)
 			return err
 		}
 		changed = true
-		secret.Data = regenerated.Data
+		regenerated = true
+		secret.Data = generated.Data
 		secret.Annotations["internal.skupper.io/hosts"] = strings.Join(certificate.Spec.Hosts, ",")
 	}
 	if m.context != nil && controlled {
@@ -308,6 +316,10 @@ func (m *CertificateManagerImpl) updateSecret(key string, certificate *skupperv2
 	}
 	m.secrets[key] = updated
 	log.Printf("Updated Secret %s/%s for Certificate %s (hosts %v)", secret.Namespace, secret.Name, key, certificate.Spec.Hosts)
+	if regenerated {
+		m.processor.GetEventRecorder().Eventf(certificate, corev1.EventTypeNormal, events.CertificateRegenerated,
+			"Regenerated Secret %s for hosts %v", secret.Name, certificate.Spec.Hosts)
+	}
 	return nil
 }
 
@@ -333,6 +345,7 @@ func (m *CertificateManagerImpl) generateSecret(certificate *skupperv2alpha1.Cer
 			return nil, err
 		}
 	}
+	metrics.CertificateGenerated(certificate.Spec.Signing)
 	secret.ObjectMeta.OwnerReferences = ownerReferences(certificate)
 	return secret, nil
 }
@@ -362,6 +375,8 @@ func (m *CertificateManagerImpl) createSecret(key string, certificate *skupperv2
 	}
 	m.secrets[key] = created
 	log.Printf("Created Secret %s/%s for Certificate %s (hosts %v)", certificate.Namespace, secret.Name, key, certificate.Spec.Hosts)
+	m.processor.GetEventRecorder().Eventf(certificate, corev1.EventTypeNormal, events.CertificateGenerated,
+		"Generated Secret %s", secret.Name)
 	return nil
 }
 
@@ -55,6 +55,8 @@ func NewFakeClient(namespace string, k8sObjects []runtime.Object, skupperObjects
 		resource.GatewayResource():          "GatewayList",
 		resource.TlsRouteResource():         "TLSRouteList",
 		resource.DeploymentResource():       "DeploymentList",
+		resource.ServiceExportResource():    "ServiceExportList",
+		resource.ServiceImportResource():    "ServiceImportList",
 	}, dynamic...)
 	// prepopulated objects not working for some reason with dynamic client, so create them manually here for now:
 	for _, d := range dynamic {
@@ -77,6 +79,13 @@ func gvrFromGvk(gvk schema.GroupVersionKind) (schema.GroupVersionResource, bool)
 		if gvk.Kind == "HTTPProxy" {
 			return resource.ContourHttpProxyResource(), true
 		}
+	case "multicluster.x-k8s.io":
+		if gvk.Kind == "ServiceExport" {
+			return resource.ServiceExportResource(), true
+		}
+		if gvk.Kind == "ServiceImport" {
+			return resource.ServiceImportResource(), true
+		}
 	case "gateway.networking.k8s.io":
 		if gvk.Kind == "TLSRoute" {
 			return resource.TlsRouteResource(), true
@@ -115,6 +124,27 @@ func fakedApiResources() []*metav1.APIResourceList {
 				},
 			},
 		},
+		{
+			GroupVersion: "multicluster.x-k8s.io/v1alpha1",
+			APIResources: []metav1.APIResource{
+				{
+					Name:         "serviceexports",
+					SingularName: "serviceexport",
+					Namespaced:   true,
+					Group:        "multicluster.x-k8s.io",
+					Version:      "v1alpha1",
+					Kind:         "ServiceExport",
+				},
+				{
+					Name:         "serviceimports",
+					SingularName: "serviceimport",
+					Namespaced:   true,
+					Group:        "multicluster.x-k8s.io",
+					Version:      "v1alpha1",
+					Kind:         "ServiceImport",
+				},
+			},
+		},
 		{
 			GroupVersion: "gateway.networking.k8s.io/v1",
 			APIResources: []metav1.APIResource{
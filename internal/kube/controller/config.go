@@ -2,6 +2,7 @@ package controller
 
 import (
 	"flag"
+	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -18,6 +19,10 @@ type Config struct {
 	WatchNamespace         string
 	Name                   string
 	RequireExplicitControl bool
+	MultiClusterServices   bool
+	MetricsAddress         string
+	LeaderElection         bool
+	Sharding               bool
 }
 
 func (c *Config) WatchingAllNamespaces() bool {
@@ -48,5 +53,16 @@ func BoundConfig(flags *flag.FlagSet) (*Config, error) {
 	iflag.StringVar(flags, &c.WatchNamespace, "watch-namespace", "WATCH_NAMESPACE", metav1.NamespaceAll, "The Kubernetes namespace the controller should monitor for controlled resources (will monitor all if not specified)")
 	iflag.StringVar(flags, &c.Name, "name", "CONTROLLER_NAME", "", "A name identifying the controller. If not specified it will be deduced from the hostname.")
 	iflag.BoolVar(flags, &c.RequireExplicitControl, "require-explicit-control", "REQUIRE_EXPLICIT_CONTROL", false, "If set, this controller instance will only process resources in which there is a ConfigMap named skupper with an entry 'controller' whose value matches the controller's namespace qualified name. Controllers watching a single namespace require that ConfigMap regardless of this setting.")
+	iflag.BoolVar(flags, &c.MultiClusterServices, "enable-multicluster-services", "ENABLE_MULTICLUSTER_SERVICES", false, "If set, ServiceExports are treated as requests to create Connectors, and ServiceImports are published in the namespace of each site for exported services reachable in the network. Sites are only considered in watched namespaces.")
+	iflag.StringVar(flags, &c.MetricsAddress, "metrics-address", "METRICS_ADDRESS", ":9191", "The address on which to serve metrics (/metrics) and health probes (/healthz and /readyz). If empty, they are not served.")
+	iflag.BoolVar(flags, &c.LeaderElection, "enable-leader-election", "ENABLE_LEADER_ELECTION", false, "If set, replicas of the controller elect a leader to process events while the others stand by, ready to take over.")
+	iflag.BoolVar(flags, &c.Sharding, "enable-sharding", "ENABLE_SHARDING", false, "If set, the namespaces watched are partitioned between the replicas of the controller by consistent hashing. Cannot be combined with leader election.")
 	return c, nil
 }
+
+func (c *Config) Verify() error {
+	if c.LeaderElection && c.Sharding {
+		return fmt.Errorf("Leader election and sharding cannot both be enabled")
+	}
+	return nil
+}
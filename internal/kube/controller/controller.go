@@ -8,6 +8,8 @@ import (
 	"log/slog"
 	"os"
 	"regexp"
+	"sync/atomic"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -18,7 +20,9 @@ import (
 	"github.com/skupperproject/skupper/internal/kube/certificates"
 	internalclient "github.com/skupperproject/skupper/internal/kube/client"
 	"github.com/skupperproject/skupper/internal/kube/grants"
+	"github.com/skupperproject/skupper/internal/kube/mcs"
 	"github.com/skupperproject/skupper/internal/kube/securedaccess"
+	"github.com/skupperproject/skupper/internal/kube/sharding"
 	"github.com/skupperproject/skupper/internal/kube/site"
 	"github.com/skupperproject/skupper/internal/kube/site/labels"
 	"github.com/skupperproject/skupper/internal/kube/site/sizing"
@@ -52,8 +56,17 @@ type Controller struct {
 	attachableConnectors map[string]*skupperv2alpha1.AttachedConnector
 	log                  *slog.Logger
 	namespaces           *NamespaceConfig
+	multiClusterServices *mcs.MultiClusterServices
+	ready                atomic.Bool
+	leaderElection       *leaderElection
+	sharding             *sharding.Membership
+	shardRings           chan *sharding.Ring
 }
 
+// The longest an individual event may take to be handled before the
+// controller is considered unhealthy.
+const eventProcessingTimeout = 5 * time.Minute
+
 func skupperRouterConfig() internalinterfaces.TweakListOptionsFunc {
 	return func(options *metav1.ListOptions) {
 		options.LabelSelector = "internal.skupper.io/router-config"
@@ -84,6 +97,9 @@ func labelling() internalinterfaces.TweakListOptionsFunc {
 }
 
 func NewController(cli internalclient.Clients, config *Config) (*Controller, error) {
+	if err := config.Verify(); err != nil {
+		return nil, err
+	}
 	controller := &Controller{
 		eventProcessor:       watchers.NewEventProcessor("Controller", cli),
 		sites:                map[string]*site.Site{},
@@ -117,6 +133,22 @@ func NewController(cli internalclient.Clients, config *Config) (*Controller, err
 	controller.self.Namespace = config.Namespace
 	controller.self.Version = version.Version
 
+	identity := hostname
+	if identity == "" {
+		identity = name
+	}
+	if config.LeaderElection {
+		controller.leaderElection = newLeaderElection(cli.GetKubeClient(), config.Namespace, name, identity, controller.log)
+	}
+	if config.Sharding {
+		controller.namespaces.enableSharding(identity)
+		controller.shardRings = make(chan *sharding.Ring, 1)
+		controller.sharding = sharding.NewMembership(cli.GetKubeClient(), config.Namespace, name, identity, controller.shardChanged)
+		if config.GrantConfig.Enabled {
+			controller.log.Warn("AccessGrants can only be redeemed through the controller instance that owns the grant's namespace when sharding is enabled")
+		}
+	}
+
 	controller.siteWatcher = controller.eventProcessor.WatchSites(config.WatchNamespace, filter(controller, controller.checkSite))
 	controller.listenerWatcher = controller.eventProcessor.WatchListeners(config.WatchNamespace, filter(controller, controller.checkListener))
 	controller.eventProcessor.WatchServices(listenerServices(), config.WatchNamespace, filter(controller, controller.checkListenerService))
@@ -147,6 +179,13 @@ func NewController(cli internalclient.Clients, config *Config) (*Controller, err
 
 	controller.eventProcessor.WatchConfigMaps(skupperLogConfig(), config.Namespace, controller.logConfigUpdate)
 
+	if config.MultiClusterServices {
+		multiClusterServices := mcs.NewMultiClusterServices(controller.eventProcessor)
+		if multiClusterServices.Watch(config.WatchNamespace, controller.IsControlled) {
+			controller.multiClusterServices = multiClusterServices
+		}
+	}
+
 	return controller, nil
 }
 
@@ -189,6 +228,15 @@ func (c *Controller) getDeploymentForPod(podName string, namespace string) (*app
 }
 
 func (c *Controller) Run(stopCh <-chan struct{}) error {
+	if c.leaderElection != nil {
+		if err := c.sync(stopCh); err != nil {
+			return err
+		}
+		return c.leaderElection.run(stopCh, func() {
+			c.recover()
+			c.eventProcessor.Start(stopCh)
+		})
+	}
 	if err := c.init(stopCh); err != nil {
 		return err
 	}
@@ -196,6 +244,17 @@ func (c *Controller) Run(stopCh <-chan struct{}) error {
 }
 
 func (c *Controller) init(stopCh <-chan struct{}) error {
+	if err := c.sync(stopCh); err != nil {
+		return err
+	}
+	c.recover()
+	return nil
+}
+
+// sync starts the informers and waits for their caches to be
+// populated. A standby instance stops at this point, keeping its caches
+// warm until it becomes the leader.
+func (c *Controller) sync(stopCh <-chan struct{}) error {
 	c.log.Info("Starting informers")
 	c.eventProcessor.StartWatchers(stopCh)
 	c.stopCh = stopCh
@@ -205,7 +264,6 @@ func (c *Controller) init(stopCh <-chan struct{}) error {
 		return fmt.Errorf("Failed to wait for caches to sync")
 	}
 	c.namespaces.recover()
-
 	for _, config := range c.siteSizingWatcher.List() {
 		c.log.Info("Recovering site sizing",
 			slog.String("name", config.Name),
@@ -220,10 +278,33 @@ func (c *Controller) init(stopCh <-chan struct{}) error {
 		)
 		c.labelling.Update(config.Namespace+"/"+config.Name, config)
 	}
-	//recover existing sites & bindings
+	if c.sharding != nil {
+		if err := c.sharding.Update(time.Now()); err != nil {
+			return fmt.Errorf("Failed to determine controller shard membership: %s", err)
+		}
+		c.sharding.Start(stopCh)
+	}
+	c.ready.Store(true)
+	return nil
+}
+
+// recover establishes the internal state for existing resources in
+// controlled namespaces, and then starts the grant server.
+func (c *Controller) recover() {
+	c.recoverNamespaces(c.namespaces.isControlled)
+	c.certMgr.Recover()
+	c.accessRecovery.Recover()
+	if c.startGrantServer != nil {
+		c.startGrantServer()
+	}
+}
+
+// recoverNamespaces establishes the internal state for the sites and
+// their bindings in the namespaces selected by include.
+func (c *Controller) recoverNamespaces(include func(namespace string) bool) {
 	siteRecovery := site.NewSiteRecovery(c.eventProcessor.GetKubeClient())
 	for _, site := range c.siteWatcher.List() {
-		if !c.namespaces.isControlled(site.Namespace) {
+		if !include(site.Namespace) {
 			continue
 		}
 		if !siteRecovery.IsActive(site) {
@@ -247,7 +328,7 @@ func (c *Controller) init(stopCh <-chan struct{}) error {
 		}
 	}
 	for _, connector := range c.connectorWatcher.List() {
-		if !c.namespaces.isControlled(connector.Namespace) {
+		if !include(connector.Namespace) {
 			continue
 		}
 		site := c.getSite(connector.ObjectMeta.Namespace)
@@ -258,7 +339,7 @@ func (c *Controller) init(stopCh <-chan struct{}) error {
 		site.CheckConnector(connector.ObjectMeta.Name, connector)
 	}
 	for _, listener := range c.listenerWatcher.List() {
-		if !c.namespaces.isControlled(listener.Namespace) {
+		if !include(listener.Namespace) {
 			continue
 		}
 		site := c.getSite(listener.ObjectMeta.Namespace)
@@ -269,7 +350,7 @@ func (c *Controller) init(stopCh <-chan struct{}) error {
 		site.CheckListener(listener.ObjectMeta.Name, listener)
 	}
 	for _, la := range c.linkAccessWatcher.List() {
-		if !c.namespaces.isControlled(la.Namespace) {
+		if !include(la.Namespace) {
 			continue
 		}
 		site := c.getSite(la.ObjectMeta.Namespace)
@@ -280,7 +361,7 @@ func (c *Controller) init(stopCh <-chan struct{}) error {
 		site.CheckRouterAccess(la.ObjectMeta.Name, la)
 	}
 	for _, site := range c.siteWatcher.List() {
-		if !c.namespaces.isControlled(site.Namespace) {
+		if !include(site.Namespace) {
 			continue
 		}
 		site.Status.Controller = &c.self
@@ -298,14 +379,21 @@ func (c *Controller) init(stopCh <-chan struct{}) error {
 			)
 		}
 	}
-	c.certMgr.Recover()
-	c.accessRecovery.Recover()
-	if c.startGrantServer != nil {
-		c.startGrantServer()
+}
+
+// Ready returns an error until the informer caches have synced.
+func (c *Controller) Ready() error {
+	if !c.ready.Load() {
+		return fmt.Errorf("Informer caches not yet synced")
 	}
 	return nil
 }
 
+// Healthy returns an error if event processing appears to be stuck.
+func (c *Controller) Healthy() error {
+	return c.eventProcessor.CheckProgress(eventProcessingTimeout)
+}
+
 func (c *Controller) start(stopCh <-chan struct{}) error {
 	c.log.Info("Starting event loop")
 	c.eventProcessor.Start(stopCh)
@@ -375,6 +463,11 @@ func (c *Controller) checkListenerService(key string, svc *corev1.Service) error
 	if svc == nil {
 		return nil
 	}
+	if c.multiClusterServices != nil {
+		if err := c.multiClusterServices.ServiceUpdated(svc); err != nil {
+			c.log.Error("Error updating ServiceImport for service", slog.String("key", key), slog.Any("error", err))
+		}
+	}
 	return c.getSite(svc.Namespace).CheckListenerService(svc)
 }
 
@@ -394,7 +487,7 @@ func (c *Controller) checkAccessToken(key string, token *skupperv2alpha1.AccessT
 	if site == nil {
 		return nil
 	}
-	return grants.RedeemAccessToken(token, site, c.eventProcessor)
+	return grants.RedeemAccessToken(token, site, c.eventProcessor, c.eventProcessor.GetEventRecorder())
 }
 
 func (c *Controller) routerPodEvent(key string, pod *corev1.Pod) error {
@@ -482,7 +575,17 @@ func (c *Controller) networkStatusUpdate(key string, cm *corev1.ConfigMap) error
 		return nil
 	}
 	c.log.Debug("Updating network status", slog.String("site", key))
-	return c.getSite(cm.ObjectMeta.Namespace).NetworkStatusUpdated(network.ExtractSiteRecords(status))
+	records := network.ExtractSiteRecords(status)
+	if c.multiClusterServices != nil {
+		if err := c.multiClusterServices.NetworkUpdated(cm.ObjectMeta.Namespace, records); err != nil {
+			c.log.Error("Error updating ServiceImports", slog.String("site", key), slog.Any("error", err))
+		}
+	}
+	s := c.getSite(cm.ObjectMeta.Namespace)
+	if err := s.NetworkStatusUpdated(records); err != nil {
+		return err
+	}
+	return s.UpdateLinkCosts(&status)
 }
 
 func filter[V any](controller *Controller, handler func(string, V) error) func(string, V) error {
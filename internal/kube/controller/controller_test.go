@@ -8,11 +8,13 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"gotest.tools/v3/assert"
 
 	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -30,6 +32,7 @@ import (
 	internalclient "github.com/skupperproject/skupper/internal/kube/client"
 	fakeclient "github.com/skupperproject/skupper/internal/kube/client/fake"
 	"github.com/skupperproject/skupper/internal/kube/resource"
+	"github.com/skupperproject/skupper/internal/kube/sharding"
 	"github.com/skupperproject/skupper/internal/network"
 	"github.com/skupperproject/skupper/internal/qdr"
 	"github.com/skupperproject/skupper/internal/version"
@@ -505,8 +508,10 @@ func TestGeneral(t *testing.T) {
 			controller, err := NewController(clients, config)
 			assert.Assert(t, err)
 			stopCh := make(chan struct{})
+			assert.Error(t, controller.Ready(), "Informer caches not yet synced")
 			err = controller.init(stopCh)
 			assert.Assert(t, err)
+			assert.Assert(t, controller.Ready())
 			for i := 0; i < len(tt.k8sObjects)+len(tt.skupperObjects); i++ {
 				controller.eventProcessor.TestProcess()
 			}
@@ -672,6 +677,75 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestSharding(t *testing.T) {
+	t.Setenv("HOSTNAME", "controller-a")
+	flags := &flag.FlagSet{}
+	config, err := BoundConfig(flags)
+	assert.Assert(t, err)
+	flags.Parse([]string{"-namespace", "skupper", "-name", "skupper-controller", "-enable-sharding"})
+
+	var namespaces []string
+	var skupperObjects []runtime.Object
+	for i := 0; i < 10; i++ {
+		namespace := fmt.Sprintf("ns-%d", i)
+		namespaces = append(namespaces, namespace)
+		skupperObjects = append(skupperObjects, f.site("mysite", namespace, "", false, false))
+	}
+	k8sObjects := []runtime.Object{f.shardLease("skupper-controller", "skupper", "controller-b")}
+	clients, err := fakeclient.NewFakeClient(config.Namespace, k8sObjects, skupperObjects, "")
+	assert.Assert(t, err)
+	enableSSA(clients.GetDynamicClient())
+	controller, err := NewController(clients, config)
+	assert.Assert(t, err)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	assert.Assert(t, controller.init(stopCh))
+
+	owned := func(ring *sharding.Ring) []string {
+		var owned []string
+		for _, namespace := range namespaces {
+			if ring.Owner(namespace) == "controller-a" {
+				owned = append(owned, namespace)
+			}
+		}
+		return owned
+	}
+	sites := func() []string {
+		var sites []string
+		for _, namespace := range namespaces {
+			if _, ok := controller.sites[namespace]; ok {
+				sites = append(sites, namespace)
+			}
+		}
+		return sites
+	}
+	shared := sharding.NewRing([]string{"controller-a", "controller-b"})
+	assert.DeepEqual(t, sites(), owned(shared))
+	assert.Assert(t, len(sites()) > 0 && len(sites()) < len(namespaces))
+
+	// controller-b leaves, so controller-a takes over all namespaces
+	controller.shardChanged(sharding.NewRing([]string{"controller-a"}))
+	controller.eventProcessor.TestProcessAll()
+	assert.DeepEqual(t, sites(), namespaces)
+	assert.Assert(t, controller.IsControlled("ns-0"))
+
+	// controller-b rejoins, so controller-a releases its namespaces
+	controller.shardChanged(shared)
+	controller.eventProcessor.TestProcessAll()
+	assert.DeepEqual(t, sites(), owned(shared))
+}
+
+func TestLeaderElectionAndShardingExclusive(t *testing.T) {
+	flags := &flag.FlagSet{}
+	config, err := BoundConfig(flags)
+	assert.Assert(t, err)
+	flags.Parse([]string{"-enable-sharding", "-enable-leader-election"})
+	clients, err := fakeclient.NewFakeClient(config.Namespace, nil, nil, "")
+	assert.Assert(t, err)
+	_, err = NewController(clients, config)
+	assert.Error(t, err, "Leader election and sharding cannot both be enabled")
+}
+
 func verifyStatus(t *testing.T, expected skupperv2alpha1.Status, actual skupperv2alpha1.Status) {
 	t.Helper()
 	assert.Equal(t, expected.StatusType, actual.StatusType, actual.Message)
@@ -1214,6 +1288,25 @@ func (*factory) unstructured(name string, namespace string, content map[string]i
 	return obj
 }
 
+func (*factory) shardLease(group string, namespace string, identity string) *coordinationv1.Lease {
+	now := metav1.NewMicroTime(time.Now())
+	duration := int32(15)
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      group + "-" + identity,
+			Namespace: namespace,
+			Labels: map[string]string{
+				sharding.GroupLabel: group,
+			},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &identity,
+			LeaseDurationSeconds: &duration,
+			RenewTime:            &now,
+		},
+	}
+}
+
 func (*factory) routerAccess(name string, namespace string, accessType string, credentials string, generate bool, issuer string, roles ...skupperv2alpha1.RouterAccessRole) *skupperv2alpha1.RouterAccess {
 	return &skupperv2alpha1.RouterAccess{
 		TypeMeta: metav1.TypeMeta{
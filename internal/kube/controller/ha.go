@@ -0,0 +1,183 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/skupperproject/skupper/internal/kube/sharding"
+)
+
+// leaderElection allows only one of several replicas of the controller
+// to process events at any time. The others remain on standby with
+// their informer caches synced, ready to take over when the leader's
+// lease expires.
+type leaderElection struct {
+	lock          resourcelock.Interface
+	identity      string
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+	log           *slog.Logger
+}
+
+func newLeaderElection(client kubernetes.Interface, namespace string, name string, identity string, log *slog.Logger) *leaderElection {
+	return &leaderElection{
+		lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      name + "-leader",
+				Namespace: namespace,
+			},
+			Client: client.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{
+				Identity: identity,
+			},
+		},
+		identity:      identity,
+		leaseDuration: 15 * time.Second,
+		renewDeadline: 10 * time.Second,
+		retryPeriod:   2 * time.Second,
+		log:           log,
+	}
+}
+
+// run blocks until stopCh is closed or leadership is lost, calling lead
+// once this instance becomes the leader. Losing leadership is reported
+// as an error, as the caller can no longer safely continue processing.
+func (l *leaderElection) run(stopCh <-chan struct{}, lead func()) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	begin := time.Now()
+	lost := false
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            l.lock,
+		ReleaseOnCancel: true,
+		LeaseDuration:   l.leaseDuration,
+		RenewDeadline:   l.renewDeadline,
+		RetryPeriod:     l.retryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(context.Context) {
+				l.log.Info("Elected leader, starting event processing", slog.Duration("waited", time.Since(begin)))
+				lead()
+			},
+			OnStoppedLeading: func() {
+				lost = ctx.Err() == nil
+			},
+			OnNewLeader: func(identity string) {
+				if identity != l.identity {
+					l.log.Info("Standing by for leader", slog.String("leader", identity))
+				}
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	l.log.Info("Waiting to be elected leader", slog.String("identity", l.identity))
+	elector.Run(ctx)
+	if lost {
+		return fmt.Errorf("Lost leadership after %s", time.Since(begin))
+	}
+	l.log.Info("Shutting down")
+	return nil
+}
+
+// shardChanged is called by the shard membership, on its own goroutine,
+// when the set of live controller instances changes. The new ring is
+// applied on the event processing thread.
+func (c *Controller) shardChanged(ring *sharding.Ring) {
+	if !c.ready.Load() {
+		// initial membership is applied before any events are processed
+		c.namespaces.setShards(ring)
+		return
+	}
+	select {
+	case <-c.shardRings:
+	default:
+	}
+	c.shardRings <- ring
+	c.eventProcessor.CallbackAfter(0, c.rebalance, "")
+}
+
+// rebalance applies a change in shard membership, releasing namespaces
+// now owned by other instances and recovering the resources in
+// namespaces newly owned by this one.
+func (c *Controller) rebalance(string) error {
+	var ring *sharding.Ring
+	select {
+	case ring = <-c.shardRings:
+	default:
+		return nil
+	}
+	previous := c.namespaces.shards
+	c.namespaces.setShards(ring)
+	gained := map[string]bool{}
+	for _, namespace := range c.knownNamespaces() {
+		owned := ring.Owner(namespace) == c.namespaces.shardIdentity
+		previouslyOwned := previous.Owner(namespace) == c.namespaces.shardIdentity
+		if owned && !previouslyOwned {
+			c.log.Info("Taking over namespace from another controller instance", slog.String("namespace", namespace))
+			gained[namespace] = true
+		} else if previouslyOwned && !owned {
+			c.log.Info("Releasing namespace to another controller instance",
+				slog.String("namespace", namespace),
+				slog.String("owner", ring.Owner(namespace)),
+			)
+			if site, ok := c.sites[namespace]; ok {
+				site.Released()
+				delete(c.sites, namespace)
+			}
+		}
+	}
+	if len(gained) == 0 {
+		return nil
+	}
+	c.recoverNamespaces(func(namespace string) bool {
+		return gained[namespace] && c.namespaces.isControlled(namespace)
+	})
+	c.certMgr.Recover()
+	c.accessRecovery.Recover()
+	return nil
+}
+
+// knownNamespaces returns the namespaces in which there are sites or
+// their bindings.
+func (c *Controller) knownNamespaces() []string {
+	seen := map[string]bool{}
+	var namespaces []string
+	add := func(namespace string) {
+		if !seen[namespace] {
+			seen[namespace] = true
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	for _, site := range c.siteWatcher.List() {
+		add(site.Namespace)
+	}
+	for _, connector := range c.connectorWatcher.List() {
+		add(connector.Namespace)
+	}
+	for _, listener := range c.listenerWatcher.List() {
+		add(listener.Namespace)
+	}
+	for _, la := range c.linkAccessWatcher.List() {
+		add(la.Namespace)
+	}
+	for namespace := range c.sites {
+		add(namespace)
+	}
+	return namespaces
+}
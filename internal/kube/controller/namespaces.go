@@ -7,6 +7,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/skupperproject/skupper/internal/kube/sharding"
 	"github.com/skupperproject/skupper/internal/kube/watchers"
 )
 
@@ -21,6 +22,9 @@ type NamespaceConfig struct {
 	controllerName         string
 	requireExplicitControl bool
 	logging                ControlLogging
+	sharded                bool
+	shardIdentity          string
+	shards                 *sharding.Ring
 }
 
 func newNamespaceConfig(controllerName string, requireExplicitControl bool, logging ControlLogging) *NamespaceConfig {
@@ -41,6 +45,28 @@ func (c *NamespaceConfig) update(key string, cm *corev1.ConfigMap) error {
 	return nil
 }
 
+// enableSharding restricts the namespaces controlled to those assigned
+// to the supplied identity by the shard ring.
+func (c *NamespaceConfig) enableSharding(identity string) {
+	c.sharded = true
+	c.shardIdentity = identity
+}
+
+func (c *NamespaceConfig) setShards(ring *sharding.Ring) {
+	c.shards = ring
+}
+
+func (c *NamespaceConfig) isOwnedShard(namespace string) bool {
+	if !c.sharded {
+		return true
+	}
+	if owner := c.shards.Owner(namespace); owner != c.shardIdentity {
+		c.logging.NamespaceNotControlled(namespace, owner)
+		return false
+	}
+	return true
+}
+
 func (c *NamespaceConfig) controller(namespace string) (string, bool) {
 	if controller, ok := c.get(namespace, controllerSettingKey); ok {
 		if strings.Contains(controller, "/") {
@@ -57,13 +83,13 @@ func (c *NamespaceConfig) isControlled(namespace string) bool {
 			c.logging.NamespaceNotControlled(namespace, controller)
 			return false
 		}
-		return true
+		return c.isOwnedShard(namespace)
 	}
 	if c.requireExplicitControl {
 		c.logging.NamespaceNotControlled(namespace, "")
 		return false
 	}
-	return true
+	return c.isOwnedShard(namespace)
 }
 
 func (c *NamespaceConfig) get(namespace string, setting string) (string, bool) {
@@ -0,0 +1,120 @@
+// Package events defines the Kubernetes Events the controller records when
+// the state of a skupper resource changes.
+//
+// Event reasons are part of the controller's interface: tooling and alerts
+// may select events by reason, so existing reasons must not be renamed.
+package events
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+
+	skupperscheme "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/scheme"
+)
+
+// Component is the source component of the events the controller records.
+const Component = "skupper-controller"
+
+// Site reasons.
+const (
+	// SiteConfigurationFailed is recorded when a Site can no longer be
+	// configured.
+	SiteConfigurationFailed = "SiteConfigurationFailed"
+)
+
+// Link reasons.
+const (
+	// LinkOperational is recorded when a Link becomes operational.
+	LinkOperational = "LinkOperational"
+	// LinkNotOperational is recorded when a Link stops being operational.
+	LinkNotOperational = "LinkNotOperational"
+	// LinkConfigurationFailed is recorded when a Link can no longer be
+	// configured.
+	LinkConfigurationFailed = "LinkConfigurationFailed"
+)
+
+// Listener reasons.
+const (
+	// ListenerMatched is recorded when a Listener gains a matching
+	// connector somewhere in the network.
+	ListenerMatched = "ListenerMatched"
+	// ListenerUnmatched is recorded when a Listener loses its last
+	// matching connector.
+	ListenerUnmatched = "ListenerUnmatched"
+	// ListenerConfigurationFailed is recorded when a Listener can no
+	// longer be configured.
+	ListenerConfigurationFailed = "ListenerConfigurationFailed"
+)
+
+// Connector reasons.
+const (
+	// ConnectorMatched is recorded when a Connector gains a matching
+	// listener somewhere in the network.
+	ConnectorMatched = "ConnectorMatched"
+	// ConnectorUnmatched is recorded when a Connector loses its last
+	// matching listener.
+	ConnectorUnmatched = "ConnectorUnmatched"
+	// ConnectorNoPodsSelected is recorded when the selector of a
+	// Connector stops matching any pod.
+	ConnectorNoPodsSelected = "ConnectorNoPodsSelected"
+	// ConnectorPodsSelected is recorded when the selector of a Connector
+	// matches pods again.
+	ConnectorPodsSelected = "ConnectorPodsSelected"
+	// ConnectorConfigurationFailed is recorded when a Connector can no
+	// longer be configured.
+	ConnectorConfigurationFailed = "ConnectorConfigurationFailed"
+)
+
+// Certificate reasons.
+const (
+	// CertificateGenerated is recorded when the Secret for a Certificate
+	// is first generated.
+	CertificateGenerated = "CertificateGenerated"
+	// CertificateRegenerated is recorded when the Secret for a Certificate
+	// is generated again, for example because its hosts changed.
+	CertificateRegenerated = "CertificateRegenerated"
+	// CertificateFailed is recorded when a Certificate stops being ready.
+	CertificateFailed = "CertificateFailed"
+)
+
+// AccessToken and AccessGrant reasons.
+const (
+	// TokenRedeemed is recorded when an AccessToken is redeemed.
+	TokenRedeemed = "TokenRedeemed"
+	// TokenRedemptionFailed is recorded when redeeming an AccessToken
+	// fails.
+	TokenRedemptionFailed = "TokenRedemptionFailed"
+	// RedemptionRejected is recorded on an AccessGrant when the grant
+	// server refuses to redeem it.
+	RedemptionRejected = "RedemptionRejected"
+)
+
+// Multi-Cluster Services reasons.
+const (
+	// ServiceImportConflict is recorded on a ServiceImport that was not
+	// created by skupper but has the name of a service exported
+	// elsewhere in the network.
+	ServiceImportConflict = "ServiceImportConflict"
+)
+
+// Scheme resolves the kinds of the objects events are recorded for,
+// including the skupper resources.
+var Scheme = runtime.NewScheme()
+
+func init() {
+	if err := clientgoscheme.AddToScheme(Scheme); err != nil {
+		panic(err)
+	}
+	if err := skupperscheme.AddToScheme(Scheme); err != nil {
+		panic(err)
+	}
+}
+
+// NewRecorder returns a recorder for events from the controller. Events
+// are only delivered once the broadcaster is started with
+// StartRecordingToSink.
+func NewRecorder(broadcaster record.EventBroadcaster) record.EventRecorder {
+	return broadcaster.NewRecorder(Scheme, corev1.EventSource{Component: Component})
+}
@@ -3,7 +3,9 @@ package grants
 import (
 	"flag"
 	"fmt"
+	"net"
 	"strings"
+	"time"
 
 	iflag "github.com/skupperproject/skupper/internal/flag"
 )
@@ -15,6 +17,15 @@ type GrantConfig struct {
 	Port                 int
 	TlsCredentialsSecret string
 	Hostname             string
+	MaxFailedRedemptions int
+	LockoutSeconds       int
+	// TrustedProxies lists, as comma separated addresses or CIDRs, the
+	// reverse proxies or load balancers in front of the grant server
+	// whose X-Forwarded-For header is used to determine the address
+	// of a client. When empty, the address of the peer connecting to
+	// the grant server is used, so source restrictions and lockouts
+	// only apply as intended when the server is exposed directly.
+	TrustedProxies string
 }
 
 func BoundGrantConfig(flags *flag.FlagSet) (*GrantConfig, error) {
@@ -32,6 +43,13 @@ func BoundGrantConfig(flags *flag.FlagSet) (*GrantConfig, error) {
 	}
 	iflag.StringVar(flags, &c.TlsCredentialsSecret, "grant-server-tls-credentials", "SKUPPER_GRANT_SERVER_TLS_CREDENTIALS", "skupper-grant-server", "The name of a secret in which TLS credentials for the AccessGrant server are found.")
 	iflag.StringVar(flags, &c.Hostname, "grant-server-podname", "HOSTNAME", "", "The name of the pod in which the AccessGrant server is running (defaults to $HOSTNAME).")
+	if err := iflag.IntVar(flags, &c.MaxFailedRedemptions, "grant-server-max-failed-redemptions", "SKUPPER_GRANT_SERVER_MAX_FAILED_REDEMPTIONS", 5, "The number of consecutive failed redemptions after which a source address is locked out (0 disables lockouts)."); err != nil {
+		errors = append(errors, err.Error())
+	}
+	if err := iflag.IntVar(flags, &c.LockoutSeconds, "grant-server-lockout-seconds", "SKUPPER_GRANT_SERVER_LOCKOUT_SECONDS", 60, "How long, in seconds, a source address is first locked out for. Each further lockout of the same source lasts twice as long."); err != nil {
+		errors = append(errors, err.Error())
+	}
+	iflag.StringVar(flags, &c.TrustedProxies, "grant-server-trusted-proxies", "SKUPPER_GRANT_SERVER_TRUSTED_PROXIES", "", "Comma separated addresses or CIDRs of proxies in front of the AccessGrant server whose X-Forwarded-For header identifies the client. When empty, the address of the peer connecting to the server is used.")
 	if len(errors) > 0 {
 		return c, fmt.Errorf("Invalid environment variable(s): %s", strings.Join(errors, ", "))
 	}
@@ -42,6 +60,26 @@ func (c *GrantConfig) addr() string {
 	return fmt.Sprintf(":%d", c.Port)
 }
 
+func (c *GrantConfig) limiter() *attemptLimiter {
+	return newAttemptLimiter(c.MaxFailedRedemptions, time.Duration(c.LockoutSeconds)*time.Second)
+}
+
+func (c *GrantConfig) trustedProxies() ([]*net.IPNet, error) {
+	var proxies []*net.IPNet
+	for _, value := range strings.Split(c.TrustedProxies, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		network, err := parseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", value)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
 func (c *GrantConfig) scheme() string {
 	if c.tlsEnabled() {
 		return "https"
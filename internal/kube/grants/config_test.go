@@ -22,17 +22,21 @@ func Test_BoundGrantConfig(t *testing.T) {
 				Port:                 9090,
 				TlsCredentialsSecret: "skupper-grant-server",
 				Hostname:             os.Getenv("HOSTNAME"),
+				MaxFailedRedemptions: 5,
+				LockoutSeconds:       60,
 			},
 		},
 		{
 			name: "env vars",
 			env: map[string]string{
-				"SKUPPER_ENABLE_GRANTS":                "true",
-				"SKUPPER_GRANT_SERVER_AUTOCONFIGURE":   "true",
-				"SKUPPER_GRANT_SERVER_BASE_URL":        "https://acme.org:8888/grants",
-				"SKUPPER_GRANT_SERVER_PORT":            "1234",
-				"SKUPPER_GRANT_SERVER_TLS_CREDENTIALS": "my-secret",
-				"HOSTNAME":                             "my-host",
+				"SKUPPER_ENABLE_GRANTS":                       "true",
+				"SKUPPER_GRANT_SERVER_AUTOCONFIGURE":          "true",
+				"SKUPPER_GRANT_SERVER_BASE_URL":               "https://acme.org:8888/grants",
+				"SKUPPER_GRANT_SERVER_PORT":                   "1234",
+				"SKUPPER_GRANT_SERVER_TLS_CREDENTIALS":        "my-secret",
+				"HOSTNAME":                                    "my-host",
+				"SKUPPER_GRANT_SERVER_MAX_FAILED_REDEMPTIONS": "3",
+				"SKUPPER_GRANT_SERVER_LOCKOUT_SECONDS":        "30",
 			},
 			expectedValue: &GrantConfig{
 				Enabled:              true,
@@ -41,6 +45,8 @@ func Test_BoundGrantConfig(t *testing.T) {
 				Port:                 1234,
 				TlsCredentialsSecret: "my-secret",
 				Hostname:             "my-host",
+				MaxFailedRedemptions: 3,
+				LockoutSeconds:       30,
 			},
 		},
 		{
@@ -60,6 +66,8 @@ func Test_BoundGrantConfig(t *testing.T) {
 				"--grant-server-port=9876",
 				"--grant-server-tls-credentials=a-different-secret",
 				"--grant-server-podname=a-different-host",
+				"--grant-server-max-failed-redemptions=0",
+				"--grant-server-lockout-seconds=10",
 			},
 			expectedValue: &GrantConfig{
 				Enabled:              false,
@@ -68,6 +76,8 @@ func Test_BoundGrantConfig(t *testing.T) {
 				Port:                 9876,
 				TlsCredentialsSecret: "a-different-secret",
 				Hostname:             "a-different-host",
+				MaxFailedRedemptions: 0,
+				LockoutSeconds:       10,
 			},
 		},
 		{
@@ -87,6 +97,8 @@ func Test_BoundGrantConfig(t *testing.T) {
 				Port:                 1234,
 				TlsCredentialsSecret: "my-secret",
 				Hostname:             "my-host",
+				MaxFailedRedemptions: 5,
+				LockoutSeconds:       60,
 			},
 		},
 		{
@@ -111,6 +123,8 @@ func Test_BoundGrantConfig(t *testing.T) {
 				Port:                 1234,
 				TlsCredentialsSecret: "my-secret",
 				Hostname:             "my-host",
+				MaxFailedRedemptions: 5,
+				LockoutSeconds:       60,
 			},
 		},
 		{
@@ -135,6 +149,8 @@ func Test_BoundGrantConfig(t *testing.T) {
 				Port:                 1234,
 				TlsCredentialsSecret: "my-secret",
 				Hostname:             "my-host",
+				MaxFailedRedemptions: 5,
+				LockoutSeconds:       60,
 			},
 		},
 		{
@@ -159,6 +175,8 @@ func Test_BoundGrantConfig(t *testing.T) {
 				Port:                 9090,
 				TlsCredentialsSecret: "my-secret",
 				Hostname:             "my-host",
+				MaxFailedRedemptions: 5,
+				LockoutSeconds:       60,
 			},
 		},
 	}
@@ -214,3 +232,42 @@ func Test_GrantConfigMethods(t *testing.T) {
 		})
 	}
 }
+
+func Test_GrantConfigTrustedProxies(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected []string
+		err      string
+	}{
+		{
+			name: "none",
+		},
+		{
+			name:     "addresses and networks",
+			value:    "10.0.0.0/8, 192.0.2.1,,2001:db8::/32",
+			expected: []string{"10.0.0.0/8", "192.0.2.1/32", "2001:db8::/32"},
+		},
+		{
+			name:  "invalid",
+			value: "10.0.0.0/8,proxy.example.com",
+			err:   "invalid trusted proxy \"proxy.example.com\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &GrantConfig{TrustedProxies: tt.value}
+			proxies, err := config.trustedProxies()
+			if tt.err != "" {
+				assert.Error(t, err, tt.err)
+				return
+			}
+			assert.Assert(t, err)
+			var actual []string
+			for _, proxy := range proxies {
+				actual = append(actual, proxy.String())
+			}
+			assert.DeepEqual(t, actual, tt.expected)
+		})
+	}
+}
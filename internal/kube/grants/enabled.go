@@ -16,6 +16,13 @@ func enabled(controller *watchers.EventProcessor, currentNamespace string, watch
 	gc := &GrantsEnabled{
 		grants: newGrants(controller, generator, config.scheme(), config.BaseUrl),
 	}
+	gc.grants.limiter = config.limiter()
+	gc.grants.recorder = controller.GetEventRecorder()
+	if proxies, err := config.trustedProxies(); err != nil {
+		log.Printf("Ignoring trusted proxies for grant server: %s", err)
+	} else {
+		gc.grants.trustedProxies = proxies
+	}
 	gc.server = newServer(config.addr(), config.tlsEnabled(), gc.grants)
 
 	gc.grantWatcher = controller.WatchAccessGrants(watchNamespace, watchers.FilterByNamespace(filter, gc.grants.checkGrant))
@@ -7,16 +7,23 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"gotest.tools/v3/assert"
+	"gotest.tools/v3/poll"
+	corev1 "k8s.io/api/core/v1"
 	meta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/skupperproject/skupper/internal/kube/client/fake"
 	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	fakev2alpha1 "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned/typed/skupper/v2alpha1/fake"
 )
 
 func dummyGenerator(namespace string, name string, subject string, writer io.Writer) error {
@@ -517,3 +524,372 @@ func Test_checkGrant(t *testing.T) {
 		})
 	}
 }
+
+func Test_ServeHttpRestrictions(t *testing.T) {
+	restricted := &v2alpha1.AccessGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "restricted",
+			Namespace: "test",
+			UID:       "5e1c1f4e-4c3b-4a34-9a3c-1e2c63a4a4a1",
+		},
+		Spec: v2alpha1.AccessGrantSpec{
+			RedemptionsAllowed: 10,
+			AllowedSourceCIDRs: []string{"10.0.0.0/8", "192.0.2.10"},
+			ExpectedSiteName:   "west",
+		},
+		Status: v2alpha1.AccessGrantStatus{
+			Code:           "supersecret",
+			ExpirationTime: time.Date(2124, time.January, 0, 0, 0, 0, 0, time.UTC).Format(time.RFC3339),
+		},
+	}
+	tests := []struct {
+		name           string
+		remoteAddr     string
+		site           string
+		code           string
+		expectedCode   int
+		expectedReason string
+	}{
+		{
+			name:         "allowed network and site",
+			remoteAddr:   "10.1.2.3:43210",
+			site:         "west",
+			code:         "supersecret",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "allowed address and site",
+			remoteAddr:   "192.0.2.10:43210",
+			site:         "west",
+			code:         "supersecret",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:           "source not allowed",
+			remoteAddr:     "192.0.2.11:43210",
+			site:           "west",
+			code:           "supersecret",
+			expectedCode:   http.StatusForbidden,
+			expectedReason: "source 192.0.2.11 not allowed",
+		},
+		{
+			name:           "unexpected site",
+			remoteAddr:     "10.1.2.3:43210",
+			site:           "east",
+			code:           "supersecret",
+			expectedCode:   http.StatusForbidden,
+			expectedReason: "site \"east\" not expected",
+		},
+		{
+			name:           "site not provided",
+			remoteAddr:     "10.1.2.3:43210",
+			code:           "supersecret",
+			expectedCode:   http.StatusForbidden,
+			expectedReason: "site name not provided",
+		},
+		{
+			name:           "bad code",
+			remoteAddr:     "10.1.2.3:43210",
+			site:           "west",
+			code:           "guess",
+			expectedCode:   http.StatusForbidden,
+			expectedReason: "invalid code",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grant := restricted.DeepCopy()
+			client, err := fake.NewFakeClient("test", nil, []runtime.Object{grant}, "")
+			assert.Assert(t, err)
+			registry := newGrants(client, dummyGenerator, "https", "")
+			recorder := record.NewFakeRecorder(10)
+			registry.recorder = recorder
+			assert.Assert(t, registry.checkGrant(grant.Namespace+"/"+grant.Name, grant))
+
+			req := httptest.NewRequest(http.MethodPost, "/"+string(grant.ObjectMeta.UID), bytes.NewBufferString(tt.code))
+			req.RemoteAddr = tt.remoteAddr
+			if tt.site != "" {
+				req.Header.Add("site", tt.site)
+			}
+			res := httptest.NewRecorder()
+			registry.ServeHTTP(res, req)
+			assert.Equal(t, res.Code, tt.expectedCode)
+
+			latest, err := client.GetSkupperClient().SkupperV2alpha1().AccessGrants(grant.Namespace).Get(context.TODO(), grant.Name, metav1.GetOptions{})
+			assert.Assert(t, err)
+			if tt.expectedReason == "" {
+				assert.Equal(t, latest.Status.Redemptions, 1)
+				assert.Equal(t, len(latest.Status.RejectedRedemptions), 0)
+				assert.Equal(t, len(recorder.Events), 0)
+				return
+			}
+			assert.Equal(t, latest.Status.Redemptions, 0)
+			assert.Equal(t, len(latest.Status.RejectedRedemptions), 1)
+			rejected := latest.Status.RejectedRedemptions[0]
+			source, _, _ := strings.Cut(tt.remoteAddr, ":")
+			assert.Equal(t, rejected.Source, source)
+			assert.Equal(t, rejected.Reason, tt.expectedReason)
+			assert.Equal(t, len(recorder.Events), 1)
+			event := <-recorder.Events
+			assert.Assert(t, strings.HasPrefix(event, corev1.EventTypeWarning+" RedemptionRejected "), event)
+			assert.Assert(t, strings.Contains(event, tt.expectedReason), event)
+		})
+	}
+}
+
+func Test_ServeHttpLockout(t *testing.T) {
+	grant := &v2alpha1.AccessGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "good",
+			Namespace: "test",
+			UID:       "0bde3bc8-a4a2-404a-bfbe-44fdf7bf3231",
+		},
+		Spec: v2alpha1.AccessGrantSpec{
+			RedemptionsAllowed: 1,
+		},
+		Status: v2alpha1.AccessGrantStatus{
+			Code:           "supersecret",
+			ExpirationTime: time.Date(2124, time.January, 0, 0, 0, 0, 0, time.UTC).Format(time.RFC3339),
+		},
+	}
+	client, err := fake.NewFakeClient("test", nil, []runtime.Object{grant}, "")
+	assert.Assert(t, err)
+	registry := newGrants(client, dummyGenerator, "https", "")
+	registry.limiter = newAttemptLimiter(3, time.Minute)
+	registry.rejectionInterval = 0
+	assert.Assert(t, registry.checkGrant(grant.Namespace+"/"+grant.Name, grant))
+
+	redeem := func(path string, code string, remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(code))
+		req.RemoteAddr = remoteAddr
+		res := httptest.NewRecorder()
+		registry.ServeHTTP(res, req)
+		return res
+	}
+	path := "/" + string(grant.ObjectMeta.UID)
+
+	assert.Equal(t, redeem("/no-such-grant", "supersecret", "203.0.113.5:1000").Code, http.StatusNotFound)
+	assert.Equal(t, redeem(path, "guess", "203.0.113.5:1000").Code, http.StatusForbidden)
+	assert.Equal(t, redeem(path, "guess", "203.0.113.5:1000").Code, http.StatusForbidden)
+
+	// the source is now locked out, even with the right code
+	res := redeem(path, "supersecret", "203.0.113.5:1000")
+	assert.Equal(t, res.Code, http.StatusTooManyRequests)
+	assert.Equal(t, res.Header().Get("Retry-After"), "60")
+
+	latest, err := client.GetSkupperClient().SkupperV2alpha1().AccessGrants(grant.Namespace).Get(context.TODO(), grant.Name, metav1.GetOptions{})
+	assert.Assert(t, err)
+	assert.Equal(t, len(latest.Status.RejectedRedemptions), 2)
+	assert.Equal(t, latest.Status.RejectedRedemptions[1].Reason, "invalid code (source locked out for 1m0s)")
+
+	// other sources are not affected
+	assert.Equal(t, redeem(path, "supersecret", "203.0.113.6:1000").Code, http.StatusOK)
+}
+
+func Test_checkGrantInvalidSourceCIDR(t *testing.T) {
+	grant := &v2alpha1.AccessGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "bad-cidr",
+			Namespace: "test",
+			UID:       "7a3d0c55-3c8e-4d6b-8c0e-0d5b8f1f2b1a",
+		},
+		Spec: v2alpha1.AccessGrantSpec{
+			AllowedSourceCIDRs: []string{"10.0.0.0/8", "10.0.0.0/33"},
+		},
+	}
+	client, err := fake.NewFakeClient("test", nil, []runtime.Object{grant}, "")
+	assert.Assert(t, err)
+	registry := newGrants(client, dummyGenerator, "https", "")
+	assert.Assert(t, registry.checkGrant(grant.Namespace+"/"+grant.Name, grant))
+
+	latest, err := client.GetSkupperClient().SkupperV2alpha1().AccessGrants(grant.Namespace).Get(context.TODO(), grant.Name, metav1.GetOptions{})
+	assert.Assert(t, err)
+	assert.Assert(t, meta.IsStatusConditionFalse(latest.Status.Conditions, v2alpha1.CONDITION_TYPE_PROCESSED))
+	assert.Assert(t, strings.Contains(latest.Status.Message, "Invalid source CIDR \"10.0.0.0/33\""), latest.Status.Message)
+}
+
+func Test_ServeHttpRejectionsAggregated(t *testing.T) {
+	grant := &v2alpha1.AccessGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "good",
+			Namespace: "test",
+			UID:       "1c6b1a53-8a6f-4b7e-9a63-3f0f7d8e2c11",
+		},
+		Spec: v2alpha1.AccessGrantSpec{
+			RedemptionsAllowed: 1,
+		},
+		Status: v2alpha1.AccessGrantStatus{
+			Code:           "supersecret",
+			ExpirationTime: time.Date(2124, time.January, 0, 0, 0, 0, 0, time.UTC).Format(time.RFC3339),
+		},
+	}
+	client, err := fake.NewFakeClient("test", nil, []runtime.Object{grant}, "")
+	assert.Assert(t, err)
+	registry := newGrants(client, dummyGenerator, "https", "")
+	registry.limiter = newAttemptLimiter(0, time.Minute)
+	registry.rejectionInterval = 500 * time.Millisecond
+	recorder := record.NewFakeRecorder(10)
+	registry.recorder = recorder
+	assert.Assert(t, registry.checkGrant(grant.Namespace+"/"+grant.Name, grant))
+
+	redeem := func(remoteAddr string) {
+		req := httptest.NewRequest(http.MethodPost, "/"+string(grant.ObjectMeta.UID), bytes.NewBufferString("guess"))
+		req.RemoteAddr = remoteAddr
+		res := httptest.NewRecorder()
+		registry.ServeHTTP(res, req)
+		assert.Equal(t, res.Code, http.StatusForbidden)
+	}
+	rejected := func() []v2alpha1.RejectedRedemption {
+		latest, err := client.GetSkupperClient().SkupperV2alpha1().AccessGrants(grant.Namespace).Get(context.TODO(), grant.Name, metav1.GetOptions{})
+		assert.Assert(t, err)
+		return latest.Status.RejectedRedemptions
+	}
+
+	// the first rejection is recorded straight away, those following
+	// within the interval only once it has elapsed
+	redeem("203.0.113.5:1000")
+	redeem("203.0.113.6:1000")
+	redeem("203.0.113.7:1000")
+	assert.Equal(t, len(rejected()), 1)
+	assert.Equal(t, len(recorder.Events), 1)
+	assert.Equal(t, <-recorder.Events, "Warning RedemptionRejected Redemption from 203.0.113.5 rejected: invalid code")
+
+	poll.WaitOn(t, func(poll.LogT) poll.Result {
+		if redemptions := rejected(); len(redemptions) < 3 {
+			return poll.Continue("%d rejected redemptions recorded", len(redemptions))
+		}
+		return poll.Success()
+	}, poll.WithDelay(20*time.Millisecond), poll.WithTimeout(5*time.Second))
+	assert.Equal(t, rejected()[2].Source, "203.0.113.7")
+	select {
+	case event := <-recorder.Events:
+		assert.Equal(t, event, "Warning RedemptionRejected 2 redemptions rejected, most recently from 203.0.113.7: invalid code")
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event recorded for the aggregated rejections")
+	}
+}
+
+// Test_ServeHttpConcurrentRejections is meant to be run with -race:
+// rejections are flushed from a timer while other requests redeem the
+// same grant.
+func Test_ServeHttpConcurrentRejections(t *testing.T) {
+	grant := &v2alpha1.AccessGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "good",
+			Namespace: "test",
+			UID:       "5e0f4a2b-6c1d-4f3e-9b8a-7d2c1e0f3a4b",
+		},
+		Spec: v2alpha1.AccessGrantSpec{
+			RedemptionsAllowed: 100,
+		},
+		Status: v2alpha1.AccessGrantStatus{
+			Code:           "supersecret",
+			ExpirationTime: time.Date(2124, time.January, 0, 0, 0, 0, 0, time.UTC).Format(time.RFC3339),
+		},
+	}
+	client, err := fake.NewFakeClient("test", nil, []runtime.Object{grant}, "")
+	assert.Assert(t, err)
+	registry := newGrants(client, dummyGenerator, "https", "")
+	registry.limiter = newAttemptLimiter(0, time.Minute)
+	registry.rejectionInterval = time.Millisecond
+	registry.recorder = record.NewFakeRecorder(100)
+	assert.Assert(t, registry.checkGrant(grant.Namespace+"/"+grant.Name, grant))
+	// slow status updates down so that other requests get to use the
+	// grant while it is being written
+	client.GetSkupperClient().SkupperV2alpha1().(*fakev2alpha1.FakeSkupperV2alpha1).PrependReactor("update", "accessgrants", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		time.Sleep(time.Millisecond)
+		return false, nil, nil
+	})
+
+	redeem := func(code string) int {
+		req := httptest.NewRequest(http.MethodPost, "/"+string(grant.ObjectMeta.UID), bytes.NewBufferString(code))
+		req.RemoteAddr = "203.0.113.5:1000"
+		res := httptest.NewRecorder()
+		registry.ServeHTTP(res, req)
+		return res.Code
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 5; j++ {
+				assert.Check(t, redeem("guess") == http.StatusForbidden)
+				time.Sleep(time.Millisecond)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			redeem("supersecret")
+			registry.recheckUrl()
+		}()
+	}
+	wg.Wait()
+
+	// the cached grant is not changed other than through its status
+	// updates, so the code is still accepted
+	assert.Equal(t, registry.get(string(grant.ObjectMeta.UID)).Status.Code, "supersecret")
+}
+
+func Test_remoteHost(t *testing.T) {
+	tests := []struct {
+		name       string
+		proxies    string
+		remoteAddr string
+		forwarded  []string
+		expected   string
+	}{
+		{
+			name:       "direct",
+			remoteAddr: "203.0.113.5:1000",
+			expected:   "203.0.113.5",
+		},
+		{
+			name:       "forwarded header ignored without trusted proxies",
+			remoteAddr: "203.0.113.5:1000",
+			forwarded:  []string{"198.51.100.1"},
+			expected:   "203.0.113.5",
+		},
+		{
+			name:       "forwarded header ignored from untrusted peer",
+			proxies:    "10.0.0.0/8",
+			remoteAddr: "203.0.113.5:1000",
+			forwarded:  []string{"198.51.100.1"},
+			expected:   "203.0.113.5",
+		},
+		{
+			name:       "client behind trusted proxy",
+			proxies:    "10.0.0.0/8",
+			remoteAddr: "10.1.1.1:1000",
+			forwarded:  []string{"198.51.100.1"},
+			expected:   "198.51.100.1",
+		},
+		{
+			name:       "spoofed entries before the last untrusted address",
+			proxies:    "10.0.0.0/8",
+			remoteAddr: "10.1.1.1:1000",
+			forwarded:  []string{"192.0.2.99, 198.51.100.1", "10.2.2.2"},
+			expected:   "198.51.100.1",
+		},
+		{
+			name:       "no forwarded header from trusted proxy",
+			proxies:    "10.0.0.0/8",
+			remoteAddr: "10.1.1.1:1000",
+			expected:   "10.1.1.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proxies, err := (&GrantConfig{TrustedProxies: tt.proxies}).trustedProxies()
+			assert.Assert(t, err)
+			registry := newGrants(nil, nil, "https", "")
+			registry.trustedProxies = proxies
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwarded {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+			assert.Equal(t, registry.remoteHost(req), tt.expected)
+		})
+	}
+}
@@ -5,21 +5,37 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math"
+	"net"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubetypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 
 	internalclient "github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/kube/events"
+	"github.com/skupperproject/skupper/internal/kube/metrics"
 	"github.com/skupperproject/skupper/internal/utils"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 )
 
 type GrantResponse func(namespace string, name string, subject string, writer io.Writer) error
 
+// maxRejectedRedemptions is the number of rejected redemptions kept in
+// the status of an AccessGrant.
+const maxRejectedRedemptions = 10
+
+// rejectionInterval is the minimum time between writes recording
+// rejected redemptions of the same AccessGrant. Rejections within the
+// interval are recorded together once it has elapsed.
+const rejectionInterval = 10 * time.Second
+
 type Grants struct {
 	clients    internalclient.Clients
 	generator  GrantResponse
@@ -29,6 +45,21 @@ type Grants struct {
 	grants     map[kubetypes.UID]*skupperv2alpha1.AccessGrant
 	grantIndex map[string]kubetypes.UID
 	lock       sync.Mutex
+	limiter    *attemptLimiter
+	recorder   record.EventRecorder
+	// trustedProxies are the peers whose X-Forwarded-For header is
+	// used to determine the source of a request.
+	trustedProxies    []*net.IPNet
+	rejections        map[kubetypes.UID]*rejectionLog
+	rejectionInterval time.Duration
+}
+
+// rejectionLog holds the rejected redemptions of an AccessGrant that
+// are yet to be recorded.
+type rejectionLog struct {
+	pending []skupperv2alpha1.RejectedRedemption
+	written time.Time
+	timer   *time.Timer
 }
 
 func newGrants(clients internalclient.Clients, generator GrantResponse, scheme string, url string) *Grants {
@@ -39,6 +70,9 @@ func newGrants(clients internalclient.Clients, generator GrantResponse, scheme s
 		url:        url,
 		grants:     map[kubetypes.UID]*skupperv2alpha1.AccessGrant{},
 		grantIndex: map[string]kubetypes.UID{},
+
+		rejections:        map[kubetypes.UID]*rejectionLog{},
+		rejectionInterval: rejectionInterval,
 	}
 }
 
@@ -74,6 +108,12 @@ func (g *Grants) remove(key string) {
 	if uid, ok := g.grantIndex[key]; ok {
 		delete(g.grantIndex, key)
 		delete(g.grants, uid)
+		if rejections, ok := g.rejections[uid]; ok {
+			if rejections.timer != nil {
+				rejections.timer.Stop()
+			}
+			delete(g.rejections, uid)
+		}
 	}
 }
 
@@ -84,12 +124,15 @@ func (g *Grants) put(grant *skupperv2alpha1.AccessGrant) error {
 	return nil
 }
 
+// get returns a copy of the cached grant, which the caller is free to
+// modify, as the request path and the flushing of rejections may
+// update the same grant concurrently.
 func (g *Grants) get(key string) *skupperv2alpha1.AccessGrant {
 	g.lock.Lock()
 	defer g.lock.Unlock()
 
 	if grant, ok := g.grants[kubetypes.UID(key)]; ok {
-		return grant
+		return grant.DeepCopy()
 	}
 	return nil
 }
@@ -99,7 +142,7 @@ func (g *Grants) getAll() []*skupperv2alpha1.AccessGrant {
 	defer g.lock.Unlock()
 	var grants []*skupperv2alpha1.AccessGrant
 	for _, grant := range g.grants {
-		grants = append(grants, grant)
+		grants = append(grants, grant.DeepCopy())
 	}
 	return grants
 }
@@ -175,7 +218,6 @@ func (g *Grants) checkGrant(key string, grant *skupperv2alpha1.AccessGrant) erro
 		g.remove(key)
 		return nil
 	}
-	g.record(key, grant)
 	changed := false
 	var status []string
 	if g.checkUrl(key, grant) {
@@ -212,6 +254,12 @@ func (g *Grants) checkGrant(key string, grant *skupperv2alpha1.AccessGrant) erro
 	}
 	var err error
 
+	for _, cidr := range grant.Spec.AllowedSourceCIDRs {
+		if _, e := parseCIDR(cidr); e != nil {
+			status = append(status, fmt.Sprintf("Invalid source CIDR %q: %s", cidr, e))
+		}
+	}
+
 	if len(status) != 0 {
 		err = fmt.Errorf("%s", strings.Join(status, ", "))
 	}
@@ -222,6 +270,9 @@ func (g *Grants) checkGrant(key string, grant *skupperv2alpha1.AccessGrant) erro
 		changed = true
 	}
 
+	// redemptions read the cached grant concurrently, so a copy is
+	// only cached once the status is complete
+	g.record(key, grant.DeepCopy())
 	if !changed {
 		return nil
 	}
@@ -237,11 +288,20 @@ func (g *Grants) updateGrantStatus(grant *skupperv2alpha1.AccessGrant) error {
 	return nil
 }
 
-func (g *Grants) checkAndUpdateAccessToken(key string, data []byte) (*skupperv2alpha1.AccessGrant, *HttpError) {
+// redemption is an attempt to redeem an access token.
+type redemption struct {
+	key    string
+	code   []byte
+	source net.IP
+	site   string
+}
+
+func (g *Grants) checkAndUpdateAccessToken(request redemption) (*skupperv2alpha1.AccessGrant, *HttpError) {
+	key := request.key
 	log.Printf("Checking access token for %s", key)
 	grant := g.get(key)
 	if grant == nil {
-		return nil, httpError("No such claim", http.StatusNotFound)
+		return nil, rejection("No such claim", http.StatusNotFound, "no such access grant")
 	}
 
 	expiration, err := time.Parse(time.RFC3339, grant.Status.ExpirationTime)
@@ -251,14 +311,30 @@ func (g *Grants) checkAndUpdateAccessToken(key string, data []byte) (*skupperv2a
 	}
 	if expiration.Before(time.Now()) {
 		log.Printf("AccessGrant %s/%s expired", grant.Namespace, grant.Name)
-		return nil, httpError("No such claim", http.StatusNotFound)
+		return grant, rejection("No such claim", http.StatusNotFound, "access grant expired")
 	}
 	if grant.Spec.RedemptionsAllowed <= grant.Status.Redemptions {
 		log.Printf("AccessGrant %s/%s already redeemed", grant.Namespace, grant.Name)
-		return nil, httpError("No such access granted", http.StatusNotFound)
+		return grant, rejection("No such access granted", http.StatusNotFound, "access grant already redeemed")
+	}
+	if allowed, err := sourceAllowed(grant, request.source); !allowed {
+		reason := fmt.Sprintf("source %s not allowed", request.source)
+		if err != nil {
+			reason = err.Error()
+		}
+		log.Printf("Redemption of AccessGrant %s/%s refused: %s", grant.Namespace, grant.Name, reason)
+		return grant, rejection("Redemption of access token refused", http.StatusForbidden, reason)
 	}
-	if grant.Status.Code != string(data) {
-		return nil, httpError("Redemption of access token refused", http.StatusForbidden)
+	if grant.Status.Code != string(request.code) {
+		return grant, rejection("Redemption of access token refused", http.StatusForbidden, "invalid code")
+	}
+	if grant.Spec.ExpectedSiteName != "" && grant.Spec.ExpectedSiteName != request.site {
+		reason := fmt.Sprintf("site %q not expected", request.site)
+		if request.site == "" {
+			reason = "site name not provided"
+		}
+		log.Printf("Redemption of AccessGrant %s/%s refused: %s", grant.Namespace, grant.Name, reason)
+		return grant, rejection("Redemption of access token refused", http.StatusForbidden, reason)
 	}
 	grant.Status.Redemptions += 1
 	err = g.updateGrantStatus(grant)
@@ -269,12 +345,125 @@ func (g *Grants) checkAndUpdateAccessToken(key string, data []byte) (*skupperv2a
 	return grant, nil
 }
 
+// sourceAllowed returns whether the access grant can be redeemed from
+// the given address.
+func sourceAllowed(grant *skupperv2alpha1.AccessGrant, source net.IP) (bool, error) {
+	if len(grant.Spec.AllowedSourceCIDRs) == 0 {
+		return true, nil
+	}
+	if source == nil {
+		return false, fmt.Errorf("source address unknown")
+	}
+	for _, cidr := range grant.Spec.AllowedSourceCIDRs {
+		network, err := parseCIDR(cidr)
+		if err != nil {
+			return false, fmt.Errorf("invalid source CIDR %q", cidr)
+		}
+		if network.Contains(source) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parseCIDR parses a network in CIDR notation, or a single address.
+func parseCIDR(cidr string) (*net.IPNet, error) {
+	if ip := net.ParseIP(cidr); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip.To4() != nil {
+			ip = ip.To4()
+			bits = 8 * net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, network, err := net.ParseCIDR(cidr)
+	return network, err
+}
+
+// recordRejection keeps track of a refused redemption in the status of
+// the access grant and reports it through a warning event. To bound
+// the writes made for unauthenticated requests, rejections following
+// within rejectionInterval of the last write are recorded together
+// once the interval has elapsed.
+func (g *Grants) recordRejection(grant *skupperv2alpha1.AccessGrant, source string, reason string) {
+	g.lock.Lock()
+	rejections, ok := g.rejections[grant.UID]
+	if !ok {
+		rejections = &rejectionLog{}
+		g.rejections[grant.UID] = rejections
+	}
+	rejections.pending = append(rejections.pending, skupperv2alpha1.RejectedRedemption{
+		Time:   time.Now().Format(time.RFC3339),
+		Source: source,
+		Reason: reason,
+	})
+	if rejections.timer != nil {
+		g.lock.Unlock()
+		return
+	}
+	if wait := g.rejectionInterval - time.Since(rejections.written); wait > 0 {
+		uid := grant.UID
+		rejections.timer = time.AfterFunc(wait, func() {
+			g.flushRejections(uid)
+		})
+		g.lock.Unlock()
+		return
+	}
+	g.lock.Unlock()
+	g.flushRejections(grant.UID)
+}
+
+// flushRejections records the pending rejected redemptions of an
+// access grant in its status and in a single warning event.
+func (g *Grants) flushRejections(uid kubetypes.UID) {
+	g.lock.Lock()
+	grant, known := g.grants[uid]
+	rejections, ok := g.rejections[uid]
+	if !ok || !known {
+		g.lock.Unlock()
+		return
+	}
+	grant = grant.DeepCopy()
+	pending := rejections.pending
+	rejections.pending = nil
+	rejections.timer = nil
+	rejections.written = time.Now()
+	g.lock.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+
+	grant.Status.RejectedRedemptions = append(grant.Status.RejectedRedemptions, pending...)
+	if excess := len(grant.Status.RejectedRedemptions) - maxRejectedRedemptions; excess > 0 {
+		grant.Status.RejectedRedemptions = grant.Status.RejectedRedemptions[excess:]
+	}
+	if err := g.updateGrantStatus(grant); err != nil {
+		log.Printf("Error recording rejected redemption for access grant %s/%s: %s", grant.Namespace, grant.Name, err)
+	}
+	latest := pending[len(pending)-1]
+	message := fmt.Sprintf("Redemption from %s rejected: %s", latest.Source, latest.Reason)
+	if len(pending) > 1 {
+		message = fmt.Sprintf("%d redemptions rejected, most recently from %s: %s", len(pending), latest.Source, latest.Reason)
+	}
+	if g.recorder != nil {
+		g.recorder.Event(grant, corev1.EventTypeWarning, events.RedemptionRejected, message)
+	}
+}
+
 func (g *Grants) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		log.Printf("Bad method %s for path %s", r.Method, r.URL.Path)
 		http.Error(w, "Only POST is supported", http.StatusMethodNotAllowed)
 		return
 	}
+	source := g.remoteHost(r)
+	if allowed, retryAfter := g.limiter.allowed(source); !allowed {
+		log.Printf("Redemption from %s refused: too many failed attempts", source)
+		metrics.GrantRedeemed(metrics.GrantRedemptionRefused)
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		http.Error(w, "Too many failed attempts", http.StatusTooManyRequests)
+		return
+	}
 	key := strings.Join(strings.Split(r.URL.Path, "/"), "")
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -283,11 +472,28 @@ func (g *Grants) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	grant, e := g.checkAndUpdateAccessToken(key, body)
+	grant, e := g.checkAndUpdateAccessToken(redemption{
+		key:    key,
+		code:   body,
+		source: net.ParseIP(source),
+		site:   r.Header.Get("site"),
+	})
 	if e != nil {
+		metrics.GrantRedeemed(metrics.GrantRedemptionRefused)
+		if e.reason != "" {
+			reason := e.reason
+			if lockout := g.limiter.failed(source); lockout > 0 {
+				log.Printf("Source %s locked out for %s after too many failed redemptions", source, lockout)
+				reason = fmt.Sprintf("%s (source locked out for %s)", reason, lockout)
+			}
+			if grant != nil {
+				g.recordRejection(grant, source, reason)
+			}
+		}
 		e.write(w)
 		return
 	}
+	g.limiter.succeeded(source)
 
 	name := r.Header.Get("name")
 	if name == "" {
@@ -300,15 +506,20 @@ func (g *Grants) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	if err := g.generator(grant.Namespace, name, subject, w); err != nil {
 		log.Printf("Failed to create token for %s/%s: %s", grant.Namespace, grant.Name, err.Error())
+		metrics.GrantRedeemed(metrics.GrantRedemptionFailed)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	metrics.GrantRedeemed(metrics.GrantRedemptionSucceeded)
 	log.Printf("Redemption of access token %s/%s succeeded", grant.Namespace, grant.Name)
 }
 
 type HttpError struct {
 	text string
 	code int
+	// reason explains why a redemption was rejected, when the
+	// error results from a rejected redemption.
+	reason string
 }
 
 func (e *HttpError) write(w http.ResponseWriter) {
@@ -321,3 +532,54 @@ func httpError(text string, code int) *HttpError {
 		code: code,
 	}
 }
+
+func rejection(text string, code int, reason string) *HttpError {
+	return &HttpError{
+		text:   text,
+		code:   code,
+		reason: reason,
+	}
+}
+
+// remoteHost returns the address of the client that sent the request.
+// When the request was received from a trusted proxy, the client is
+// the last address in the X-Forwarded-For header that is not itself
+// a trusted proxy.
+func (g *Grants) remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !g.trusted(host) {
+		return host
+	}
+	var forwarded []string
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		for _, address := range strings.Split(header, ",") {
+			forwarded = append(forwarded, strings.TrimSpace(address))
+		}
+	}
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		if forwarded[i] == "" {
+			continue
+		}
+		host = forwarded[i]
+		if !g.trusted(host) {
+			break
+		}
+	}
+	return host
+}
+
+func (g *Grants) trusted(host string) bool {
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, proxy := range g.trustedProxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
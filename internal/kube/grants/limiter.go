@@ -0,0 +1,109 @@
+package grants
+
+import (
+	"sync"
+	"time"
+)
+
+// attemptLimiter tracks failed redemption attempts per source address.
+// A source that fails too many times in a row is locked out, and each
+// further lockout of the same source lasts twice as long as the
+// previous one, up to maxLockout.
+type attemptLimiter struct {
+	maxFailures int
+	lockout     time.Duration
+	maxLockout  time.Duration
+	now         func() time.Time
+	lock        sync.Mutex
+	sources     map[string]*sourceAttempts
+}
+
+type sourceAttempts struct {
+	failures    int
+	lockouts    int
+	lastFailure time.Time
+	lockedUntil time.Time
+}
+
+func newAttemptLimiter(maxFailures int, lockout time.Duration) *attemptLimiter {
+	return &attemptLimiter{
+		maxFailures: maxFailures,
+		lockout:     lockout,
+		maxLockout:  lockout * 64,
+		now:         time.Now,
+		sources:     map[string]*sourceAttempts{},
+	}
+}
+
+func (l *attemptLimiter) enabled() bool {
+	return l != nil && l.maxFailures > 0 && l.lockout > 0
+}
+
+// allowed returns whether the source may attempt a redemption and, if
+// not, how long remains until it may.
+func (l *attemptLimiter) allowed(source string) (bool, time.Duration) {
+	if !l.enabled() {
+		return true, 0
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	attempts, ok := l.sources[source]
+	if !ok {
+		return true, 0
+	}
+	if remaining := attempts.lockedUntil.Sub(l.now()); remaining > 0 {
+		return false, remaining
+	}
+	return true, 0
+}
+
+// failed records a failed attempt from the source, returning the length
+// of the lockout it triggered, if any.
+func (l *attemptLimiter) failed(source string) time.Duration {
+	if !l.enabled() {
+		return 0
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := l.now()
+	l.prune(now)
+	attempts, ok := l.sources[source]
+	if !ok {
+		attempts = &sourceAttempts{}
+		l.sources[source] = attempts
+	}
+	attempts.failures++
+	attempts.lastFailure = now
+	if attempts.failures < l.maxFailures {
+		return 0
+	}
+	lockout := l.lockout << attempts.lockouts
+	if lockout > l.maxLockout || lockout <= 0 {
+		lockout = l.maxLockout
+	} else {
+		attempts.lockouts++
+	}
+	attempts.failures = 0
+	attempts.lockedUntil = now.Add(lockout)
+	return lockout
+}
+
+// succeeded forgets the failed attempts of the source.
+func (l *attemptLimiter) succeeded(source string) {
+	if !l.enabled() {
+		return
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.sources, source)
+}
+
+// prune forgets the sources that have not failed for long enough that
+// their next lockout would start over from the shortest one.
+func (l *attemptLimiter) prune(now time.Time) {
+	for source, attempts := range l.sources {
+		if now.Sub(attempts.lastFailure) > l.maxLockout && now.After(attempts.lockedUntil) {
+			delete(l.sources, source)
+		}
+	}
+}
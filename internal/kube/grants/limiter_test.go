@@ -0,0 +1,67 @@
+package grants
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func Test_attemptLimiter(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	limiter := newAttemptLimiter(2, time.Minute)
+	limiter.now = func() time.Time { return now }
+
+	assert.Equal(t, limiter.failed("a"), time.Duration(0))
+	allowed, _ := limiter.allowed("a")
+	assert.Assert(t, allowed)
+
+	// the second failure locks the source out
+	assert.Equal(t, limiter.failed("a"), time.Minute)
+	allowed, remaining := limiter.allowed("a")
+	assert.Assert(t, !allowed)
+	assert.Equal(t, remaining, time.Minute)
+	allowed, _ = limiter.allowed("b")
+	assert.Assert(t, allowed)
+
+	// each further lockout lasts twice as long
+	now = now.Add(time.Minute)
+	allowed, _ = limiter.allowed("a")
+	assert.Assert(t, allowed)
+	limiter.failed("a")
+	assert.Equal(t, limiter.failed("a"), 2*time.Minute)
+	now = now.Add(2 * time.Minute)
+	limiter.failed("a")
+	assert.Equal(t, limiter.failed("a"), 4*time.Minute)
+
+	// up to the maximum lockout
+	for i := 0; i < 20; i++ {
+		limiter.failed("a")
+		limiter.failed("a")
+	}
+	allowed, remaining = limiter.allowed("a")
+	assert.Assert(t, !allowed)
+	assert.Equal(t, remaining, limiter.maxLockout)
+
+	// a success forgets the failures
+	limiter.succeeded("a")
+	allowed, _ = limiter.allowed("a")
+	assert.Assert(t, allowed)
+	assert.Equal(t, limiter.failed("a"), time.Duration(0))
+
+	// sources that stopped failing are eventually forgotten
+	now = now.Add(limiter.maxLockout + time.Second)
+	limiter.failed("b")
+	_, ok := limiter.sources["a"]
+	assert.Assert(t, !ok)
+}
+
+func Test_attemptLimiterDisabled(t *testing.T) {
+	for _, limiter := range []*attemptLimiter{nil, newAttemptLimiter(0, time.Minute), newAttemptLimiter(5, 0)} {
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, limiter.failed("a"), time.Duration(0))
+		}
+		allowed, _ := limiter.allowed("a")
+		assert.Assert(t, allowed)
+	}
+}
@@ -15,21 +15,26 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/tools/record"
 
 	internalclient "github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/kube/events"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 )
 
-func RedeemAccessToken(token *skupperv2alpha1.AccessToken, site *skupperv2alpha1.Site, clients internalclient.Clients) error {
+// RedeemAccessToken redeems a token at the grant server it was issued by,
+// creating the links received in return. The outcome is recorded in the
+// status of the token and as an event.
+func RedeemAccessToken(token *skupperv2alpha1.AccessToken, site *skupperv2alpha1.Site, clients internalclient.Clients, recorder record.EventRecorder) error {
 	transport := &http.Transport{
 		TLSClientConfig: tlsConfig(token),
 	}
 	body, err := postTokenRequest(token, site, transport)
 	if err != nil {
-		return updateAccessTokenStatus(token, err, clients)
+		return updateAccessTokenStatus(token, err, clients, recorder)
 	}
 	log.Printf("HTTP Post to %s for %s/%s was successful, decoding response body", token.Spec.Url, token.Namespace, token.Name)
-	return handleTokenResponse(body, token, site, clients)
+	return handleTokenResponse(body, token, site, clients, recorder)
 }
 
 func tlsConfig(token *skupperv2alpha1.AccessToken) *tls.Config {
@@ -53,6 +58,7 @@ func postTokenRequest(token *skupperv2alpha1.AccessToken, site *skupperv2alpha1.
 	}
 	request.Header.Add("name", token.Name)
 	request.Header.Add("subject", string(site.ObjectMeta.UID))
+	request.Header.Add("site", site.Name)
 	response, err := client.Do(request)
 	if err != nil {
 		return nil, fmt.Errorf("Controller got error: %s", err)
@@ -64,11 +70,11 @@ func postTokenRequest(token *skupperv2alpha1.AccessToken, site *skupperv2alpha1.
 	return response.Body, nil
 }
 
-func handleTokenResponse(body io.Reader, token *skupperv2alpha1.AccessToken, site *skupperv2alpha1.Site, clients internalclient.Clients) error {
+func handleTokenResponse(body io.Reader, token *skupperv2alpha1.AccessToken, site *skupperv2alpha1.Site, clients internalclient.Clients, recorder record.EventRecorder) error {
 	decoder := newLinkDecoder(body)
 	if err := decoder.decodeAll(); err != nil {
 		log.Printf("Could not decode response for AccessToken %s/%s: %s", token.Namespace, token.Name, err)
-		return updateAccessTokenStatus(token, errors.New("Controller could not decode response"), clients)
+		return updateAccessTokenStatus(token, errors.New("Controller could not decode response"), clients, recorder)
 	}
 	refs := []metav1.OwnerReference{
 		{
@@ -80,7 +86,7 @@ func handleTokenResponse(body io.Reader, token *skupperv2alpha1.AccessToken, sit
 	}
 	decoder.secret.ObjectMeta.OwnerReferences = refs
 	if _, err := clients.GetKubeClient().CoreV1().Secrets(token.ObjectMeta.Namespace).Create(context.TODO(), &decoder.secret, metav1.CreateOptions{}); err != nil {
-		return updateAccessTokenStatus(token, fmt.Errorf("Controller could not create received secret: %s", err), clients)
+		return updateAccessTokenStatus(token, fmt.Errorf("Controller could not create received secret: %s", err), clients, recorder)
 	}
 	for _, link := range decoder.links {
 		link.ObjectMeta.OwnerReferences = refs
@@ -88,15 +94,20 @@ func handleTokenResponse(body io.Reader, token *skupperv2alpha1.AccessToken, sit
 			link.Spec.Cost = token.Spec.LinkCost
 		}
 		if _, err := clients.GetSkupperClient().SkupperV2alpha1().Links(token.ObjectMeta.Namespace).Create(context.TODO(), &link, metav1.CreateOptions{}); err != nil {
-			return updateAccessTokenStatus(token, fmt.Errorf("Controller could not create received link: %s", err), clients)
+			return updateAccessTokenStatus(token, fmt.Errorf("Controller could not create received link: %s", err), clients, recorder)
 		}
 	}
 
-	return updateAccessTokenStatus(token, nil, clients)
+	return updateAccessTokenStatus(token, nil, clients, recorder)
 }
 
-func updateAccessTokenStatus(token *skupperv2alpha1.AccessToken, err error, clients internalclient.Clients) error {
+func updateAccessTokenStatus(token *skupperv2alpha1.AccessToken, err error, clients internalclient.Clients, recorder record.EventRecorder) error {
 	if token.SetRedeemed(err) {
+		if err != nil {
+			recorder.Eventf(token, corev1.EventTypeWarning, events.TokenRedemptionFailed, "Token could not be redeemed: %s", err)
+		} else {
+			recorder.Eventf(token, corev1.EventTypeNormal, events.TokenRedeemed, "Token redeemed at %s", token.Spec.Url)
+		}
 		_, err = clients.GetSkupperClient().SkupperV2alpha1().AccessTokens(token.ObjectMeta.Namespace).UpdateStatus(context.TODO(), token, metav1.UpdateOptions{})
 		return err
 	}
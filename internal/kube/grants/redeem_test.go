@@ -14,6 +14,7 @@ import (
 	meta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 
 	internalclient "github.com/skupperproject/skupper/internal/kube/client"
 	"github.com/skupperproject/skupper/internal/kube/client/fake"
@@ -242,7 +243,7 @@ func Test_handleTokenResponse(t *testing.T) {
 					fail:   tt.failReadAt,
 				}
 			}
-			err := handleTokenResponse(reader, tt.token, tt.site, client)
+			err := handleTokenResponse(reader, tt.token, tt.site, client, record.NewFakeRecorder(10))
 			if tt.expectedError != "" {
 				assert.ErrorContains(t, err, tt.expectedError)
 			} else if err != nil {
@@ -449,7 +450,7 @@ func Test_RedeemAccessToken(t *testing.T) {
 			if err != nil {
 				t.Error(err)
 			}
-			err = RedeemAccessToken(token, site, client)
+			err = RedeemAccessToken(token, site, client, record.NewFakeRecorder(10))
 			if tt.expectedError != "" {
 				assert.ErrorContains(t, err, tt.expectedError)
 			} else if err != nil {
@@ -517,39 +518,57 @@ func Test_updateAccessTokenStatus(t *testing.T) {
 		name           string
 		errs           []error
 		expectedStatus string
+		expectedEvents []string
 	}{
 		{
 			name:           "simple",
 			errs:           []error{nil},
 			expectedStatus: "OK",
+			expectedEvents: []string{"Normal TokenRedeemed Token redeemed at "},
 		},
 		{
 			name:           "failure",
 			errs:           []error{errors.New("something bad happened")},
 			expectedStatus: "something bad happened",
+			expectedEvents: []string{"Warning TokenRedemptionFailed Token could not be redeemed: something bad happened"},
 		},
 		{
 			name:           "repeated failure",
 			errs:           []error{errors.New("something bad happened"), errors.New("it happened again"), errors.New("it happened again")},
 			expectedStatus: "it happened again",
+			expectedEvents: []string{
+				"Warning TokenRedemptionFailed Token could not be redeemed: something bad happened",
+				"Warning TokenRedemptionFailed Token could not be redeemed: it happened again",
+			},
 		},
 		{
 			name:           "recovered sequence",
 			errs:           []error{errors.New("something bad happened"), nil, nil},
 			expectedStatus: "OK",
+			expectedEvents: []string{
+				"Warning TokenRedemptionFailed Token could not be redeemed: something bad happened",
+				"Normal TokenRedeemed Token redeemed at ",
+			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			client, _ := fake.NewFakeClient("test", nil, []runtime.Object{tf.token("my-token", "test", "", "", "")}, "")
+			recorder := record.NewFakeRecorder(10)
 			for _, err := range tt.errs {
 				token, apiError := client.GetSkupperClient().SkupperV2alpha1().AccessTokens("test").Get(context.TODO(), "my-token", metav1.GetOptions{})
 				if apiError != nil {
 					t.Error(apiError)
 				} else {
-					updateAccessTokenStatus(token, err, client)
+					updateAccessTokenStatus(token, err, client, recorder)
 				}
 			}
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			assert.DeepEqual(t, events, tt.expectedEvents)
 			token, apiError := client.GetSkupperClient().SkupperV2alpha1().AccessTokens("test").Get(context.TODO(), "my-token", metav1.GetOptions{})
 			if apiError != nil {
 				t.Error(apiError)
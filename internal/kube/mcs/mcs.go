@@ -0,0 +1,708 @@
+// Package mcs maps the Kubernetes Multi-Cluster Services API
+// (multicluster.x-k8s.io) onto skupper Connectors and Listeners.
+//
+// A ServiceExport is treated as a request to create a Connector for
+// each TCP port of the Service it names, and the Connectors follow
+// any change to the ports of that Service. Wherever a Connector for an
+// exported service is visible in the site's network status, a
+// ServiceImport is published along with a Listener for each port,
+// which in turn causes the site to expose a derived Service whose
+// ClusterIP is recorded in the ServiceImport. An existing
+// ServiceImport that was not created by skupper is never modified;
+// the conflict is instead reported through an Event on that
+// ServiceImport.
+//
+// ServiceImports are only published in the namespace of a site, for
+// services exported from a namespace of the same name, and only if
+// that namespace is watched by the controller. A controller scoped to
+// its own namespace therefore needs no more than its Role; a
+// controller watching all namespaces relies on its ClusterRole.
+package mcs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/skupperproject/skupper/internal/kube/events"
+	"github.com/skupperproject/skupper/internal/kube/resource"
+	"github.com/skupperproject/skupper/internal/kube/watchers"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+const (
+	ExportLabel      = "internal.skupper.io/service-export"
+	ImportLabel      = "internal.skupper.io/service-import"
+	ClusterSetDomain = "svc.clusterset.local"
+
+	CONDITION_TYPE_VALID    = "Valid"
+	CONDITION_TYPE_CONFLICT = "Conflict"
+)
+
+// RoutingKey returns the routing key used for the given port of an
+// exported service. The key mirrors the clusterset.local DNS name
+// of the service so that it can be mapped back to a ServiceImport by
+// any site in the network.
+func RoutingKey(name string, namespace string, port int) string {
+	return fmt.Sprintf("%s.%s.%s:%d", name, namespace, ClusterSetDomain, port)
+}
+
+func parseRoutingKey(key string) (string, string, int, bool) {
+	host, portString, ok := strings.Cut(key, ":")
+	if !ok {
+		return "", "", 0, false
+	}
+	port, err := strconv.Atoi(portString)
+	if err != nil {
+		return "", "", 0, false
+	}
+	qualified, ok := strings.CutSuffix(host, "."+ClusterSetDomain)
+	if !ok {
+		return "", "", 0, false
+	}
+	name, namespace, ok := strings.Cut(qualified, ".")
+	if !ok || name == "" || namespace == "" || strings.Contains(namespace, ".") {
+		return "", "", 0, false
+	}
+	return name, namespace, port, true
+}
+
+// DerivedServiceName returns the name of the Service that is
+// exposed by the Listeners created for a ServiceImport.
+func DerivedServiceName(name string) string {
+	return name + derivedServiceSuffix
+}
+
+const derivedServiceSuffix = "-clusterset"
+
+func connectorName(name string, port int) string {
+	return fmt.Sprintf("%s-%d", name, port)
+}
+
+func listenerName(name string, port int) string {
+	return fmt.Sprintf("%s-%d", DerivedServiceName(name), port)
+}
+
+type MultiClusterServices struct {
+	clients        *watchers.EventProcessor
+	exportWatcher  *watchers.DynamicWatcher
+	serviceWatcher *watchers.ServiceWatcher
+	// namespace is the namespace watched, or empty if all namespaces
+	// are watched
+	namespace string
+	filter    func(string) bool
+	// conflicts holds the keys of ServiceImports not created by
+	// skupper for which a conflict has been reported
+	conflicts map[string]bool
+	logger    *slog.Logger
+}
+
+func NewMultiClusterServices(clients *watchers.EventProcessor) *MultiClusterServices {
+	return &MultiClusterServices{
+		clients:   clients,
+		conflicts: map[string]bool{},
+		logger: slog.New(slog.Default().Handler()).With(
+			slog.String("component", "kube.mcs"),
+		),
+	}
+}
+
+// Watch starts watching ServiceExports, and the Services they name,
+// in the given namespace. It returns false if the Multi-Cluster
+// Services API is not available in the cluster.
+func (m *MultiClusterServices) Watch(namespace string, filter func(string) bool) bool {
+	if !m.clients.HasServiceExport() || !m.clients.HasServiceImport() {
+		m.logger.Warn("Multi-Cluster Services API is not installed, ServiceExports will be ignored")
+		return false
+	}
+	m.namespace = namespace
+	m.filter = filter
+	m.exportWatcher = m.clients.WatchServiceExports(nil, namespace, watchers.FilterByNamespace(filter, m.CheckServiceExport))
+	if m.exportWatcher == nil {
+		return false
+	}
+	m.serviceWatcher = m.clients.WatchServices(nil, namespace, watchers.FilterByNamespace(filter, m.CheckService))
+	return true
+}
+
+// isWatched returns true if ServiceImports can be published in the
+// given namespace, i.e. if it is within the scope of the controller.
+func (m *MultiClusterServices) isWatched(namespace string) bool {
+	if m.namespace != "" && namespace != m.namespace {
+		return false
+	}
+	return m.filter == nil || m.filter(namespace)
+}
+
+// CheckService reconciles the ServiceExport, if any, of the same name
+// as a Service that has changed, so that the Connectors follow its
+// ports.
+func (m *MultiClusterServices) CheckService(key string, svc *corev1.Service) error {
+	if m.exportWatcher == nil {
+		return nil
+	}
+	export, err := m.exportWatcher.Get(key)
+	if err != nil || export == nil {
+		return err
+	}
+	return m.CheckServiceExport(key, export)
+}
+
+// CheckServiceExport ensures that there is a Connector for each port
+// of the exported Service, and removes any that are no longer needed.
+func (m *MultiClusterServices) CheckServiceExport(key string, export *unstructured.Unstructured) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	if export == nil {
+		return m.deleteConnectors(namespace, name, nil)
+	}
+	svc, err := m.clients.GetKubeClient().CoreV1().Services(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if err := m.deleteConnectors(namespace, name, nil); err != nil {
+			return err
+		}
+		return m.updateExportStatus(export, metav1.ConditionFalse, "ServiceNotFound", fmt.Sprintf("Service %s not found", name), nil)
+	} else if err != nil {
+		return err
+	}
+	var conflicts []string
+	desired := map[string]bool{}
+	for _, connector := range desiredConnectors(export, svc) {
+		desired[connector.Name] = true
+		conflict, err := m.ensureConnector(connector)
+		if err != nil {
+			return err
+		}
+		if conflict {
+			conflicts = append(conflicts, connector.Name)
+		}
+	}
+	if err := m.deleteConnectors(namespace, name, desired); err != nil {
+		return err
+	}
+	if len(desired) == 0 {
+		return m.updateExportStatus(export, metav1.ConditionFalse, "NoSupportedPorts", "Service has no TCP ports", conflicts)
+	}
+	return m.updateExportStatus(export, metav1.ConditionTrue, "Exported", "Service exported", conflicts)
+}
+
+func desiredConnectors(export *unstructured.Unstructured, svc *corev1.Service) []*skupperv2alpha1.Connector {
+	var connectors []*skupperv2alpha1.Connector
+	for _, port := range svc.Spec.Ports {
+		if port.Protocol != "" && port.Protocol != corev1.ProtocolTCP {
+			continue
+		}
+		connectors = append(connectors, &skupperv2alpha1.Connector{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "skupper.io/v2alpha1",
+				Kind:       "Connector",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      connectorName(svc.Name, int(port.Port)),
+				Namespace: svc.Namespace,
+				Labels: map[string]string{
+					ExportLabel: svc.Name,
+				},
+				Annotations: map[string]string{
+					"internal.skupper.io/controlled": "true",
+				},
+				OwnerReferences: ownerReferences(export),
+			},
+			Spec: skupperv2alpha1.ConnectorSpec{
+				RoutingKey: RoutingKey(svc.Name, svc.Namespace, int(port.Port)),
+				Host:       svc.Name,
+				Port:       int(port.Port),
+				Type:       "tcp",
+			},
+		})
+	}
+	return connectors
+}
+
+// ensureConnector creates or updates the desired Connector. It
+// returns true if a Connector with the same name exists that was not
+// created for the ServiceExport, in which case it is left untouched.
+func (m *MultiClusterServices) ensureConnector(desired *skupperv2alpha1.Connector) (bool, error) {
+	connectors := m.clients.GetSkupperClient().SkupperV2alpha1().Connectors(desired.Namespace)
+	current, err := connectors.Get(context.TODO(), desired.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if _, err := connectors.Create(context.TODO(), desired, metav1.CreateOptions{}); err != nil {
+			return false, err
+		}
+		m.logger.Info("Created connector for ServiceExport",
+			slog.String("namespace", desired.Namespace),
+			slog.String("name", desired.Name))
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if current.ObjectMeta.Labels[ExportLabel] != desired.ObjectMeta.Labels[ExportLabel] {
+		return true, nil
+	}
+	if reflect.DeepEqual(current.Spec, desired.Spec) {
+		return false, nil
+	}
+	current.Spec = desired.Spec
+	if _, err := connectors.Update(context.TODO(), current, metav1.UpdateOptions{}); err != nil {
+		return false, err
+	}
+	m.logger.Info("Updated connector for ServiceExport",
+		slog.String("namespace", desired.Namespace),
+		slog.String("name", desired.Name))
+	return false, nil
+}
+
+// deleteConnectors removes Connectors created for the named
+// ServiceExport, other than those in the retain set.
+func (m *MultiClusterServices) deleteConnectors(namespace string, name string, retain map[string]bool) error {
+	connectors := m.clients.GetSkupperClient().SkupperV2alpha1().Connectors(namespace)
+	list, err := connectors.List(context.TODO(), metav1.ListOptions{
+		LabelSelector: ExportLabel + "=" + name,
+	})
+	if err != nil {
+		return err
+	}
+	for _, connector := range list.Items {
+		if retain[connector.Name] {
+			continue
+		}
+		if err := connectors.Delete(context.TODO(), connector.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		m.logger.Info("Deleted connector for ServiceExport",
+			slog.String("namespace", namespace),
+			slog.String("name", connector.Name))
+	}
+	return nil
+}
+
+func (m *MultiClusterServices) updateExportStatus(export *unstructured.Unstructured, valid metav1.ConditionStatus, reason string, message string, conflicts []string) error {
+	conditions, err := getConditions(export)
+	if err != nil {
+		return err
+	}
+	changed := meta.SetStatusCondition(&conditions, metav1.Condition{
+		Type:               CONDITION_TYPE_VALID,
+		Status:             valid,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: export.GetGeneration(),
+	})
+	conflict := metav1.Condition{
+		Type:               CONDITION_TYPE_CONFLICT,
+		Status:             metav1.ConditionFalse,
+		Reason:             "NoConflict",
+		Message:            "No conflicting connectors",
+		ObservedGeneration: export.GetGeneration(),
+	}
+	if len(conflicts) > 0 {
+		conflict.Status = metav1.ConditionTrue
+		conflict.Reason = "ConnectorConflict"
+		conflict.Message = fmt.Sprintf("Connectors not owned by the ServiceExport already exist: %s", strings.Join(conflicts, ", "))
+	}
+	if meta.SetStatusCondition(&conditions, conflict) {
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	updated := export.DeepCopy()
+	if err := setConditions(updated, conditions); err != nil {
+		return err
+	}
+	_, err = m.clients.GetDynamicClient().Resource(resource.ServiceExportResource()).Namespace(export.GetNamespace()).UpdateStatus(context.TODO(), updated, metav1.UpdateOptions{})
+	return err
+}
+
+type serviceImport struct {
+	name     string
+	ports    map[int]bool
+	clusters map[string]bool
+}
+
+func (i *serviceImport) sortedPorts() []int {
+	var ports []int
+	for port := range i.ports {
+		ports = append(ports, port)
+	}
+	sort.Ints(ports)
+	return ports
+}
+
+func (i *serviceImport) sortedClusters() []string {
+	var clusters []string
+	for cluster := range i.clusters {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+	return clusters
+}
+
+func desiredImports(namespace string, network []skupperv2alpha1.SiteRecord) map[string]*serviceImport {
+	imports := map[string]*serviceImport{}
+	for _, site := range network {
+		for _, service := range site.Services {
+			if len(service.Connectors) == 0 {
+				continue
+			}
+			name, ns, port, ok := parseRoutingKey(service.RoutingKey)
+			if !ok || ns != namespace {
+				continue
+			}
+			imp, ok := imports[name]
+			if !ok {
+				imp = &serviceImport{
+					name:     name,
+					ports:    map[int]bool{},
+					clusters: map[string]bool{},
+				}
+				imports[name] = imp
+			}
+			imp.ports[port] = true
+			imp.clusters[site.Name] = true
+		}
+	}
+	return imports
+}
+
+// NetworkUpdated publishes a ServiceImport, and the Listeners that
+// back it, for every exported service in the namespace that has a
+// Connector somewhere in the network. ServiceImports that are no
+// longer backed by any Connector are removed.
+func (m *MultiClusterServices) NetworkUpdated(namespace string, network []skupperv2alpha1.SiteRecord) error {
+	if !m.isWatched(namespace) {
+		return nil
+	}
+	desired := desiredImports(namespace, network)
+	for key := range m.conflicts {
+		if ns, name, _ := cache.SplitMetaNamespaceKey(key); ns == namespace && desired[name] == nil {
+			delete(m.conflicts, key)
+		}
+	}
+	imports := m.clients.GetDynamicClient().Resource(resource.ServiceImportResource()).Namespace(namespace)
+	existing, err := imports.List(context.TODO(), metav1.ListOptions{
+		LabelSelector: ImportLabel,
+	})
+	if err != nil {
+		return err
+	}
+	for _, current := range existing.Items {
+		if _, ok := desired[current.GetName()]; ok {
+			continue
+		}
+		if err := imports.Delete(context.TODO(), current.GetName(), metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if err := m.deleteListeners(namespace, current.GetName(), nil); err != nil {
+			return err
+		}
+		m.logger.Info("Deleted ServiceImport",
+			slog.String("namespace", namespace),
+			slog.String("name", current.GetName()))
+	}
+	for _, imp := range desired {
+		obj, conflict, err := m.ensureImport(namespace, imp)
+		if err != nil {
+			return err
+		}
+		if conflict {
+			continue
+		}
+		if err := m.ensureListeners(namespace, imp, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureImport creates or updates the ServiceImport for an exported
+// service. It returns true if a ServiceImport of the same name exists
+// that was not created by skupper, in which case it is left untouched
+// and no Listeners are created for it.
+func (m *MultiClusterServices) ensureImport(namespace string, imp *serviceImport) (*unstructured.Unstructured, bool, error) {
+	imports := m.clients.GetDynamicClient().Resource(resource.ServiceImportResource()).Namespace(namespace)
+	var ports []interface{}
+	for _, port := range imp.sortedPorts() {
+		ports = append(ports, map[string]interface{}{
+			"name":     strconv.Itoa(port),
+			"port":     int64(port),
+			"protocol": string(corev1.ProtocolTCP),
+		})
+	}
+	var clusters []interface{}
+	for _, cluster := range imp.sortedClusters() {
+		clusters = append(clusters, map[string]interface{}{
+			"cluster": cluster,
+		})
+	}
+	ips, err := m.clusterIPs(namespace, imp.name)
+	if err != nil {
+		return nil, false, err
+	}
+	current, err := imports.Get(context.TODO(), imp.name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		desired := &unstructured.Unstructured{}
+		desired.SetAPIVersion(resource.ServiceImportResource().GroupVersion().String())
+		desired.SetKind("ServiceImport")
+		desired.SetName(imp.name)
+		desired.SetNamespace(namespace)
+		desired.SetLabels(map[string]string{
+			ImportLabel: imp.name,
+		})
+		desired.SetAnnotations(map[string]string{
+			"internal.skupper.io/controlled": "true",
+		})
+		spec := map[string]interface{}{
+			"type":  "ClusterSetIP",
+			"ports": ports,
+		}
+		if len(ips) > 0 {
+			spec["ips"] = ips
+		}
+		desired.Object["spec"] = spec
+		created, err := imports.Create(context.TODO(), desired, metav1.CreateOptions{})
+		if err != nil {
+			return nil, false, err
+		}
+		m.logger.Info("Created ServiceImport",
+			slog.String("namespace", namespace),
+			slog.String("name", imp.name))
+		delete(m.conflicts, namespace+"/"+imp.name)
+		updated, err := m.updateImportClusters(created, clusters)
+		return updated, false, err
+	} else if err != nil {
+		return nil, false, err
+	}
+	if _, ok := current.GetLabels()[ImportLabel]; !ok {
+		m.reportImportConflict(current)
+		return current, true, nil
+	}
+	delete(m.conflicts, namespace+"/"+imp.name)
+	currentPorts, _, _ := unstructured.NestedSlice(current.Object, "spec", "ports")
+	currentIps, _, _ := unstructured.NestedSlice(current.Object, "spec", "ips")
+	if !reflect.DeepEqual(currentPorts, ports) || !reflect.DeepEqual(currentIps, ips) {
+		updated := current.DeepCopy()
+		if err := unstructured.SetNestedSlice(updated.Object, ports, "spec", "ports"); err != nil {
+			return nil, false, err
+		}
+		if err := setIPs(updated, ips); err != nil {
+			return nil, false, err
+		}
+		current, err = imports.Update(context.TODO(), updated, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, false, err
+		}
+		m.logger.Info("Updated ServiceImport",
+			slog.String("namespace", namespace),
+			slog.String("name", imp.name))
+	}
+	updated, err := m.updateImportClusters(current, clusters)
+	return updated, false, err
+}
+
+// reportImportConflict records an Event the first time a ServiceImport
+// not created by skupper is found to clash with an exported service.
+func (m *MultiClusterServices) reportImportConflict(current *unstructured.Unstructured) {
+	key := current.GetNamespace() + "/" + current.GetName()
+	if m.conflicts[key] {
+		return
+	}
+	m.conflicts[key] = true
+	m.logger.Warn("ServiceImport exists but was not created by skupper, ignoring",
+		slog.String("namespace", current.GetNamespace()),
+		slog.String("name", current.GetName()))
+	m.clients.GetEventRecorder().Eventf(current, corev1.EventTypeWarning, events.ServiceImportConflict,
+		"ServiceImport was not created by skupper, so the service %s exported elsewhere in the network is not imported", current.GetName())
+}
+
+func (m *MultiClusterServices) updateImportClusters(current *unstructured.Unstructured, clusters []interface{}) (*unstructured.Unstructured, error) {
+	existing, _, _ := unstructured.NestedSlice(current.Object, "status", "clusters")
+	if reflect.DeepEqual(existing, clusters) {
+		return current, nil
+	}
+	updated := current.DeepCopy()
+	if err := unstructured.SetNestedSlice(updated.Object, clusters, "status", "clusters"); err != nil {
+		return nil, err
+	}
+	return m.clients.GetDynamicClient().Resource(resource.ServiceImportResource()).Namespace(current.GetNamespace()).UpdateStatus(context.TODO(), updated, metav1.UpdateOptions{})
+}
+
+func (m *MultiClusterServices) clusterIPs(namespace string, name string) ([]interface{}, error) {
+	svc, err := m.clients.GetKubeClient().CoreV1().Services(namespace).Get(context.TODO(), DerivedServiceName(name), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return serviceIPs(svc), nil
+}
+
+func serviceIPs(svc *corev1.Service) []interface{} {
+	var ips []interface{}
+	if svc.Spec.ClusterIP != "" && svc.Spec.ClusterIP != corev1.ClusterIPNone {
+		ips = append(ips, svc.Spec.ClusterIP)
+	}
+	return ips
+}
+
+func (m *MultiClusterServices) ensureListeners(namespace string, imp *serviceImport, owner *unstructured.Unstructured) error {
+	listeners := m.clients.GetSkupperClient().SkupperV2alpha1().Listeners(namespace)
+	desired := map[string]bool{}
+	for _, port := range imp.sortedPorts() {
+		listener := &skupperv2alpha1.Listener{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "skupper.io/v2alpha1",
+				Kind:       "Listener",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      listenerName(imp.name, port),
+				Namespace: namespace,
+				Labels: map[string]string{
+					ImportLabel: imp.name,
+				},
+				Annotations: map[string]string{
+					"internal.skupper.io/controlled": "true",
+				},
+				OwnerReferences: ownerReferences(owner),
+			},
+			Spec: skupperv2alpha1.ListenerSpec{
+				RoutingKey: RoutingKey(imp.name, namespace, port),
+				Host:       DerivedServiceName(imp.name),
+				Port:       port,
+				Type:       "tcp",
+			},
+		}
+		desired[listener.Name] = true
+		current, err := listeners.Get(context.TODO(), listener.Name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			if _, err := listeners.Create(context.TODO(), listener, metav1.CreateOptions{}); err != nil {
+				return err
+			}
+			m.logger.Info("Created listener for ServiceImport",
+				slog.String("namespace", namespace),
+				slog.String("name", listener.Name))
+		} else if err != nil {
+			return err
+		} else if current.ObjectMeta.Labels[ImportLabel] == imp.name && !reflect.DeepEqual(current.Spec, listener.Spec) {
+			current.Spec = listener.Spec
+			if _, err := listeners.Update(context.TODO(), current, metav1.UpdateOptions{}); err != nil {
+				return err
+			}
+		}
+	}
+	return m.deleteListeners(namespace, imp.name, desired)
+}
+
+func (m *MultiClusterServices) deleteListeners(namespace string, name string, retain map[string]bool) error {
+	listeners := m.clients.GetSkupperClient().SkupperV2alpha1().Listeners(namespace)
+	list, err := listeners.List(context.TODO(), metav1.ListOptions{
+		LabelSelector: ImportLabel + "=" + name,
+	})
+	if err != nil {
+		return err
+	}
+	for _, listener := range list.Items {
+		if retain[listener.Name] {
+			continue
+		}
+		if err := listeners.Delete(context.TODO(), listener.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// ServiceUpdated records the ClusterIP of a derived Service in the
+// corresponding ServiceImport.
+func (m *MultiClusterServices) ServiceUpdated(svc *corev1.Service) error {
+	name, ok := strings.CutSuffix(svc.Name, derivedServiceSuffix)
+	if !ok || !m.isWatched(svc.Namespace) {
+		return nil
+	}
+	imports := m.clients.GetDynamicClient().Resource(resource.ServiceImportResource()).Namespace(svc.Namespace)
+	current, err := imports.Get(context.TODO(), name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if _, ok := current.GetLabels()[ImportLabel]; !ok {
+		return nil
+	}
+	ips := serviceIPs(svc)
+	currentIps, _, _ := unstructured.NestedSlice(current.Object, "spec", "ips")
+	if reflect.DeepEqual(currentIps, ips) {
+		return nil
+	}
+	updated := current.DeepCopy()
+	if err := setIPs(updated, ips); err != nil {
+		return err
+	}
+	_, err = imports.Update(context.TODO(), updated, metav1.UpdateOptions{})
+	return err
+}
+
+func setIPs(obj *unstructured.Unstructured, ips []interface{}) error {
+	if len(ips) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "spec", "ips")
+		return nil
+	}
+	return unstructured.SetNestedSlice(obj.Object, ips, "spec", "ips")
+}
+
+func ownerReferences(obj *unstructured.Unstructured) []metav1.OwnerReference {
+	return []metav1.OwnerReference{
+		{
+			Kind:       obj.GetKind(),
+			APIVersion: obj.GetAPIVersion(),
+			Name:       obj.GetName(),
+			UID:        obj.GetUID(),
+		},
+	}
+}
+
+func getConditions(obj *unstructured.Unstructured) ([]metav1.Condition, error) {
+	raw, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !found {
+		return nil, err
+	}
+	var conditions []metav1.Condition
+	for _, item := range raw {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var condition metav1.Condition
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &condition); err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+func setConditions(obj *unstructured.Unstructured, conditions []metav1.Condition) error {
+	var raw []interface{}
+	for _, condition := range conditions {
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&condition)
+		if err != nil {
+			return err
+		}
+		raw = append(raw, m)
+	}
+	return unstructured.SetNestedSlice(obj.Object, raw, "status", "conditions")
+}
@@ -0,0 +1,456 @@
+package mcs
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	fakeclient "github.com/skupperproject/skupper/internal/kube/client/fake"
+	"github.com/skupperproject/skupper/internal/kube/events"
+	"github.com/skupperproject/skupper/internal/kube/resource"
+	"github.com/skupperproject/skupper/internal/kube/watchers"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+func Test_parseRoutingKey(t *testing.T) {
+	testTable := []struct {
+		key       string
+		name      string
+		namespace string
+		port      int
+		ok        bool
+	}{
+		{
+			key:       RoutingKey("backend", "test", 8080),
+			name:      "backend",
+			namespace: "test",
+			port:      8080,
+			ok:        true,
+		},
+		{
+			key: "backend:8080",
+		},
+		{
+			key: "backend.test.svc.clusterset.local",
+		},
+		{
+			key: "backend.test.svc.clusterset.local:http",
+		},
+		{
+			key: "backend.test.svc.cluster.local:8080",
+		},
+		{
+			key: "backend.foo.test.svc.clusterset.local:8080",
+		},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.key, func(t *testing.T) {
+			name, namespace, port, ok := parseRoutingKey(tt.key)
+			assert.Equal(t, ok, tt.ok)
+			assert.Equal(t, name, tt.name)
+			assert.Equal(t, namespace, tt.namespace)
+			assert.Equal(t, port, tt.port)
+		})
+	}
+}
+
+func TestMultiClusterServices_CheckServiceExport(t *testing.T) {
+	testTable := []struct {
+		name               string
+		k8sObjects         []runtime.Object
+		skupperObjects     []runtime.Object
+		export             *unstructured.Unstructured
+		expectedConnectors []skupperv2alpha1.Connector
+		expectedValid      metav1.ConditionStatus
+		expectedConflict   metav1.ConditionStatus
+	}{
+		{
+			name: "single port",
+			k8sObjects: []runtime.Object{
+				service("backend", "test", port("http", 8080, corev1.ProtocolTCP)),
+				serviceExport("backend", "test"),
+			},
+			export: serviceExport("backend", "test"),
+			expectedConnectors: []skupperv2alpha1.Connector{
+				connector("backend-8080", "test", "backend", "backend.test.svc.clusterset.local:8080", 8080),
+			},
+			expectedValid:    metav1.ConditionTrue,
+			expectedConflict: metav1.ConditionFalse,
+		},
+		{
+			name: "multiple ports",
+			k8sObjects: []runtime.Object{
+				service("backend", "test", port("http", 8080, corev1.ProtocolTCP), port("dns", 53, corev1.ProtocolUDP), port("https", 8443, corev1.ProtocolTCP)),
+				serviceExport("backend", "test"),
+			},
+			export: serviceExport("backend", "test"),
+			expectedConnectors: []skupperv2alpha1.Connector{
+				connector("backend-8080", "test", "backend", "backend.test.svc.clusterset.local:8080", 8080),
+				connector("backend-8443", "test", "backend", "backend.test.svc.clusterset.local:8443", 8443),
+			},
+			expectedValid:    metav1.ConditionTrue,
+			expectedConflict: metav1.ConditionFalse,
+		},
+		{
+			name: "stale connector removed",
+			k8sObjects: []runtime.Object{
+				service("backend", "test", port("http", 8080, corev1.ProtocolTCP)),
+				serviceExport("backend", "test"),
+			},
+			skupperObjects: []runtime.Object{
+				labelled(connector("backend-9090", "test", "backend", "backend.test.svc.clusterset.local:9090", 9090), ExportLabel, "backend"),
+			},
+			export: serviceExport("backend", "test"),
+			expectedConnectors: []skupperv2alpha1.Connector{
+				connector("backend-8080", "test", "backend", "backend.test.svc.clusterset.local:8080", 8080),
+			},
+			expectedValid:    metav1.ConditionTrue,
+			expectedConflict: metav1.ConditionFalse,
+		},
+		{
+			name: "conflicting connector",
+			k8sObjects: []runtime.Object{
+				service("backend", "test", port("http", 8080, corev1.ProtocolTCP)),
+				serviceExport("backend", "test"),
+			},
+			skupperObjects: []runtime.Object{
+				userConnector("backend-8080", "test", "something-else", 8080),
+			},
+			export:           serviceExport("backend", "test"),
+			expectedValid:    metav1.ConditionTrue,
+			expectedConflict: metav1.ConditionTrue,
+		},
+		{
+			name: "service missing",
+			k8sObjects: []runtime.Object{
+				serviceExport("backend", "test"),
+			},
+			export:           serviceExport("backend", "test"),
+			expectedValid:    metav1.ConditionFalse,
+			expectedConflict: metav1.ConditionFalse,
+		},
+		{
+			name: "export deleted",
+			skupperObjects: []runtime.Object{
+				labelled(connector("backend-8080", "test", "backend", "backend.test.svc.clusterset.local:8080", 8080), ExportLabel, "backend"),
+			},
+		},
+	}
+	for _, tt := range testTable {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := fakeclient.NewFakeClient("test", tt.k8sObjects, tt.skupperObjects, "")
+			assert.Assert(t, err)
+			m := NewMultiClusterServices(watchers.NewEventProcessor("Test", client))
+			assert.Assert(t, m.CheckServiceExport("test/backend", tt.export))
+
+			connectors, err := client.GetSkupperClient().SkupperV2alpha1().Connectors("test").List(context.Background(), metav1.ListOptions{
+				LabelSelector: ExportLabel + "=backend",
+			})
+			assert.Assert(t, err)
+			assert.Equal(t, len(connectors.Items), len(tt.expectedConnectors))
+			for i, expected := range tt.expectedConnectors {
+				assert.DeepEqual(t, connectors.Items[i].Spec, expected.Spec)
+				assert.Equal(t, connectors.Items[i].ObjectMeta.OwnerReferences[0].Kind, "ServiceExport")
+			}
+			if tt.export == nil {
+				return
+			}
+			export, err := client.GetDynamicClient().Resource(resource.ServiceExportResource()).Namespace("test").Get(context.Background(), "backend", metav1.GetOptions{})
+			assert.Assert(t, err)
+			conditions, err := getConditions(export)
+			assert.Assert(t, err)
+			assert.Equal(t, meta.FindStatusCondition(conditions, CONDITION_TYPE_VALID).Status, tt.expectedValid)
+			assert.Equal(t, meta.FindStatusCondition(conditions, CONDITION_TYPE_CONFLICT).Status, tt.expectedConflict)
+		})
+	}
+}
+
+func TestMultiClusterServices_NetworkUpdated(t *testing.T) {
+	client, err := fakeclient.NewFakeClient("test", []runtime.Object{
+		derivedService("backend-clusterset", "test", "10.0.0.15"),
+	}, nil, "")
+	assert.Assert(t, err)
+	m := NewMultiClusterServices(watchers.NewEventProcessor("Test", client))
+	network := []skupperv2alpha1.SiteRecord{
+		{
+			Name: "east",
+			Services: []skupperv2alpha1.ServiceRecord{
+				{
+					RoutingKey: RoutingKey("backend", "test", 8080),
+					Connectors: []string{"backend"},
+				},
+				{
+					RoutingKey: RoutingKey("backend", "other", 8080),
+					Connectors: []string{"backend"},
+				},
+				{
+					RoutingKey: "unrelated",
+					Connectors: []string{"unrelated"},
+				},
+			},
+		},
+		{
+			Name: "west",
+			Services: []skupperv2alpha1.ServiceRecord{
+				{
+					RoutingKey: RoutingKey("backend", "test", 8080),
+					Connectors: []string{"backend"},
+				},
+				{
+					RoutingKey: RoutingKey("backend", "test", 9090),
+					Connectors: []string{"backend"},
+				},
+				{
+					RoutingKey: RoutingKey("frontend", "test", 80),
+					Listeners:  []string{"frontend"},
+				},
+			},
+		},
+	}
+	assert.Assert(t, m.NetworkUpdated("test", network))
+
+	imports := client.GetDynamicClient().Resource(resource.ServiceImportResource()).Namespace("test")
+	imp, err := imports.Get(context.Background(), "backend", metav1.GetOptions{})
+	assert.Assert(t, err)
+	importType, _, _ := unstructured.NestedString(imp.Object, "spec", "type")
+	assert.Equal(t, importType, "ClusterSetIP")
+	ports, _, _ := unstructured.NestedSlice(imp.Object, "spec", "ports")
+	assert.Equal(t, len(ports), 2)
+	ips, _, _ := unstructured.NestedStringSlice(imp.Object, "spec", "ips")
+	assert.DeepEqual(t, ips, []string{"10.0.0.15"})
+	clusters, _, _ := unstructured.NestedSlice(imp.Object, "status", "clusters")
+	assert.Equal(t, len(clusters), 2)
+	_, err = imports.Get(context.Background(), "frontend", metav1.GetOptions{})
+	assert.Assert(t, errors.IsNotFound(err))
+
+	listeners, err := client.GetSkupperClient().SkupperV2alpha1().Listeners("test").List(context.Background(), metav1.ListOptions{})
+	assert.Assert(t, err)
+	assert.Equal(t, len(listeners.Items), 2)
+	for _, listener := range listeners.Items {
+		assert.Equal(t, listener.Spec.Host, "backend-clusterset")
+		assert.Equal(t, listener.Spec.RoutingKey, RoutingKey("backend", "test", listener.Spec.Port))
+	}
+
+	network[1].Services = network[1].Services[:1]
+	assert.Assert(t, m.NetworkUpdated("test", network))
+	listeners, err = client.GetSkupperClient().SkupperV2alpha1().Listeners("test").List(context.Background(), metav1.ListOptions{})
+	assert.Assert(t, err)
+	assert.Equal(t, len(listeners.Items), 1)
+	assert.Equal(t, listeners.Items[0].Spec.Port, 8080)
+
+	assert.Assert(t, m.NetworkUpdated("test", nil))
+	_, err = imports.Get(context.Background(), "backend", metav1.GetOptions{})
+	assert.Assert(t, errors.IsNotFound(err))
+	listeners, err = client.GetSkupperClient().SkupperV2alpha1().Listeners("test").List(context.Background(), metav1.ListOptions{})
+	assert.Assert(t, err)
+	assert.Equal(t, len(listeners.Items), 0)
+}
+
+func TestMultiClusterServices_NetworkUpdatedUnwatchedNamespace(t *testing.T) {
+	client, err := fakeclient.NewFakeClient("test", nil, nil, "")
+	assert.Assert(t, err)
+	m := NewMultiClusterServices(watchers.NewEventProcessor("Test", client))
+	m.namespace = "test"
+	network := []skupperv2alpha1.SiteRecord{
+		{
+			Name: "east",
+			Services: []skupperv2alpha1.ServiceRecord{
+				{
+					RoutingKey: RoutingKey("backend", "other", 8080),
+					Connectors: []string{"backend"},
+				},
+			},
+		},
+	}
+	assert.Assert(t, m.NetworkUpdated("other", network))
+	imports, err := client.GetDynamicClient().Resource(resource.ServiceImportResource()).Namespace("other").List(context.Background(), metav1.ListOptions{})
+	assert.Assert(t, err)
+	assert.Equal(t, len(imports.Items), 0)
+	listeners, err := client.GetSkupperClient().SkupperV2alpha1().Listeners("other").List(context.Background(), metav1.ListOptions{})
+	assert.Assert(t, err)
+	assert.Equal(t, len(listeners.Items), 0)
+}
+
+func TestMultiClusterServices_ServiceUpdated(t *testing.T) {
+	client, err := fakeclient.NewFakeClient("test", nil, nil, "")
+	assert.Assert(t, err)
+	m := NewMultiClusterServices(watchers.NewEventProcessor("Test", client))
+	network := []skupperv2alpha1.SiteRecord{
+		{
+			Name: "east",
+			Services: []skupperv2alpha1.ServiceRecord{
+				{
+					RoutingKey: RoutingKey("backend", "test", 8080),
+					Connectors: []string{"backend"},
+				},
+			},
+		},
+	}
+	assert.Assert(t, m.NetworkUpdated("test", network))
+	imports := client.GetDynamicClient().Resource(resource.ServiceImportResource()).Namespace("test")
+	imp, err := imports.Get(context.Background(), "backend", metav1.GetOptions{})
+	assert.Assert(t, err)
+	_, found, _ := unstructured.NestedStringSlice(imp.Object, "spec", "ips")
+	assert.Assert(t, !found)
+
+	assert.Assert(t, m.ServiceUpdated(derivedService("backend-clusterset", "test", "10.0.0.20")))
+	imp, err = imports.Get(context.Background(), "backend", metav1.GetOptions{})
+	assert.Assert(t, err)
+	ips, _, _ := unstructured.NestedStringSlice(imp.Object, "spec", "ips")
+	assert.DeepEqual(t, ips, []string{"10.0.0.20"})
+
+	assert.Assert(t, m.ServiceUpdated(derivedService("unrelated", "test", "10.0.0.21")))
+}
+
+func TestMultiClusterServices_ImportConflict(t *testing.T) {
+	foreign := &unstructured.Unstructured{}
+	foreign.SetAPIVersion(resource.ServiceImportResource().GroupVersion().String())
+	foreign.SetKind("ServiceImport")
+	foreign.SetName("backend")
+	foreign.SetNamespace("test")
+	foreign.Object["spec"] = map[string]interface{}{
+		"type": "ClusterSetIP",
+	}
+	client, err := fakeclient.NewFakeClient("test", []runtime.Object{foreign}, nil, "")
+	assert.Assert(t, err)
+	processor := watchers.NewEventProcessor("Test", client)
+	recorder := record.NewFakeRecorder(10)
+	processor.SetEventRecorder(recorder)
+	m := NewMultiClusterServices(processor)
+	network := []skupperv2alpha1.SiteRecord{
+		{
+			Name: "east",
+			Services: []skupperv2alpha1.ServiceRecord{
+				{
+					RoutingKey: RoutingKey("backend", "test", 8080),
+					Connectors: []string{"backend"},
+				},
+			},
+		},
+	}
+	assert.Assert(t, m.NetworkUpdated("test", network))
+	assert.Assert(t, m.NetworkUpdated("test", network))
+
+	imp, err := client.GetDynamicClient().Resource(resource.ServiceImportResource()).Namespace("test").Get(context.Background(), "backend", metav1.GetOptions{})
+	assert.Assert(t, err)
+	_, found, _ := unstructured.NestedSlice(imp.Object, "spec", "ports")
+	assert.Assert(t, !found, "foreign ServiceImport should not be modified")
+	listeners, err := client.GetSkupperClient().SkupperV2alpha1().Listeners("test").List(context.Background(), metav1.ListOptions{})
+	assert.Assert(t, err)
+	assert.Equal(t, len(listeners.Items), 0)
+	assert.Equal(t, len(recorder.Events), 1)
+	assert.Assert(t, strings.Contains(<-recorder.Events, events.ServiceImportConflict))
+}
+
+func TestMultiClusterServices_CheckService(t *testing.T) {
+	svc := service("backend", "test", port("http", 8080, corev1.ProtocolTCP))
+	client, err := fakeclient.NewFakeClient("test", []runtime.Object{svc, serviceExport("backend", "test")}, nil, "")
+	assert.Assert(t, err)
+	processor := watchers.NewEventProcessor("Test", client)
+	m := NewMultiClusterServices(processor)
+	m.exportWatcher = processor.WatchServiceExports(nil, "test", m.CheckServiceExport)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	processor.StartWatchers(stopCh)
+	assert.Assert(t, processor.WaitForCacheSync(stopCh))
+
+	ports := func() []int {
+		connectors, err := client.GetSkupperClient().SkupperV2alpha1().Connectors("test").List(context.Background(), metav1.ListOptions{
+			LabelSelector: ExportLabel + "=backend",
+		})
+		assert.Assert(t, err)
+		var ports []int
+		for _, connector := range connectors.Items {
+			ports = append(ports, connector.Spec.Port)
+		}
+		return ports
+	}
+	assert.Assert(t, m.CheckService("test/backend", svc))
+	assert.DeepEqual(t, ports(), []int{8080})
+
+	svc.Spec.Ports = []corev1.ServicePort{port("https", 8443, corev1.ProtocolTCP)}
+	_, err = client.GetKubeClient().CoreV1().Services("test").Update(context.Background(), svc, metav1.UpdateOptions{})
+	assert.Assert(t, err)
+	assert.Assert(t, m.CheckService("test/backend", svc))
+	assert.DeepEqual(t, ports(), []int{8443})
+
+	// services that are not exported are ignored
+	assert.Assert(t, m.CheckService("test/other", service("other", "test", port("http", 80, corev1.ProtocolTCP))))
+}
+
+func service(name string, namespace string, ports ...corev1.ServicePort) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: ports,
+		},
+	}
+}
+
+func derivedService(name string, namespace string, clusterIP string) *corev1.Service {
+	svc := service(name, namespace, port("8080", 8080, corev1.ProtocolTCP))
+	svc.Spec.ClusterIP = clusterIP
+	return svc
+}
+
+func port(name string, port int32, protocol corev1.Protocol) corev1.ServicePort {
+	return corev1.ServicePort{
+		Name:     name,
+		Port:     port,
+		Protocol: protocol,
+	}
+}
+
+func serviceExport(name string, namespace string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("multicluster.x-k8s.io/v1alpha1")
+	obj.SetKind("ServiceExport")
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	return obj
+}
+
+func connector(name string, namespace string, host string, routingKey string, port int) skupperv2alpha1.Connector {
+	return skupperv2alpha1.Connector{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: skupperv2alpha1.ConnectorSpec{
+			RoutingKey: routingKey,
+			Host:       host,
+			Port:       port,
+			Type:       "tcp",
+		},
+	}
+}
+
+func userConnector(name string, namespace string, routingKey string, port int) *skupperv2alpha1.Connector {
+	c := connector(name, namespace, "somewhere", routingKey, port)
+	return &c
+}
+
+func labelled(c skupperv2alpha1.Connector, key string, value string) *skupperv2alpha1.Connector {
+	c.ObjectMeta.Labels = map[string]string{
+		key: value,
+	}
+	c.ObjectMeta.OwnerReferences = []metav1.OwnerReference{
+		{
+			Kind: "ServiceExport",
+			Name: value,
+		},
+	}
+	return &c
+}
@@ -0,0 +1,137 @@
+// Package metrics defines the Prometheus metrics exposed by the
+// controller, along with the HTTP server through which they, and the
+// controller's health and readiness, are made available.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const namespace = "skupper_controller"
+
+var (
+	registry          = prometheus.NewRegistry()
+	workqueueProvider = newWorkqueueMetricsProvider(registry)
+
+	eventsProcessed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "events_processed_total",
+		Help:      "Number of events handled by the event processing loop, by resource kind.",
+	}, []string{"kind"})
+	eventErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "event_errors_total",
+		Help:      "Number of events whose handling returned an error, by resource kind.",
+	}, []string{"kind"})
+	eventDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "event_processing_seconds",
+		Help:      "Time taken to handle an event, by resource kind.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 4, 8),
+	}, []string{"kind"})
+	siteReconciles = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "site_reconciles_total",
+		Help:      "Number of times a site has been reconciled.",
+	})
+	siteReconcileErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "site_reconcile_errors_total",
+		Help:      "Number of site reconciliations that failed.",
+	})
+	certificatesGenerated = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "certificates_generated_total",
+		Help:      "Number of certificates generated, by type (ca or certificate).",
+	}, []string{"type"})
+	grantRedemptions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grant_redemptions_total",
+		Help:      "Number of attempts to redeem an access token against the grant server, by result (succeeded, refused or failed).",
+	}, []string{"result"})
+	securedAccessErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "secured_access_errors_total",
+		Help:      "Number of errors provisioning SecuredAccess resources, by the stage (service, access or certificate) that failed.",
+	}, []string{"stage"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		eventsProcessed,
+		eventErrors,
+		eventDuration,
+		siteReconciles,
+		siteReconcileErrors,
+		certificatesGenerated,
+		grantRedemptions,
+		securedAccessErrors,
+	)
+}
+
+// RegisterWorkqueueMetrics records the depth, latency and retries of
+// client-go work queues in the controller's metrics. It must be called
+// before any work queue is created, and only the first provider set in
+// a process takes effect.
+func RegisterWorkqueueMetrics() {
+	workqueue.SetProvider(workqueueProvider)
+}
+
+// EventProcessed records the handling of an event for a resource of
+// the supplied kind.
+func EventProcessed(kind string, duration time.Duration, err error) {
+	eventsProcessed.WithLabelValues(kind).Inc()
+	eventDuration.WithLabelValues(kind).Observe(duration.Seconds())
+	if err != nil {
+		eventErrors.WithLabelValues(kind).Inc()
+	}
+}
+
+// SiteReconciled records the outcome of reconciling a site.
+func SiteReconciled(err error) {
+	siteReconciles.Inc()
+	if err != nil {
+		siteReconcileErrors.Inc()
+	}
+}
+
+// CertificateGenerated records the generation of a certificate, which
+// is a CA if signing is true.
+func CertificateGenerated(signing bool) {
+	if signing {
+		certificatesGenerated.WithLabelValues("ca").Inc()
+	} else {
+		certificatesGenerated.WithLabelValues("certificate").Inc()
+	}
+}
+
+// GrantRedemption results.
+const (
+	GrantRedemptionSucceeded = "succeeded"
+	GrantRedemptionRefused   = "refused"
+	GrantRedemptionFailed    = "failed"
+)
+
+// GrantRedeemed records an attempt to redeem an access token.
+func GrantRedeemed(result string) {
+	grantRedemptions.WithLabelValues(result).Inc()
+}
+
+// SecuredAccess provisioning stages.
+const (
+	SecuredAccessService     = "service"
+	SecuredAccessAccess      = "access"
+	SecuredAccessCertificate = "certificate"
+)
+
+// SecuredAccessFailed records an error provisioning a SecuredAccess
+// at the supplied stage.
+func SecuredAccessFailed(stage string) {
+	securedAccessErrors.WithLabelValues(stage).Inc()
+}
@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func TestRecording(t *testing.T) {
+	EventProcessed("Site", time.Millisecond, nil)
+	EventProcessed("Site", time.Millisecond, errors.New("failed"))
+	assert.Equal(t, testutil.ToFloat64(eventsProcessed.WithLabelValues("Site")), float64(2))
+	assert.Equal(t, testutil.ToFloat64(eventErrors.WithLabelValues("Site")), float64(1))
+
+	SiteReconciled(nil)
+	SiteReconciled(errors.New("failed"))
+	assert.Equal(t, testutil.ToFloat64(siteReconciles), float64(2))
+	assert.Equal(t, testutil.ToFloat64(siteReconcileErrors), float64(1))
+
+	CertificateGenerated(true)
+	CertificateGenerated(false)
+	CertificateGenerated(false)
+	assert.Equal(t, testutil.ToFloat64(certificatesGenerated.WithLabelValues("ca")), float64(1))
+	assert.Equal(t, testutil.ToFloat64(certificatesGenerated.WithLabelValues("certificate")), float64(2))
+
+	GrantRedeemed(GrantRedemptionSucceeded)
+	GrantRedeemed(GrantRedemptionRefused)
+	assert.Equal(t, testutil.ToFloat64(grantRedemptions.WithLabelValues(GrantRedemptionSucceeded)), float64(1))
+	assert.Equal(t, testutil.ToFloat64(grantRedemptions.WithLabelValues(GrantRedemptionRefused)), float64(1))
+
+	SecuredAccessFailed(SecuredAccessCertificate)
+	assert.Equal(t, testutil.ToFloat64(securedAccessErrors.WithLabelValues(SecuredAccessCertificate)), float64(1))
+}
+
+func TestWorkqueueMetrics(t *testing.T) {
+	RegisterWorkqueueMetrics()
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "metrics-test")
+	defer queue.ShutDown()
+	queue.Add("a")
+	queue.Add("b")
+	assert.Equal(t, testutil.ToFloat64(workqueueProvider.depth.WithLabelValues("metrics-test")), float64(2))
+	item, _ := queue.Get()
+	queue.Done(item)
+	assert.Equal(t, testutil.ToFloat64(workqueueProvider.depth.WithLabelValues("metrics-test")), float64(1))
+	assert.Equal(t, testutil.ToFloat64(workqueueProvider.adds.WithLabelValues("metrics-test")), float64(2))
+}
+
+func TestHandler(t *testing.T) {
+	notReady := func() error { return errors.New("Informer caches not yet synced") }
+	tests := []struct {
+		name     string
+		path     string
+		healthy  Check
+		ready    Check
+		status   int
+		contains string
+	}{
+		{
+			name:     "metrics",
+			path:     "/metrics",
+			status:   http.StatusOK,
+			contains: "skupper_controller_workqueue_depth",
+		},
+		{
+			name:     "healthy",
+			path:     "/healthz",
+			healthy:  func() error { return nil },
+			status:   http.StatusOK,
+			contains: "ok",
+		},
+		{
+			name:     "unhealthy",
+			path:     "/healthz",
+			healthy:  func() error { return errors.New("Event handling has been in progress for 6m0s") },
+			status:   http.StatusServiceUnavailable,
+			contains: "Event handling has been in progress for 6m0s",
+		},
+		{
+			name:     "not ready",
+			path:     "/readyz",
+			ready:    notReady,
+			status:   http.StatusServiceUnavailable,
+			contains: "Informer caches not yet synced",
+		},
+		{
+			name:     "ready",
+			path:     "/readyz",
+			ready:    func() error { return nil },
+			status:   http.StatusOK,
+			contains: "ok",
+		},
+	}
+	// ensure the queue metrics have at least one series to render
+	workqueueProvider.depth.WithLabelValues("handler-test")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(Handler(tt.healthy, tt.ready))
+			defer server.Close()
+			resp, err := http.Get(server.URL + tt.path)
+			assert.Assert(t, err)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			assert.Assert(t, err)
+			assert.Equal(t, resp.StatusCode, tt.status)
+			assert.Assert(t, cmp.Contains(string(body), tt.contains))
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Check returns an error describing why the controller is not healthy
+// (or not ready), or nil if it is.
+type Check func() error
+
+// Server serves the controller's metrics at /metrics, its liveness at
+// /healthz and its readiness at /readyz.
+type Server struct {
+	server *http.Server
+	logger *slog.Logger
+}
+
+func NewServer(addr string, healthy Check, ready Check) *Server {
+	return &Server{
+		server: &http.Server{
+			Addr:              addr,
+			Handler:           Handler(healthy, ready),
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+		logger: slog.New(slog.Default().Handler()).With(slog.String("component", "kube.metrics")),
+	}
+}
+
+// Handler returns the handler for the metrics, liveness and readiness
+// endpoints.
+func Handler(healthy Check, ready Check) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	mux.Handle("/healthz", probe(healthy))
+	mux.Handle("/readyz", probe(ready))
+	return mux
+}
+
+func probe(check Check) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if check != nil {
+			if err := check(); err != nil {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+				return
+			}
+		}
+		fmt.Fprintln(w, "ok")
+	}
+}
+
+// Start serves requests on a separate goroutine until stopCh is closed.
+func (s *Server) Start(stopCh <-chan struct{}) {
+	s.logger.Info("Serving metrics and health probes", slog.String("address", s.server.Addr))
+	go func() {
+		if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("Metrics server failed", slog.Any("error", err))
+		}
+	}()
+	go func() {
+		<-stopCh
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		s.server.Shutdown(ctx)
+	}()
+}
@@ -0,0 +1,77 @@
+// Package observer locates the network observer deployed alongside a
+// kubernetes site and forwards a local port to its API.
+package observer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+const (
+	Selector = "app.kubernetes.io/name=network-observer"
+	// Port is the port the network observer API listens on within its
+	// pod, behind the authenticating proxy.
+	Port = 8080
+)
+
+// FindPod returns a running network observer pod in the namespace.
+func FindPod(ctx context.Context, kubeClient kubernetes.Interface, namespace string) (*corev1.Pod, error) {
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: Selector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up the network observer: %w", err)
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodRunning && pod.DeletionTimestamp == nil {
+			return &pod, nil
+		}
+	}
+	return nil, fmt.Errorf("the network observer is not running in namespace %q", namespace)
+}
+
+// ForwardPort forwards a local port to the network observer API of the
+// given pod, until the context is done, returning the endpoint at which
+// the API is reachable.
+func ForwardPort(ctx context.Context, config *restclient.Config, kubeClient kubernetes.Interface, pod *corev1.Pod) (string, error) {
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return "", err
+	}
+	portForwardURL := kubeClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("portforward").
+		URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, portForwardURL)
+
+	readyCh := make(chan struct{})
+	forwarder, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, []string{fmt.Sprintf("0:%d", Port)}, ctx.Done(), readyCh, io.Discard, io.Discard)
+	if err != nil {
+		return "", err
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- forwarder.ForwardPorts()
+	}()
+	select {
+	case <-readyCh:
+	case err := <-errCh:
+		return "", fmt.Errorf("failed to forward a port to the network observer: %w", err)
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+	ports, err := forwarder.GetPorts()
+	if err != nil || len(ports) == 0 {
+		return "", fmt.Errorf("failed to forward a port to the network observer: %v", err)
+	}
+	return fmt.Sprintf("http://127.0.0.1:%d", ports[0].Local), nil
+}
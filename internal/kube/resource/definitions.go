@@ -37,6 +37,22 @@ func DeploymentResource() schema.GroupVersionResource {
 	}
 }
 
+func ServiceExportResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "multicluster.x-k8s.io",
+		Version:  "v1alpha1",
+		Resource: "serviceexports",
+	}
+}
+
+func ServiceImportResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "multicluster.x-k8s.io",
+		Version:  "v1alpha1",
+		Resource: "serviceimports",
+	}
+}
+
 func IsResourceAvailable(client discovery.DiscoveryInterface, resource schema.GroupVersionResource) bool {
 	resources, err := client.ServerResourcesForGroupVersion(resource.GroupVersion().String())
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 
 	"github.com/skupperproject/skupper/internal/kube/certificates"
 	internalclient "github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/kube/metrics"
 	"github.com/skupperproject/skupper/internal/kube/resource"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 )
@@ -228,6 +229,7 @@ func (m *SecuredAccessManager) accessType(sa *skupperv2alpha1.SecuredAccess) Acc
 func (m *SecuredAccessManager) reconcile(sa *skupperv2alpha1.SecuredAccess) error {
 	svc, err := m.checkService(sa)
 	if err != nil {
+		metrics.SecuredAccessFailed(metrics.SecuredAccessService)
 		if sa.SetConfigured(err) {
 			return m.updateStatus(sa)
 		}
@@ -244,6 +246,12 @@ func (m *SecuredAccessManager) reconcile(sa *skupperv2alpha1.SecuredAccess) erro
 	}
 
 	certErr := m.checkCertificate(sa)
+	if resourceErr != nil {
+		metrics.SecuredAccessFailed(metrics.SecuredAccessAccess)
+	}
+	if certErr != nil {
+		metrics.SecuredAccessFailed(metrics.SecuredAccessCertificate)
+	}
 
 	if sa.SetConfigured(errors.Join(resourceErr, certErr)) {
 		updated = true
@@ -0,0 +1,194 @@
+package sharding
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// GroupLabel identifies the Leases through which the members of a
+	// group of controller instances announce themselves.
+	GroupLabel = "skupper.io/controller-shard"
+
+	DefaultLeaseDuration = 15 * time.Second
+	DefaultRenewInterval = 5 * time.Second
+)
+
+// Membership maintains a Lease for this controller instance and tracks
+// the other live instances in the same group. A member is live while
+// its Lease has been renewed within the lease duration. Leases left
+// behind by instances that did not shut down cleanly are deleted once
+// they have been expired for a further lease duration.
+type Membership struct {
+	client        kubernetes.Interface
+	namespace     string
+	group         string
+	identity      string
+	LeaseDuration time.Duration
+	RenewInterval time.Duration
+	onChange      func(ring *Ring)
+	ring          *Ring
+	log           *slog.Logger
+}
+
+// NewMembership returns a Membership for the instance with the supplied
+// identity, in the named group. The onChange function is called with a
+// new ring whenever the set of live members changes.
+func NewMembership(client kubernetes.Interface, namespace string, group string, identity string, onChange func(ring *Ring)) *Membership {
+	return &Membership{
+		client:        client,
+		namespace:     namespace,
+		group:         group,
+		identity:      identity,
+		LeaseDuration: DefaultLeaseDuration,
+		RenewInterval: DefaultRenewInterval,
+		onChange:      onChange,
+		log: slog.New(slog.Default().Handler()).With(
+			slog.String("component", "kube.sharding"),
+			slog.String("group", group),
+			slog.String("identity", identity),
+		),
+	}
+}
+
+func (m *Membership) Identity() string {
+	return m.identity
+}
+
+// Start renews the Lease and refreshes the membership on a separate
+// goroutine until stopCh is closed, at which point the Lease is deleted
+// so that the remaining members can take over promptly.
+func (m *Membership) Start(stopCh <-chan struct{}) {
+	go func() {
+		wait.Until(func() {
+			if err := m.Update(time.Now()); err != nil {
+				m.log.Error("Failed to update controller shard membership", slog.Any("error", err))
+			}
+		}, m.RenewInterval, stopCh)
+		if err := m.client.CoordinationV1().Leases(m.namespace).Delete(context.Background(), m.leaseName(), metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			m.log.Error("Failed to release controller shard lease", slog.Any("error", err))
+		}
+	}()
+}
+
+// Update renews this instance's Lease, then determines the live members
+// and calls onChange if they differ from those previously seen.
+func (m *Membership) Update(now time.Time) error {
+	if err := m.renew(now); err != nil {
+		return err
+	}
+	members, err := m.live(now)
+	if err != nil {
+		return err
+	}
+	if m.ring != nil && slices.Equal(m.ring.Members(), members) {
+		return nil
+	}
+	m.ring = NewRing(members)
+	m.log.Info("Controller shard membership changed", slog.Any("members", members))
+	if m.onChange != nil {
+		m.onChange(m.ring)
+	}
+	return nil
+}
+
+func (m *Membership) leaseName() string {
+	return m.group + "-" + m.identity
+}
+
+func (m *Membership) renew(now time.Time) error {
+	leases := m.client.CoordinationV1().Leases(m.namespace)
+	renewTime := metav1.NewMicroTime(now)
+	duration := int32(m.LeaseDuration.Seconds())
+	current, err := leases.Get(context.Background(), m.leaseName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		lease := &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: m.leaseName(),
+				Labels: map[string]string{
+					GroupLabel: m.group,
+				},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &m.identity,
+				LeaseDurationSeconds: &duration,
+				AcquireTime:          &renewTime,
+				RenewTime:            &renewTime,
+			},
+		}
+		_, err = leases.Create(context.Background(), lease, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+	current.Spec.HolderIdentity = &m.identity
+	current.Spec.LeaseDurationSeconds = &duration
+	current.Spec.RenewTime = &renewTime
+	_, err = leases.Update(context.Background(), current, metav1.UpdateOptions{})
+	return err
+}
+
+func (m *Membership) live(now time.Time) ([]string, error) {
+	list, err := m.client.CoordinationV1().Leases(m.namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: GroupLabel + "=" + m.group,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var members []string
+	for _, lease := range list.Items {
+		if isLive(&lease, now) {
+			members = append(members, *lease.Spec.HolderIdentity)
+		} else if lease.Name != m.leaseName() && isStale(&lease, now) {
+			m.prune(&lease)
+		}
+	}
+	slices.Sort(members)
+	return slices.Compact(members), nil
+}
+
+// prune deletes the Lease of an instance that is no longer live. The
+// deletion is conditional on the Lease not having been renewed since it
+// was listed.
+func (m *Membership) prune(lease *coordinationv1.Lease) {
+	err := m.client.CoordinationV1().Leases(m.namespace).Delete(context.Background(), lease.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{
+			ResourceVersion: &lease.ResourceVersion,
+		},
+	})
+	if k8serrors.IsNotFound(err) || k8serrors.IsConflict(err) {
+		return
+	} else if err != nil {
+		m.log.Error("Failed to delete stale controller shard lease", slog.String("lease", lease.Name), slog.Any("error", err))
+		return
+	}
+	m.log.Info("Deleted stale controller shard lease", slog.String("lease", lease.Name))
+}
+
+func isLive(lease *coordinationv1.Lease, now time.Time) bool {
+	spec := lease.Spec
+	if spec.HolderIdentity == nil || *spec.HolderIdentity == "" || spec.RenewTime == nil || spec.LeaseDurationSeconds == nil {
+		return false
+	}
+	expiry := spec.RenewTime.Add(time.Duration(*spec.LeaseDurationSeconds) * time.Second)
+	return now.Before(expiry)
+}
+
+// isStale returns true if the lease has been expired for at least its
+// lease duration, or cannot be interpreted at all.
+func isStale(lease *coordinationv1.Lease, now time.Time) bool {
+	spec := lease.Spec
+	if spec.RenewTime == nil || spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	duration := time.Duration(*spec.LeaseDurationSeconds) * time.Second
+	return !now.Before(spec.RenewTime.Add(2 * duration))
+}
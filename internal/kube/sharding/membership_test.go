@@ -0,0 +1,132 @@
+package sharding
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func lease(name string, group string, identity string, renewed time.Time) *coordinationv1.Lease {
+	renewTime := metav1.NewMicroTime(renewed)
+	duration := int32(15)
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+			Labels: map[string]string{
+				GroupLabel: group,
+			},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &identity,
+			LeaseDurationSeconds: &duration,
+			RenewTime:            &renewTime,
+		},
+	}
+}
+
+func TestMembershipUpdate(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		existing []runtime.Object
+		expected []string
+		pruned   []string
+	}{
+		{
+			name:     "alone",
+			expected: []string{"a"},
+		},
+		{
+			name: "others live",
+			existing: []runtime.Object{
+				lease("controller-b", "controller", "b", now.Add(-5*time.Second)),
+				lease("controller-c", "controller", "c", now),
+			},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name: "expired member",
+			existing: []runtime.Object{
+				lease("controller-b", "controller", "b", now.Add(-time.Minute)),
+			},
+			expected: []string{"a"},
+			pruned:   []string{"controller-b"},
+		},
+		{
+			name: "recently expired member",
+			existing: []runtime.Object{
+				lease("controller-b", "controller", "b", now.Add(-20*time.Second)),
+			},
+			expected: []string{"a"},
+		},
+		{
+			name: "other group",
+			existing: []runtime.Object{
+				lease("other-b", "other", "b", now),
+			},
+			expected: []string{"a"},
+		},
+		{
+			name: "own lease renewed",
+			existing: []runtime.Object{
+				lease("controller-a", "controller", "a", now.Add(-time.Minute)),
+			},
+			expected: []string{"a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(tt.existing...)
+			var rings []*Ring
+			membership := NewMembership(client, "test", "controller", "a", func(ring *Ring) {
+				rings = append(rings, ring)
+			})
+			assert.Assert(t, membership.Update(now))
+			assert.Equal(t, len(rings), 1)
+			assert.DeepEqual(t, rings[0].Members(), tt.expected)
+
+			own, err := client.CoordinationV1().Leases("test").Get(context.Background(), "controller-a", metav1.GetOptions{})
+			assert.Assert(t, err)
+			assert.Equal(t, *own.Spec.HolderIdentity, "a")
+			assert.Assert(t, own.Spec.RenewTime.Time.Equal(now))
+			for _, existing := range tt.existing {
+				name := existing.(*coordinationv1.Lease).Name
+				_, err := client.CoordinationV1().Leases("test").Get(context.Background(), name, metav1.GetOptions{})
+				if slices.Contains(tt.pruned, name) {
+					assert.Assert(t, k8serrors.IsNotFound(err), name)
+				} else {
+					assert.Assert(t, err, name)
+				}
+			}
+
+			// no change in membership
+			assert.Assert(t, membership.Update(now.Add(time.Second)))
+			assert.Equal(t, len(rings), 1)
+		})
+	}
+}
+
+func TestMembershipChange(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	client := fake.NewSimpleClientset(lease("controller-b", "controller", "b", now))
+	var rings []*Ring
+	membership := NewMembership(client, "test", "controller", "a", func(ring *Ring) {
+		rings = append(rings, ring)
+	})
+	assert.Assert(t, membership.Update(now))
+	assert.DeepEqual(t, rings[len(rings)-1].Members(), []string{"a", "b"})
+
+	// b stops renewing its lease
+	assert.Assert(t, membership.Update(now.Add(20*time.Second)))
+	assert.Equal(t, len(rings), 2)
+	assert.DeepEqual(t, rings[len(rings)-1].Members(), []string{"a"})
+}
@@ -0,0 +1,69 @@
+// Package sharding partitions namespaces across a set of controller
+// instances by consistent hashing, so that each namespace is handled by
+// exactly one instance and a change in membership only moves the
+// namespaces owned by the instances that joined or left.
+package sharding
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+	"strconv"
+)
+
+// The number of points each member is given on the ring. More points
+// give a more even distribution of namespaces across members.
+const pointsPerMember = 128
+
+// Ring assigns keys to members by consistent hashing.
+type Ring struct {
+	members []string
+	points  []uint64
+	owners  map[uint64]string
+}
+
+func NewRing(members []string) *Ring {
+	r := &Ring{
+		members: slices.Sorted(slices.Values(members)),
+		owners:  map[uint64]string{},
+	}
+	r.members = slices.Compact(r.members)
+	for _, member := range r.members {
+		for i := 0; i < pointsPerMember; i++ {
+			point := hash(member + "#" + strconv.Itoa(i))
+			if _, ok := r.owners[point]; ok {
+				continue
+			}
+			r.owners[point] = member
+			r.points = append(r.points, point)
+		}
+	}
+	slices.Sort(r.points)
+	return r
+}
+
+// Owner returns the member to which the key is assigned, or an empty
+// string if the ring has no members.
+func (r *Ring) Owner(key string) string {
+	if r == nil || len(r.points) == 0 {
+		return ""
+	}
+	i, _ := slices.BinarySearch(r.points, hash(key))
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.owners[r.points[i]]
+}
+
+// Members returns the sorted members of the ring.
+func (r *Ring) Members() []string {
+	if r == nil {
+		return nil
+	}
+	return r.members
+}
+
+func hash(key string) uint64 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
@@ -0,0 +1,66 @@
+package sharding
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestRingOwner(t *testing.T) {
+	keys := make([]string, 1000)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("namespace-%d", i)
+	}
+	tests := []struct {
+		name    string
+		members []string
+	}{
+		{
+			name:    "single",
+			members: []string{"a"},
+		},
+		{
+			name:    "several",
+			members: []string{"c", "a", "b"},
+		},
+		{
+			name:    "duplicates",
+			members: []string{"a", "b", "a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ring := NewRing(tt.members)
+			counts := map[string]int{}
+			for _, key := range keys {
+				owner := ring.Owner(key)
+				counts[owner]++
+				assert.Equal(t, NewRing(tt.members).Owner(key), owner, "ownership of %s not stable", key)
+			}
+			assert.Equal(t, len(counts), len(ring.Members()))
+			for member, count := range counts {
+				// each member should get a reasonable share of the keys
+				assert.Assert(t, count > len(keys)/len(counts)/2, "%s owns only %d keys", member, count)
+			}
+		})
+	}
+}
+
+func TestRingMembershipChange(t *testing.T) {
+	before := NewRing([]string{"a", "b", "c"})
+	after := NewRing([]string{"a", "b", "c", "d"})
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("namespace-%d", i)
+		if owner := after.Owner(key); owner != "d" {
+			assert.Equal(t, owner, before.Owner(key), "%s moved between existing members", key)
+		}
+	}
+}
+
+func TestRingEmpty(t *testing.T) {
+	var ring *Ring
+	assert.Equal(t, ring.Owner("test"), "")
+	assert.Equal(t, NewRing(nil).Owner("test"), "")
+	assert.Assert(t, ring.Members() == nil)
+}
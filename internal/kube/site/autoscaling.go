@@ -0,0 +1,176 @@
+package site
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/skupperproject/skupper/internal/kube/site/scaling"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+const autoscalingInterval = 30 * time.Second
+
+type routerAutoscaling struct {
+	scaler    *scaling.Autoscaler
+	agents    scaling.AgentFactory
+	routers   int
+	scheduled bool
+	sampling  bool
+	samples   chan loadSample
+}
+
+type loadSample struct {
+	connections int
+	err         error
+}
+
+func (s *Site) autoscalingPolicy() (scaling.Policy, bool) {
+	return scaling.PolicyFor(s.site)
+}
+
+// autoscaledRouters returns the number of router groups chosen by the
+// autoscaler, or zero if autoscaling is not enabled for the site.
+func (s *Site) autoscaledRouters() int {
+	policy, ok := s.autoscalingPolicy()
+	if !ok {
+		return 0
+	}
+	return policy.Bound(s.autoscaling.routers)
+}
+
+// checkAutoscaling starts or stops the periodic evaluation of router
+// load as autoscaling is enabled or disabled for the site.
+func (s *Site) checkAutoscaling() error {
+	policy, ok := s.autoscalingPolicy()
+	if !ok {
+		s.autoscaling.scaler = nil
+		s.autoscaling.routers = 0
+		if s.site.SetAutoscaling(nil) {
+			return s.updateSiteStatus()
+		}
+		return nil
+	}
+	if s.autoscaling.scaler == nil {
+		s.autoscaling.scaler = scaling.NewAutoscaler(policy)
+		if s.autoscaling.routers == 0 && s.site.Status.Autoscaling != nil {
+			// resume with the number of routers previously chosen
+			s.autoscaling.routers = s.site.Status.Autoscaling.Routers
+		}
+		s.logger.Info("Router autoscaling enabled for site",
+			slog.String("namespace", s.namespace),
+			slog.String("name", s.name),
+			slog.Int("minRouters", policy.MinRouters),
+			slog.Int("maxRouters", policy.MaxRouters),
+			slog.Int("targetConnections", policy.TargetConnections))
+	} else {
+		s.autoscaling.scaler.SetPolicy(policy)
+	}
+	if s.autoscaling.samples == nil {
+		s.autoscaling.samples = make(chan loadSample, 1)
+	}
+	s.scheduleAutoscaling()
+	return nil
+}
+
+func (s *Site) scheduleAutoscaling() {
+	if s.autoscaling.scheduled || s.autoscaling.scaler == nil {
+		return
+	}
+	s.autoscaling.scheduled = true
+	s.clients.CallbackAfter(autoscalingInterval, s.autoscale, s.namespace)
+}
+
+// autoscale runs on the event processing thread. The router load is
+// sampled on a separate goroutine so as not to block event processing,
+// and the result delivered back to receiveLoadSample.
+func (s *Site) autoscale(string) error {
+	s.autoscaling.scheduled = false
+	if s.site == nil || s.autoscaling.scaler == nil {
+		return nil
+	}
+	s.scheduleAutoscaling()
+	if s.autoscaling.sampling || s.autoscaling.agents == nil {
+		return nil
+	}
+	s.autoscaling.sampling = true
+	pods := s.readyRouterPods()
+	agents := s.autoscaling.agents
+	samples := s.autoscaling.samples
+	go func() {
+		connections, err := scaling.ActiveConnections(pods, agents)
+		samples <- loadSample{connections: connections, err: err}
+		s.clients.CallbackAfter(0, s.receiveLoadSample, s.namespace)
+	}()
+	return nil
+}
+
+func (s *Site) receiveLoadSample(string) error {
+	select {
+	case sample := <-s.autoscaling.samples:
+		s.autoscaling.sampling = false
+		return s.applyLoadSample(sample, time.Now())
+	default:
+		return nil
+	}
+}
+
+func (s *Site) readyRouterPods() []*corev1.Pod {
+	var pods []*corev1.Pod
+	for _, pod := range s.routerPods {
+		if isPodRunning(pod) && isPodReady(pod) {
+			pods = append(pods, pod)
+		}
+	}
+	return pods
+}
+
+// applyLoadSample decides whether the number of router groups should
+// change in response to the load sampled, applies any change and records
+// the decision in the site status. No decision is taken on an incomplete
+// sample, as undercounting the load could otherwise cause a scale down.
+func (s *Site) applyLoadSample(sample loadSample, now time.Time) error {
+	if s.site == nil || s.autoscaling.scaler == nil {
+		return nil
+	}
+	current := len(s.groups())
+	status := &skupperv2alpha1.RouterAutoscalingStatus{
+		Routers:           current,
+		ActiveConnections: sample.connections,
+	}
+	if s.site.Status.Autoscaling != nil {
+		status.LastScaleTime = s.site.Status.Autoscaling.LastScaleTime
+	}
+	if sample.err != nil {
+		status.Message = fmt.Sprintf("Could not observe router load: %s", sample.err)
+		s.logger.Warn("Could not observe router load for autoscaling",
+			slog.String("namespace", s.namespace),
+			slog.String("name", s.name),
+			slog.Any("error", sample.err))
+	} else {
+		decision := s.autoscaling.scaler.Decide(current, sample.connections, now)
+		status.Message = decision.Reason
+		if decision.Changed {
+			s.logger.Info("Autoscaling routers for site",
+				slog.String("namespace", s.namespace),
+				slog.String("name", s.name),
+				slog.Int("previous", current),
+				slog.Int("routers", decision.Routers),
+				slog.Int("connections", sample.connections))
+			s.autoscaling.routers = decision.Routers
+			status.Routers = decision.Routers
+			scaled := metav1.NewTime(now)
+			status.LastScaleTime = &scaled
+			if err := s.reconcile(s.site, false); err != nil {
+				return err
+			}
+		}
+	}
+	if s.site.SetAutoscaling(status) {
+		return s.updateSiteStatus()
+	}
+	return nil
+}
@@ -5,7 +5,11 @@ import (
 	"log/slog"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
 	internalclient "github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/kube/events"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 )
 
@@ -13,13 +17,15 @@ type BindingStatus struct {
 	connectors map[string][]string
 	listeners  map[string][]string
 	client     internalclient.Clients
+	recorder   record.EventRecorder
 	errors     []string
 	logger     *slog.Logger
 }
 
-func newBindingStatus(client internalclient.Clients, network []skupperv2alpha1.SiteRecord) *BindingStatus {
+func newBindingStatus(client internalclient.Clients, recorder record.EventRecorder, network []skupperv2alpha1.SiteRecord) *BindingStatus {
 	s := &BindingStatus{
 		client:     client,
+		recorder:   recorder,
 		connectors: map[string][]string{},
 		listeners:  map[string][]string{},
 		logger: slog.New(slog.Default().Handler()).With(
@@ -49,7 +55,18 @@ func (s *BindingStatus) populate(network []skupperv2alpha1.SiteRecord) {
 }
 
 func (s *BindingStatus) updateMatchingListenerCount(connector *skupperv2alpha1.Connector) *skupperv2alpha1.Connector {
-	if connector.SetHasMatchingListener(len(s.listeners[connector.Spec.RoutingKey]) > 0) {
+	matched := len(s.listeners[connector.Spec.RoutingKey]) > 0
+	wasMatched := connector.Status.HasMatchingListener
+	if connector.SetHasMatchingListener(matched) {
+		if matched != wasMatched {
+			if matched {
+				s.recorder.Eventf(connector, corev1.EventTypeNormal, events.ConnectorMatched,
+					"Listener found for routing key %q", connector.Spec.RoutingKey)
+			} else {
+				s.recorder.Eventf(connector, corev1.EventTypeWarning, events.ConnectorUnmatched,
+					"No listener for routing key %q", connector.Spec.RoutingKey)
+			}
+		}
 		updated, err := updateConnectorStatus(s.client, connector)
 		if err != nil {
 			s.logger.Error("Failed to update status for connector",
@@ -64,7 +81,18 @@ func (s *BindingStatus) updateMatchingListenerCount(connector *skupperv2alpha1.C
 }
 
 func (s *BindingStatus) updateMatchingConnectorCount(listener *skupperv2alpha1.Listener) *skupperv2alpha1.Listener {
-	if listener.SetHasMatchingConnector(len(s.connectors[listener.Spec.RoutingKey]) > 0) {
+	matched := len(s.connectors[listener.Spec.RoutingKey]) > 0
+	wasMatched := listener.Status.HasMatchingConnector
+	if listener.SetHasMatchingConnector(matched) {
+		if matched != wasMatched {
+			if matched {
+				s.recorder.Eventf(listener, corev1.EventTypeNormal, events.ListenerMatched,
+					"Connector found for routing key %q", listener.Spec.RoutingKey)
+			} else {
+				s.recorder.Eventf(listener, corev1.EventTypeWarning, events.ListenerUnmatched,
+					"No connector for routing key %q", listener.Spec.RoutingKey)
+			}
+		}
 		updated, err := updateListenerStatus(s.client, listener)
 		if err != nil {
 			s.logger.Error("Failed to update status for listener",
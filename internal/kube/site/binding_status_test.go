@@ -0,0 +1,110 @@
+package site
+
+import (
+	"testing"
+
+	fakeclient "github.com/skupperproject/skupper/internal/kube/client/fake"
+	"github.com/skupperproject/skupper/internal/kube/watchers"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+func networkWithService(routingKey string, listeners []string, connectors []string) []skupperv2alpha1.SiteRecord {
+	return []skupperv2alpha1.SiteRecord{
+		{
+			Name: "site1",
+			Services: []skupperv2alpha1.ServiceRecord{
+				{
+					RoutingKey: routingKey,
+					Listeners:  listeners,
+					Connectors: connectors,
+				},
+			},
+		},
+	}
+}
+
+func TestBindingStatus_Events(t *testing.T) {
+	tests := []struct {
+		name           string
+		wasMatched     bool
+		network        []skupperv2alpha1.SiteRecord
+		expectedEvents []string
+	}{
+		{
+			name:       "matched",
+			wasMatched: false,
+			network:    networkWithService("backend", []string{"backend"}, []string{"backend"}),
+			expectedEvents: []string{
+				"Normal ListenerMatched Connector found for routing key \"backend\"",
+				"Normal ConnectorMatched Listener found for routing key \"backend\"",
+			},
+		},
+		{
+			name:       "unmatched",
+			wasMatched: true,
+			network:    networkWithService("other", []string{"other"}, []string{"other"}),
+			expectedEvents: []string{
+				"Warning ListenerUnmatched No connector for routing key \"backend\"",
+				"Warning ConnectorUnmatched No listener for routing key \"backend\"",
+			},
+		},
+		{
+			name:       "still matched",
+			wasMatched: true,
+			network:    networkWithService("backend", []string{"backend"}, []string{"backend"}),
+		},
+		{
+			name:       "still unmatched",
+			wasMatched: false,
+			network:    networkWithService("other", []string{"other"}, []string{"other"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listener := &skupperv2alpha1.Listener{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "backend",
+					Namespace: "test",
+				},
+				Spec: skupperv2alpha1.ListenerSpec{
+					RoutingKey: "backend",
+					Host:       "backend",
+					Port:       8080,
+				},
+			}
+			connector := &skupperv2alpha1.Connector{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "backend",
+					Namespace: "test",
+				},
+				Spec: skupperv2alpha1.ConnectorSpec{
+					RoutingKey: "backend",
+					Host:       "backend",
+					Port:       8080,
+				},
+			}
+			listener.SetHasMatchingConnector(tt.wasMatched)
+			connector.SetHasMatchingListener(tt.wasMatched)
+			client, err := fakeclient.NewFakeClient("test", nil, []runtime.Object{listener, connector}, "")
+			assert.Assert(t, err)
+			processor := watchers.NewEventProcessor("test", client)
+			recorder := record.NewFakeRecorder(10)
+
+			status := newBindingStatus(processor, recorder, tt.network)
+			status.updateMatchingConnectorCount(listener)
+			status.updateMatchingListenerCount(connector)
+			assert.Assert(t, status.error())
+
+			close(recorder.Events)
+			var actual []string
+			for event := range recorder.Events {
+				actual = append(actual, event)
+			}
+			assert.DeepEqual(t, actual, tt.expectedEvents)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package site
+
+import (
+	"context"
+	"log/slog"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/skupperproject/skupper/internal/site"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+// newHealthMonitor returns a monitor for connector health checks that
+// handles any change on the event processing thread.
+func (s *Site) newHealthMonitor() *site.HealthMonitor {
+	return site.NewHealthMonitor(func(connector string) {
+		s.clients.CallbackAfter(0, s.connectorHealthChanged, connector)
+	})
+}
+
+// connectorHealthChanged reconfigures the router to use only those
+// targets of the connector that are healthy, and records the health
+// of its targets in its status.
+func (s *Site) connectorHealthChanged(name string) error {
+	if s.site == nil || s.bindings.GetConnector(name) == nil {
+		return nil
+	}
+	if err := s.updateRouterConfig(s.bindings); err != nil {
+		return err
+	}
+	s.updateTargetHealthStatus()
+	return nil
+}
+
+// updateTargetHealthStatus records the healthy and unhealthy targets
+// of any connector that has a health check.
+func (s *Site) updateTargetHealthStatus() {
+	cf := func(connector *skupperv2alpha1.Connector) *skupperv2alpha1.Connector {
+		healthy, unhealthy := s.bindings.TargetHealth(connector.Name)
+		if connector.SetTargetHealth(healthy, unhealthy) {
+			updated, err := s.clients.GetSkupperClient().SkupperV2alpha1().Connectors(connector.ObjectMeta.Namespace).UpdateStatus(context.TODO(), connector, metav1.UpdateOptions{})
+			if err == nil {
+				return updated
+			} else {
+				s.logger.Error("Could not update connector status",
+					slog.String("namespace", connector.ObjectMeta.Namespace),
+					slog.String("connector", connector.ObjectMeta.Name),
+					slog.Any("error", err))
+			}
+		}
+		return nil
+	}
+	s.bindings.Map(cf, nil)
+}
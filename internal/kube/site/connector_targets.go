@@ -0,0 +1,54 @@
+package site
+
+import (
+	"context"
+	"log/slog"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/skupperproject/skupper/internal/site"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+// newTargetResolver returns a resolver for connector SRV records that
+// handles any change on the event processing thread.
+func (s *Site) newTargetResolver() *site.TargetResolver {
+	return site.NewTargetResolver(func(connector string) {
+		s.clients.CallbackAfter(0, s.connectorTargetsChanged, connector)
+	})
+}
+
+// connectorTargetsChanged reconfigures the router with one
+// tcpConnector per resolved target of the connector, and records
+// those targets in its status.
+func (s *Site) connectorTargetsChanged(name string) error {
+	if s.site == nil || s.bindings.GetConnector(name) == nil {
+		return nil
+	}
+	if err := s.updateRouterConfig(s.bindings); err != nil {
+		return err
+	}
+	s.updateResolvedTargetsStatus()
+	s.updateTargetHealthStatus()
+	return nil
+}
+
+// updateResolvedTargetsStatus records the targets of any connector
+// with multiple hosts or an SRV record.
+func (s *Site) updateResolvedTargetsStatus() {
+	cf := func(connector *skupperv2alpha1.Connector) *skupperv2alpha1.Connector {
+		if connector.SetResolvedTargets(s.bindings.ResolvedTargets(connector.Name)) {
+			updated, err := s.clients.GetSkupperClient().SkupperV2alpha1().Connectors(connector.ObjectMeta.Namespace).UpdateStatus(context.TODO(), connector, metav1.UpdateOptions{})
+			if err == nil {
+				return updated
+			} else {
+				s.logger.Error("Could not update connector status",
+					slog.String("namespace", connector.ObjectMeta.Namespace),
+					slog.String("connector", connector.ObjectMeta.Name),
+					slog.Any("error", err))
+			}
+		}
+		return nil
+	}
+	s.bindings.Map(cf, nil)
+}
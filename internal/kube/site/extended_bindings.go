@@ -19,6 +19,8 @@ type ExtendedBindings struct {
 	connectors         map[string]*AttachedConnector
 	perTargetListeners map[string]*PerTargetListener
 	listenerHosts      map[string]string // listener name -> host
+	health             *site.HealthMonitor
+	resolver           *site.TargetResolver
 	controller         *watchers.EventProcessor
 	site               *Site
 	logger             *slog.Logger
@@ -55,6 +57,12 @@ func (a *ExtendedBindings) cleanup() {
 	for _, s := range a.selectors {
 		s.Close()
 	}
+	if a.health != nil {
+		a.health.Stop()
+	}
+	if a.resolver != nil {
+		a.resolver.Stop()
+	}
 }
 
 func (a *ExtendedBindings) ConnectorUpdated(connector *skupperv2alpha1.Connector) bool {
@@ -243,6 +251,32 @@ func (b *ExtendedBindings) Map(cf site.ConnectorFunction, lf site.ListenerFuncti
 
 type AttachedConnectorFunction func(*AttachedConnector)
 
+func (b *ExtendedBindings) ListenerBalancing(listener *skupperv2alpha1.Listener) (string, skupperv2alpha1.ConditionState) {
+	return b.bindings.ListenerBalancing(listener)
+}
+
+func (b *ExtendedBindings) ConnectorBalancing(connector *skupperv2alpha1.Connector) (string, skupperv2alpha1.ConditionState) {
+	return b.bindings.ConnectorBalancing(connector)
+}
+
+func (b *ExtendedBindings) SetHealthMonitor(monitor *site.HealthMonitor) {
+	b.health = monitor
+	b.bindings.SetHealthMonitor(monitor)
+}
+
+func (b *ExtendedBindings) TargetHealth(name string) ([]string, []string) {
+	return b.bindings.TargetHealth(name)
+}
+
+func (b *ExtendedBindings) SetTargetResolver(resolver *site.TargetResolver) {
+	b.resolver = resolver
+	b.bindings.SetTargetResolver(resolver)
+}
+
+func (b *ExtendedBindings) ResolvedTargets(name string) []string {
+	return b.bindings.ResolvedTargets(name)
+}
+
 func (b *ExtendedBindings) MapOverAttachedConnectors(cf AttachedConnectorFunction) {
 	for _, value := range b.connectors {
 		cf(value)
@@ -259,6 +293,7 @@ func (b *ExtendedBindings) Apply(config *qdr.RouterConfig) bool {
 	}
 	b.bindings.AddSslProfiles(config)
 	config.UpdateBridgeConfig(desired)
+	b.bindings.UpdateAddresses(config)
 	config.RemoveUnreferencedSslProfiles()
 	return true //TODO: can optimise by indicating if no change was required
 }
@@ -56,18 +56,20 @@ func resourceTemplates(site *skupperv2alpha1.Site, group string, size sizing.Siz
 }
 
 type CoreParams struct {
-	SiteId             string
-	SiteName           string
-	Group              string
-	Replicas           int
-	ServiceAccount     string
-	ConfigDigest       string
-	RouterImage        skuppertypes.ImageDetails
-	AdaptorImage       skuppertypes.ImageDetails
-	Sizing             sizing.Sizing
-	Labels             map[string]string
-	Annotations        map[string]string
-	EnableAntiAffinity bool
+	SiteId               string
+	SiteName             string
+	Group                string
+	Replicas             int
+	ServiceAccount       string
+	ConfigDigest         string
+	RouterImage          skuppertypes.ImageDetails
+	AdaptorImage         skuppertypes.ImageDetails
+	Sizing               sizing.Sizing
+	Labels               map[string]string
+	Annotations          map[string]string
+	EnableAntiAffinity   bool
+	ProbeInterval        string
+	ProbeThroughputBytes string
 }
 
 func (p *CoreParams) setLabelsAndAnnotations(labelling Labelling, namespace string, name string, kind string) *CoreParams {
@@ -151,17 +153,19 @@ func configDigest(config *skupperv2alpha1.SiteSpec) string {
 
 func getCoreParams(site *skupperv2alpha1.Site, group string, size sizing.Sizing) *CoreParams {
 	return &CoreParams{
-		SiteId:             site.GetSiteId(),
-		SiteName:           site.Name,
-		Group:              group,
-		Replicas:           1,
-		ServiceAccount:     site.Spec.GetServiceAccount(),
-		ConfigDigest:       configDigest(&site.Spec),
-		RouterImage:        images.GetRouterImageDetails(),
-		AdaptorImage:       images.GetKubeAdaptorImageDetails(),
-		Sizing:             size,
-		Labels:             map[string]string{},
-		EnableAntiAffinity: enableAntiAffinity(site),
+		SiteId:               site.GetSiteId(),
+		SiteName:             site.Name,
+		Group:                group,
+		Replicas:             1,
+		ServiceAccount:       site.Spec.GetServiceAccount(),
+		ConfigDigest:         configDigest(&site.Spec),
+		RouterImage:          images.GetRouterImageDetails(),
+		AdaptorImage:         images.GetKubeAdaptorImageDetails(),
+		Sizing:               size,
+		Labels:               map[string]string{},
+		EnableAntiAffinity:   enableAntiAffinity(site),
+		ProbeInterval:        site.Spec.GetProbeInterval(),
+		ProbeThroughputBytes: site.Spec.GetProbeThroughputBytes(),
 	}
 }
 
@@ -0,0 +1,63 @@
+package scaling
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/skupperproject/skupper/internal/qdr"
+)
+
+const (
+	// ClientCredentials names the Secret holding the client
+	// certificate used to access the router management agent.
+	ClientCredentials = "skupper-local-client"
+	routerServerName  = "skupper-router-local"
+	routerAmqpsPort   = "5671"
+)
+
+// NewAgentFactory returns an AgentFactory that connects directly to
+// the amqps listener of the router in each pod, authenticating with
+// the client certificate in the ClientCredentials Secret.
+func NewAgentFactory(client kubernetes.Interface, namespace string) AgentFactory {
+	return func(pod *corev1.Pod) (Agent, error) {
+		if pod.Status.PodIP == "" {
+			return nil, errors.New("pod has no IP address")
+		}
+		url := "amqps://" + net.JoinHostPort(pod.Status.PodIP, routerAmqpsPort)
+		return qdr.Connect(url, &secretTlsConfig{client: client, namespace: namespace})
+	}
+}
+
+type secretTlsConfig struct {
+	client    kubernetes.Interface
+	namespace string
+}
+
+func (s *secretTlsConfig) GetTlsConfig() (*tls.Config, error) {
+	secret, err := s.client.CoreV1().Secrets(s.namespace).Get(context.TODO(), ClientCredentials, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.X509KeyPair(secret.Data["tls.crt"], secret.Data["tls.key"])
+	if err != nil {
+		return nil, fmt.Errorf("invalid client certificate in %s: %s", ClientCredentials, err)
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(secret.Data["ca.crt"]) {
+		return nil, fmt.Errorf("no CA certificate in %s", ClientCredentials)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      caPool,
+		ServerName:   routerServerName,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
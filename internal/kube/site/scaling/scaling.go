@@ -0,0 +1,204 @@
+package scaling
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/skupperproject/skupper/internal/qdr"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+const (
+	DefaultTargetConnections = 500
+	DefaultScaleDownDelay    = 5 * time.Minute
+	// The load must fall below this fraction of the target capacity
+	// of one fewer router before a scale down is considered. This
+	// leaves a margin between the scale up and scale down thresholds
+	// so that load hovering around a boundary does not cause flapping.
+	ScaleDownThreshold = 0.8
+)
+
+// Agent is the part of the router management agent used to observe
+// load. It is satisfied by *qdr.Agent.
+type Agent interface {
+	GetLocalTcpConnections() ([]qdr.TcpConnection, error)
+	Close() error
+}
+
+// AgentFactory returns an Agent connected to the router in the
+// supplied pod.
+type AgentFactory func(pod *corev1.Pod) (Agent, error)
+
+// Policy is the effective autoscaling configuration for a site.
+type Policy struct {
+	MinRouters        int
+	MaxRouters        int
+	TargetConnections int
+	ScaleDownDelay    time.Duration
+}
+
+// PolicyFor returns the autoscaling policy for the site, with defaults
+// applied, or false if autoscaling is not enabled for it.
+func PolicyFor(site *skupperv2alpha1.Site) (Policy, bool) {
+	if site == nil || site.Spec.Autoscaling == nil {
+		return Policy{}, false
+	}
+	config := site.Spec.Autoscaling
+	policy := Policy{
+		MinRouters:        config.MinRouters,
+		MaxRouters:        config.MaxRouters,
+		TargetConnections: config.TargetConnections,
+		ScaleDownDelay:    time.Duration(config.ScaleDownDelaySeconds) * time.Second,
+	}
+	if policy.MinRouters < 1 {
+		policy.MinRouters = 1
+		if site.Spec.HA {
+			policy.MinRouters = 2
+		}
+	}
+	if policy.MaxRouters < policy.MinRouters {
+		policy.MaxRouters = policy.MinRouters
+	}
+	if policy.TargetConnections < 1 {
+		policy.TargetConnections = DefaultTargetConnections
+	}
+	if policy.ScaleDownDelay <= 0 {
+		policy.ScaleDownDelay = DefaultScaleDownDelay
+	}
+	return policy, true
+}
+
+// Bound returns the number of routers closest to that supplied that is
+// within the policy's limits.
+func (p Policy) Bound(routers int) int {
+	if routers < p.MinRouters {
+		return p.MinRouters
+	}
+	if routers > p.MaxRouters {
+		return p.MaxRouters
+	}
+	return routers
+}
+
+// Decision is the outcome of evaluating the observed load.
+type Decision struct {
+	Routers int
+	Changed bool
+	Reason  string
+}
+
+// Autoscaler decides on the number of routers required for the
+// observed load. Scaling up happens as soon as the load exceeds the
+// target for the current number of routers. Scaling down only happens
+// once the load has remained below the scale down threshold for the
+// policy's delay.
+type Autoscaler struct {
+	policy     Policy
+	belowSince time.Time
+}
+
+func NewAutoscaler(policy Policy) *Autoscaler {
+	return &Autoscaler{
+		policy: policy,
+	}
+}
+
+// SetPolicy updates the policy in use, e.g. after the site has changed.
+func (a *Autoscaler) SetPolicy(policy Policy) {
+	if a.policy != policy {
+		a.policy = policy
+		a.belowSince = time.Time{}
+	}
+}
+
+// Decide returns the number of routers that should be run given the
+// current number and the total number of active connections across
+// them.
+func (a *Autoscaler) Decide(current int, connections int, now time.Time) Decision {
+	if bounded := a.policy.Bound(current); bounded != current {
+		a.belowSince = time.Time{}
+		return Decision{
+			Routers: bounded,
+			Changed: true,
+			Reason:  fmt.Sprintf("Scaled from %d to %d routers to satisfy bounds [%d, %d]", current, bounded, a.policy.MinRouters, a.policy.MaxRouters),
+		}
+	}
+	target := a.policy.TargetConnections
+	required := a.policy.Bound(ceilDiv(connections, target))
+	if required > current {
+		a.belowSince = time.Time{}
+		return Decision{
+			Routers: required,
+			Changed: true,
+			Reason:  fmt.Sprintf("Scaled up from %d to %d routers: %d active connections exceeds target of %d per router", current, required, connections, target),
+		}
+	}
+	if current > a.policy.MinRouters && float64(connections) < ScaleDownThreshold*float64(target*(current-1)) {
+		if a.belowSince.IsZero() {
+			a.belowSince = now
+		}
+		if now.Sub(a.belowSince) >= a.policy.ScaleDownDelay {
+			a.belowSince = time.Time{}
+			desired := a.policy.Bound(ceilDiv(connections, max(1, int(ScaleDownThreshold*float64(target)))))
+			if desired >= current {
+				desired = current - 1
+			}
+			return Decision{
+				Routers: desired,
+				Changed: true,
+				Reason:  fmt.Sprintf("Scaled down from %d to %d routers: %d active connections below threshold for %s", current, desired, connections, a.policy.ScaleDownDelay),
+			}
+		}
+		return Decision{
+			Routers: current,
+			Reason:  fmt.Sprintf("%d active connections across %d routers; waiting to scale down", connections, current),
+		}
+	}
+	a.belowSince = time.Time{}
+	return Decision{
+		Routers: current,
+		Reason:  fmt.Sprintf("%d active connections within target for %d routers", connections, current),
+	}
+}
+
+func ceilDiv(a int, b int) int {
+	if a <= 0 {
+		return 0
+	}
+	return (a + b - 1) / b
+}
+
+// ActiveConnections returns the total number of tcp connections active
+// across the routers in the supplied pods.
+func ActiveConnections(pods []*corev1.Pod, agents AgentFactory) (int, error) {
+	if len(pods) == 0 {
+		return 0, errors.New("No router pods are ready")
+	}
+	total := 0
+	var errs []error
+	for _, pod := range pods {
+		count, err := activeConnections(pod, agents)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Could not retrieve connections from %s: %s", pod.Name, err))
+			continue
+		}
+		total += count
+	}
+	return total, errors.Join(errs...)
+}
+
+func activeConnections(pod *corev1.Pod, agents AgentFactory) (int, error) {
+	agent, err := agents(pod)
+	if err != nil {
+		return 0, err
+	}
+	defer agent.Close()
+	connections, err := agent.GetLocalTcpConnections()
+	if err != nil {
+		return 0, err
+	}
+	return len(connections), nil
+}
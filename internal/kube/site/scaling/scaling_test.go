@@ -0,0 +1,221 @@
+package scaling
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/skupperproject/skupper/internal/qdr"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+func TestPolicyFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     skupperv2alpha1.SiteSpec
+		expected Policy
+		enabled  bool
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name: "defaults",
+			spec: skupperv2alpha1.SiteSpec{
+				Autoscaling: &skupperv2alpha1.RouterAutoscaling{MaxRouters: 3},
+			},
+			expected: Policy{MinRouters: 1, MaxRouters: 3, TargetConnections: DefaultTargetConnections, ScaleDownDelay: DefaultScaleDownDelay},
+			enabled:  true,
+		},
+		{
+			name: "ha minimum",
+			spec: skupperv2alpha1.SiteSpec{
+				HA:          true,
+				Autoscaling: &skupperv2alpha1.RouterAutoscaling{MaxRouters: 4},
+			},
+			expected: Policy{MinRouters: 2, MaxRouters: 4, TargetConnections: DefaultTargetConnections, ScaleDownDelay: DefaultScaleDownDelay},
+			enabled:  true,
+		},
+		{
+			name: "max below min",
+			spec: skupperv2alpha1.SiteSpec{
+				Autoscaling: &skupperv2alpha1.RouterAutoscaling{MinRouters: 3, MaxRouters: 2, TargetConnections: 10, ScaleDownDelaySeconds: 60},
+			},
+			expected: Policy{MinRouters: 3, MaxRouters: 3, TargetConnections: 10, ScaleDownDelay: time.Minute},
+			enabled:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, enabled := PolicyFor(&skupperv2alpha1.Site{Spec: tt.spec})
+			assert.Equal(t, enabled, tt.enabled)
+			assert.Equal(t, policy, tt.expected)
+		})
+	}
+}
+
+func TestAutoscalerDecide(t *testing.T) {
+	policy := Policy{MinRouters: 1, MaxRouters: 4, TargetConnections: 100, ScaleDownDelay: 5 * time.Minute}
+	t0 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	type sample struct {
+		current     int
+		connections int
+		at          time.Duration
+		routers     int
+		changed     bool
+	}
+	tests := []struct {
+		name    string
+		samples []sample
+	}{
+		{
+			name: "scale up immediately",
+			samples: []sample{
+				{current: 1, connections: 90, routers: 1},
+				{current: 1, connections: 250, at: time.Second, routers: 3, changed: true},
+			},
+		},
+		{
+			name: "scale up bounded by max",
+			samples: []sample{
+				{current: 2, connections: 1000, routers: 4, changed: true},
+			},
+		},
+		{
+			name: "below min",
+			samples: []sample{
+				{current: 0, connections: 0, routers: 1, changed: true},
+			},
+		},
+		{
+			name: "scale down after delay",
+			samples: []sample{
+				{current: 3, connections: 50, routers: 3},
+				{current: 3, connections: 50, at: 4 * time.Minute, routers: 3},
+				{current: 3, connections: 50, at: 5 * time.Minute, routers: 1, changed: true},
+			},
+		},
+		{
+			name: "scale down delay reset by load",
+			samples: []sample{
+				{current: 3, connections: 50, routers: 3},
+				{current: 3, connections: 190, at: 3 * time.Minute, routers: 3},
+				{current: 3, connections: 50, at: 6 * time.Minute, routers: 3},
+				{current: 3, connections: 50, at: 11 * time.Minute, routers: 1, changed: true},
+			},
+		},
+		{
+			name: "no scale down within hysteresis band",
+			samples: []sample{
+				// 170 connections could be served by 2 routers at the
+				// target, but is above the scale down threshold
+				{current: 3, connections: 170, routers: 3},
+				{current: 3, connections: 170, at: time.Hour, routers: 3},
+			},
+		},
+		{
+			name: "no scale down below min",
+			samples: []sample{
+				{current: 1, connections: 0, routers: 1},
+				{current: 1, connections: 0, at: time.Hour, routers: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scaler := NewAutoscaler(policy)
+			for i, s := range tt.samples {
+				decision := scaler.Decide(s.current, s.connections, t0.Add(s.at))
+				assert.Equal(t, decision.Routers, s.routers, "sample %d: %s", i, decision.Reason)
+				assert.Equal(t, decision.Changed, s.changed, "sample %d: %s", i, decision.Reason)
+				assert.Assert(t, decision.Reason != "")
+			}
+		})
+	}
+}
+
+type mockAgent struct {
+	connections int
+	err         error
+	closed      bool
+}
+
+func (a *mockAgent) GetLocalTcpConnections() ([]qdr.TcpConnection, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+	return make([]qdr.TcpConnection, a.connections), nil
+}
+
+func (a *mockAgent) Close() error {
+	a.closed = true
+	return nil
+}
+
+func TestActiveConnections(t *testing.T) {
+	pod := func(name string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+	tests := []struct {
+		name     string
+		pods     []*corev1.Pod
+		agents   map[string]*mockAgent
+		expected int
+		err      string
+	}{
+		{
+			name: "no pods",
+			err:  "No router pods are ready",
+		},
+		{
+			name: "sums pods",
+			pods: []*corev1.Pod{pod("a"), pod("b")},
+			agents: map[string]*mockAgent{
+				"a": {connections: 3},
+				"b": {connections: 4},
+			},
+			expected: 7,
+		},
+		{
+			name: "query error",
+			pods: []*corev1.Pod{pod("a"), pod("b")},
+			agents: map[string]*mockAgent{
+				"a": {connections: 3},
+				"b": {err: errors.New("timeout")},
+			},
+			expected: 3,
+			err:      "Could not retrieve connections from b: timeout",
+		},
+		{
+			name:     "connect error",
+			pods:     []*corev1.Pod{pod("a")},
+			agents:   map[string]*mockAgent{},
+			expected: 0,
+			err:      "Could not retrieve connections from a: no agent",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := func(pod *corev1.Pod) (Agent, error) {
+				if agent, ok := tt.agents[pod.Name]; ok {
+					return agent, nil
+				}
+				return nil, errors.New("no agent")
+			}
+			connections, err := ActiveConnections(tt.pods, factory)
+			if tt.err != "" {
+				assert.Error(t, err, tt.err)
+			} else {
+				assert.Assert(t, err)
+			}
+			assert.Equal(t, connections, tt.expected)
+			for name, agent := range tt.agents {
+				assert.Assert(t, agent.closed, "agent for %s not closed", name)
+			}
+		})
+	}
+}
@@ -5,23 +5,31 @@ import (
 	stderrors "errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	internalnetwork "github.com/skupperproject/skupper/internal/network"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/skupperproject/skupper/api/types"
+	"github.com/skupperproject/skupper/internal/flow"
 	"github.com/skupperproject/skupper/internal/kube/certificates"
 	internalclient "github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/kube/events"
+	"github.com/skupperproject/skupper/internal/kube/metrics"
 	kubeqdr "github.com/skupperproject/skupper/internal/kube/qdr"
 	"github.com/skupperproject/skupper/internal/kube/secrets"
 	"github.com/skupperproject/skupper/internal/kube/site/resources"
+	"github.com/skupperproject/skupper/internal/kube/site/scaling"
 	"github.com/skupperproject/skupper/internal/kube/site/sizing"
 	"github.com/skupperproject/skupper/internal/kube/watchers"
 	"github.com/skupperproject/skupper/internal/qdr"
@@ -49,6 +57,7 @@ type Site struct {
 	clients       *watchers.EventProcessor
 	bindings      *ExtendedBindings
 	links         map[string]*site.Link
+	linkCosts     site.LinkCostPolicy
 	errors        map[string]string
 	linkAccess    site.RouterAccessMap
 	certs         certificates.CertificateManager
@@ -59,6 +68,7 @@ type Site struct {
 	currentGroups []string
 	labelling     Labelling
 	profiles      *secrets.ProfilesWatcher
+	autoscaling   routerAutoscaling
 }
 
 func NewSite(namespace string, eventProcessor *watchers.EventProcessor, certs certificates.CertificateManager, access SecuredAccessFactory, sizes *sizing.Registry, labelling Labelling) *Site {
@@ -77,6 +87,9 @@ func NewSite(namespace string, eventProcessor *watchers.EventProcessor, certs ce
 			slog.String("component", "kube.site.site"),
 		),
 		labelling: labelling,
+		autoscaling: routerAutoscaling{
+			agents: scaling.NewAgentFactory(eventProcessor.GetKubeClient(), namespace),
+		},
 	}
 	site.profiles = secrets.NewProfilesWatcher(
 		sslSecretsWatcher(namespace, eventProcessor),
@@ -88,6 +101,8 @@ func NewSite(namespace string, eventProcessor *watchers.EventProcessor, certs ce
 			slog.String("component", "kube.site.secrets"),
 			slog.String("namespace", namespace)),
 	)
+	site.bindings.SetHealthMonitor(site.newHealthMonitor())
+	site.bindings.SetTargetResolver(site.newTargetResolver())
 	return site
 }
 
@@ -102,10 +117,15 @@ func (s *Site) NameMatches(name string) bool {
 	return s.name == name
 }
 
-func (s *Site) verifySiteSpec(site *skupperv2alpha1.Site) error {
-	if site.Spec.LinkAccess != "" && site.Spec.LinkAccess != "none" && site.Spec.LinkAccess != "default" && !s.access.IsValidAccessType(site.Spec.LinkAccess) {
-		return fmt.Errorf("Unsupported value for LinkAccess: %s", site.Spec.LinkAccess)
+func (s *Site) verifySiteSpec(siteDef *skupperv2alpha1.Site) error {
+	if siteDef.Spec.LinkAccess != "" && siteDef.Spec.LinkAccess != "none" && siteDef.Spec.LinkAccess != "default" && !s.access.IsValidAccessType(siteDef.Spec.LinkAccess) {
+		return fmt.Errorf("Unsupported value for LinkAccess: %s", siteDef.Spec.LinkAccess)
 	}
+	policy, err := site.GetLinkCostPolicy(&siteDef.Spec)
+	if err != nil {
+		return err
+	}
+	s.linkCosts = policy
 	return nil
 }
 
@@ -130,6 +150,7 @@ const SSL_PROFILE_PATH = "/etc/skupper-router-certs"
 
 func (s *Site) Reconcile(siteDef *skupperv2alpha1.Site) error {
 	err := s.reconcile(siteDef, false)
+	metrics.SiteReconciled(err)
 	return s.updateConfigured(err)
 }
 
@@ -146,6 +167,9 @@ func (s *Site) reconcile(siteDef *skupperv2alpha1.Site, inRecovery bool) error {
 	if err := s.verifySiteSpec(siteDef); err != nil {
 		return err
 	}
+	if err := s.checkAutoscaling(); err != nil {
+		return err
+	}
 	// ensure necessary resources:
 	// 1. skupper-internal configmap
 	if !s.initialised {
@@ -166,9 +190,10 @@ func (s *Site) reconcile(siteDef *skupperv2alpha1.Site, inRecovery bool) error {
 		s.bindings.init(s, routerConfig)
 		s.bindings.SetSite(s)
 		s.setBindingsConfiguredStatus(nil)
+		s.updateBalancingStatus()
 		s.checkSecuredAccess()
 	} else if len(s.currentGroups) != len(s.groups()) {
-		s.logger.Info("EnableHA setting changed for site",
+		s.logger.Info("Router groups changed for site",
 			slog.String("namespace", siteDef.Namespace),
 			slog.String("name", siteDef.Name),
 			slog.String("latest", strings.Join(s.groups(), ",")),
@@ -209,6 +234,12 @@ func (s *Site) reconcile(siteDef *skupperv2alpha1.Site, inRecovery bool) error {
 	if err := s.certs.Ensure(s.namespace, "skupper-local-server", "skupper-local-ca", "skupper-router-local", s.qualified("skupper-router-local"), false, true, s.ownerReferences()); err != nil {
 		return err
 	}
+	if s.autoscaling.scaler != nil {
+		// client credentials for observing router load
+		if err := s.certs.Ensure(s.namespace, scaling.ClientCredentials, "skupper-local-ca", scaling.ClientCredentials, nil, true, false, s.ownerReferences()); err != nil {
+			return err
+		}
+	}
 	// RouterAccess for router
 	if err := s.checkDefaultRouterAccess(ctxt, siteDef); err != nil {
 		return err
@@ -265,6 +296,13 @@ func (s *Site) initialRouterConfig() *qdr.RouterConfig {
 }
 
 func (s *Site) groups() []string {
+	if routers := s.autoscaledRouters(); routers > 0 {
+		groups := []string{"skupper-router"}
+		for i := 2; i <= routers; i++ {
+			groups = append(groups, fmt.Sprintf("skupper-router-%d", i))
+		}
+		return groups
+	}
 	if s.site.Spec.HA {
 		return []string{"skupper-router", "skupper-router-2"}
 	} else {
@@ -825,22 +863,38 @@ func (s *Site) updateConnectorStatus(connector *skupperv2alpha1.Connector) error
 
 func (s *Site) updateConnectorConfiguredStatus(connector *skupperv2alpha1.Connector, err error) error {
 	if connector.SetConfigured(err) {
+		if err != nil {
+			s.clients.GetEventRecorder().Eventf(connector, corev1.EventTypeWarning, events.ConnectorConfigurationFailed,
+				"Connector could not be configured: %s", err)
+		}
 		return s.updateConnectorStatus(connector)
 	}
 	return nil
 }
 
+// errNoPodsSelected is the configuration error of a connector whose
+// selector matches no pods.
+var errNoPodsSelected = stderrors.New("No pods match selector")
+
 func (s *Site) updateConnectorConfiguredStatusWithSelectedPods(connector *skupperv2alpha1.Connector, selected []skupperv2alpha1.PodDetails) error {
 	var err error
 	if len(selected) == 0 {
 		s.logger.Error("No pods selected for connector",
 			slog.String("namespace", connector.Namespace),
 			slog.String("name", connector.Name))
-		err = fmt.Errorf("No pods match selector")
-	} else {
-
+		err = errNoPodsSelected
 	}
+	previous := meta.FindStatusCondition(connector.Status.Conditions, skupperv2alpha1.CONDITION_TYPE_CONFIGURED)
+	noPodsSelectedBefore := previous != nil && previous.Status == metav1.ConditionFalse && previous.Message == errNoPodsSelected.Error()
 	if connector.SetConfigured(err) || connector.SetSelectedPods(selected) {
+		switch {
+		case err != nil && !noPodsSelectedBefore:
+			s.clients.GetEventRecorder().Eventf(connector, corev1.EventTypeWarning, events.ConnectorNoPodsSelected,
+				"Selector %q matches no pods", connector.Spec.Selector)
+		case err == nil && noPodsSelectedBefore:
+			s.clients.GetEventRecorder().Eventf(connector, corev1.EventTypeNormal, events.ConnectorPodsSelected,
+				"Selector %q matches %d pods", connector.Spec.Selector, len(selected))
+		}
 		return s.updateConnectorStatus(connector)
 	}
 	return nil
@@ -854,6 +908,9 @@ func (s *Site) CheckConnector(name string, connector *skupperv2alpha1.Connector)
 		}
 		return s.updateConnectorConfiguredStatus(connector, stderrors.New("No active site in namespace"))
 	}
+	defer s.updateBalancingStatus()
+	defer s.updateTargetHealthStatus()
+	defer s.updateResolvedTargetsStatus()
 	if update == nil {
 		return nil
 	}
@@ -861,11 +918,18 @@ func (s *Site) CheckConnector(name string, connector *skupperv2alpha1.Connector)
 	if connector == nil {
 		return err
 	}
+	if err == nil && site.HasMultipleTargets(connector) {
+		err = site.ValidateTargets(connector)
+	}
 	return s.updateConnectorConfiguredStatus(connector, err)
 }
 
 func (s *Site) updateListenerStatus(listener *skupperv2alpha1.Listener, err error) error {
 	if listener.SetConfigured(err) {
+		if err != nil {
+			s.clients.GetEventRecorder().Eventf(listener, corev1.EventTypeWarning, events.ListenerConfigurationFailed,
+				"Listener could not be configured: %s", err)
+		}
 		updated, err := s.clients.GetSkupperClient().SkupperV2alpha1().Listeners(listener.ObjectMeta.Namespace).UpdateStatus(context.TODO(), listener, metav1.UpdateOptions{})
 		if err == nil {
 			return err
@@ -913,6 +977,7 @@ func (s *Site) CheckListener(name string, listener *skupperv2alpha1.Listener) er
 		}
 		return s.updateListenerStatus(listener, stderrors.New("No active site in namespace"))
 	}
+	defer s.updateBalancingStatus()
 	if update == nil {
 		return nil
 	}
@@ -955,6 +1020,52 @@ func (s *Site) setBindingsConfiguredStatus(err error) {
 	s.bindings.Map(cf, lf)
 }
 
+// updateBalancingStatus reports the effective balancing policy for
+// any listener or connector that requested one. As that policy
+// depends on all the bindings for the same routing key, a change to
+// any one of them may alter the status of the others.
+func (s *Site) updateBalancingStatus() {
+	lf := func(listener *skupperv2alpha1.Listener) *skupperv2alpha1.Listener {
+		effective := ""
+		state := skupperv2alpha1.ReadyCondition()
+		if listener.BalancingRequested() {
+			effective, state = s.bindings.ListenerBalancing(listener)
+		}
+		if listener.SetBalancing(effective, state) {
+			updated, err := s.clients.GetSkupperClient().SkupperV2alpha1().Listeners(listener.ObjectMeta.Namespace).UpdateStatus(context.TODO(), listener, metav1.UpdateOptions{})
+			if err == nil {
+				return updated
+			} else {
+				s.logger.Error("Could not update listener status",
+					slog.String("namespace", listener.ObjectMeta.Namespace),
+					slog.String("listener", listener.ObjectMeta.Name),
+					slog.Any("error", err))
+			}
+		}
+		return nil
+	}
+	cf := func(connector *skupperv2alpha1.Connector) *skupperv2alpha1.Connector {
+		effective := ""
+		state := skupperv2alpha1.ReadyCondition()
+		if connector.BalancingRequested() {
+			effective, state = s.bindings.ConnectorBalancing(connector)
+		}
+		if connector.SetBalancing(effective, state) {
+			updated, err := s.clients.GetSkupperClient().SkupperV2alpha1().Connectors(connector.ObjectMeta.Namespace).UpdateStatus(context.TODO(), connector, metav1.UpdateOptions{})
+			if err == nil {
+				return updated
+			} else {
+				s.logger.Error("Could not update connector status",
+					slog.String("namespace", connector.ObjectMeta.Namespace),
+					slog.String("connector", connector.ObjectMeta.Name),
+					slog.Any("error", err))
+			}
+		}
+		return nil
+	}
+	s.bindings.Map(cf, lf)
+}
+
 func (s *Site) newLink(linkconfig *skupperv2alpha1.Link) *site.Link {
 	config := site.NewLink(linkconfig.ObjectMeta.Name, SSL_PROFILE_PATH)
 	config.Update(linkconfig)
@@ -1019,6 +1130,10 @@ func (s *Site) updateLinkConfiguredCondition(link *skupperv2alpha1.Link, err err
 		return nil
 	}
 	if link.SetConfigured(err) {
+		if err != nil {
+			s.clients.GetEventRecorder().Eventf(link, corev1.EventTypeWarning, events.LinkConfigurationFailed,
+				"Link could not be configured: %s", err)
+		}
 		return s.updateLinkStatus(link)
 	}
 	return nil
@@ -1037,9 +1152,24 @@ func (s *Site) Deleted() {
 	s.logger.Info("Deleting site",
 		slog.String("namespace", s.namespace),
 		slog.String("name", s.name))
-	s.bindings.cleanup()
+	s.stop()
 	s.setBindingsConfiguredStatus(stderrors.New("No active site"))
+}
+
+// Released stops the watchers and handlers of a site whose namespace
+// is now managed by another controller instance. Unlike Deleted, it
+// leaves the status of the site and its bindings to the new owner.
+func (s *Site) Released() {
+	s.logger.Info("Releasing site",
+		slog.String("namespace", s.namespace),
+		slog.String("name", s.name))
+	s.stop()
+}
+
+func (s *Site) stop() {
+	s.bindings.cleanup()
 	s.profiles.Stop()
+	s.autoscaling.scaler = nil
 }
 
 func (s *Site) setDefaultIssuerInStatus() bool {
@@ -1063,6 +1193,8 @@ func (s *Site) updateConfigured(err error) error {
 				slog.String("name", s.site.Name),
 				slog.String("id", s.site.GetSiteId()),
 				slog.Any("error", err))
+			s.clients.GetEventRecorder().Eventf(s.site, corev1.EventTypeWarning, events.SiteConfigurationFailed,
+				"Site could not be configured: %s", err)
 		}
 	}
 	if changed {
@@ -1088,7 +1220,17 @@ func (s *Site) updateSiteStatus() error {
 }
 
 func (s *Site) updateLinkOperationalCondition(link *skupperv2alpha1.Link, operational bool, remoteSiteId string, remoteSiteName string) error {
+	wasOperational := meta.IsStatusConditionTrue(link.Status.Conditions, skupperv2alpha1.CONDITION_TYPE_OPERATIONAL)
+	previousRemoteSiteName := link.Status.RemoteSiteName
 	if link.SetOperational(operational, remoteSiteId, remoteSiteName) {
+		switch {
+		case operational && !wasOperational:
+			s.clients.GetEventRecorder().Eventf(link, corev1.EventTypeNormal, events.LinkOperational,
+				"Link to site %s is operational", remoteSiteName)
+		case !operational && wasOperational:
+			s.clients.GetEventRecorder().Eventf(link, corev1.EventTypeWarning, events.LinkNotOperational,
+				"Link to site %s is no longer operational", previousRemoteSiteName)
+		}
 		return s.updateLinkStatus(link)
 	}
 	return nil
@@ -1132,13 +1274,61 @@ func (s *Site) NetworkStatusUpdated(network []skupperv2alpha1.SiteRecord) error
 		}
 	}
 
-	bindingStatus := newBindingStatus(s.clients, network)
+	bindingStatus := newBindingStatus(s.clients, s.clients.GetEventRecorder(), network)
 	s.bindings.Map(bindingStatus.updateMatchingListenerCount, bindingStatus.updateMatchingConnectorCount)
 	s.logger.Debug("Updating matching listeners for attached connectors")
 	s.bindings.MapOverAttachedConnectors(bindingStatus.updateMatchingListenerCountForAttachedConnector)
 	return bindingStatus.error()
 }
 
+// UpdateLinkCosts adjusts the cost of each link from the latest probes
+// of the site it connects to, if the site is configured for that.
+func (s *Site) UpdateLinkCosts(status *internalnetwork.NetworkStatusInfo) error {
+	if !s.initialised || s.site == nil {
+		return nil
+	}
+	probes := internalnetwork.GetProbesForSite(s.site.GetSiteId(), status)
+	now := time.Now()
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(s.links)) {
+		link := s.links[name]
+		definition := link.Definition()
+		if definition == nil {
+			continue
+		}
+		var changed bool
+		if !s.linkCosts.Enabled {
+			changed = link.ResetCost()
+		} else if probe, ok := probes[definition.Status.RemoteSiteId]; ok {
+			var err error
+			latency := time.Duration(probe.Latency) * time.Microsecond
+			changed, err = link.AdjustCost(s.linkCosts, probe.Round, latency, probe.Status == flow.ProbeResultOK, now)
+			if err != nil {
+				errs = append(errs, s.updateLinkConfiguredCondition(definition, err))
+				continue
+			}
+		}
+		if changed {
+			s.logger.Info("Adjusting link cost",
+				slog.String("namespace", s.namespace),
+				slog.String("link", name),
+				slog.Int("cost", link.Cost()))
+			if err := s.updateRouterConfig(link); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+		effective := 0
+		if s.linkCosts.Enabled {
+			effective = link.Cost()
+		}
+		if definition.SetEffectiveCost(effective) {
+			errs = append(errs, s.updateLinkStatus(definition))
+		}
+	}
+	return stderrors.Join(errs...)
+}
+
 func (s *Site) markSiteInactive(site *skupperv2alpha1.Site, err error) error {
 	if site.SetConfigured(err) {
 		s.logger.Info("Site marked inactive",
@@ -2,12 +2,17 @@ package site
 
 import (
 	"context"
+	stderrors "errors"
 	"testing"
+	"time"
 
 	"github.com/skupperproject/skupper/internal/kube/certificates"
 	fakeclient "github.com/skupperproject/skupper/internal/kube/client/fake"
 	"github.com/skupperproject/skupper/internal/kube/securedaccess"
+	"github.com/skupperproject/skupper/internal/kube/site/scaling"
+	"github.com/skupperproject/skupper/internal/kube/site/sizing"
 	"github.com/skupperproject/skupper/internal/kube/watchers"
+	internalnetwork "github.com/skupperproject/skupper/internal/network"
 	"github.com/skupperproject/skupper/internal/qdr"
 	site1 "github.com/skupperproject/skupper/internal/site"
 	"github.com/skupperproject/skupper/internal/version"
@@ -15,9 +20,13 @@ import (
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	"gotest.tools/v3/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"log/slog"
 )
 
@@ -881,6 +890,105 @@ func Test_NetworkStatusUpdate(t *testing.T) {
 	}
 }
 
+func Test_UpdateLinkCosts(t *testing.T) {
+	link := func(name string, settings map[string]string) *skupperv2alpha1.Link {
+		return &skupperv2alpha1.Link{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      name,
+				Namespace: "test",
+			},
+			Spec: skupperv2alpha1.LinkSpec{
+				Cost:     1,
+				Settings: settings,
+				Endpoints: []skupperv2alpha1.Endpoint{
+					{
+						Name: string(qdr.RoleInterRouter),
+						Host: "10.10.10.1",
+						Port: "55671",
+					},
+				},
+			},
+			Status: skupperv2alpha1.LinkStatus{
+				RemoteSiteId: "remote-site",
+			},
+		}
+	}
+	status := &internalnetwork.NetworkStatusInfo{
+		SiteStatus: []internalnetwork.SiteStatusInfo{
+			{
+				Site: internalnetwork.SiteInfo{
+					Identity: "8a96ffdf-403b-4e4a-83a8-97d3d459adb6",
+				},
+				Probes: []internalnetwork.ProbeInfo{
+					{
+						Peer:    "remote-site",
+						Status:  "ok",
+						Latency: 125000,
+						Round:   1,
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		settings     map[string]string
+		linkSettings map[string]string
+		expectedCost int32
+		expectedLink int
+	}{
+		{
+			name:         "adjustment disabled",
+			expectedCost: 1,
+		},
+		{
+			name: "adjustment enabled",
+			settings: map[string]string{
+				"link-cost-adjustment": "latency",
+			},
+			expectedCost: 51,
+			expectedLink: 51,
+		},
+		{
+			name: "link pinned",
+			settings: map[string]string{
+				"link-cost-adjustment": "latency",
+			},
+			linkSettings: map[string]string{
+				"cost-pinned": "true",
+			},
+			expectedCost: 1,
+			expectedLink: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			definition := link("link1", tt.linkSettings)
+			s, err := newSiteMocks("test", nil, []runtime.Object{definition}, "", false)
+			assert.Assert(t, err)
+			s.site.Spec.Settings = tt.settings
+			assert.Assert(t, s.verifySiteSpec(s.site))
+			s.initialised = true
+			assert.Assert(t, createRouterConfigMock(s))
+			assert.Assert(t, s.CheckLink(definition.Name, definition))
+
+			assert.Assert(t, s.UpdateLinkCosts(status))
+			// repeated network status updates within the same probing
+			// round do not feed the same sample in again
+			assert.Assert(t, s.UpdateLinkCosts(status))
+
+			cm, err := s.clients.GetKubeClient().CoreV1().ConfigMaps("test").Get(context.Background(), "skupper-router", metav1.GetOptions{})
+			assert.Assert(t, err)
+			config, err := qdr.GetRouterConfigFromConfigMap(cm)
+			assert.Assert(t, err)
+			assert.Equal(t, config.Connectors["link1"].Cost, tt.expectedCost)
+			updated, err := s.clients.GetSkupperClient().SkupperV2alpha1().Links("test").Get(context.Background(), "link1", metav1.GetOptions{})
+			assert.Assert(t, err)
+			assert.Equal(t, updated.Status.EffectiveCost, tt.expectedLink)
+		})
+	}
+}
+
 func Test_CheckSecuredAccess(t *testing.T) {
 	type args struct {
 		sa *skupperv2alpha1.SecuredAccess
@@ -1073,3 +1181,79 @@ func createRouterConfigMock(s *Site) error {
 	}
 	return nil
 }
+
+func TestSite_Autoscaling(t *testing.T) {
+	s, err := newSiteMocks("test", nil, nil, "", false)
+	assert.Assert(t, err)
+	s.sizes = sizing.NewRegistry()
+	// the fake dynamic client does not support server side apply
+	s.clients.GetDynamicClient().(*dynamicfake.FakeDynamicClient).PrependReactor("patch", "*", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &unstructured.Unstructured{}, nil
+	})
+	s.site.Spec.Autoscaling = &skupperv2alpha1.RouterAutoscaling{
+		MaxRouters:            3,
+		TargetConnections:     100,
+		ScaleDownDelaySeconds: 60,
+	}
+	assert.Assert(t, s.Reconcile(s.site))
+	assert.DeepEqual(t, s.groups(), []string{"skupper-router"})
+	assert.Assert(t, s.autoscaling.scheduled)
+
+	// load is sampled from the router in each ready pod
+	s.routerPods["test/skupper-router-abc"] = &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "skupper-router-abc", Namespace: "test"},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}
+	s.autoscaling.agents = func(pod *corev1.Pod) (scaling.Agent, error) {
+		return &mockRouterAgent{connections: 250}, nil
+	}
+	assert.Assert(t, s.autoscale(""))
+	assert.Assert(t, s.autoscaling.sampling)
+	s.clients.TestProcess()
+	assert.Assert(t, !s.autoscaling.sampling)
+	t0 := time.Now()
+	assert.DeepEqual(t, s.groups(), []string{"skupper-router", "skupper-router-2", "skupper-router-3"})
+	for _, group := range s.groups() {
+		_, err := s.clients.GetKubeClient().CoreV1().ConfigMaps("test").Get(context.TODO(), group, metav1.GetOptions{})
+		assert.Assert(t, err, group)
+	}
+	site, err := s.clients.GetSkupperClient().SkupperV2alpha1().Sites("test").Get(context.TODO(), "site1", metav1.GetOptions{})
+	assert.Assert(t, err)
+	assert.Assert(t, site.Status.Autoscaling != nil)
+	assert.Equal(t, site.Status.Autoscaling.Routers, 3)
+	assert.Equal(t, site.Status.Autoscaling.ActiveConnections, 250)
+	assert.Assert(t, site.Status.Autoscaling.LastScaleTime != nil)
+
+	// a failed sample records the error but does not scale
+	assert.Assert(t, s.applyLoadSample(loadSample{connections: 10, err: stderrors.New("timeout")}, t0.Add(time.Minute)))
+	assert.Equal(t, len(s.groups()), 3)
+	assert.Equal(t, s.site.Status.Autoscaling.Message, "Could not observe router load: timeout")
+
+	// scale down only once the load has stayed low for the delay
+	assert.Assert(t, s.applyLoadSample(loadSample{connections: 10}, t0.Add(2*time.Minute)))
+	assert.Equal(t, len(s.groups()), 3)
+	assert.Assert(t, s.applyLoadSample(loadSample{connections: 10}, t0.Add(3*time.Minute)))
+	assert.DeepEqual(t, s.groups(), []string{"skupper-router"})
+	_, err = s.clients.GetKubeClient().CoreV1().ConfigMaps("test").Get(context.TODO(), "skupper-router-3", metav1.GetOptions{})
+	assert.Assert(t, errors.IsNotFound(err))
+
+	// disabling autoscaling clears the status
+	s.site.Spec.Autoscaling = nil
+	assert.Assert(t, s.Reconcile(s.site))
+	assert.Assert(t, s.site.Status.Autoscaling == nil)
+}
+
+type mockRouterAgent struct {
+	connections int
+}
+
+func (a *mockRouterAgent) GetLocalTcpConnections() ([]qdr.TcpConnection, error) {
+	return make([]qdr.TcpConnection, a.connections), nil
+}
+
+func (a *mockRouterAgent) Close() error {
+	return nil
+}
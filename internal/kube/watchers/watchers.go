@@ -5,6 +5,9 @@ package watchers
 import (
 	"fmt"
 	"log"
+	"reflect"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -21,7 +24,9 @@ import (
 	"k8s.io/client-go/informers/internalinterfaces"
 	networkingv1informer "k8s.io/client-go/informers/networking/v1"
 	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
 	routev1 "github.com/openshift/api/route/v1"
@@ -31,6 +36,8 @@ import (
 	routev1informer "github.com/openshift/client-go/route/informers/externalversions/route/v1"
 
 	internalclient "github.com/skupperproject/skupper/internal/kube/client"
+	"github.com/skupperproject/skupper/internal/kube/events"
+	"github.com/skupperproject/skupper/internal/kube/metrics"
 	"github.com/skupperproject/skupper/internal/kube/resource"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	skupperclient "github.com/skupperproject/skupper/pkg/generated/client/clientset/versioned"
@@ -80,10 +87,14 @@ type EventProcessor struct {
 	queue           workqueue.RateLimitingInterface
 	resync          time.Duration
 	watchers        []Watcher
+	handlingSince   atomic.Int64
+	broadcaster     record.EventBroadcaster
+	recorder        record.EventRecorder
 }
 
 // Creates a properly initialised EventProcessor instance.
 func NewEventProcessor(name string, clients internalclient.Clients) *EventProcessor {
+	broadcaster := record.NewBroadcaster()
 	return &EventProcessor{
 		errorKey:        name + "Error",
 		client:          clients.GetKubeClient(),
@@ -93,6 +104,8 @@ func NewEventProcessor(name string, clients internalclient.Clients) *EventProces
 		skupperClient:   clients.GetSkupperClient(),
 		queue:           workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), name),
 		resync:          time.Minute * 5,
+		broadcaster:     broadcaster,
+		recorder:        events.NewRecorder(broadcaster),
 	}
 }
 
@@ -120,6 +133,14 @@ func (c *EventProcessor) HasTlsRoute() bool {
 	return resource.IsResourceAvailable(c.discoveryClient, resource.TlsRouteResource())
 }
 
+func (c *EventProcessor) HasServiceExport() bool {
+	return resource.IsResourceAvailable(c.discoveryClient, resource.ServiceExportResource())
+}
+
+func (c *EventProcessor) HasServiceImport() bool {
+	return resource.IsResourceAvailable(c.discoveryClient, resource.ServiceImportResource())
+}
+
 func (c *EventProcessor) GetRouteInterface() openshiftroute.Interface {
 	return c.routeClient
 }
@@ -135,8 +156,30 @@ func (c *EventProcessor) GetSkupperClient() skupperclient.Interface {
 	return c.skupperClient
 }
 
-// Starts the event processing loop in a new go routine.
+// GetEventRecorder returns the recorder through which the handlers of
+// the EventProcessor report transitions in the state of the resources
+// they manage as Kubernetes Events.
+func (c *EventProcessor) GetEventRecorder() record.EventRecorder {
+	return c.recorder
+}
+
+// SetEventRecorder replaces the recorder returned by GetEventRecorder,
+// for tests that check the events recorded.
+func (c *EventProcessor) SetEventRecorder(recorder record.EventRecorder) {
+	c.recorder = recorder
+}
+
+// Starts the event processing loop in a new go routine. Events recorded
+// through GetEventRecorder are written to the API server until stopCh is
+// closed.
 func (c *EventProcessor) Start(stopCh <-chan struct{}) {
+	if c.client != nil {
+		c.broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: c.client.CoreV1().Events("")})
+		go func() {
+			<-stopCh
+			c.broadcaster.Shutdown()
+		}()
+	}
 	go wait.Until(c.run, time.Second, stopCh)
 }
 
@@ -169,7 +212,11 @@ func (c *EventProcessor) process() bool {
 	retry := false
 	defer c.queue.Done(obj)
 	if evt, ok := obj.(ResourceChange); ok {
+		start := time.Now()
+		c.handlingSince.Store(start.UnixNano())
 		err := evt.Handler.Handle(evt)
+		c.handlingSince.Store(0)
+		metrics.EventProcessed(kindOf(evt.Handler), time.Since(start), err)
 		if err != nil {
 			retry = true
 			log.Printf("[%s] Error while handling %s: %s", c.errorKey, evt.Handler.Describe(evt), err)
@@ -187,6 +234,36 @@ func (c *EventProcessor) process() bool {
 	return true
 }
 
+// CheckProgress returns an error if the handling of a single event has
+// been in progress for longer than the supplied threshold, which
+// indicates that the event processing loop is stuck.
+func (c *EventProcessor) CheckProgress(threshold time.Duration) error {
+	since := c.handlingSince.Load()
+	if since == 0 {
+		return nil
+	}
+	if elapsed := time.Since(time.Unix(0, since)); elapsed > threshold {
+		return fmt.Errorf("Event handling has been in progress for %s", elapsed.Truncate(time.Second))
+	}
+	return nil
+}
+
+// kindOf returns the kind of resource the events for a handler relate
+// to, for use in metrics.
+func kindOf(handler ResourceChangeHandler) string {
+	switch h := handler.(type) {
+	case *DynamicWatcher:
+		return h.resource.Resource
+	case *CallbackHandler:
+		return "Callback"
+	}
+	t := reflect.TypeOf(handler)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return strings.TrimSuffix(t.Name(), "Watcher")
+}
+
 // Stops event processing.
 func (c *EventProcessor) Stop() {
 	c.queue.ShutDown()
@@ -582,6 +659,14 @@ func (c *EventProcessor) WatchTlsRoutes(options dynamicinformer.TweakListOptions
 	return c.WatchDynamic(resource.TlsRouteResource(), options, namespace, handler)
 }
 
+func (c *EventProcessor) WatchServiceExports(options dynamicinformer.TweakListOptionsFunc, namespace string, handler DynamicHandler) *DynamicWatcher {
+	if !c.HasServiceExport() {
+		log.Println("Cannot watch ServiceExports; resource not installed")
+		return nil
+	}
+	return c.WatchDynamic(resource.ServiceExportResource(), options, namespace, handler)
+}
+
 func (c *EventProcessor) WatchDynamic(resource schema.GroupVersionResource, options dynamicinformer.TweakListOptionsFunc, namespace string, handler DynamicHandler) *DynamicWatcher {
 	watcher := &DynamicWatcher{
 		handler: handler,
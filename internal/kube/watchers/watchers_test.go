@@ -14,6 +14,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	fakeclient "github.com/skupperproject/skupper/internal/kube/client/fake"
+	"github.com/skupperproject/skupper/internal/kube/resource"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/assert/cmp"
 )
@@ -376,6 +377,59 @@ func TestProcessRequeueLimit(t *testing.T) {
 	assert.Equal(t, stubHandler.CallCount, 6, "Should Requeue 5 times + 1 for the initial event")
 }
 
+func TestKindOf(t *testing.T) {
+	client, _ := fakeclient.NewFakeClient("test", nil, nil, "")
+	processor := NewEventProcessor("tester", client)
+	tests := []struct {
+		name     string
+		handler  ResourceChangeHandler
+		expected string
+	}{
+		{
+			name:     "typed",
+			handler:  &SiteWatcher{},
+			expected: "Site",
+		},
+		{
+			name:     "dynamic",
+			handler:  processor.WatchDynamic(resource.GatewayResource(), nil, "test", nil),
+			expected: "gateways",
+		},
+		{
+			name:     "callback",
+			handler:  &CallbackHandler{},
+			expected: "Callback",
+		},
+		{
+			name:     "other",
+			handler:  &stubErrResourceChangeHandler{},
+			expected: "stubErrResourceChangeHandler",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, kindOf(tt.handler), tt.expected)
+		})
+	}
+}
+
+func TestCheckProgress(t *testing.T) {
+	client, _ := fakeclient.NewFakeClient("test", nil, nil, "")
+	processor := NewEventProcessor("tester", client)
+	assert.Assert(t, processor.CheckProgress(time.Minute))
+	var progress []error
+	processor.CallbackAfter(0, func(string) error {
+		progress = append(progress, processor.CheckProgress(time.Minute))
+		progress = append(progress, processor.CheckProgress(0))
+		return nil
+	}, "")
+	processor.TestProcess()
+	assert.Equal(t, len(progress), 2)
+	assert.Assert(t, progress[0])
+	assert.ErrorContains(t, progress[1], "Event handling has been in progress for")
+	assert.Assert(t, processor.CheckProgress(0))
+}
+
 type callbackResult[T any] struct {
 	Key string
 	Obj *T
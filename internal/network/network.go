@@ -261,6 +261,24 @@ func GetLinkRecordsForSite(siteId string, network []v2alpha1.SiteRecord) []v2alp
 	return nil
 }
 
+// GetProbesForSite returns the latest probes from the identified site,
+// keyed by the id of the site probed.
+func GetProbesForSite(siteId string, status *NetworkStatusInfo) map[string]ProbeInfo {
+	probes := map[string]ProbeInfo{}
+	if status == nil {
+		return probes
+	}
+	for _, siteStatus := range status.SiteStatus {
+		if siteStatus.Site.Identity != siteId {
+			continue
+		}
+		for _, probe := range siteStatus.Probes {
+			probes[probe.Peer] = probe
+		}
+	}
+	return probes
+}
+
 func HasMatchingPair(networkStatus NetworkStatusInfo, address string) bool {
 	for _, addressInfo := range networkStatus.Addresses {
 		if addressInfo.Name == address {
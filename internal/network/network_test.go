@@ -208,3 +208,31 @@ func TestHasMatchingPair(t *testing.T) {
 		assert.Equal(t, scenario.expectedMatch, HasMatchingPair(networkStatus, scenario.address))
 	}
 }
+
+func TestGetProbesForSite(t *testing.T) {
+	status := &NetworkStatusInfo{
+		SiteStatus: []SiteStatusInfo{
+			{
+				Site: SiteInfo{Identity: "site-a"},
+				Probes: []ProbeInfo{
+					{Peer: "site-b", Status: "ok", Latency: 1500},
+					{Peer: "site-c", Status: "unreachable", DownCount: 1},
+				},
+			},
+			{
+				Site: SiteInfo{Identity: "site-b"},
+				Probes: []ProbeInfo{
+					{Peer: "site-a", Status: "ok", Latency: 1600},
+				},
+			},
+		},
+	}
+
+	probes := GetProbesForSite("site-a", status)
+	assert.DeepEqual(t, probes, map[string]ProbeInfo{
+		"site-b": {Peer: "site-b", Status: "ok", Latency: 1500},
+		"site-c": {Peer: "site-c", Status: "unreachable", DownCount: 1},
+	})
+	assert.Equal(t, len(GetProbesForSite("site-d", status)), 0)
+	assert.Equal(t, len(GetProbesForSite("site-a", nil)), 0)
+}
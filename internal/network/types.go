@@ -19,6 +19,7 @@ type AddressInfo struct {
 type SiteStatusInfo struct {
 	Site         SiteInfo           `json:"site"`
 	RouterStatus []RouterStatusInfo `json:"routerStatus"`
+	Probes       []ProbeInfo        `json:"probes,omitempty"`
 }
 
 type SiteInfo struct {
@@ -39,6 +40,17 @@ type RouterStatusInfo struct {
 	Connectors   []ConnectorInfo    `json:"connectors"`
 }
 
+// ProbeInfo holds the results of a site probing another site.
+type ProbeInfo struct {
+	Peer       string `json:"peer,omitempty"`
+	Status     string `json:"status,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	Latency    uint64 `json:"latency,omitempty"`    // round trip time in microseconds
+	Throughput uint64 `json:"throughput,omitempty"` // bytes per second
+	DownCount  uint64 `json:"downCount,omitempty"`
+	Round      uint64 `json:"round,omitempty"` // probing round of the latest result
+}
+
 type RouterInfo struct {
 	Name         string `json:"name,omitempty"`
 	Namespace    string `json:"namespace,omitempty"`
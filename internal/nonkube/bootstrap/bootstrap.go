@@ -24,6 +24,8 @@ type Config struct {
 	IsBundle       bool
 	Platform       types.Platform
 	Binary         string
+	Encryption     *internalbundle.Encryption
+	Parameters     internalbundle.Parameters
 }
 
 func PreBootstrap(config *Config) error {
@@ -123,9 +125,11 @@ func Bootstrap(config *Config) (*api.SiteState, error) {
 	var siteStateRenderer api.StaticSiteStateRenderer
 	if config.IsBundle {
 		siteStateRenderer = &internalbundle.SiteStateRenderer{
-			Strategy: internalbundle.BundleStrategy(config.BundleStrategy),
-			Platform: config.Platform,
-			FileName: config.BundleName,
+			Strategy:   internalbundle.BundleStrategy(config.BundleStrategy),
+			Platform:   config.Platform,
+			FileName:   config.BundleName,
+			Encryption: config.Encryption,
+			Parameters: config.Parameters,
 		}
 	} else if config.Platform == types.PlatformLinux {
 		siteStateRenderer = &linux.SiteStateRenderer{}
@@ -180,7 +184,18 @@ func PostBootstrap(config *Config, siteState *api.SiteState) {
 			installationFile = path.Join(siteHome, fmt.Sprintf("%s.tar.gz", config.BundleName))
 		}
 		fmt.Println("Installation bundle available at:", installationFile)
+		fmt.Println("Bundle signature available at:", installationFile+".sig")
 		fmt.Println("Default namespace:", siteState.GetNamespace())
 		fmt.Println("Default platform:", string(config.Platform))
+		fmt.Println("Signing key:", internalbundle.HostSigningPublicKeyFile())
+		if fingerprint, err := internalbundle.PublicKeyFingerprint(internalbundle.SigningKeyPath()); err == nil {
+			fmt.Println("Signing key fingerprint:", fingerprint)
+		}
+		if len(config.Parameters) > 0 {
+			fmt.Println("Parameters:", config.Parameters.String())
+		}
+		if config.Encryption.Enabled() {
+			fmt.Println("Private keys are encrypted, age is required to install the bundle")
+		}
 	}
 }
@@ -12,6 +12,8 @@ const (
 	scriptExit = "\nexit 0\n"
 	shellDelim = "\n__TARBALL_CONTENT__\n"
 	BundleEnv  = "SKUPPER_BUNDLE"
+
+	installScriptFile = "install.sh"
 )
 
 var (
@@ -23,7 +25,11 @@ var (
 )
 
 type BundleGenerator interface {
+	// AddInstallScript adds the installation script to the tarball and
+	// to its manifest, if the script is part of the tarball.
+	AddInstallScript(tarball *utils.Tarball, manifest Manifest, defaultPlatform string) error
 	Generate(tarball *utils.Tarball, defaultPlatform string) error
+	InstallFile() string
 }
 
 type BundleStrategy string
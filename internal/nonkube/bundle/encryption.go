@@ -0,0 +1,130 @@
+package bundle
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"filippo.io/age"
+	"github.com/skupperproject/skupper/internal/utils"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+// EncryptedSecretsFile holds the private material of an encrypted
+// bundle, as an age encrypted tarball that is extracted on top of the
+// bundle's content during installation.
+const EncryptedSecretsFile = "secrets.tar.age"
+
+// Encryption determines how the private material in a bundle (private
+// keys and the static links embedding client credentials) is
+// encrypted. The material is either encrypted to the age recipients
+// (X25519 public keys) or with a passphrase, never both.
+type Encryption struct {
+	Recipients []string
+	Passphrase string
+}
+
+func (e *Encryption) Enabled() bool {
+	return e != nil && (len(e.Recipients) > 0 || e.Passphrase != "")
+}
+
+func (e *Encryption) Validate() error {
+	if !e.Enabled() {
+		return nil
+	}
+	_, err := e.recipients()
+	return err
+}
+
+func (e *Encryption) recipients() ([]age.Recipient, error) {
+	if e.Passphrase != "" {
+		if len(e.Recipients) > 0 {
+			return nil, fmt.Errorf("a passphrase cannot be combined with recipients")
+		}
+		recipient, err := age.NewScryptRecipient(e.Passphrase)
+		if err != nil {
+			return nil, err
+		}
+		return []age.Recipient{recipient}, nil
+	}
+	var recipients []age.Recipient
+	for _, r := range e.Recipients {
+		recipient, err := age.ParseX25519Recipient(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid recipient %q: %w", r, err)
+		}
+		recipients = append(recipients, recipient)
+	}
+	return recipients, nil
+}
+
+// isPrivate returns true for the files, relative to the namespace
+// directory of a bundle, that contain private material.
+func isPrivate(name string) bool {
+	return path.Base(name) == "tls.key" || strings.HasPrefix(name, string(api.RuntimeTokenPath)+"/")
+}
+
+// Encrypt moves the private material below root/namespace into an
+// encrypted tarball, returning its content.
+func (e *Encryption) Encrypt(root string, namespace string) ([]byte, error) {
+	recipients, err := e.recipients()
+	if err != nil {
+		return nil, err
+	}
+	namespaceDir := path.Join(root, namespace)
+	secrets := utils.NewTarball()
+	var private []string
+	err = filepath.WalkDir(namespaceDir, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() {
+			return err
+		}
+		relative, err := filepath.Rel(namespaceDir, name)
+		if err != nil {
+			return err
+		}
+		if !isPrivate(filepath.ToSlash(relative)) {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		if err = secrets.AddFileData(path.Join(namespace, filepath.ToSlash(relative)), int64(info.Mode().Perm()), info.ModTime(), data); err != nil {
+			return err
+		}
+		private = append(private, name)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to collect private material: %w", err)
+	}
+	data, err := secrets.SaveData()
+	if err != nil {
+		return nil, err
+	}
+	encrypted := new(bytes.Buffer)
+	w, err := age.Encrypt(encrypted, recipients...)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(data); err != nil {
+		return nil, err
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	for _, name := range private {
+		if err = os.Remove(name); err != nil {
+			return nil, err
+		}
+	}
+	return encrypted.Bytes(), nil
+}
@@ -0,0 +1,113 @@
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path"
+	"testing"
+
+	"filippo.io/age"
+	"gotest.tools/v3/assert"
+)
+
+func TestEncryption(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	assert.Assert(t, err)
+	passphraseIdentity, err := age.NewScryptIdentity("secret")
+	assert.Assert(t, err)
+
+	tests := []struct {
+		name          string
+		encryption    *Encryption
+		identity      age.Identity
+		expectedError string
+	}{
+		{
+			name:       "recipient",
+			encryption: &Encryption{Recipients: []string{identity.Recipient().String()}},
+			identity:   identity,
+		},
+		{
+			name:       "passphrase",
+			encryption: &Encryption{Passphrase: "secret"},
+			identity:   passphraseIdentity,
+		},
+		{
+			name:          "passphrase-and-recipient",
+			encryption:    &Encryption{Passphrase: "secret", Recipients: []string{identity.Recipient().String()}},
+			expectedError: "a passphrase cannot be combined with recipients",
+		},
+		{
+			name:          "invalid-recipient",
+			encryption:    &Encryption{Recipients: []string{"age1invalid"}},
+			expectedError: `invalid recipient "age1invalid"`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := t.TempDir()
+			certs := path.Join(root, "west", "runtime", "certs", "skupper-site-server")
+			links := path.Join(root, "west", "runtime", "links")
+			assert.Assert(t, os.MkdirAll(certs, 0755))
+			assert.Assert(t, os.MkdirAll(links, 0755))
+			assert.Assert(t, os.WriteFile(path.Join(certs, "tls.crt"), []byte("crt"), 0644))
+			assert.Assert(t, os.WriteFile(path.Join(certs, "tls.key"), []byte("key"), 0600))
+			assert.Assert(t, os.WriteFile(path.Join(links, "link-west-127.0.0.1.yaml"), []byte("link"), 0644))
+
+			if test.expectedError != "" {
+				assert.ErrorContains(t, test.encryption.Validate(), test.expectedError)
+				_, err := test.encryption.Encrypt(root, "west")
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+			assert.Assert(t, test.encryption.Validate())
+			encrypted, err := test.encryption.Encrypt(root, "west")
+			assert.Assert(t, err)
+
+			// private material is removed from the bundle content
+			_, err = os.Stat(path.Join(certs, "tls.key"))
+			assert.Assert(t, os.IsNotExist(err))
+			_, err = os.Stat(path.Join(links, "link-west-127.0.0.1.yaml"))
+			assert.Assert(t, os.IsNotExist(err))
+			_, err = os.Stat(path.Join(certs, "tls.crt"))
+			assert.Assert(t, err)
+
+			r, err := age.Decrypt(bytes.NewReader(encrypted), test.identity)
+			assert.Assert(t, err)
+			decrypted, err := io.ReadAll(r)
+			assert.Assert(t, err)
+			assert.DeepEqual(t, readTarball(t, decrypted), map[string]string{
+				"west/runtime/certs/skupper-site-server/tls.key": "key",
+				"west/runtime/links/link-west-127.0.0.1.yaml":    "link",
+			})
+		})
+	}
+}
+
+func TestEncryptionEnabled(t *testing.T) {
+	var encryption *Encryption
+	assert.Assert(t, !encryption.Enabled())
+	assert.Assert(t, !(&Encryption{}).Enabled())
+	assert.Assert(t, (&Encryption{Passphrase: "secret"}).Enabled())
+}
+
+func readTarball(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	assert.Assert(t, err)
+	files := map[string]string{}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		assert.Assert(t, err)
+		content, err := io.ReadAll(tr)
+		assert.Assert(t, err)
+		files[header.Name] = string(content)
+	}
+}
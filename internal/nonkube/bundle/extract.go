@@ -28,6 +28,13 @@ func (s *SelfExtractingBundle) InstallFile() string {
 	}
 }
 
+// AddInstallScript does nothing, as the installation script precedes
+// the tarball, being covered by the detached signature of the bundle
+// instead of the manifest.
+func (s *SelfExtractingBundle) AddInstallScript(tarBall *utils.Tarball, manifest Manifest, defaultPlatform string) error {
+	return nil
+}
+
 func (s *SelfExtractingBundle) Generate(tarBall *utils.Tarball, defaultPlatform string) error {
 	var data = new(bytes.Buffer)
 	var err error
@@ -0,0 +1,361 @@
+package bundle
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/internal/qdr"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+const (
+	// ParametersFile holds the parameters declared by a bundle along
+	// with their default values.
+	ParametersFile = "parameters.json"
+	// BinaryFile is the skupper binary included in bundles that declare
+	// parameters, used to apply their values during installation.
+	BinaryFile = "skupper"
+)
+
+// Parameter is a value of the site definition that can be overridden
+// when a bundle is installed, so that a single bundle can be installed
+// on multiple hosts.
+type Parameter string
+
+const (
+	ParameterLinkAccessHost            Parameter = "link-access-host"
+	ParameterLinkAccessInterRouterPort Parameter = "link-access-inter-router-port"
+	ParameterLinkAccessEdgePort        Parameter = "link-access-edge-port"
+	ParameterListenerHost              Parameter = "listener-host"
+	ParameterRouterLogLevel            Parameter = "router-log-level"
+
+	routerLoggingSetting = "router-logging"
+	defaultRouterLogging = "info"
+)
+
+var SupportedParameters = []Parameter{
+	ParameterLinkAccessHost,
+	ParameterLinkAccessInterRouterPort,
+	ParameterLinkAccessEdgePort,
+	ParameterListenerHost,
+	ParameterRouterLogLevel,
+}
+
+// Parameters maps the parameters declared by a bundle to their
+// default values. An empty default means that the value from the
+// site definition is used.
+type Parameters map[Parameter]string
+
+// ParseParameters parses parameter declarations in the form
+// name[=default].
+func ParseParameters(declarations []string) (Parameters, error) {
+	parameters := Parameters{}
+	for _, declaration := range declarations {
+		name, value, _ := strings.Cut(declaration, "=")
+		parameter := Parameter(name)
+		if !slices.Contains(SupportedParameters, parameter) {
+			return nil, fmt.Errorf("unknown parameter %q (supported parameters: %s)", name, SupportedParameters)
+		}
+		if _, ok := parameters[parameter]; ok {
+			return nil, fmt.Errorf("parameter %q declared more than once", name)
+		}
+		parameters[parameter] = value
+	}
+	return parameters, nil
+}
+
+// Apply sets the default value of each parameter on the site state.
+// Parameters declared without a default get the value currently set
+// on the site state, so that every parameter has a default once
+// applied.
+func (p Parameters) Apply(siteState *api.SiteState) error {
+	for parameter, value := range p {
+		var err error
+		switch parameter {
+		case ParameterLinkAccessHost:
+			value, err = p.applyLinkAccessHost(siteState, value)
+		case ParameterLinkAccessInterRouterPort:
+			value, err = p.applyLinkAccessPort(siteState, "inter-router", value)
+		case ParameterLinkAccessEdgePort:
+			value, err = p.applyLinkAccessPort(siteState, "edge", value)
+		case ParameterListenerHost:
+			value, err = p.applyListenerHost(siteState, value)
+		case ParameterRouterLogLevel:
+			value, err = p.applyRouterLogLevel(siteState, value)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid parameter %q: %w", parameter, err)
+		}
+		p[parameter] = value
+	}
+	return nil
+}
+
+func (p Parameters) applyLinkAccessHost(siteState *api.SiteState, value string) (string, error) {
+	if len(siteState.RouterAccesses) == 0 {
+		return "", fmt.Errorf("no router access defined")
+	}
+	for _, name := range sortedKeys(siteState.RouterAccesses) {
+		routerAccess := siteState.RouterAccesses[name]
+		if value == "" {
+			value = routerAccess.Spec.BindHost
+		}
+		routerAccess.Spec.BindHost = value
+	}
+	if value == "" {
+		return "", fmt.Errorf("a default value is required as no bind host is defined")
+	}
+	return value, nil
+}
+
+func (p Parameters) applyLinkAccessPort(siteState *api.SiteState, role string, value string) (string, error) {
+	var port int
+	if value != "" {
+		var err error
+		if port, err = strconv.Atoi(value); err != nil || port < 1 || port > 65535 {
+			return "", fmt.Errorf("invalid port %q", value)
+		}
+	}
+	found := false
+	for _, name := range sortedKeys(siteState.RouterAccesses) {
+		routerAccess := siteState.RouterAccesses[name]
+		for i, r := range routerAccess.Spec.Roles {
+			if r.Name != role {
+				continue
+			}
+			if port == 0 {
+				port = r.Port
+			}
+			routerAccess.Spec.Roles[i].Port = port
+			found = true
+		}
+	}
+	if !found {
+		return "", fmt.Errorf("no router access defined with the %s role", role)
+	}
+	if port == 0 {
+		return "", fmt.Errorf("a default value is required as no %s port is defined", role)
+	}
+	return strconv.Itoa(port), nil
+}
+
+func (p Parameters) applyListenerHost(siteState *api.SiteState, value string) (string, error) {
+	if len(siteState.Listeners) == 0 {
+		return "", fmt.Errorf("no listener defined")
+	}
+	for _, name := range sortedKeys(siteState.Listeners) {
+		listener := siteState.Listeners[name]
+		if value == "" {
+			value = listener.Spec.Host
+		}
+		listener.Spec.Host = value
+	}
+	return value, nil
+}
+
+func (p Parameters) applyRouterLogLevel(siteState *api.SiteState, value string) (string, error) {
+	if value == "" {
+		value = defaultRouterLogging
+		if logging := siteState.Site.Spec.GetRouterLogging(); logging != "" && !strings.ContainsAny(logging, ",:") {
+			value = logging
+		}
+	}
+	if !slices.Contains(qdr.LoggingLevels, value) {
+		return "", fmt.Errorf("invalid router log level %q (valid levels: %s)", value, qdr.LoggingLevels)
+	}
+	if siteState.Site.Spec.Settings == nil {
+		siteState.Site.Spec.Settings = map[string]string{}
+	}
+	siteState.Site.Spec.Settings[routerLoggingSetting] = value
+	return value, nil
+}
+
+// String returns the parameters in the form name=default, sorted by
+// name.
+func (p Parameters) String() string {
+	var parameters []string
+	for _, parameter := range sortedKeys(p) {
+		parameters = append(parameters, fmt.Sprintf("%s=%s", parameter, p[parameter]))
+	}
+	return strings.Join(parameters, ", ")
+}
+
+// EnvName returns the environment variable through which a value can
+// be provided for the parameter during installation.
+func (p Parameter) EnvName() string {
+	return "SKUPPER_PARAM_" + strings.ToUpper(strings.ReplaceAll(string(p), "-", "_"))
+}
+
+// Resolve returns the values of the declared parameters, given in the
+// form name=value. Values given take precedence over those from the
+// environment, which take precedence over the defaults.
+func (p Parameters) Resolve(values []string) (Parameters, error) {
+	overrides := Parameters{}
+	for _, nameValue := range values {
+		name, value, ok := strings.Cut(nameValue, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid parameter %q (name=value expected)", nameValue)
+		}
+		if _, declared := p[Parameter(name)]; !declared {
+			return nil, fmt.Errorf("parameter %q is not declared by this bundle (declared parameters: %s)", name, sortedKeys(p))
+		}
+		overrides[Parameter(name)] = value
+	}
+	resolved := Parameters{}
+	for parameter, value := range p {
+		if env, ok := os.LookupEnv(parameter.EnvName()); ok {
+			value = env
+		}
+		if override, ok := overrides[parameter]; ok {
+			value = override
+		}
+		resolved[parameter] = value
+	}
+	return resolved, nil
+}
+
+// bundleParameters is the content of the ParametersFile.
+type bundleParameters struct {
+	Parameters Parameters `json:"parameters"`
+}
+
+// Write stores the parameters into the given namespace directory of a
+// bundle.
+func (p Parameters) Write(namespaceDir string) error {
+	data, err := json.MarshalIndent(bundleParameters{
+		Parameters: p,
+	}, "", "    ")
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(path.Join(namespaceDir, string(api.InternalBasePath), ParametersFile), data, 0644); err != nil {
+		return fmt.Errorf("failed to write bundle parameters: %v", err)
+	}
+	return nil
+}
+
+// ReadParameters reads the parameters declared by the bundle extracted
+// into the given namespace directory. It returns nil if the bundle does
+// not declare any parameter.
+func ReadParameters(namespaceDir string) (Parameters, error) {
+	data, err := os.ReadFile(path.Join(namespaceDir, string(api.InternalBasePath), ParametersFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read bundle parameters: %v", err)
+	}
+	declared := bundleParameters{}
+	if err = json.Unmarshal(data, &declared); err != nil {
+		return nil, fmt.Errorf("invalid bundle parameters: %v", err)
+	}
+	return declared.Parameters, nil
+}
+
+// RenderParameters applies the values of the parameters declared by the
+// bundle extracted into namespaceDir to its site definition, which is
+// then validated and rendered again the same way it was rendered when
+// the bundle was generated. It returns the values applied.
+func RenderParameters(namespaceDir string, platform string, values []string) (Parameters, error) {
+	declared, err := ReadParameters(namespaceDir)
+	if err != nil {
+		return nil, err
+	}
+	if declared == nil {
+		if len(values) > 0 {
+			return nil, fmt.Errorf("this bundle does not declare any parameter")
+		}
+		return nil, nil
+	}
+	parameters, err := declared.Resolve(values)
+	if err != nil {
+		return nil, err
+	}
+	loadSiteState := func(dir api.InternalPath) (*api.SiteState, error) {
+		loader := &common.FileSystemSiteStateLoader{
+			Path:   path.Join(namespaceDir, string(dir)),
+			Bundle: true,
+		}
+		return loader.Load()
+	}
+	loadedSiteState, err := loadSiteState(api.LoadedSiteStatePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load site definition: %v", err)
+	}
+	bundledSiteState, err := loadSiteState(api.RuntimeSiteStatePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load runtime site state: %v", err)
+	}
+	if err = parameters.Apply(loadedSiteState); err != nil {
+		return nil, err
+	}
+	var validator api.SiteStateValidator = &common.SiteStateValidator{}
+	if err = validator.Validate(loadedSiteState); err != nil {
+		return nil, err
+	}
+
+	siteState := common.CopySiteState(loadedSiteState)
+	siteState.SiteId = "{{.SiteId}}"
+	// claims have been redeemed when the bundle was generated, so the
+	// resulting links and their secrets are taken from the bundle
+	for name, link := range bundledSiteState.Links {
+		if _, ok := siteState.Links[name]; !ok {
+			siteState.Links[name] = link
+		}
+	}
+	for name, secret := range bundledSiteState.Secrets {
+		if _, ok := siteState.Secrets[name]; !ok {
+			siteState.Secrets[name] = secret
+		}
+	}
+	if err = common.CreateRouterAccess(siteState); err != nil {
+		return nil, err
+	}
+	siteState.CreateLinkAccessesCertificates()
+	siteState.CreateBridgeCertificates()
+
+	// resources and static links are written again as their names may
+	// depend on the values applied
+	for _, dir := range []api.InternalPath{api.InputSiteStatePath, api.LoadedSiteStatePath, api.RuntimeSiteStatePath, api.RuntimeTokenPath} {
+		if err = os.RemoveAll(path.Join(namespaceDir, string(dir))); err != nil {
+			return nil, fmt.Errorf("failed to remove %s: %v", dir, err)
+		}
+	}
+	configRenderer := &common.FileSystemConfigurationRenderer{
+		SslProfileBasePath: "{{.SslProfileBasePath}}",
+		Platform:           platform,
+		Bundle:             true,
+		OutputPath:         namespaceDir,
+	}
+	if err = configRenderer.Render(siteState); err != nil {
+		return nil, err
+	}
+	for dir, state := range map[api.InternalPath]*api.SiteState{
+		api.InputSiteStatePath:   loadedSiteState,
+		api.LoadedSiteStatePath:  loadedSiteState,
+		api.RuntimeSiteStatePath: siteState,
+	} {
+		if err = api.MarshalSiteState(*state, path.Join(namespaceDir, string(dir))); err != nil {
+			return nil, err
+		}
+	}
+	if err = parameters.Write(namespaceDir); err != nil {
+		return nil, err
+	}
+	return parameters, nil
+}
+
+func sortedKeys[K ~string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
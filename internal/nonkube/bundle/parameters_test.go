@@ -0,0 +1,282 @@
+package bundle
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/qdr"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func TestParseParameters(t *testing.T) {
+	tests := []struct {
+		name          string
+		declarations  []string
+		expected      Parameters
+		expectedError string
+	}{
+		{
+			name:         "with-and-without-defaults",
+			declarations: []string{"link-access-host", "router-log-level=debug"},
+			expected:     Parameters{ParameterLinkAccessHost: "", ParameterRouterLogLevel: "debug"},
+		},
+		{
+			name:          "unknown-parameter",
+			declarations:  []string{"namespace=east"},
+			expectedError: `unknown parameter "namespace"`,
+		},
+		{
+			name:          "duplicate-parameter",
+			declarations:  []string{"listener-host", "listener-host=0.0.0.0"},
+			expectedError: `parameter "listener-host" declared more than once`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parameters, err := ParseParameters(test.declarations)
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+			assert.Assert(t, err)
+			assert.DeepEqual(t, parameters, test.expected)
+		})
+	}
+}
+
+func TestParametersApply(t *testing.T) {
+	tests := []struct {
+		name          string
+		parameters    Parameters
+		customize     func(siteState *api.SiteState)
+		expected      Parameters
+		expectedError string
+	}{
+		{
+			name: "defaults-from-site-definition",
+			parameters: Parameters{
+				ParameterLinkAccessHost:            "",
+				ParameterLinkAccessInterRouterPort: "",
+				ParameterLinkAccessEdgePort:        "",
+				ParameterListenerHost:              "",
+				ParameterRouterLogLevel:            "",
+			},
+			expected: Parameters{
+				ParameterLinkAccessHost:            "0.0.0.0",
+				ParameterLinkAccessInterRouterPort: "55671",
+				ParameterLinkAccessEdgePort:        "45671",
+				ParameterListenerHost:              "127.0.0.1",
+				ParameterRouterLogLevel:            "info",
+			},
+		},
+		{
+			name: "defaults-provided",
+			parameters: Parameters{
+				ParameterLinkAccessHost:     "10.0.0.1",
+				ParameterLinkAccessEdgePort: "46000",
+				ParameterListenerHost:       "0.0.0.0",
+				ParameterRouterLogLevel:     "debug",
+			},
+			expected: Parameters{
+				ParameterLinkAccessHost:     "10.0.0.1",
+				ParameterLinkAccessEdgePort: "46000",
+				ParameterListenerHost:       "0.0.0.0",
+				ParameterRouterLogLevel:     "debug",
+			},
+		},
+		{
+			name:          "invalid-port",
+			parameters:    Parameters{ParameterLinkAccessEdgePort: "70000"},
+			expectedError: `invalid parameter "link-access-edge-port": invalid port "70000"`,
+		},
+		{
+			name:          "invalid-log-level",
+			parameters:    Parameters{ParameterRouterLogLevel: "loud"},
+			expectedError: `invalid parameter "router-log-level": invalid router log level "loud"`,
+		},
+		{
+			name:       "missing-role",
+			parameters: Parameters{ParameterLinkAccessEdgePort: ""},
+			customize: func(siteState *api.SiteState) {
+				siteState.RouterAccesses["go-west"].Spec.Roles = siteState.RouterAccesses["go-west"].Spec.Roles[:1]
+			},
+			expectedError: `invalid parameter "link-access-edge-port": no router access defined with the edge role`,
+		},
+		{
+			name:          "missing-listener",
+			parameters:    Parameters{ParameterListenerHost: ""},
+			customize:     func(siteState *api.SiteState) { siteState.Listeners = nil },
+			expectedError: `invalid parameter "listener-host": no listener defined`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			siteState := fakeParametersSiteState()
+			if test.customize != nil {
+				test.customize(siteState)
+			}
+			err := test.parameters.Apply(siteState)
+			if test.expectedError != "" {
+				assert.ErrorContains(t, err, test.expectedError)
+				return
+			}
+			assert.Assert(t, err)
+			assert.DeepEqual(t, test.parameters, test.expected)
+			routerAccess := siteState.RouterAccesses["go-west"]
+			if host, ok := test.expected[ParameterLinkAccessHost]; ok {
+				assert.Equal(t, routerAccess.Spec.BindHost, host)
+			}
+			if port, ok := test.expected[ParameterLinkAccessEdgePort]; ok {
+				assert.Equal(t, routerAccess.Spec.Roles[1].Name, "edge")
+				assert.Equal(t, strconv.Itoa(routerAccess.Spec.Roles[1].Port), port)
+			}
+			if host, ok := test.expected[ParameterListenerHost]; ok {
+				assert.Equal(t, siteState.Listeners["backend"].Spec.Host, host)
+			}
+			if level, ok := test.expected[ParameterRouterLogLevel]; ok {
+				assert.Equal(t, siteState.Site.Spec.GetRouterLogging(), level)
+			}
+		})
+	}
+}
+
+// TestRenderParameters ensures that the values provided during the
+// installation are validated and applied to the site definition
+// extracted from the bundle.
+func TestRenderParameters(t *testing.T) {
+	siteState := fakeParametersSiteState()
+	parameters := Parameters{
+		ParameterLinkAccessHost:     "",
+		ParameterLinkAccessEdgePort: "",
+		ParameterListenerHost:       "",
+		ParameterRouterLogLevel:     "",
+	}
+	assert.Assert(t, parameters.Apply(siteState))
+
+	namespaceDir := t.TempDir()
+	for _, dir := range []api.InternalPath{api.InternalBasePath, api.LoadedSiteStatePath, api.RuntimeSiteStatePath, api.RuntimeTokenPath} {
+		assert.Assert(t, os.MkdirAll(path.Join(namespaceDir, string(dir)), 0755))
+	}
+	assert.Assert(t, parameters.Write(namespaceDir))
+	assert.Assert(t, api.MarshalSiteState(*siteState, path.Join(namespaceDir, string(api.LoadedSiteStatePath))))
+	assert.Assert(t, api.MarshalSiteState(*siteState, path.Join(namespaceDir, string(api.RuntimeSiteStatePath))))
+	staleLink := path.Join(namespaceDir, string(api.RuntimeTokenPath), "link-go-west-0.0.0.0.yaml")
+	assert.Assert(t, os.WriteFile(staleLink, []byte("stale"), 0644))
+
+	_, err := RenderParameters(namespaceDir, "podman", []string{"link-access-host=10.0.0.1", "namespace=east"})
+	assert.ErrorContains(t, err, `parameter "namespace" is not declared by this bundle`)
+	_, err = RenderParameters(namespaceDir, "podman", []string{"listener-host=Invalid_Host"})
+	assert.ErrorContains(t, err, "invalid listener host: Invalid_Host")
+	_, err = RenderParameters(namespaceDir, "podman", []string{"link-access-host=Invalid_Host"})
+	assert.ErrorContains(t, err, "invalid bind host: Invalid_Host")
+
+	t.Setenv("SKUPPER_PARAM_ROUTER_LOG_LEVEL", "debug")
+	t.Setenv("SKUPPER_PARAM_LINK_ACCESS_EDGE_PORT", "1")
+	applied, err := RenderParameters(namespaceDir, "podman", []string{"link-access-host=10.0.0.1", "link-access-edge-port=46000"})
+	assert.Assert(t, err)
+	assert.DeepEqual(t, applied, Parameters{
+		ParameterLinkAccessHost:     "10.0.0.1",
+		ParameterLinkAccessEdgePort: "46000",
+		ParameterListenerHost:       "127.0.0.1",
+		ParameterRouterLogLevel:     "debug",
+	})
+
+	// router config
+	data, err := os.ReadFile(path.Join(namespaceDir, string(api.RouterConfigPath), "skrouterd.json"))
+	assert.Assert(t, err)
+	config, err := qdr.UnmarshalRouterConfig(string(data))
+	assert.Assert(t, err)
+	assert.Equal(t, config.Listeners["go-west-edge"].Host, "10.0.0.1")
+	assert.Equal(t, config.Listeners["go-west-edge"].Port, int32(46000))
+	assert.Equal(t, config.Listeners["go-west-inter-router"].Host, "10.0.0.1")
+	assert.Equal(t, config.Listeners["go-west-inter-router"].Port, int32(55671))
+	assert.Equal(t, config.Bridges.TcpListeners["backend"].Host, "127.0.0.1")
+	assert.Equal(t, config.LogConfig["DEFAULT"].Enable, "debug+")
+
+	// site definition
+	for _, dir := range []api.InternalPath{api.InputSiteStatePath, api.LoadedSiteStatePath, api.RuntimeSiteStatePath} {
+		routerAccess := &v2alpha1.RouterAccess{}
+		readYaml(t, path.Join(namespaceDir, string(dir), "RouterAccess-go-west.yaml"), routerAccess)
+		assert.Equal(t, routerAccess.Spec.BindHost, "10.0.0.1", dir)
+		assert.Equal(t, routerAccess.Spec.Roles[1].Port, 46000, dir)
+		site := &v2alpha1.Site{}
+		readYaml(t, path.Join(namespaceDir, string(dir), "Site-west.yaml"), site)
+		assert.Equal(t, site.Spec.GetRouterLogging(), "debug", dir)
+	}
+
+	// static links are generated again for the bind host
+	_, err = os.Stat(staleLink)
+	assert.Assert(t, os.IsNotExist(err))
+	links, err := filepath.Glob(path.Join(namespaceDir, string(api.RuntimeTokenPath), "*-10.0.0.1.yaml"))
+	assert.Assert(t, err)
+	assert.Equal(t, len(links), 1)
+	data, err = os.ReadFile(links[0])
+	assert.Assert(t, err)
+	assert.Assert(t, strings.Contains(string(data), "port: \"46000\""), string(data))
+
+	// installed values are recorded
+	installed, err := ReadParameters(namespaceDir)
+	assert.Assert(t, err)
+	assert.DeepEqual(t, installed, applied)
+}
+
+func TestRenderParametersNotDeclared(t *testing.T) {
+	namespaceDir := t.TempDir()
+	applied, err := RenderParameters(namespaceDir, "podman", nil)
+	assert.Assert(t, err)
+	assert.Assert(t, applied == nil)
+	_, err = RenderParameters(namespaceDir, "podman", []string{"listener-host=0.0.0.0"})
+	assert.Error(t, err, "this bundle does not declare any parameter")
+}
+
+func fakeParametersSiteState() *api.SiteState {
+	siteState := api.NewSiteState(true)
+	siteState.Site = &v2alpha1.Site{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "skupper.io/v2alpha1", Kind: "Site"},
+		ObjectMeta: metav1.ObjectMeta{Name: "west"},
+	}
+	siteState.RouterAccesses["go-west"] = &v2alpha1.RouterAccess{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "skupper.io/v2alpha1", Kind: "RouterAccess"},
+		ObjectMeta: metav1.ObjectMeta{Name: "go-west"},
+		Spec: v2alpha1.RouterAccessSpec{
+			Roles: []v2alpha1.RouterAccessRole{
+				{Name: "inter-router", Port: 55671},
+				{Name: "edge", Port: 45671},
+			},
+			BindHost:       "0.0.0.0",
+			TlsCredentials: "go-west",
+		},
+	}
+	siteState.Listeners["backend"] = &v2alpha1.Listener{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "skupper.io/v2alpha1", Kind: "Listener"},
+		ObjectMeta: metav1.ObjectMeta{Name: "backend"},
+		Spec: v2alpha1.ListenerSpec{
+			Host:       "127.0.0.1",
+			Port:       8080,
+			RoutingKey: "backend",
+		},
+	}
+	return siteState
+}
+
+func writeYaml(t *testing.T, name string, obj interface{}) {
+	t.Helper()
+	data, err := yaml.Marshal(obj)
+	assert.Assert(t, err)
+	assert.Assert(t, os.WriteFile(name, data, 0644))
+}
+
+func readYaml(t *testing.T, name string, obj interface{}) {
+	t.Helper()
+	data, err := os.ReadFile(name)
+	assert.Assert(t, err)
+	assert.Assert(t, yaml.Unmarshal(data, obj))
+}
@@ -0,0 +1,219 @@
+package bundle
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/utils"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+const (
+	// ManifestFile lists the SHA-256 hash of every file in a bundle,
+	// in the format read by "sha256sum -c".
+	ManifestFile = "manifest.sha256"
+	// SignatureFile holds the Ed25519 signature of the manifest.
+	SignatureFile = "manifest.sha256.sig"
+	// PublicKeyFile holds the public key matching the signature, which
+	// the installation script compares against a pinned key.
+	PublicKeyFile = "signing-key.pub"
+
+	signingKeyDir        = "signing"
+	signingKeyFile       = "bundle-signing.key"
+	signingPublicKeyFile = "bundle-signing.pub"
+)
+
+// SigningKeyPath returns the directory in which the key used to sign
+// the bundles produced on this host is kept.
+func SigningKeyPath() string {
+	return path.Join(api.GetDefaultOutputBundlesPath(), signingKeyDir)
+}
+
+// HostSigningPublicKeyFile returns the location of the public signing
+// key, as seen from the host, to be distributed to the hosts on which
+// bundles are installed.
+func HostSigningPublicKeyFile() string {
+	return path.Join(api.GetHostBundlesPath(), signingKeyDir, signingPublicKeyFile)
+}
+
+// LoadOrCreateSigningKey returns the Ed25519 key stored in the given
+// directory, generating and storing a new one if none exists yet.
+func LoadOrCreateSigningKey(dir string) (ed25519.PrivateKey, error) {
+	keyFile := path.Join(dir, signingKeyFile)
+	data, err := os.ReadFile(keyFile)
+	if err == nil {
+		return parsePrivateKey(data)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("unable to read signing key: %w", err)
+	}
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate signing key: %w", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := encodePublicKey(key.Public().(ed25519.PublicKey))
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("unable to create signing key directory: %w", err)
+	}
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600); err != nil {
+		return nil, fmt.Errorf("unable to write signing key: %w", err)
+	}
+	if err = os.WriteFile(path.Join(dir, signingPublicKeyFile), publicKey, 0644); err != nil {
+		return nil, fmt.Errorf("unable to write signing public key: %w", err)
+	}
+	return key, nil
+}
+
+func parsePrivateKey(data []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, fmt.Errorf("invalid signing key: PEM encoded private key expected")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing key: %w", err)
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("invalid signing key: Ed25519 key expected")
+	}
+	return edKey, nil
+}
+
+func encodePublicKey(key ed25519.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+// Fingerprint returns the hex encoded SHA-256 hash of the DER encoded
+// public key, as computed by the installation script with
+// "openssl pkey -pubin -outform DER | sha256sum".
+func Fingerprint(key ed25519.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// PublicKeyFingerprint returns the fingerprint of the signing key kept
+// in the given directory.
+func PublicKeyFingerprint(dir string) (string, error) {
+	data, err := os.ReadFile(path.Join(dir, signingPublicKeyFile))
+	if err != nil {
+		return "", err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return "", fmt.Errorf("invalid signing public key")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+	edKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("invalid signing public key: Ed25519 key expected")
+	}
+	return Fingerprint(edKey)
+}
+
+// Manifest lists the content hashes of the files in a bundle, by their
+// path relative to the root of the bundle.
+type Manifest map[string][sha256.Size]byte
+
+// AddDir adds every regular file below root/dir to the manifest.
+func (m Manifest) AddDir(root string, dir string) error {
+	return filepath.WalkDir(path.Join(root, dir), func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() {
+			return err
+		}
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		relative, err := filepath.Rel(root, name)
+		if err != nil {
+			return err
+		}
+		m.Add(filepath.ToSlash(relative), data)
+		return nil
+	})
+}
+
+func (m Manifest) Add(name string, data []byte) {
+	m[name] = sha256.Sum256(data)
+}
+
+// Bytes returns the manifest in the format read by "sha256sum -c",
+// sorted by file name.
+func (m Manifest) Bytes() []byte {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var sb strings.Builder
+	for _, name := range names {
+		sum := m[name]
+		fmt.Fprintf(&sb, "%s  %s\n", hex.EncodeToString(sum[:]), name)
+	}
+	return []byte(sb.String())
+}
+
+// Sign returns the manifest along with its signature.
+func (m Manifest) Sign(key ed25519.PrivateKey) ([]byte, []byte) {
+	data := m.Bytes()
+	return data, ed25519.Sign(key, data)
+}
+
+// addSignedManifest adds the manifest, its signature and the public key
+// needed to verify it to the root of the tarball.
+func addSignedManifest(tarball *utils.Tarball, manifest Manifest, key ed25519.PrivateKey) error {
+	publicKey, err := encodePublicKey(key.Public().(ed25519.PublicKey))
+	if err != nil {
+		return err
+	}
+	data, signature := manifest.Sign(key)
+	now := time.Now()
+	if err = tarball.AddFileData(ManifestFile, 0644, now, data); err != nil {
+		return err
+	}
+	if err = tarball.AddFileData(SignatureFile, 0644, now, signature); err != nil {
+		return err
+	}
+	return tarball.AddFileData(PublicKeyFile, 0644, now, publicKey)
+}
+
+// signFile writes a detached signature of the named file alongside it,
+// so that the bundle as a whole can be verified before it is run.
+func signFile(name string, key ed25519.PrivateKey) error {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(name+".sig", ed25519.Sign(key, data), 0644)
+}
@@ -0,0 +1,91 @@
+package bundle
+
+import (
+	"crypto/ed25519"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestLoadOrCreateSigningKey(t *testing.T) {
+	dir := path.Join(t.TempDir(), "signing")
+
+	key, err := LoadOrCreateSigningKey(dir)
+	assert.Assert(t, err)
+	keyStat, err := os.Stat(path.Join(dir, signingKeyFile))
+	assert.Assert(t, err)
+	assert.Equal(t, keyStat.Mode().Perm(), os.FileMode(0600))
+
+	loaded, err := LoadOrCreateSigningKey(dir)
+	assert.Assert(t, err)
+	assert.Assert(t, key.Equal(loaded), "signing key must be reused")
+
+	fingerprint, err := PublicKeyFingerprint(dir)
+	assert.Assert(t, err)
+	expected, err := Fingerprint(key.Public().(ed25519.PublicKey))
+	assert.Assert(t, err)
+	assert.Equal(t, fingerprint, expected)
+
+	assert.Assert(t, os.WriteFile(path.Join(dir, signingKeyFile), []byte("invalid"), 0600))
+	_, err = LoadOrCreateSigningKey(dir)
+	assert.ErrorContains(t, err, "invalid signing key")
+}
+
+func TestManifest(t *testing.T) {
+	root := t.TempDir()
+	assert.Assert(t, os.MkdirAll(path.Join(root, "west", "runtime", "certs", "ca"), 0755))
+	assert.Assert(t, os.WriteFile(path.Join(root, "west", "runtime", "certs", "ca", "tls.crt"), []byte("crt"), 0644))
+	assert.Assert(t, os.WriteFile(path.Join(root, "west", "platform.yaml"), []byte("platform: podman\n"), 0644))
+
+	manifest := Manifest{}
+	assert.Assert(t, manifest.AddDir(root, "west"))
+	manifest.Add(EncryptedSecretsFile, []byte("secrets"))
+	assert.Assert(t, os.WriteFile(path.Join(root, EncryptedSecretsFile), []byte("secrets"), 0644))
+
+	_, key, err := ed25519.GenerateKey(nil)
+	assert.Assert(t, err)
+	data, signature := manifest.Sign(key)
+	assert.Equal(t, string(data), manifestLine("secrets", EncryptedSecretsFile)+
+		manifestLine("platform: podman\n", "west/platform.yaml")+
+		manifestLine("crt", "west/runtime/certs/ca/tls.crt"))
+	assert.Assert(t, ed25519.Verify(key.Public().(ed25519.PublicKey), data, signature))
+
+	// the manifest and signature must be verifiable by the tools used
+	// in the installation script
+	assert.Assert(t, os.WriteFile(path.Join(root, ManifestFile), data, 0644))
+	assert.Assert(t, os.WriteFile(path.Join(root, SignatureFile), signature, 0644))
+	publicKey, err := encodePublicKey(key.Public().(ed25519.PublicKey))
+	assert.Assert(t, err)
+	assert.Assert(t, os.WriteFile(path.Join(root, PublicKeyFile), publicKey, 0644))
+
+	if _, err := exec.LookPath("sha256sum"); err == nil {
+		check := exec.Command("sha256sum", "-c", "--quiet", "--strict", ManifestFile)
+		check.Dir = root
+		output, err := check.CombinedOutput()
+		assert.Assert(t, err, string(output))
+	}
+	if _, err := exec.LookPath("openssl"); err == nil {
+		verify := exec.Command("openssl", "pkeyutl", "-verify", "-pubin", "-inkey", PublicKeyFile, "-rawin", "-in", ManifestFile, "-sigfile", SignatureFile)
+		verify.Dir = root
+		output, err := verify.CombinedOutput()
+		assert.Assert(t, err, string(output))
+
+		fingerprint := exec.Command("sh", "-c", "openssl pkey -pubin -in "+PublicKeyFile+" -outform DER | sha256sum | cut -d' ' -f1")
+		fingerprint.Dir = root
+		output, err = fingerprint.Output()
+		assert.Assert(t, err)
+		expected, err := Fingerprint(key.Public().(ed25519.PublicKey))
+		assert.Assert(t, err)
+		assert.Equal(t, strings.TrimSpace(string(output)), expected)
+	}
+}
+
+func manifestLine(content string, name string) string {
+	m := Manifest{}
+	m.Add(name, []byte(content))
+	return string(m.Bytes())
+}
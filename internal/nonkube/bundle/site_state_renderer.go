@@ -7,6 +7,8 @@ import (
 	"log/slog"
 	"os"
 	"path"
+	"runtime"
+	"time"
 
 	"github.com/skupperproject/skupper/api/types"
 	"github.com/skupperproject/skupper/internal/images"
@@ -29,10 +31,17 @@ type SiteStateRenderer struct {
 	Strategy        BundleStrategy
 	Platform        types.Platform
 	FileName        string
+	Encryption      *Encryption
+	Parameters      Parameters
 }
 
 func (s *SiteStateRenderer) Render(loadedSiteState *api.SiteState, reload bool) error {
 	var err error
+	// parameter defaults are applied to the site definition so that
+	// they are validated along with it
+	if err = s.Parameters.Apply(loadedSiteState); err != nil {
+		return err
+	}
 	var validator api.SiteStateValidator = &common.SiteStateValidator{}
 	err = validator.Validate(loadedSiteState)
 	if err != nil {
@@ -79,6 +88,11 @@ func (s *SiteStateRenderer) Render(loadedSiteState *api.SiteState, reload bool)
 	if err = s.createFreePortScript(); err != nil {
 		return err
 	}
+	if len(s.Parameters) > 0 {
+		if err = s.Parameters.Write(api.GetDefaultBundleOutputPath(s.siteState.Site.Namespace)); err != nil {
+			return err
+		}
+	}
 	// Create systemd service and scripts
 	if err = CreateSystemdServices(s.siteState); err != nil {
 		return err
@@ -159,10 +173,35 @@ func (s *SiteStateRenderer) createBundle() error {
 	bundlesHomeDir := api.GetDefaultOutputBundlesPath()
 	siteHomeDir := api.GetDefaultBundleOutputPath(s.siteState.Site.Namespace)
 	tarball := utils.NewTarball()
+	manifest := Manifest{}
+	if s.Encryption.Enabled() {
+		logger.Debug("encrypting private material", slog.String("site", s.siteState.Site.Name))
+		secrets, err := s.Encryption.Encrypt(bundlesHomeDir, s.siteState.GetNamespace())
+		if err != nil {
+			return fmt.Errorf("failed to encrypt private material: %v", err)
+		}
+		manifest.Add(EncryptedSecretsFile, secrets)
+		if err = tarball.AddFileData(EncryptedSecretsFile, 0600, time.Now(), secrets); err != nil {
+			return fmt.Errorf("failed to add encrypted private material to tarball: %v", err)
+		}
+	}
+	if len(s.Parameters) > 0 {
+		binary, err := bundleBinary()
+		if err != nil {
+			return err
+		}
+		manifest.Add(BinaryFile, binary)
+		if err = tarball.AddFileData(BinaryFile, 0755, time.Now(), binary); err != nil {
+			return fmt.Errorf("failed to add skupper binary to tarball: %v", err)
+		}
+	}
 	err := tarball.AddFiles(bundlesHomeDir, s.siteState.GetNamespace())
 	if err != nil {
 		return fmt.Errorf("failed to add files to tarball (%q): %v", siteHomeDir, err)
 	}
+	if err = manifest.AddDir(bundlesHomeDir, s.siteState.GetNamespace()); err != nil {
+		return fmt.Errorf("failed to create bundle manifest: %v", err)
+	}
 	var generator BundleGenerator
 	switch s.Strategy {
 	case BundleStrategyTarball:
@@ -180,14 +219,44 @@ func (s *SiteStateRenderer) createBundle() error {
 			Filename:   s.FileName,
 		}
 	}
+	if err = generator.AddInstallScript(tarball, manifest, string(s.Platform)); err != nil {
+		return fmt.Errorf("failed to add installation script to tarball: %v", err)
+	}
+	signingKey, err := LoadOrCreateSigningKey(SigningKeyPath())
+	if err != nil {
+		return err
+	}
+	if err = addSignedManifest(tarball, manifest, signingKey); err != nil {
+		return fmt.Errorf("failed to sign bundle: %v", err)
+	}
 	logger.Debug("generating bundle:", slog.String("path", bundlesHomeDir), slog.String("site", s.siteState.Site.Name))
 	err = generator.Generate(tarball, string(s.Platform))
 	if err != nil {
 		return fmt.Errorf("failed to generate site bundle (%q): %v", s.siteState.Site.Name, err)
 	}
+	if err = signFile(generator.InstallFile(), signingKey); err != nil {
+		return fmt.Errorf("failed to sign site bundle (%q): %v", s.siteState.Site.Name, err)
+	}
 	return nil
 }
 
+// bundleBinary returns the running skupper binary, which applies the
+// values of the bundle parameters during installation.
+func bundleBinary() ([]byte, error) {
+	if runtime.GOOS != "linux" {
+		return nil, fmt.Errorf("bundles declaring parameters can only be generated on linux")
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("unable to locate the skupper binary: %v", err)
+	}
+	binary, err := os.ReadFile(executable)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the skupper binary: %v", err)
+	}
+	return binary, nil
+}
+
 func (s *SiteStateRenderer) removeSiteFiles() error {
 	logger := common.NewLogger()
 	siteHomeDir := api.GetDefaultBundleOutputPath(s.siteState.Site.Namespace)
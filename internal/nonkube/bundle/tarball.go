@@ -28,12 +28,12 @@ func (s *TarballBundle) InstallFile() string {
 	}
 }
 
-func (s *TarballBundle) Generate(tarBall *utils.Tarball, defaultPlatform string) error {
-	var err error
-
+// AddInstallScript adds install.sh to the tarball, listing it in the
+// manifest so that it is verified along with the site definition.
+func (s *TarballBundle) AddInstallScript(tarBall *utils.Tarball, manifest Manifest, defaultPlatform string) error {
 	installScriptTemplate := template.Must(template.New("install").Parse(installScript))
 	var parsedInstallScript = new(bytes.Buffer)
-	err = installScriptTemplate.Execute(parsedInstallScript, map[string]interface{}{
+	err := installScriptTemplate.Execute(parsedInstallScript, map[string]interface{}{
 		"SiteName":        s.SiteName,
 		"Namespace":       s.Namespace,
 		"Platform":        pkgutils.DefaultStr(defaultPlatform, "podman"),
@@ -43,9 +43,13 @@ func (s *TarballBundle) Generate(tarBall *utils.Tarball, defaultPlatform string)
 	if err != nil {
 		return err
 	}
-	if err = tarBall.AddFileData("install.sh", 0755, time.Now(), parsedInstallScript.Bytes()); err != nil {
+	manifest.Add(installScriptFile, parsedInstallScript.Bytes())
+	if err = tarBall.AddFileData(installScriptFile, 0755, time.Now(), parsedInstallScript.Bytes()); err != nil {
 		return fmt.Errorf("error writing install.sh: %w", err)
 	}
-	err = tarBall.Save(s.InstallFile())
-	return err
+	return nil
+}
+
+func (s *TarballBundle) Generate(tarBall *utils.Tarball, defaultPlatform string) error {
+	return tarBall.Save(s.InstallFile())
 }
@@ -52,6 +52,10 @@ func TestTarballBundle_Generate(t *testing.T) {
 
 	t.Run("generate-tarball-bundle", func(t *testing.T) {
 		assert.Assert(t, tb.AddFiles(sitePath))
+		manifest := Manifest{}
+		assert.Assert(t, b.AddInstallScript(tb, manifest, ""))
+		_, ok := manifest["install.sh"]
+		assert.Assert(t, ok, "install.sh must be listed in the manifest")
 		assert.Assert(t, b.Generate(tb, ""))
 		cleanupPaths = append(cleanupPaths, b.InstallFile())
 	})
@@ -0,0 +1,57 @@
+package fs
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// resourcePointer is satisfied by pointers to the custom resources
+// stored by the handlers.
+type resourcePointer[T any] interface {
+	*T
+	metav1.Object
+}
+
+// ListNamespaces returns the namespaces found under the skupper data
+// directory, sorted by name.
+func ListNamespaces() ([]string, error) {
+	entries, err := os.ReadDir(api.GetDefaultOutputNamespacesPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read namespaces directory: %w", err)
+	}
+	var namespaces []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			namespaces = append(namespaces, entry.Name())
+		}
+	}
+	return namespaces, nil
+}
+
+// ListAllNamespaces returns the resources listed by the given function
+// for every namespace, setting the namespace of the resources that do
+// not define one. Namespaces that cannot be listed, as they have no
+// resources of the kind, are skipped.
+func ListAllNamespaces[T metav1.Object](list func(namespace string) ([]T, error)) ([]T, error) {
+	namespaces, err := ListNamespaces()
+	if err != nil {
+		return nil, err
+	}
+	var resources []T
+	for _, namespace := range namespaces {
+		namespaceResources, err := list(namespace)
+		if err != nil {
+			continue
+		}
+		for _, resource := range namespaceResources {
+			if resource.GetNamespace() == "" {
+				resource.SetNamespace(namespace)
+			}
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
@@ -0,0 +1,102 @@
+package fs
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/skupperproject/skupper/internal/filesystem"
+)
+
+// WatchRuntimeResources watches the runtime resources of the given kind
+// in each namespace, calling onChange with every resource found when the
+// watch begins and with every resource created, updated or removed
+// afterwards, until stopCh is closed. The deleted argument is true when
+// the resource has been removed, in which case the resource is the last
+// version seen.
+func WatchRuntimeResources[T any, PT resourcePointer[T]](namespaces []string, kind string, onChange func(resource PT, deleted bool), stopCh <-chan struct{}) error {
+	watcher, err := filesystem.NewWatcher(slog.String("kind", kind))
+	if err != nil {
+		return fmt.Errorf("failed to create watcher: %w", err)
+	}
+	// the watcher reports every event it dispatches, which would be
+	// interleaved with the resources printed by the command
+	watcher.SetLogger(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn})))
+	for _, namespace := range namespaces {
+		pathProvider := PathProvider{Namespace: namespace}
+		watcher.Add(pathProvider.GetRuntimeNamespace(), &runtimeResourceHandler[T, PT]{
+			namespace: namespace,
+			kind:      kind,
+			onChange:  onChange,
+			contents:  map[string][]byte{},
+			resources: map[string]PT{},
+		})
+	}
+	watcher.Start(stopCh)
+	<-stopCh
+	return nil
+}
+
+type runtimeResourceHandler[T any, PT resourcePointer[T]] struct {
+	BaseCustomResourceHandler
+	namespace string
+	kind      string
+	onChange  func(PT, bool)
+	mutex     sync.Mutex
+	contents  map[string][]byte
+	resources map[string]PT
+}
+
+func (h *runtimeResourceHandler[T, PT]) OnBasePathAdded(basePath string) {}
+
+func (h *runtimeResourceHandler[T, PT]) OnCreate(name string) {
+	h.OnUpdate(name)
+}
+
+func (h *runtimeResourceHandler[T, PT]) OnUpdate(name string) {
+	content, err := os.ReadFile(name)
+	if err != nil || len(content) == 0 {
+		// the file is still being written
+		return
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if bytes.Equal(h.contents[name], content) {
+		return
+	}
+	// links are stored after the secret holding their credentials
+	document := content
+	if lastIndex := bytes.LastIndex(content, []byte("---")); lastIndex != -1 {
+		document = content[lastIndex+3:]
+	}
+	resource := PT(new(T))
+	if err = h.DecodeYaml(document, resource); err != nil {
+		return
+	}
+	h.contents[name] = content
+	if resource.GetNamespace() == "" {
+		resource.SetNamespace(h.namespace)
+	}
+	h.resources[name] = resource
+	h.onChange(resource, false)
+}
+
+func (h *runtimeResourceHandler[T, PT]) OnRemove(name string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	resource, ok := h.resources[name]
+	delete(h.contents, name)
+	delete(h.resources, name)
+	if ok {
+		h.onChange(resource, true)
+	}
+}
+
+func (h *runtimeResourceHandler[T, PT]) Filter(name string) bool {
+	fileName := filepath.Base(name)
+	return strings.HasPrefix(fileName, h.kind+"-") && strings.HasSuffix(fileName, ".yaml")
+}
@@ -24,7 +24,7 @@ func RedeemClaims(siteState *api.SiteState) error {
 
 	logger := NewLogger()
 	for name, claim := range siteState.Claims {
-		decoder, err := RedeemAccessToken(claim, siteState.Site.Name)
+		decoder, err := RedeemAccessToken(claim, siteState.Site.Name, siteState.Site.Name)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed to redeem claim %s: %w", name, err))
 			logger.Error("RedeemClaims: failed to redeem claim",
@@ -49,8 +49,10 @@ func RedeemClaims(siteState *api.SiteState) error {
 	return nil
 }
 
-// Redeem logic that populates siteState.Secrets and siteState.Links
-func RedeemAccessToken(claim *skupperv2alpha1.AccessToken, subject string) (*LinkDecoder, error) {
+// Redeem logic that populates siteState.Secrets and siteState.Links.
+// The site name is sent along with the claim, when known, so that
+// grants expecting a given site can be redeemed.
+func RedeemAccessToken(claim *skupperv2alpha1.AccessToken, subject string, site string) (*LinkDecoder, error) {
 	transport := &http.Transport{}
 	if claim.Spec.Ca != "" {
 		caPool := x509.NewCertPool()
@@ -69,6 +71,9 @@ func RedeemAccessToken(claim *skupperv2alpha1.AccessToken, subject string) (*Lin
 	}
 	request.Header.Add("name", claim.Name)
 	request.Header.Add("subject", subject)
+	if site != "" {
+		request.Header.Add("site", site)
+	}
 	response, err := client.Do(request)
 	if err != nil {
 		return nil, err
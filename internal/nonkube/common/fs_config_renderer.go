@@ -60,7 +60,10 @@ type FileSystemConfigurationRenderer struct {
 	RouterConfig       qdr.RouterConfig
 	Platform           string
 	Bundle             bool
-	customOutputPath   string
+	// OutputPath when set, configuration is rendered into this directory
+	// instead of the site home (used to render an extracted bundle)
+	OutputPath       string
+	customOutputPath string
 }
 
 func NewFileSystemConfigurationRenderer(outputPath string) *FileSystemConfigurationRenderer {
@@ -152,6 +155,9 @@ func (c *FileSystemConfigurationRenderer) Render(siteState *api.SiteState) error
 }
 
 func (c *FileSystemConfigurationRenderer) GetOutputPath(siteState *api.SiteState) string {
+	if c.OutputPath != "" {
+		return c.OutputPath
+	}
 	var customSiteHomeProvider = api.GetCustomSiteHome
 	var defaultOutputPathProvider = api.GetDefaultOutputPath
 	if siteState.IsBundle() {
@@ -165,6 +171,9 @@ func (c *FileSystemConfigurationRenderer) GetOutputPath(siteState *api.SiteState
 }
 
 func (c *FileSystemConfigurationRenderer) GetInputPath(siteState *api.SiteState, dir InputPathType) string {
+	if c.OutputPath != "" {
+		return path.Join(c.OutputPath, "input", string(dir))
+	}
 	var customSiteHomeProvider = api.GetCustomSiteHome
 	var defaultOutputPathProvider = api.GetDefaultOutputPath
 	if c.customOutputPath != "" {
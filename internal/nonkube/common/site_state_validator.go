@@ -5,10 +5,13 @@ import (
 	"net"
 	"regexp"
 
+	"github.com/skupperproject/skupper/internal/qdr"
+	"github.com/skupperproject/skupper/internal/site"
 	"github.com/skupperproject/skupper/internal/utils"
 	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	"github.com/skupperproject/skupper/pkg/nonkube/api"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 var (
@@ -28,10 +31,14 @@ type SiteStateValidator struct{}
 // to validate the site state against the spec (CRD), but to a more
 // basic level, like to ensure that mandatory fields for each resource are
 // populated and users will be able to operate the non-k8s site.
+// The site may be omitted when validating resources that are to be
+// added to an existing site.
 func (s *SiteStateValidator) Validate(siteState *api.SiteState) error {
 	var err error
-	if err = s.validateSite(siteState.Site); err != nil {
-		return err
+	if siteState.Site != nil {
+		if err = s.validateSite(siteState.Site); err != nil {
+			return err
+		}
 	}
 	if err = s.validateRouterAccesses(siteState.RouterAccesses); err != nil {
 		return err
@@ -65,6 +72,11 @@ func (s *SiteStateValidator) validateSite(site *v2alpha1.Site) error {
 	if err := ValidateName(site.Name); err != nil {
 		return fmt.Errorf("invalid site name: %w", err)
 	}
+	if logging := site.Spec.GetRouterLogging(); logging != "" {
+		if _, err := qdr.ParseRouterLogConfig(logging); err != nil {
+			return fmt.Errorf("invalid site settings: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -81,6 +93,11 @@ func (s *SiteStateValidator) validateRouterAccesses(routerAccesses map[string]*v
 		if len(routerAccess.Spec.Roles) == 0 {
 			return fmt.Errorf("invalid router access: %s - roles are required", routerAccess.Name)
 		}
+		if bindHost := routerAccess.Spec.BindHost; bindHost != "" {
+			if net.ParseIP(bindHost) == nil && !hostnameRfc1123Regex.MatchString(bindHost) {
+				return fmt.Errorf("invalid router access: %s - invalid bind host: %s - a valid IP address or hostname is expected", routerAccess.Name, bindHost)
+			}
+		}
 		for _, role := range routerAccess.Spec.Roles {
 			if !utils.StringSliceContains(validLinkAccessRoles, role.Name) {
 				return fmt.Errorf("invalid router access: %s - invalid role: %s (valid roles: %s)",
@@ -158,17 +175,38 @@ func (s *SiteStateValidator) validateConnectors(connectors map[string]*v2alpha1.
 		if err := ValidateName(connector.Name); err != nil {
 			return fmt.Errorf("invalid connector name: %w", err)
 		}
-		if connector.Spec.Host == "" || connector.Spec.Port == 0 {
-			return fmt.Errorf("connector host and port are required (connector: %q)", connector.Name)
+		if connector.Spec.Host != "" && connector.Spec.Selector != "" {
+			return fmt.Errorf("connector host and selector cannot both be set (connector: %q)", connector.Name)
 		}
-		ip := net.ParseIP(connector.Spec.Host)
-		validHostname := hostnameRfc1123Regex.MatchString(connector.Spec.Host)
-		if ip == nil && !validHostname {
-			return fmt.Errorf("invalid connector host: %s - a valid IP address or hostname is expected (connector: %q)", connector.Spec.Host, connector.Name)
+		if err := site.ValidateTargets(connector); err != nil {
+			return fmt.Errorf("%w (connector: %q)", err, connector.Name)
+		}
+		if site.HasMultipleTargets(connector) {
+			targets, _ := site.ParseHosts(connector)
+			for _, target := range targets {
+				if net.ParseIP(target.Host) == nil && !hostnameRfc1123Regex.MatchString(target.Host) {
+					return fmt.Errorf("invalid connector host: %s - a valid IP address or hostname is expected (connector: %q)", target.Host, connector.Name)
+				}
+			}
+		} else if (connector.Spec.Host == "" && connector.Spec.Selector == "") || connector.Spec.Port == 0 {
+			return fmt.Errorf("connector host and port are required (connector: %q)", connector.Name)
+		} else if connector.Spec.Selector != "" {
+			if _, err := labels.Parse(connector.Spec.Selector); err != nil {
+				return fmt.Errorf("invalid connector selector: %w (connector: %q)", err, connector.Name)
+			}
+		} else {
+			ip := net.ParseIP(connector.Spec.Host)
+			validHostname := hostnameRfc1123Regex.MatchString(connector.Spec.Host)
+			if ip == nil && !validHostname {
+				return fmt.Errorf("invalid connector host: %s - a valid IP address or hostname is expected (connector: %q)", connector.Spec.Host, connector.Name)
+			}
 		}
 		if connector.Spec.RoutingKey == "" {
 			return fmt.Errorf("routingKey is missing for connector: %s", connector.Name)
 		}
+		if err := site.ValidateHealthCheck(connector.Spec.HealthCheck); err != nil {
+			return fmt.Errorf("%w (connector: %q)", err, connector.Name)
+		}
 	}
 	return nil
 }
@@ -25,6 +25,28 @@ func TestSiteStateValidator_Validate(t *testing.T) {
 			valid:         false,
 			errorContains: "invalid site name:",
 		},
+		{
+			info: "invalid-router-logging",
+			siteState: customize(func(siteState *api.SiteState) {
+				siteState.Site.Spec.Settings = map[string]string{"router-logging": "loud"}
+			}),
+			valid:         false,
+			errorContains: "invalid site settings: Invalid logging level for router: loud",
+		},
+		{
+			info: "valid-router-logging",
+			siteState: customize(func(siteState *api.SiteState) {
+				siteState.Site.Spec.Settings = map[string]string{"router-logging": "ROUTER_CORE:debug,info"}
+			}),
+			valid: true,
+		},
+		{
+			info: "site-omitted",
+			siteState: customize(func(siteState *api.SiteState) {
+				siteState.Site = nil
+			}),
+			valid: true,
+		},
 		{
 			info: "invalid-link-access-name",
 			siteState: customize(func(siteState *api.SiteState) {
@@ -79,6 +101,16 @@ func TestSiteStateValidator_Validate(t *testing.T) {
 			valid:         false,
 			errorContains: "invalid role: ",
 		},
+		{
+			info: "invalid-link-access-bind-host",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, la := range siteState.RouterAccesses {
+					la.Spec.BindHost = "Invalid_Host"
+				}
+			}),
+			valid:         false,
+			errorContains: "invalid bind host: Invalid_Host",
+		},
 		{
 			info: "invalid-links-no-secrets",
 			siteState: customize(func(siteState *api.SiteState) {
@@ -201,6 +233,101 @@ func TestSiteStateValidator_Validate(t *testing.T) {
 			valid:         false,
 			errorContains: "invalid connector host: ",
 		},
+		{
+			info: "valid-connector-selector",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, connector := range siteState.Connectors {
+					connector.Spec.Host = ""
+					connector.Spec.Selector = "app=backend"
+				}
+			}),
+			valid: true,
+		},
+		{
+			info: "invalid-connector-host-and-selector",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, connector := range siteState.Connectors {
+					connector.Spec.Selector = "app=backend"
+				}
+			}),
+			valid:         false,
+			errorContains: "connector host and selector cannot both be set",
+		},
+		{
+			info: "invalid-connector-selector",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, connector := range siteState.Connectors {
+					connector.Spec.Host = ""
+					connector.Spec.Selector = "app in backend"
+				}
+			}),
+			valid:         false,
+			errorContains: "invalid connector selector: ",
+		},
+		{
+			info: "valid-connector-hosts",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, connector := range siteState.Connectors {
+					connector.Spec.Host = ""
+					connector.Spec.Hosts = []string{"10.0.0.1", "backend.example.com:8443"}
+				}
+			}),
+			valid: true,
+		},
+		{
+			info: "valid-connector-srv-record",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, connector := range siteState.Connectors {
+					connector.Spec.Host = ""
+					connector.Spec.Port = 0
+					connector.Spec.SrvRecord = "_backend._tcp.example.com"
+				}
+			}),
+			valid: true,
+		},
+		{
+			info: "invalid-connector-host-and-hosts",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, connector := range siteState.Connectors {
+					connector.Spec.Hosts = []string{"10.0.0.1"}
+				}
+			}),
+			valid:         false,
+			errorContains: "only one of host, hosts, srvRecord and selector may be set",
+		},
+		{
+			info: "invalid-connector-hosts-invalid-hostname",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, connector := range siteState.Connectors {
+					connector.Spec.Host = ""
+					connector.Spec.Hosts = []string{"invalid_hostname"}
+				}
+			}),
+			valid:         false,
+			errorContains: "invalid connector host: invalid_hostname",
+		},
+		{
+			info: "invalid-connector-hosts-no-port",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, connector := range siteState.Connectors {
+					connector.Spec.Host = ""
+					connector.Spec.Port = 0
+					connector.Spec.Hosts = []string{"10.0.0.1"}
+				}
+			}),
+			valid:         false,
+			errorContains: "a port between 1 and 65535 is required",
+		},
+		{
+			info: "invalid-connector-health-check",
+			siteState: customize(func(siteState *api.SiteState) {
+				for _, connector := range siteState.Connectors {
+					connector.Spec.HealthCheck = &v2alpha1.HealthCheck{Type: "grpc"}
+				}
+			}),
+			valid:         false,
+			errorContains: "invalid health check type \"grpc\"",
+		},
 		{
 			info: "invalid-claim-name",
 			siteState: customize(func(siteState *api.SiteState) {
@@ -0,0 +1,315 @@
+package controller
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/nonkube/client/runtime"
+	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/internal/qdr"
+	"github.com/skupperproject/skupper/internal/site"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+const (
+	tcpConnectorType            = "io.skupper.router.tcpConnector"
+	connectorHealthSyncInterval = 30 * time.Second
+)
+
+// connectorAgent is the subset of the router management operations
+// needed to remove and restore tcp connectors.
+type connectorAgent interface {
+	GetLocalTcpConnectors(filter qdr.TcpEndpointFilter) ([]qdr.TcpEndpoint, error)
+	Create(typename string, name string, entity qdr.TcpEndpoint) error
+	Delete(typename string, name string) error
+	Close() error
+}
+
+type routerAgent struct {
+	*qdr.Agent
+}
+
+func (a routerAgent) Create(typename string, name string, entity qdr.TcpEndpoint) error {
+	return a.Agent.Create(typename, name, entity)
+}
+
+// syncTcpConnectors creates and deletes router tcpConnectors so that
+// those for which managed returns true match the desired bridge
+// configuration. Unmanaged tcpConnectors are left alone.
+func syncTcpConnectors(agent connectorAgent, desired qdr.BridgeConfig, managed func(name string) bool, logger *slog.Logger) error {
+	endpoints, err := agent.GetLocalTcpConnectors(nil)
+	if err != nil {
+		return err
+	}
+	for _, endpoint := range endpoints {
+		if !managed(endpoint.Name) {
+			continue
+		}
+		if wanted, ok := desired.TcpConnectors[endpoint.Name]; ok && wanted.Equivalent(endpoint) {
+			delete(desired.TcpConnectors, endpoint.Name)
+			continue
+		}
+		if err := agent.Delete(tcpConnectorType, endpoint.Name); err != nil {
+			return err
+		}
+		logger.Info("Removed router connector", slog.String("name", endpoint.Name))
+	}
+	for name, endpoint := range desired.TcpConnectors {
+		if err := agent.Create(tcpConnectorType, name, endpoint); err != nil {
+			return err
+		}
+		logger.Info("Added router connector", slog.String("name", name))
+	}
+	return nil
+}
+
+// ConnectorHealthHandler runs the health checks requested by the
+// connectors of a site while its router is configured. The router
+// tcpConnector for a target failing its check is removed, and is
+// restored once the target passes the check again.
+type ConnectorHealthHandler struct {
+	namespace  string
+	logger     *slog.Logger
+	mutex      sync.Mutex
+	running    bool
+	done       chan struct{}
+	events     chan struct{}
+	monitor    *site.HealthMonitor
+	connectors map[string]*v2alpha1.Connector
+	removed    map[string]qdr.TcpEndpoint
+	agent      func() (connectorAgent, error)
+}
+
+func NewConnectorHealthHandler(namespace string) *ConnectorHealthHandler {
+	handler := &ConnectorHealthHandler{
+		namespace: namespace,
+		removed:   map[string]qdr.TcpEndpoint{},
+	}
+	handler.agent = handler.connect
+	handler.logger = slog.Default().
+		With("component", handler.Id()).
+		With("namespace", namespace)
+	return handler
+}
+
+func (h *ConnectorHealthHandler) Id() string {
+	return "connector.health.handler"
+}
+
+func (h *ConnectorHealthHandler) Start(stopCh <-chan struct{}) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.running {
+		return
+	}
+	connectors, err := h.loadConnectors()
+	if err != nil {
+		h.logger.Error("Unable to load connectors", slog.Any("error", err))
+		return
+	}
+	h.logger.Info("Starting", slog.Int("connectors", len(connectors)))
+	h.init()
+	h.updateConnectors(connectors)
+	h.running = true
+	h.done = make(chan struct{})
+	go h.run(stopCh, h.done)
+}
+
+func (h *ConnectorHealthHandler) init() {
+	h.connectors = map[string]*v2alpha1.Connector{}
+	h.events = make(chan struct{}, 1)
+	h.monitor = site.NewHealthMonitor(func(string) {
+		select {
+		case h.events <- struct{}{}:
+		default:
+		}
+	})
+}
+
+// updateConnectors brings the connectors of the handler in line with
+// those given, so that connectors added, changed or removed since the
+// handler started are checked accordingly. A router tcpConnector
+// removed for a connector that has since changed or gone is no longer
+// restored, as the router configuration no longer includes it.
+func (h *ConnectorHealthHandler) updateConnectors(connectors map[string]*v2alpha1.Connector) {
+	for name, current := range h.connectors {
+		if _, ok := connectors[name]; ok {
+			continue
+		}
+		h.logger.Info("Connector removed", slog.String("name", name))
+		delete(h.connectors, name)
+		delete(h.removed, name+"@"+current.Spec.Host)
+		h.monitor.Forget(name)
+	}
+	for name, connector := range connectors {
+		current, ok := h.connectors[name]
+		if ok && reflect.DeepEqual(current.Spec, connector.Spec) {
+			continue
+		}
+		if ok {
+			delete(h.removed, name+"@"+current.Spec.Host)
+		}
+		h.connectors[name] = connector
+		h.monitor.Monitor(connector, []site.Target{hostTarget(connector)})
+	}
+}
+
+// reload reads the connectors from the runtime site state again.
+func (h *ConnectorHealthHandler) reload() error {
+	connectors, err := h.loadConnectors()
+	if err != nil {
+		return err
+	}
+	h.updateConnectors(connectors)
+	return nil
+}
+
+func (h *ConnectorHealthHandler) Stop() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if !h.running {
+		return
+	}
+	h.logger.Info("Stopping")
+	h.running = false
+	h.monitor.Stop()
+	close(h.done)
+}
+
+func (h *ConnectorHealthHandler) run(stopCh <-chan struct{}, done chan struct{}) {
+	ticker := time.NewTicker(connectorHealthSyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-h.events:
+			h.sync(false)
+		case <-ticker.C:
+			// the router may have been restarted with all its
+			// connectors configured, and the connectors may have
+			// been added, changed or removed
+			h.sync(true)
+		case <-stopCh:
+			h.Stop()
+			return
+		case <-done:
+			return
+		}
+	}
+}
+
+// loadConnectors returns the connectors of the site that request a
+// health check.
+func (h *ConnectorHealthHandler) loadConnectors() (map[string]*v2alpha1.Connector, error) {
+	loader := &common.FileSystemSiteStateLoader{
+		Path: api.GetInternalOutputPath(h.namespace, api.RuntimeSiteStatePath),
+	}
+	siteState, err := loader.Load()
+	if err != nil {
+		return nil, err
+	}
+	connectors := map[string]*v2alpha1.Connector{}
+	for name, connector := range siteState.Connectors {
+		if connector.Spec.HealthCheck != nil && connector.Spec.Host != "" {
+			connectors[name] = connector
+		}
+	}
+	return connectors, nil
+}
+
+// hostTarget returns the single target of a connector with a host.
+func hostTarget(connector *v2alpha1.Connector) site.Target {
+	return site.Target{Host: connector.Spec.Host, Port: connector.Spec.Port}
+}
+
+func (h *ConnectorHealthHandler) connect() (connectorAgent, error) {
+	port, err := runtime.GetLocalRouterPort(h.namespace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine local router url: %w", err)
+	}
+	url := fmt.Sprintf("amqps://127.0.0.1:%d", port)
+	agent, err := qdr.Connect(url, runtime.GetRuntimeTlsCert(h.namespace, "skupper-local-client"))
+	if err != nil {
+		return nil, err
+	}
+	return routerAgent{agent}, nil
+}
+
+// sync removes the router tcpConnectors of unhealthy targets and
+// restores those of targets that are healthy again, then records the
+// health of the targets in the runtime site state. The connectors are
+// first reloaded if requested.
+func (h *ConnectorHealthHandler) sync(reload bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if !h.running {
+		return
+	}
+	if reload {
+		if err := h.reload(); err != nil {
+			h.logger.Error("Unable to reload connectors", slog.Any("error", err))
+		}
+	}
+	if err := h.syncRouter(); err != nil {
+		h.logger.Error("Unable to update router connectors", slog.Any("error", err))
+	}
+	if err := h.updateRuntimeSiteState(); err != nil {
+		h.logger.Error("Unable to record connector target health", slog.Any("error", err))
+	}
+}
+
+func (h *ConnectorHealthHandler) syncRouter() error {
+	agent, err := h.agent()
+	if err != nil {
+		return err
+	}
+	defer agent.Close()
+	endpoints, err := agent.GetLocalTcpConnectors(nil)
+	if err != nil {
+		return err
+	}
+	actual := map[string]qdr.TcpEndpoint{}
+	for _, endpoint := range endpoints {
+		actual[endpoint.Name] = endpoint
+	}
+	for _, connector := range h.connectors {
+		name := connector.Name + "@" + connector.Spec.Host
+		endpoint, present := actual[name]
+		if h.monitor.Unhealthy(connector.Name, hostTarget(connector)) {
+			if !present {
+				continue
+			}
+			if err := agent.Delete(tcpConnectorType, name); err != nil {
+				return err
+			}
+			h.removed[name] = endpoint
+			h.logger.Info("Removed router connector for unhealthy target", slog.String("name", name))
+		} else if removed, ok := h.removed[name]; ok {
+			if !present {
+				if err := agent.Create(tcpConnectorType, name, removed); err != nil {
+					return err
+				}
+				h.logger.Info("Restored router connector for healthy target", slog.String("name", name))
+			}
+			delete(h.removed, name)
+		}
+	}
+	return nil
+}
+
+func (h *ConnectorHealthHandler) updateRuntimeSiteState() error {
+	return updateRuntimeSiteState(h.namespace, func(siteState *api.SiteState) bool {
+		changed := false
+		for name := range h.connectors {
+			if connector, ok := siteState.Connectors[name]; ok {
+				if connector.SetTargetHealth(h.monitor.Targets(name)) {
+					changed = true
+				}
+			}
+		}
+		return changed
+	})
+}
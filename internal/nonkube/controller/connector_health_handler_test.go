@@ -0,0 +1,192 @@
+package controller
+
+import (
+	"net"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/qdr"
+	"github.com/skupperproject/skupper/internal/utils"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeConnectorAgent struct {
+	connectors map[string]qdr.TcpEndpoint
+	created    []string
+	deleted    []string
+}
+
+func (a *fakeConnectorAgent) GetLocalTcpConnectors(filter qdr.TcpEndpointFilter) ([]qdr.TcpEndpoint, error) {
+	var endpoints []qdr.TcpEndpoint
+	for _, endpoint := range a.connectors {
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, nil
+}
+
+func (a *fakeConnectorAgent) Create(typename string, name string, entity qdr.TcpEndpoint) error {
+	a.connectors[name] = entity
+	a.created = append(a.created, name)
+	return nil
+}
+
+func (a *fakeConnectorAgent) Delete(typename string, name string) error {
+	delete(a.connectors, name)
+	a.deleted = append(a.deleted, name)
+	return nil
+}
+
+func (a *fakeConnectorAgent) Close() error {
+	return nil
+}
+
+func TestConnectorHealthHandlerSyncRouter(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Assert(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	connector := &v2alpha1.Connector{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "backend",
+		},
+		Spec: v2alpha1.ConnectorSpec{
+			RoutingKey: "backend",
+			Host:       "127.0.0.1",
+			Port:       port,
+			HealthCheck: &v2alpha1.HealthCheck{
+				PeriodSeconds:    1,
+				FailureThreshold: 1,
+			},
+		},
+	}
+	endpoint := qdr.TcpEndpoint{
+		Name:    "backend@127.0.0.1",
+		Host:    "127.0.0.1",
+		Port:    strconv.Itoa(port),
+		Address: "backend",
+	}
+	agent := &fakeConnectorAgent{
+		connectors: map[string]qdr.TcpEndpoint{endpoint.Name: endpoint},
+	}
+	handler := NewConnectorHealthHandler("test-connector-health-handler")
+	handler.agent = func() (connectorAgent, error) {
+		return agent, nil
+	}
+	handler.init()
+	defer handler.monitor.Stop()
+	handler.updateConnectors(map[string]*v2alpha1.Connector{connector.Name: connector})
+
+	assert.Assert(t, handler.syncRouter())
+	assert.Assert(t, agent.deleted == nil)
+
+	err = utils.Retry(time.Second, 5, func() (bool, error) {
+		return handler.monitor.Unhealthy(connector.Name, hostTarget(connector)), nil
+	})
+	assert.Assert(t, err)
+	assert.Assert(t, handler.syncRouter())
+	assert.DeepEqual(t, agent.deleted, []string{endpoint.Name})
+	assert.Equal(t, len(agent.connectors), 0)
+
+	listener, err = net.Listen("tcp", net.JoinHostPort("127.0.0.1", endpoint.Port))
+	assert.Assert(t, err)
+	defer listener.Close()
+	err = utils.Retry(time.Second, 5, func() (bool, error) {
+		return !handler.monitor.Unhealthy(connector.Name, hostTarget(connector)), nil
+	})
+	assert.Assert(t, err)
+	assert.Assert(t, handler.syncRouter())
+	assert.DeepEqual(t, agent.created, []string{endpoint.Name})
+	assert.DeepEqual(t, agent.connectors[endpoint.Name], endpoint)
+
+	// nothing further to restore
+	assert.Assert(t, handler.syncRouter())
+	assert.Equal(t, len(agent.created), 1)
+}
+
+func TestConnectorHealthHandlerReload(t *testing.T) {
+	tempDir := t.TempDir()
+	if os.Getuid() == 0 {
+		api.DefaultRootDataHome = tempDir
+	} else {
+		t.Setenv("XDG_DATA_HOME", tempDir)
+	}
+	namespace := "test-connector-health-handler-reload"
+	runtimePath := api.GetInternalOutputPath(namespace, api.RuntimeSiteStatePath)
+	assert.Assert(t, os.MkdirAll(runtimePath, 0755))
+	siteState := fakeSiteState()
+	assert.Assert(t, api.MarshalSiteState(*siteState, runtimePath))
+
+	agent := &fakeConnectorAgent{
+		connectors: map[string]qdr.TcpEndpoint{},
+	}
+	handler := NewConnectorHealthHandler(namespace)
+	handler.agent = func() (connectorAgent, error) {
+		return agent, nil
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	// no connector requests a health check, yet the handler starts
+	// so that connectors added later are checked
+	handler.Start(stopCh)
+	defer handler.Stop()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Assert(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+	connector := siteState.Connectors["connector-one"].DeepCopy()
+	connector.Name = "backend"
+	connector.Spec.Host = "127.0.0.1"
+	connector.Spec.Port = port
+	connector.Spec.HealthCheck = &v2alpha1.HealthCheck{
+		PeriodSeconds:    1,
+		FailureThreshold: 1,
+	}
+	endpoint := qdr.TcpEndpoint{
+		Name:    "backend@127.0.0.1",
+		Host:    "127.0.0.1",
+		Port:    strconv.Itoa(port),
+		Address: "backend",
+	}
+	// the agent is only used by the handler while it holds its mutex
+	handler.mutex.Lock()
+	agent.connectors[endpoint.Name] = endpoint
+	handler.mutex.Unlock()
+	assert.Assert(t, updateRuntimeSiteState(namespace, func(siteState *api.SiteState) bool {
+		siteState.Connectors[connector.Name] = connector
+		return true
+	}))
+
+	// the added connector is checked once the connectors are reloaded
+	handler.sync(true)
+	err = utils.Retry(time.Second, 5, func() (bool, error) {
+		handler.sync(false)
+		handler.mutex.Lock()
+		defer handler.mutex.Unlock()
+		_, present := agent.connectors[endpoint.Name]
+		return !present, nil
+	})
+	assert.Assert(t, err)
+
+	// and no longer once it has been removed
+	// the runtime site state is rendered afresh when the site changes
+	assert.Assert(t, updateRuntimeSiteState(namespace, func(siteState *api.SiteState) bool {
+		assert.Assert(t, os.RemoveAll(runtimePath))
+		assert.Assert(t, os.MkdirAll(runtimePath, 0755))
+		delete(siteState.Connectors, connector.Name)
+		return true
+	}))
+	handler.sync(true)
+	handler.mutex.Lock()
+	defer handler.mutex.Unlock()
+	assert.Equal(t, len(handler.connectors), 0)
+	assert.Equal(t, len(handler.removed), 0)
+	healthy, unhealthy := handler.monitor.Targets(connector.Name)
+	assert.Assert(t, healthy == nil && unhealthy == nil)
+}
@@ -0,0 +1,238 @@
+package controller
+
+import (
+	"log/slog"
+	"path"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/internal/site"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+const connectorTargetSyncInterval = 30 * time.Second
+
+// ConnectorTargetHandler keeps a router tcpConnector for each target
+// of the connectors of a site that have an SRV record, re-resolving
+// the record periodically. Connectors with multiple hosts are handled
+// as well when they have a health check, so that failing hosts are
+// left out.
+type ConnectorTargetHandler struct {
+	namespace  string
+	logger     *slog.Logger
+	mutex      sync.Mutex
+	running    bool
+	done       chan struct{}
+	events     chan struct{}
+	bindings   *site.Bindings
+	resolver   *site.TargetResolver
+	monitor    *site.HealthMonitor
+	connectors map[string]*v2alpha1.Connector
+	retired    map[string]bool
+	agent      func() (connectorAgent, error)
+}
+
+func NewConnectorTargetHandler(namespace string) *ConnectorTargetHandler {
+	handler := &ConnectorTargetHandler{
+		namespace: namespace,
+	}
+	handler.agent = NewConnectorHealthHandler(namespace).connect
+	handler.logger = slog.Default().
+		With("component", handler.Id()).
+		With("namespace", namespace)
+	return handler
+}
+
+func (h *ConnectorTargetHandler) Id() string {
+	return "connector.target.handler"
+}
+
+func (h *ConnectorTargetHandler) Start(stopCh <-chan struct{}) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.running {
+		return
+	}
+	siteState, err := h.loadSiteState()
+	if err != nil {
+		h.logger.Error("Unable to load connectors", slog.Any("error", err))
+		return
+	}
+	connectors := targetConnectors(siteState)
+	h.logger.Info("Starting", slog.Int("connectors", len(connectors)))
+	h.init(siteState.SiteId)
+	h.updateConnectors(connectors)
+	h.running = true
+	h.done = make(chan struct{})
+	go h.run(stopCh, h.done)
+}
+
+// targetConnectors returns the connectors of the site state whose
+// targets are resolved by the handler.
+func targetConnectors(siteState *api.SiteState) map[string]*v2alpha1.Connector {
+	connectors := map[string]*v2alpha1.Connector{}
+	for name, connector := range siteState.Connectors {
+		if connector.Spec.SrvRecord != "" || (len(connector.Spec.Hosts) > 0 && connector.Spec.HealthCheck != nil) {
+			connectors[name] = connector
+		}
+	}
+	return connectors
+}
+
+func (h *ConnectorTargetHandler) init(siteId string) {
+	h.connectors = map[string]*v2alpha1.Connector{}
+	h.retired = map[string]bool{}
+	h.events = make(chan struct{}, 1)
+	notify := func(string) {
+		select {
+		case h.events <- struct{}{}:
+		default:
+		}
+	}
+	h.resolver = site.NewTargetResolver(notify)
+	h.monitor = site.NewHealthMonitor(notify)
+	h.bindings = site.NewBindings(path.Join(api.GetDefaultOutputPath(h.namespace), string(api.CertificatesPath)))
+	h.bindings.SetSiteId(siteId)
+	h.bindings.SetTargetResolver(h.resolver)
+	h.bindings.SetHealthMonitor(h.monitor)
+}
+
+// updateConnectors brings the connectors of the handler in line with
+// those given, so that connectors added, changed or removed since the
+// handler started are taken into account. The router tcpConnectors of
+// removed connectors stay managed until the router has been synced.
+func (h *ConnectorTargetHandler) updateConnectors(connectors map[string]*v2alpha1.Connector) {
+	for name := range h.connectors {
+		if _, ok := connectors[name]; ok {
+			continue
+		}
+		h.logger.Info("Connector removed", slog.String("name", name))
+		delete(h.connectors, name)
+		h.retired[name] = true
+		h.bindings.UpdateConnector(name, nil)
+	}
+	for name, connector := range connectors {
+		if current, ok := h.connectors[name]; ok && reflect.DeepEqual(current.Spec, connector.Spec) {
+			continue
+		}
+		h.connectors[name] = connector
+		h.bindings.UpdateConnector(name, connector)
+	}
+}
+
+// reload reads the connectors from the runtime site state again.
+func (h *ConnectorTargetHandler) reload() error {
+	siteState, err := h.loadSiteState()
+	if err != nil {
+		return err
+	}
+	h.updateConnectors(targetConnectors(siteState))
+	return nil
+}
+
+func (h *ConnectorTargetHandler) Stop() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if !h.running {
+		return
+	}
+	h.logger.Info("Stopping")
+	h.running = false
+	h.resolver.Stop()
+	h.monitor.Stop()
+	close(h.done)
+}
+
+func (h *ConnectorTargetHandler) run(stopCh <-chan struct{}, done chan struct{}) {
+	h.sync(true)
+	ticker := time.NewTicker(connectorTargetSyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-h.events:
+			h.sync(false)
+		case <-ticker.C:
+			h.sync(true)
+		case <-stopCh:
+			h.Stop()
+			return
+		case <-done:
+			return
+		}
+	}
+}
+
+func (h *ConnectorTargetHandler) loadSiteState() (*api.SiteState, error) {
+	loader := &common.FileSystemSiteStateLoader{
+		Path: api.GetInternalOutputPath(h.namespace, api.RuntimeSiteStatePath),
+	}
+	return loader.Load()
+}
+
+// sync brings the router tcpConnectors of the connectors in line with
+// their current targets, then records those targets in the runtime
+// site state. The connectors are first reloaded if requested.
+func (h *ConnectorTargetHandler) sync(reload bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if !h.running {
+		return
+	}
+	if reload {
+		if err := h.reload(); err != nil {
+			h.logger.Error("Unable to reload connectors", slog.Any("error", err))
+		}
+	}
+	if err := h.syncRouter(); err != nil {
+		h.logger.Error("Unable to update router connectors", slog.Any("error", err))
+	} else {
+		clear(h.retired)
+	}
+	if err := h.updateRuntimeSiteState(); err != nil {
+		h.logger.Error("Unable to record connector targets", slog.Any("error", err))
+	}
+}
+
+func (h *ConnectorTargetHandler) syncRouter() error {
+	agent, err := h.agent()
+	if err != nil {
+		return err
+	}
+	defer agent.Close()
+	return syncTcpConnectors(agent, h.bindings.ToBridgeConfig(), h.isManaged, h.logger)
+}
+
+// isManaged indicates whether the named router tcpConnector belongs
+// to one of the connectors of the handler, or to one removed since
+// the last sync.
+func (h *ConnectorTargetHandler) isManaged(name string) bool {
+	connector, _, ok := strings.Cut(name, "@")
+	if !ok {
+		return false
+	}
+	_, current := h.connectors[connector]
+	return current || h.retired[connector]
+}
+
+func (h *ConnectorTargetHandler) updateRuntimeSiteState() error {
+	return updateRuntimeSiteState(h.namespace, func(siteState *api.SiteState) bool {
+		changed := false
+		for name := range h.connectors {
+			connector, ok := siteState.Connectors[name]
+			if !ok {
+				continue
+			}
+			if connector.SetResolvedTargets(h.bindings.ResolvedTargets(name)) {
+				changed = true
+			}
+			if connector.SetTargetHealth(h.bindings.TargetHealth(name)) {
+				changed = true
+			}
+		}
+		return changed
+	})
+}
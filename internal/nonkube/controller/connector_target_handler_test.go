@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/qdr"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConnectorTargetHandlerSyncRouter(t *testing.T) {
+	connector := &v2alpha1.Connector{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "backend",
+		},
+		Spec: v2alpha1.ConnectorSpec{
+			RoutingKey: "backend",
+			Hosts:      []string{"10.0.0.1", "10.0.0.2:9090"},
+			Port:       8080,
+			HealthCheck: &v2alpha1.HealthCheck{
+				PeriodSeconds: 3600,
+			},
+		},
+	}
+	unmanaged := qdr.TcpEndpoint{
+		Name:    "other@127.0.0.1",
+		Host:    "127.0.0.1",
+		Port:    "9090",
+		Address: "other",
+	}
+	stale := qdr.TcpEndpoint{
+		Name:    "backend@10.0.0.9:8080",
+		Host:    "10.0.0.9",
+		Port:    "8080",
+		Address: "backend",
+	}
+	agent := &fakeConnectorAgent{
+		connectors: map[string]qdr.TcpEndpoint{
+			unmanaged.Name: unmanaged,
+			stale.Name:     stale,
+		},
+	}
+	handler := NewConnectorTargetHandler("test-connector-target-handler")
+	handler.agent = func() (connectorAgent, error) {
+		return agent, nil
+	}
+	handler.init("site-id")
+	handler.updateConnectors(map[string]*v2alpha1.Connector{connector.Name: connector})
+	defer handler.resolver.Stop()
+	defer handler.monitor.Stop()
+
+	assert.Assert(t, handler.syncRouter())
+	assert.DeepEqual(t, agent.deleted, []string{stale.Name})
+	assert.Equal(t, len(agent.connectors), 3)
+	assert.DeepEqual(t, agent.connectors[unmanaged.Name], unmanaged)
+	endpoint, ok := agent.connectors["backend@10.0.0.1:8080"]
+	assert.Assert(t, ok)
+	assert.Equal(t, endpoint.Host, "10.0.0.1")
+	assert.Equal(t, endpoint.Port, "8080")
+	endpoint, ok = agent.connectors["backend@10.0.0.2:9090"]
+	assert.Assert(t, ok)
+	assert.Equal(t, endpoint.Port, "9090")
+	assert.DeepEqual(t, handler.bindings.ResolvedTargets(connector.Name), []string{"10.0.0.1:8080", "10.0.0.2:9090"})
+
+	// nothing changes while the targets are the same
+	assert.Assert(t, handler.syncRouter())
+	assert.Equal(t, len(agent.created), 2)
+	assert.Equal(t, len(agent.deleted), 1)
+
+	// a connector added after the handler started is picked up when
+	// the connectors are reloaded
+	added := connector.DeepCopy()
+	added.Name = "frontend"
+	added.Spec.RoutingKey = "frontend"
+	added.Spec.Hosts = []string{"10.0.0.3"}
+	handler.updateConnectors(map[string]*v2alpha1.Connector{connector.Name: connector, added.Name: added})
+	assert.Assert(t, handler.syncRouter())
+	assert.Equal(t, len(agent.connectors), 4)
+	endpoint, ok = agent.connectors["frontend@10.0.0.3:8080"]
+	assert.Assert(t, ok)
+	assert.Equal(t, endpoint.Address, "frontend")
+
+	// the router connectors of a removed connector are deleted
+	handler.updateConnectors(map[string]*v2alpha1.Connector{added.Name: added})
+	assert.Assert(t, handler.syncRouter())
+	assert.Equal(t, len(agent.connectors), 2)
+	assert.DeepEqual(t, agent.connectors[unmanaged.Name], unmanaged)
+	_, ok = agent.connectors["frontend@10.0.0.3:8080"]
+	assert.Assert(t, ok)
+}
@@ -0,0 +1,354 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	internalclient "github.com/skupperproject/skupper/internal/nonkube/client/compat"
+	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/internal/qdr"
+	"github.com/skupperproject/skupper/internal/site"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/container"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+const containerSelectorInterval = 5 * time.Second
+
+var errNoContainersSelected = errors.New("No containers match selector")
+
+// containerLister is the subset of the container engine operations
+// needed to resolve container selectors.
+type containerLister interface {
+	ContainerList() ([]*container.Container, error)
+}
+
+// ContainerSelectorHandler resolves the label selectors of the
+// connectors of a podman or docker site into the running containers
+// they match, and keeps a router tcpConnector for each of those
+// containers, addressed by its IP on the skupper network.
+type ContainerSelectorHandler struct {
+	namespace  string
+	logger     *slog.Logger
+	mutex      sync.Mutex
+	running    bool
+	done       chan struct{}
+	events     chan struct{}
+	bindings   *site.Bindings
+	monitor    *site.HealthMonitor
+	connectors map[string]*v2alpha1.Connector
+	selectors  map[string]labels.Selector
+	selected   map[string][]v2alpha1.ContainerDetails
+	retired    map[string]bool
+	containers func() (containerLister, error)
+	agent      func() (connectorAgent, error)
+}
+
+func NewContainerSelectorHandler(namespace string) *ContainerSelectorHandler {
+	handler := &ContainerSelectorHandler{
+		namespace: namespace,
+	}
+	handler.containers = handler.containerClient
+	handler.agent = NewConnectorHealthHandler(namespace).connect
+	handler.logger = slog.Default().
+		With("component", handler.Id()).
+		With("namespace", namespace)
+	return handler
+}
+
+func (h *ContainerSelectorHandler) Id() string {
+	return "container.selector.handler"
+}
+
+func (h *ContainerSelectorHandler) Start(stopCh <-chan struct{}) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.running {
+		return
+	}
+	siteState, err := h.loadSiteState()
+	if err != nil {
+		h.logger.Error("Unable to load connectors", slog.Any("error", err))
+		return
+	}
+	connectors := selectorConnectors(siteState)
+	h.logger.Info("Starting", slog.Int("connectors", len(connectors)))
+	h.init(siteState.SiteId)
+	h.updateConnectors(connectors)
+	h.running = true
+	h.done = make(chan struct{})
+	go h.run(stopCh, h.done)
+}
+
+// selectorConnectors returns the connectors of the site state that
+// have a selector.
+func selectorConnectors(siteState *api.SiteState) map[string]*v2alpha1.Connector {
+	connectors := map[string]*v2alpha1.Connector{}
+	for name, connector := range siteState.Connectors {
+		if connector.Spec.Selector != "" {
+			connectors[name] = connector
+		}
+	}
+	return connectors
+}
+
+func (h *ContainerSelectorHandler) init(siteId string) {
+	h.connectors = map[string]*v2alpha1.Connector{}
+	h.selectors = map[string]labels.Selector{}
+	h.selected = map[string][]v2alpha1.ContainerDetails{}
+	h.retired = map[string]bool{}
+	h.events = make(chan struct{}, 1)
+	h.monitor = site.NewHealthMonitor(func(string) {
+		select {
+		case h.events <- struct{}{}:
+		default:
+		}
+	})
+	h.bindings = site.NewBindings(path.Join(api.GetDefaultOutputPath(h.namespace), string(api.CertificatesPath)))
+	h.bindings.SetSiteId(siteId)
+	h.bindings.SetConnectorConfiguration(h.updateBridgeConfigForConnector)
+	h.bindings.SetHealthMonitor(h.monitor)
+}
+
+// updateConnectors brings the connectors of the handler in line with
+// those given, so that connectors added, changed or removed since the
+// handler started are taken into account. The router tcpConnectors of
+// removed connectors stay managed until the router has been synced.
+func (h *ContainerSelectorHandler) updateConnectors(connectors map[string]*v2alpha1.Connector) {
+	for name, current := range h.connectors {
+		if _, ok := connectors[name]; ok {
+			continue
+		}
+		h.logger.Info("Connector removed", slog.String("name", name))
+		delete(h.connectors, name)
+		delete(h.selectors, name)
+		delete(h.selected, name)
+		h.retired[name] = true
+		h.bindings.UpdateConnector(current.Name, nil)
+	}
+	for name, connector := range connectors {
+		if current, ok := h.connectors[name]; ok && reflect.DeepEqual(current.Spec, connector.Spec) {
+			continue
+		}
+		h.connectors[name] = connector
+		selector, err := labels.Parse(connector.Spec.Selector)
+		if err != nil {
+			h.logger.Error("Invalid connector selector",
+				slog.String("name", name),
+				slog.Any("error", err))
+			if _, ok := h.selectors[name]; ok {
+				delete(h.selectors, name)
+				delete(h.selected, name)
+				h.retired[name] = true
+				h.bindings.UpdateConnector(name, nil)
+			}
+			continue
+		}
+		h.selectors[name] = selector
+		h.bindings.UpdateConnector(name, connector)
+	}
+}
+
+// reload reads the connectors from the runtime site state again.
+func (h *ContainerSelectorHandler) reload() error {
+	siteState, err := h.loadSiteState()
+	if err != nil {
+		return err
+	}
+	h.updateConnectors(selectorConnectors(siteState))
+	return nil
+}
+
+func (h *ContainerSelectorHandler) Stop() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if !h.running {
+		return
+	}
+	h.logger.Info("Stopping")
+	h.running = false
+	h.monitor.Stop()
+	close(h.done)
+}
+
+func (h *ContainerSelectorHandler) run(stopCh <-chan struct{}, done chan struct{}) {
+	h.sync(true)
+	ticker := time.NewTicker(containerSelectorInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-h.events:
+			h.sync(false)
+		case <-ticker.C:
+			h.sync(true)
+		case <-stopCh:
+			h.Stop()
+			return
+		case <-done:
+			return
+		}
+	}
+}
+
+func (h *ContainerSelectorHandler) loadSiteState() (*api.SiteState, error) {
+	loader := &common.FileSystemSiteStateLoader{
+		Path: api.GetInternalOutputPath(h.namespace, api.RuntimeSiteStatePath),
+	}
+	return loader.Load()
+}
+
+func (h *ContainerSelectorHandler) containerClient() (containerLister, error) {
+	platformLoader := &common.NamespacePlatformLoader{}
+	platform, err := platformLoader.Load(h.namespace)
+	if err != nil {
+		return nil, err
+	}
+	if platform != "podman" && platform != "docker" {
+		return nil, fmt.Errorf("container selectors are not supported on platform %s", platform)
+	}
+	endpoint := os.Getenv("CONTAINER_ENDPOINT")
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("unix://%s/podman/podman.sock", api.GetRuntimeDir())
+		if platform == "docker" {
+			endpoint = "unix:///run/docker.sock"
+		}
+	}
+	return internalclient.NewCompatClient(endpoint, "")
+}
+
+func (h *ContainerSelectorHandler) updateBridgeConfigForConnector(siteId string, connector *v2alpha1.Connector, config *qdr.BridgeConfig) {
+	for _, selected := range h.selected[connector.Name] {
+		pod := v2alpha1.PodDetails{
+			Name: selected.Name,
+			IP:   selected.IP,
+		}
+		site.UpdateBridgeConfigForConnectorToPod(siteId, connector, pod, connector.Spec.ExposePodsByName, config)
+	}
+}
+
+// sync brings the router tcpConnectors for the selected containers
+// in line with the containers that are selected and healthy, first
+// resolving the selectors again if requested.
+func (h *ContainerSelectorHandler) sync(resolve bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if !h.running {
+		return
+	}
+	if resolve {
+		if err := h.reload(); err != nil {
+			h.logger.Error("Unable to reload connectors", slog.Any("error", err))
+		}
+		if err := h.resolve(); err != nil {
+			h.logger.Error("Unable to resolve container selectors", slog.Any("error", err))
+			return
+		}
+	}
+	if err := h.syncRouter(); err != nil {
+		h.logger.Error("Unable to update router connectors", slog.Any("error", err))
+	} else {
+		clear(h.retired)
+	}
+	if err := h.updateRuntimeSiteState(); err != nil {
+		h.logger.Error("Unable to record selected containers", slog.Any("error", err))
+	}
+}
+
+// resolve lists the running containers matching the selector of each
+// connector, along with their IP on the skupper network.
+func (h *ContainerSelectorHandler) resolve() error {
+	if len(h.selectors) == 0 {
+		return nil
+	}
+	client, err := h.containers()
+	if err != nil {
+		return err
+	}
+	containers, err := client.ContainerList()
+	if err != nil {
+		return err
+	}
+	for name, selector := range h.selectors {
+		var selected []v2alpha1.ContainerDetails
+		for _, c := range containers {
+			if !c.Running || !selector.Matches(labels.Set(c.Labels)) {
+				continue
+			}
+			network, ok := c.Networks[container.ContainerNetworkName]
+			if !ok || network.IPAddress == "" {
+				h.logger.Debug("Selected container is not on the skupper network",
+					slog.String("connector", name),
+					slog.String("container", c.Name))
+				continue
+			}
+			selected = append(selected, v2alpha1.ContainerDetails{
+				ID:   c.ID,
+				Name: c.Name,
+				IP:   network.IPAddress,
+			})
+		}
+		slices.SortFunc(selected, func(a, b v2alpha1.ContainerDetails) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+		h.selected[name] = selected
+	}
+	return nil
+}
+
+func (h *ContainerSelectorHandler) syncRouter() error {
+	agent, err := h.agent()
+	if err != nil {
+		return err
+	}
+	defer agent.Close()
+	return syncTcpConnectors(agent, h.bindings.ToBridgeConfig(), h.isManaged, h.logger)
+}
+
+// isManaged indicates whether the named router tcpConnector belongs
+// to a connector with a selector, or to one removed since the last
+// sync.
+func (h *ContainerSelectorHandler) isManaged(name string) bool {
+	connector, _, ok := strings.Cut(name, "@")
+	if !ok {
+		return false
+	}
+	_, selected := h.selectors[connector]
+	return selected || h.retired[connector]
+}
+
+func (h *ContainerSelectorHandler) updateRuntimeSiteState() error {
+	return updateRuntimeSiteState(h.namespace, func(siteState *api.SiteState) bool {
+		changed := false
+		for name := range h.selectors {
+			connector, ok := siteState.Connectors[name]
+			if !ok {
+				continue
+			}
+			selected := h.selected[name]
+			var configured error
+			if len(selected) == 0 {
+				configured = errNoContainersSelected
+			}
+			if connector.SetConfigured(configured) {
+				changed = true
+			}
+			if connector.SetSelectedContainers(selected) {
+				changed = true
+			}
+			if connector.SetTargetHealth(h.bindings.TargetHealth(name)) {
+				changed = true
+			}
+		}
+		return changed
+	})
+}
@@ -0,0 +1,130 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/qdr"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"github.com/skupperproject/skupper/pkg/container"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type fakeContainerLister struct {
+	containers []*container.Container
+}
+
+func (l *fakeContainerLister) ContainerList() ([]*container.Container, error) {
+	return l.containers, nil
+}
+
+func selectableContainer(name string, ip string, running bool, labels map[string]string) *container.Container {
+	networks := map[string]container.ContainerNetworkInfo{}
+	if ip != "" {
+		networks[container.ContainerNetworkName] = container.ContainerNetworkInfo{IPAddress: ip}
+	}
+	return &container.Container{
+		ID:       name + "-id",
+		Name:     name,
+		Labels:   labels,
+		Networks: networks,
+		Running:  running,
+	}
+}
+
+func TestContainerSelectorHandlerSync(t *testing.T) {
+	connector := &v2alpha1.Connector{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "backend",
+		},
+		Spec: v2alpha1.ConnectorSpec{
+			RoutingKey: "backend",
+			Selector:   "app=backend",
+			Port:       8080,
+		},
+	}
+	backend := map[string]string{"app": "backend"}
+	lister := &fakeContainerLister{
+		containers: []*container.Container{
+			selectableContainer("backend-2", "10.88.0.3", true, backend),
+			selectableContainer("backend-1", "10.88.0.2", true, backend),
+			selectableContainer("backend-stopped", "10.88.0.4", false, backend),
+			selectableContainer("backend-unattached", "", true, backend),
+			selectableContainer("frontend", "10.88.0.5", true, map[string]string{"app": "frontend"}),
+		},
+	}
+	unmanaged := qdr.TcpEndpoint{
+		Name:    "other@127.0.0.1",
+		Host:    "127.0.0.1",
+		Port:    "9090",
+		Address: "other",
+	}
+	stale := qdr.TcpEndpoint{
+		Name:    "backend@10.88.0.9",
+		Host:    "10.88.0.9",
+		Port:    "8080",
+		Address: "backend",
+	}
+	agent := &fakeConnectorAgent{
+		connectors: map[string]qdr.TcpEndpoint{
+			unmanaged.Name: unmanaged,
+			stale.Name:     stale,
+		},
+	}
+	handler := NewContainerSelectorHandler("test-container-selector-handler")
+	handler.containers = func() (containerLister, error) {
+		return lister, nil
+	}
+	handler.agent = func() (connectorAgent, error) {
+		return agent, nil
+	}
+	handler.init("site-id")
+	handler.updateConnectors(map[string]*v2alpha1.Connector{connector.Name: connector})
+	defer handler.monitor.Stop()
+
+	assert.Assert(t, handler.resolve())
+	assert.DeepEqual(t, handler.selected[connector.Name], []v2alpha1.ContainerDetails{
+		{ID: "backend-1-id", Name: "backend-1", IP: "10.88.0.2"},
+		{ID: "backend-2-id", Name: "backend-2", IP: "10.88.0.3"},
+	})
+	assert.Assert(t, handler.syncRouter())
+	assert.DeepEqual(t, agent.deleted, []string{stale.Name})
+	assert.Equal(t, len(agent.connectors), 3)
+	assert.DeepEqual(t, agent.connectors[unmanaged.Name], unmanaged)
+	for _, ip := range []string{"10.88.0.2", "10.88.0.3"} {
+		endpoint, ok := agent.connectors["backend@"+ip]
+		assert.Assert(t, ok, ip)
+		assert.Equal(t, endpoint.Host, ip)
+		assert.Equal(t, endpoint.Port, "8080")
+		assert.Equal(t, endpoint.Address, "backend")
+	}
+
+	// nothing changes while the same containers are selected
+	assert.Assert(t, handler.syncRouter())
+	assert.Equal(t, len(agent.created), 2)
+	assert.Equal(t, len(agent.deleted), 1)
+
+	// containers that stop are no longer targeted
+	lister.containers = lister.containers[1:]
+	assert.Assert(t, handler.resolve())
+	assert.Assert(t, handler.syncRouter())
+	assert.DeepEqual(t, agent.deleted, []string{stale.Name, "backend@10.88.0.3"})
+	assert.Equal(t, len(agent.connectors), 2)
+
+	// a changed selector is picked up when the connectors are reloaded
+	changed := connector.DeepCopy()
+	changed.Spec.Selector = "app=frontend"
+	handler.updateConnectors(map[string]*v2alpha1.Connector{changed.Name: changed})
+	assert.Assert(t, handler.resolve())
+	assert.Assert(t, handler.syncRouter())
+	assert.Equal(t, len(agent.connectors), 2)
+	_, ok := agent.connectors["backend@10.88.0.5"]
+	assert.Assert(t, ok)
+
+	// the router connectors of a removed connector are deleted
+	handler.updateConnectors(map[string]*v2alpha1.Connector{})
+	assert.Assert(t, handler.resolve())
+	assert.Assert(t, handler.syncRouter())
+	assert.Equal(t, len(agent.connectors), 1)
+	assert.DeepEqual(t, agent.connectors[unmanaged.Name], unmanaged)
+}
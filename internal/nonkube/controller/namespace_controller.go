@@ -37,6 +37,9 @@ func (w *NamespaceController) Start() {
 		routerConfigHandler := NewRouterConfigHandler(w.stopCh, w.ns)
 		routerStateHandler := NewRouterStateHandler(w.ns)
 		routerConfigHandler.AddCallback(routerStateHandler)
+		routerConfigHandler.AddCallback(NewConnectorHealthHandler(w.ns))
+		routerConfigHandler.AddCallback(NewContainerSelectorHandler(w.ns))
+		routerConfigHandler.AddCallback(NewConnectorTargetHandler(w.ns))
 		collectorLifecycleHandler := NewCollectorLifecycleHandler(w.ns)
 		routerStateHandler.SetCallback(collectorLifecycleHandler)
 		w.watcher.Add(api.GetInternalOutputPath(w.ns, api.RouterConfigPath), routerConfigHandler)
@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"sync"
+
+	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+)
+
+// runtimeSiteStateLocks holds a mutex per namespace, serializing the
+// updates that the handlers of a namespace make to its runtime site
+// state.
+var runtimeSiteStateLocks sync.Map
+
+// updateRuntimeSiteState loads the runtime site state of the
+// namespace, applies update to it and writes it back if update reports
+// a change. All handlers must record status through this function, so
+// that no update is lost to another made concurrently.
+func updateRuntimeSiteState(namespace string, update func(siteState *api.SiteState) bool) error {
+	value, _ := runtimeSiteStateLocks.LoadOrStore(namespace, &sync.Mutex{})
+	lock := value.(*sync.Mutex)
+	lock.Lock()
+	defer lock.Unlock()
+
+	runtimeSiteStatePath := api.GetInternalOutputPath(namespace, api.RuntimeSiteStatePath)
+	loader := &common.FileSystemSiteStateLoader{
+		Path: runtimeSiteStatePath,
+	}
+	siteState, err := loader.Load()
+	if err != nil {
+		return err
+	}
+	if !update(siteState) {
+		return nil
+	}
+	return api.MarshalSiteState(*siteState, runtimeSiteStatePath)
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/network"
+	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
+	"gotest.tools/v3/assert"
+)
+
+func TestUpdateRuntimeSiteStateConcurrently(t *testing.T) {
+	tempDir := t.TempDir()
+	if os.Getuid() == 0 {
+		api.DefaultRootDataHome = tempDir
+	} else {
+		t.Setenv("XDG_DATA_HOME", tempDir)
+	}
+	namespace := "test-update-runtime-site-state"
+	runtimePath := api.GetInternalOutputPath(namespace, api.RuntimeSiteStatePath)
+	assert.Assert(t, os.MkdirAll(runtimePath, 0755))
+	assert.Assert(t, api.MarshalSiteState(*fakeSiteState(), runtimePath))
+
+	// each updater adds its own target to those already recorded, so
+	// any update overwritten by another would leave a target missing
+	const updaters = 20
+	var wg sync.WaitGroup
+	for i := 0; i < updaters; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := updateRuntimeSiteState(namespace, func(siteState *api.SiteState) bool {
+				connector := siteState.Connectors["connector-one"]
+				// give the other updaters a chance to interleave
+				time.Sleep(time.Millisecond)
+				healthy := append(connector.Status.HealthyTargets, fmt.Sprintf("10.0.0.%d", i))
+				return connector.SetTargetHealth(healthy, nil)
+			})
+			assert.Check(t, err)
+		}()
+	}
+	// the network status handler records status in the same state
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		handler := NewNetworkStatusHandler(namespace)
+		handler.updateRuntimeSiteState(network.NetworkStatusInfo{})
+	}()
+	wg.Wait()
+
+	loader := &common.FileSystemSiteStateLoader{
+		Path: runtimePath,
+	}
+	siteState, err := loader.Load()
+	assert.Assert(t, err)
+	healthy := siteState.Connectors["connector-one"].Status.HealthyTargets
+	assert.Equal(t, len(healthy), updaters, "targets: %v", healthy)
+	for i := 0; i < updaters; i++ {
+		assert.Assert(t, slices.Contains(healthy, fmt.Sprintf("10.0.0.%d", i)))
+	}
+}
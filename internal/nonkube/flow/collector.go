@@ -12,6 +12,7 @@ import (
 	"github.com/skupperproject/skupper/internal/nonkube/client/fs"
 	"github.com/skupperproject/skupper/internal/nonkube/client/runtime"
 	"github.com/skupperproject/skupper/internal/nonkube/common"
+	"github.com/skupperproject/skupper/pkg/nonkube/api"
 	"github.com/skupperproject/skupper/pkg/vanflow/session"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -86,6 +87,7 @@ func siteCollector(ctx context.Context, namespace string) error {
 	}
 	statusSync := flow.NewStatusSync(factory, nil, statusSyncClient, types.NetworkStatusConfigMapName)
 	go statusSync.Run(ctx)
+	startProber(ctx, namespace, address, tlsConfig)
 	go func() {
 		<-ctx.Done()
 		_ = client.Delete(cm.Name, true)
@@ -93,6 +95,32 @@ func siteCollector(ctx context.Context, namespace string) error {
 	return nil
 }
 
+func startProber(ctx context.Context, namespace string, address string, tlsConfig *tls.Config) {
+	siteStateLoader := &common.FileSystemSiteStateLoader{
+		Path: api.GetInternalOutputPath(namespace, api.RuntimeSiteStatePath),
+	}
+	siteState, err := siteStateLoader.Load()
+	if err != nil {
+		slog.Error("site probing disabled: unable to load runtime site state", slog.Any("error", err))
+		return
+	}
+	site := siteState.Site
+	proberConfig, enabled, err := flow.ParseProberConfig(siteState.SiteId, site.Spec.GetProbeInterval(), site.Spec.GetProbeThroughputBytes())
+	if err != nil {
+		slog.Error("site probing disabled", slog.Any("error", err))
+		return
+	}
+	if !enabled {
+		return
+	}
+	factory := session.NewContainerFactory(address, session.ContainerConfig{
+		ContainerID: "nonkube-flow-prober",
+		TLSConfig:   tlsConfig,
+		SASLType:    session.SASLTypeExternal,
+	})
+	go flow.NewProber(factory, proberConfig).Run(ctx)
+}
+
 func getLocalTLSConfig(namespace string) (*tls.Config, error) {
 	tlsCert := runtime.GetRuntimeTlsCert(namespace, "skupper-local-client")
 	config, err := tlsCert.GetTlsConfig()
@@ -1102,6 +1102,7 @@ func asConnector(record Record) Connector {
 		RouteContainer: record.AsBool("routeContainer"),
 		VerifyHostname: record.AsBool("verifyHostname"),
 		SslProfile:     record.AsString("sslProfile"),
+		Cost:           int32(record.AsInt("cost")),
 	}
 }
 
@@ -1251,6 +1252,41 @@ func (a *Agent) GetLocalListeners() (map[string]Listener, error) {
 	return listeners, nil
 }
 
+func (a *Agent) UpdateAddressConfig(changes *AddressDifference) error {
+	for _, deleted := range changes.Deleted {
+		if err := a.Delete("io.skupper.router.router.config.address", deleted.Name); err != nil {
+			return fmt.Errorf("Error deleting addresses: %s", err)
+		}
+	}
+	for _, added := range changes.Added {
+		name := added.Name
+		if name == "" {
+			name = "address/" + added.Prefix
+		}
+		if err := a.Create("io.skupper.router.router.config.address", name, added); err != nil {
+			return fmt.Errorf("Error adding addresses: %s", err)
+		}
+	}
+	return nil
+}
+
+func (a *Agent) GetLocalAddresses() (map[string]Address, error) {
+	results, err := a.Query("io.skupper.router.router.config.address", []string{})
+	if err != nil {
+		return nil, err
+	}
+	addresses := map[string]Address{}
+	for _, record := range results {
+		address := Address{
+			Name:         record.AsString("name"),
+			Prefix:       record.AsString("prefix"),
+			Distribution: record.AsString("distribution"),
+		}
+		addresses[address.Prefix] = address
+	}
+	return addresses, nil
+}
+
 func (a *Agent) Request(request *Request) (*Response, error) {
 	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
 	defer cancel()
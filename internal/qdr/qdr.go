@@ -206,6 +206,34 @@ func (r *RouterConfig) AddAddress(a Address) {
 	r.Addresses[a.Prefix] = a
 }
 
+func (r *RouterConfig) RemoveAddress(prefix string) bool {
+	if _, ok := r.Addresses[prefix]; ok {
+		delete(r.Addresses, prefix)
+		return true
+	}
+	return false
+}
+
+// UpdateAddresses ensures that the addresses configured with the
+// specified distribution are exactly those in the desired map;
+// addresses with any other distribution are left untouched.
+func (r *RouterConfig) UpdateAddresses(distribution string, desired map[string]Address) bool {
+	changed := false
+	for prefix, address := range r.Addresses {
+		if _, ok := desired[prefix]; !ok && address.Distribution == distribution {
+			delete(r.Addresses, prefix)
+			changed = true
+		}
+	}
+	for prefix, address := range desired {
+		if actual, ok := r.Addresses[prefix]; !ok || actual.Distribution != address.Distribution {
+			r.Addresses[prefix] = address
+			changed = true
+		}
+	}
+	return changed
+}
+
 func (r *RouterConfig) AddTcpConnector(e TcpEndpoint) {
 	r.Bridges.AddTcpConnector(e)
 }
@@ -535,10 +563,25 @@ const (
 )
 
 type Address struct {
+	Name         string `json:"name,omitempty"`
 	Prefix       string `json:"prefix,omitempty"`
 	Distribution string `json:"distribution,omitempty"`
 }
 
+func (a Address) toRecord() Record {
+	result := map[string]any{}
+	if a.Name != "" {
+		result["name"] = a.Name
+	}
+	if a.Prefix != "" {
+		result["prefix"] = a.Prefix
+	}
+	if a.Distribution != "" {
+		result["distribution"] = a.Distribution
+	}
+	return result
+}
+
 type TcpEndpoint struct {
 	Name           string `json:"name,omitempty"`
 	Host           string `json:"host,omitempty"`
@@ -1036,14 +1079,25 @@ func (a *BridgeConfigDifference) Print() {
 	log.Printf("SslProfiles added=%v, deleted=%v", a.AddedSslProfiles, a.DeletedSSlProfiles)
 }
 
+// ConnectorsDifference returns the connectors to add to and delete
+// from the router to bring actual in line with desired. The router
+// cannot update a connector in place, so a change in cost is applied
+// by re-creating the connector, which drops the link and any flows
+// over it until it is re-established. To avoid that disruption for
+// small adjustments, a cost change is only applied once it exceeds a
+// quarter of the cost currently in effect.
 func ConnectorsDifference(actual map[string]Connector, desired *RouterConfig, ignorePrefix *string) *ConnectorDifference {
 	result := ConnectorDifference{}
 	result.AddedSslProfiles = make(map[string]SslProfile)
 	for key, v1 := range desired.Connectors {
-		_, ok := actual[key]
+		v2, ok := actual[key]
 		if !ok {
 			result.Added = append(result.Added, v1)
 			result.AddedSslProfiles[v1.SslProfile] = desired.SslProfiles[v1.SslProfile]
+		} else if costChanged(connectorCost(v2), connectorCost(v1)) {
+			result.Deleted = append(result.Deleted, v2)
+			result.Added = append(result.Added, v1)
+			result.AddedSslProfiles[v1.SslProfile] = desired.SslProfiles[v1.SslProfile]
 		}
 	}
 	for key, v1 := range actual {
@@ -1061,6 +1115,29 @@ func ConnectorsDifference(actual map[string]Connector, desired *RouterConfig, ig
 	return &result
 }
 
+// connectorCostThreshold is the inverse of the fraction of the current
+// cost by which a connector's cost must change for it to be re-created.
+const connectorCostThreshold = 4
+
+// connectorCost returns the cost the router applies to a connector,
+// which defaults to 1 when unset.
+func connectorCost(c Connector) int32 {
+	if c.Cost == 0 {
+		return 1
+	}
+	return c.Cost
+}
+
+// costChanged returns true if the difference between the current
+// and desired cost of a connector warrants re-creating it.
+func costChanged(current int32, desired int32) bool {
+	delta := desired - current
+	if delta < 0 {
+		delta = -delta
+	}
+	return delta > 0 && delta*connectorCostThreshold >= current
+}
+
 func (a *ConnectorDifference) Empty() bool {
 	return len(a.Deleted) == 0 && len(a.Added) == 0
 }
@@ -1070,6 +1147,38 @@ type ListenerDifference struct {
 	Added   []Listener
 }
 
+type AddressDifference struct {
+	Deleted []Address
+	Added   []Address
+}
+
+// AddressesDifference compares addresses by prefix; the name of an
+// actual address is retained so that it can be deleted, as the
+// router assigns names to addresses read from its config file.
+func AddressesDifference(actual map[string]Address, desired map[string]Address) *AddressDifference {
+	result := AddressDifference{}
+	for prefix, desiredValue := range desired {
+		if actualValue, ok := actual[prefix]; ok {
+			if actualValue.Distribution != desiredValue.Distribution {
+				result.Deleted = append(result.Deleted, actualValue)
+				result.Added = append(result.Added, desiredValue)
+			}
+		} else {
+			result.Added = append(result.Added, desiredValue)
+		}
+	}
+	for prefix, actualValue := range actual {
+		if _, ok := desired[prefix]; !ok {
+			result.Deleted = append(result.Deleted, actualValue)
+		}
+	}
+	return &result
+}
+
+func (a *AddressDifference) Empty() bool {
+	return len(a.Deleted) == 0 && len(a.Added) == 0
+}
+
 func (desired Listener) Equivalent(actual Listener) bool {
 	return desired.Name == actual.Name &&
 		desired.Role == actual.Role &&
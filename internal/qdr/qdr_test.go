@@ -3,6 +3,7 @@ package qdr
 import (
 	"encoding/json"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/skupperproject/skupper/api/types"
@@ -164,6 +165,82 @@ func TestAddAddress(t *testing.T) {
 	}
 }
 
+func TestUpdateAddresses(t *testing.T) {
+	config := InitialConfig("foo", "bar", "undefined", true, 3)
+	config.AddAddress(Address{
+		Prefix:       "mc",
+		Distribution: DistributionMulticast,
+	})
+	config.AddAddress(Address{
+		Prefix:       "stale",
+		Distribution: DistributionClosest,
+	})
+	assert.Assert(t, config.UpdateAddresses(DistributionClosest, map[string]Address{
+		"echo": {Prefix: "echo", Distribution: DistributionClosest},
+	}))
+	assert.DeepEqual(t, config.Addresses, map[string]Address{
+		"mc":   {Prefix: "mc", Distribution: DistributionMulticast},
+		"echo": {Prefix: "echo", Distribution: DistributionClosest},
+	})
+	assert.Assert(t, !config.UpdateAddresses(DistributionClosest, map[string]Address{
+		"echo": {Prefix: "echo", Distribution: DistributionClosest},
+	}))
+	assert.Assert(t, config.RemoveAddress("echo"))
+	assert.Assert(t, !config.RemoveAddress("echo"))
+}
+
+func TestAddressesDifference(t *testing.T) {
+	actual := map[string]Address{
+		"mc":    {Name: "address/0", Prefix: "mc", Distribution: DistributionMulticast},
+		"stale": {Name: "address/1", Prefix: "stale", Distribution: DistributionClosest},
+		"echo":  {Name: "address/2", Prefix: "echo", Distribution: DistributionMulticast},
+	}
+	desired := map[string]Address{
+		"mc":   {Prefix: "mc", Distribution: DistributionMulticast},
+		"echo": {Prefix: "echo", Distribution: DistributionClosest},
+		"new":  {Prefix: "new", Distribution: DistributionClosest},
+	}
+	diff := AddressesDifference(actual, desired)
+	sort.Slice(diff.Deleted, func(i, j int) bool { return diff.Deleted[i].Name < diff.Deleted[j].Name })
+	sort.Slice(diff.Added, func(i, j int) bool { return diff.Added[i].Prefix < diff.Added[j].Prefix })
+	assert.DeepEqual(t, diff.Deleted, []Address{actual["stale"], actual["echo"]})
+	assert.DeepEqual(t, diff.Added, []Address{desired["echo"], desired["new"]})
+	assert.Assert(t, AddressesDifference(desired, desired).Empty())
+}
+
+func TestConnectorsDifferenceCost(t *testing.T) {
+	// actual connectors as read back from the router management agent
+	actual := map[string]Connector{
+		"link1":       asConnector(Record{"name": "link1", "host": "a.example.com", "port": "55671", "sslProfile": "link1-profile", "cost": 1}),
+		"link2":       asConnector(Record{"name": "link2", "host": "b.example.com", "port": "55671", "sslProfile": "link2-profile", "cost": 1}),
+		"auto-mesh/x": asConnector(Record{"name": "auto-mesh/x", "host": "c.example.com", "port": "55671", "cost": 1}),
+	}
+	desired := InitialConfig("foo", "bar", "undefined", false, 3)
+	desired.AddSslProfile(SslProfile{Name: "link1-profile"})
+	desired.AddSslProfile(SslProfile{Name: "link2-profile"})
+	// unset cost matches the router default
+	desired.AddConnector(Connector{Name: "link1", Host: "a.example.com", Port: "55671", SslProfile: "link1-profile"})
+	desired.AddConnector(Connector{Name: "link2", Host: "b.example.com", Port: "55671", SslProfile: "link2-profile", Cost: 5})
+
+	ignorePrefix := "auto-mesh"
+	diff := ConnectorsDifference(actual, &desired, &ignorePrefix)
+	assert.DeepEqual(t, diff.Deleted, []Connector{actual["link2"]})
+	assert.DeepEqual(t, diff.Added, []Connector{desired.Connectors["link2"]})
+	assert.Equal(t, diff.Added[0].Cost, int32(5))
+	assert.DeepEqual(t, diff.AddedSslProfiles, map[string]SslProfile{"link2-profile": desired.SslProfiles["link2-profile"]})
+
+	actual["link2"] = asConnector(Record{"name": "link2", "host": "b.example.com", "port": "55671", "sslProfile": "link2-profile", "cost": 5})
+	assert.Assert(t, ConnectorsDifference(actual, &desired, &ignorePrefix).Empty())
+
+	// changes within a quarter of the current cost keep the connector
+	desired.AddConnector(Connector{Name: "link2", Host: "b.example.com", Port: "55671", SslProfile: "link2-profile", Cost: 6})
+	assert.Assert(t, ConnectorsDifference(actual, &desired, &ignorePrefix).Empty())
+	desired.AddConnector(Connector{Name: "link2", Host: "b.example.com", Port: "55671", SslProfile: "link2-profile", Cost: 4})
+	assert.Assert(t, ConnectorsDifference(actual, &desired, &ignorePrefix).Empty())
+	desired.AddConnector(Connector{Name: "link2", Host: "b.example.com", Port: "55671", SslProfile: "link2-profile", Cost: 7})
+	assert.Equal(t, len(ConnectorsDifference(actual, &desired, &ignorePrefix).Added), 1)
+}
+
 func TestMarshalUnmarshalRouterConfig(t *testing.T) {
 	verifyHostName := new(bool)
 	*verifyHostName = false
@@ -0,0 +1,105 @@
+package site
+
+import (
+	"fmt"
+
+	"github.com/skupperproject/skupper/internal/qdr"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+// Balancing policies that may be requested by a Listener.
+const (
+	BalancingBalanced = "balanced"
+	BalancingClosest  = "closest"
+	BalancingWeighted = "weighted"
+)
+
+// Locality preferences that may be requested by a Connector.
+const (
+	LocalityAny         = "any"
+	LocalityPreferLocal = "prefer-local"
+)
+
+// closestAddresses returns the addresses requiring closest
+// distribution. The router distributes traffic for an address on a
+// per router basis, either balancing across all reachable consumers
+// or favouring the closest. Any listener requesting closest, or any
+// connector preferring local handling, therefore results in closest
+// distribution for the routing key on this site. The router has no
+// notion of relative weights, so those cannot be honoured.
+func (b *Bindings) closestAddresses() map[string]qdr.Address {
+	addresses := map[string]qdr.Address{}
+	for _, l := range b.listeners {
+		if l.Spec.Balancing == BalancingClosest {
+			addresses[l.Spec.RoutingKey] = qdr.Address{
+				Prefix:       l.Spec.RoutingKey,
+				Distribution: qdr.DistributionClosest,
+			}
+		}
+	}
+	for _, c := range b.connectors {
+		if c.Spec.Locality == LocalityPreferLocal {
+			addresses[c.Spec.RoutingKey] = qdr.Address{
+				Prefix:       c.Spec.RoutingKey,
+				Distribution: qdr.DistributionClosest,
+			}
+		}
+	}
+	return addresses
+}
+
+func (b *Bindings) effectiveBalancing(routingKey string) string {
+	if _, ok := b.closestAddresses()[routingKey]; ok {
+		return BalancingClosest
+	}
+	return BalancingBalanced
+}
+
+// UpdateAddresses configures the distribution for any routing key
+// that requires other than the default, balanced, distribution.
+func (b *Bindings) UpdateAddresses(config *qdr.RouterConfig) bool {
+	return config.UpdateAddresses(qdr.DistributionClosest, b.closestAddresses())
+}
+
+// ListenerBalancing returns the balancing policy in effect for the
+// supplied listener and a condition indicating whether that policy
+// is the one that was requested.
+func (b *Bindings) ListenerBalancing(listener *skupperv2alpha1.Listener) (string, skupperv2alpha1.ConditionState) {
+	effective := b.effectiveBalancing(listener.Spec.RoutingKey)
+	switch listener.Spec.Balancing {
+	case "":
+	case BalancingBalanced:
+		if effective != BalancingBalanced {
+			return effective, skupperv2alpha1.ErrorCondition(fmt.Errorf("Routing key %q requires %s distribution on this site; balanced distribution cannot be used", listener.Spec.RoutingKey, effective))
+		}
+	case BalancingClosest:
+	case BalancingWeighted:
+		return effective, skupperv2alpha1.ErrorCondition(fmt.Errorf("Weighted distribution is not supported by the router; using %s", effective))
+	default:
+		return effective, skupperv2alpha1.ErrorCondition(fmt.Errorf("Invalid balancing policy %q; using %s", listener.Spec.Balancing, effective))
+	}
+	return effective, skupperv2alpha1.ReadyCondition()
+}
+
+// ConnectorBalancing returns the balancing policy in effect for the
+// supplied connector and a condition indicating whether its weight
+// and locality preference could be honoured.
+func (b *Bindings) ConnectorBalancing(connector *skupperv2alpha1.Connector) (string, skupperv2alpha1.ConditionState) {
+	effective := b.effectiveBalancing(connector.Spec.RoutingKey)
+	switch connector.Spec.Locality {
+	case "":
+	case LocalityAny:
+		if effective != BalancingBalanced {
+			return effective, skupperv2alpha1.ErrorCondition(fmt.Errorf("Routing key %q requires %s distribution on this site; locality preference %q cannot be used", connector.Spec.RoutingKey, effective, LocalityAny))
+		}
+	case LocalityPreferLocal:
+	default:
+		return effective, skupperv2alpha1.ErrorCondition(fmt.Errorf("Invalid locality preference %q; using %s", connector.Spec.Locality, effective))
+	}
+	if connector.Spec.Weight < 0 {
+		return effective, skupperv2alpha1.ErrorCondition(fmt.Errorf("Invalid weight %d; using %s", connector.Spec.Weight, effective))
+	} else if connector.Spec.Weight > 0 {
+		return effective, skupperv2alpha1.ErrorCondition(fmt.Errorf("Weighted distribution is not supported by the router; weight ignored, using %s", effective))
+	}
+	return effective, skupperv2alpha1.ReadyCondition()
+}
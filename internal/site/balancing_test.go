@@ -0,0 +1,180 @@
+package site
+
+import (
+	"testing"
+
+	"github.com/skupperproject/skupper/internal/qdr"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"gotest.tools/v3/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBindings_Balancing(t *testing.T) {
+	tests := []struct {
+		name               string
+		listeners          []*skupperv2alpha1.Listener
+		connectors         []*skupperv2alpha1.Connector
+		addresses          map[string]qdr.Address
+		listenerBalancing  map[string]string
+		listenerErrors     map[string]string
+		connectorBalancing map[string]string
+		connectorErrors    map[string]string
+	}{
+		{
+			name: "default",
+			listeners: []*skupperv2alpha1.Listener{
+				listener("l1", "echo", ""),
+			},
+			connectors: []*skupperv2alpha1.Connector{
+				connector("c1", "echo", 0, ""),
+			},
+			addresses: map[string]qdr.Address{},
+			listenerBalancing: map[string]string{
+				"l1": BalancingBalanced,
+			},
+			connectorBalancing: map[string]string{
+				"c1": BalancingBalanced,
+			},
+		},
+		{
+			name: "listener requests closest",
+			listeners: []*skupperv2alpha1.Listener{
+				listener("l1", "echo", BalancingClosest),
+				listener("l2", "echo", BalancingBalanced),
+			},
+			connectors: []*skupperv2alpha1.Connector{
+				connector("c1", "echo", 0, LocalityAny),
+			},
+			addresses: map[string]qdr.Address{
+				"echo": {Prefix: "echo", Distribution: qdr.DistributionClosest},
+			},
+			listenerBalancing: map[string]string{
+				"l1": BalancingClosest,
+				"l2": BalancingClosest,
+			},
+			listenerErrors: map[string]string{
+				"l2": `Routing key "echo" requires closest distribution on this site; balanced distribution cannot be used`,
+			},
+			connectorBalancing: map[string]string{
+				"c1": BalancingClosest,
+			},
+			connectorErrors: map[string]string{
+				"c1": `Routing key "echo" requires closest distribution on this site; locality preference "any" cannot be used`,
+			},
+		},
+		{
+			name: "connector prefers local",
+			listeners: []*skupperv2alpha1.Listener{
+				listener("l1", "echo", ""),
+				listener("l2", "other", ""),
+			},
+			connectors: []*skupperv2alpha1.Connector{
+				connector("c1", "echo", 0, LocalityPreferLocal),
+			},
+			addresses: map[string]qdr.Address{
+				"echo": {Prefix: "echo", Distribution: qdr.DistributionClosest},
+			},
+			listenerBalancing: map[string]string{
+				"l1": BalancingClosest,
+				"l2": BalancingBalanced,
+			},
+			connectorBalancing: map[string]string{
+				"c1": BalancingClosest,
+			},
+		},
+		{
+			name: "weights not supported",
+			listeners: []*skupperv2alpha1.Listener{
+				listener("l1", "echo", BalancingWeighted),
+			},
+			connectors: []*skupperv2alpha1.Connector{
+				connector("c1", "echo", 90, ""),
+				connector("c2", "echo", -1, ""),
+				connector("c3", "echo", 0, "nearby"),
+			},
+			addresses: map[string]qdr.Address{},
+			listenerBalancing: map[string]string{
+				"l1": BalancingBalanced,
+			},
+			listenerErrors: map[string]string{
+				"l1": "Weighted distribution is not supported by the router; using balanced",
+			},
+			connectorBalancing: map[string]string{
+				"c1": BalancingBalanced,
+				"c2": BalancingBalanced,
+				"c3": BalancingBalanced,
+			},
+			connectorErrors: map[string]string{
+				"c1": "Weighted distribution is not supported by the router; weight ignored, using balanced",
+				"c2": "Invalid weight -1; using balanced",
+				"c3": `Invalid locality preference "nearby"; using balanced`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBindings("")
+			for _, l := range tt.listeners {
+				b.UpdateListener(l.Name, l)
+			}
+			for _, c := range tt.connectors {
+				b.UpdateConnector(c.Name, c)
+			}
+			config := qdr.InitialConfig("foo", "bar", "undefined", false, 3)
+			config.AddAddress(qdr.Address{Prefix: "stale", Distribution: qdr.DistributionClosest})
+			b.Apply(&config)
+			assert.DeepEqual(t, config.Addresses, tt.addresses)
+			for _, l := range tt.listeners {
+				effective, state := b.ListenerBalancing(l)
+				assert.Equal(t, effective, tt.listenerBalancing[l.Name])
+				if message, ok := tt.listenerErrors[l.Name]; ok {
+					assert.Equal(t, state.Reason, skupperv2alpha1.StatusError)
+					assert.Equal(t, state.Message, message)
+				} else {
+					assert.Equal(t, state, skupperv2alpha1.ReadyCondition())
+				}
+			}
+			for _, c := range tt.connectors {
+				effective, state := b.ConnectorBalancing(c)
+				assert.Equal(t, effective, tt.connectorBalancing[c.Name])
+				if message, ok := tt.connectorErrors[c.Name]; ok {
+					assert.Equal(t, state.Reason, skupperv2alpha1.StatusError)
+					assert.Equal(t, state.Message, message)
+				} else {
+					assert.Equal(t, state, skupperv2alpha1.ReadyCondition())
+				}
+			}
+		})
+	}
+}
+
+func listener(name string, routingKey string, balancing string) *skupperv2alpha1.Listener {
+	return &skupperv2alpha1.Listener{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+		},
+		Spec: skupperv2alpha1.ListenerSpec{
+			RoutingKey: routingKey,
+			Host:       name,
+			Port:       8080,
+			Balancing:  balancing,
+		},
+	}
+}
+
+func connector(name string, routingKey string, weight int, locality string) *skupperv2alpha1.Connector {
+	return &skupperv2alpha1.Connector{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+		},
+		Spec: skupperv2alpha1.ConnectorSpec{
+			RoutingKey: routingKey,
+			Host:       name,
+			Port:       8080,
+			Weight:     weight,
+			Locality:   locality,
+		},
+	}
+}
@@ -2,6 +2,8 @@ package site
 
 import (
 	"reflect"
+	"slices"
+	"strconv"
 
 	"github.com/skupperproject/skupper/internal/qdr"
 	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
@@ -26,6 +28,8 @@ type Bindings struct {
 	connectors  map[string]*skupperv2alpha1.Connector
 	listeners   map[string]*skupperv2alpha1.Listener
 	handler     BindingEventHandler
+	health      *HealthMonitor
+	resolver    *TargetResolver
 	configure   struct {
 		listener  ListenerConfiguration
 		connector ConnectorConfiguration
@@ -65,6 +69,61 @@ func (b *Bindings) SetBindingEventHandler(handler BindingEventHandler) {
 	}
 }
 
+// SetHealthMonitor enables the health checks requested by
+// connectors. Targets failing their check are left out of the bridge
+// configuration.
+func (b *Bindings) SetHealthMonitor(monitor *HealthMonitor) {
+	b.health = monitor
+}
+
+// TargetHealth returns the healthy and unhealthy targets of the named
+// connector, if its health is being checked.
+func (b *Bindings) TargetHealth(name string) ([]string, []string) {
+	if b.health == nil {
+		return nil, nil
+	}
+	return b.health.Targets(name)
+}
+
+// SetTargetResolver enables the resolution of the SRV records of
+// connectors. Without a resolver, such connectors have no targets.
+func (b *Bindings) SetTargetResolver(resolver *TargetResolver) {
+	b.resolver = resolver
+	for _, c := range b.connectors {
+		resolver.Resolve(c)
+	}
+}
+
+// ConnectorTargets returns the current targets of the named connector,
+// if it has multiple hosts or an SRV record.
+func (b *Bindings) ConnectorTargets(name string) []Target {
+	connector, ok := b.connectors[name]
+	if !ok {
+		return nil
+	}
+	if connector.Spec.SrvRecord != "" {
+		if b.resolver == nil {
+			return nil
+		}
+		return b.resolver.Targets(name)
+	}
+	targets, err := ParseHosts(connector)
+	if err != nil {
+		return nil
+	}
+	return targets
+}
+
+// ResolvedTargets returns the targets of the named connector as
+// host:port strings.
+func (b *Bindings) ResolvedTargets(name string) []string {
+	var resolved []string
+	for _, target := range b.ConnectorTargets(name) {
+		resolved = append(resolved, target.String())
+	}
+	return resolved
+}
+
 func (b *Bindings) Map(cf ConnectorFunction, lf ListenerFunction) {
 	if cf != nil {
 		for key, connector := range b.connectors {
@@ -107,6 +166,9 @@ func (b *Bindings) updateConnector(connector *skupperv2alpha1.Connector) qdr.Con
 	name := connector.ObjectMeta.Name
 	existing, ok := b.connectors[name]
 	b.connectors[name] = connector // always update pointer, even if spec has not changed
+	if b.resolver != nil {
+		b.resolver.Resolve(connector)
+	}
 	if ok && reflect.DeepEqual(existing.Spec, connector.Spec) {
 		return nil
 	}
@@ -119,6 +181,12 @@ func (b *Bindings) updateConnector(connector *skupperv2alpha1.Connector) qdr.Con
 func (b *Bindings) deleteConnector(name string) qdr.ConfigUpdate {
 	if existing, ok := b.connectors[name]; ok {
 		delete(b.connectors, name)
+		if b.health != nil {
+			b.health.Forget(name)
+		}
+		if b.resolver != nil {
+			b.resolver.Forget(name)
+		}
 		if b.handler != nil {
 			b.handler.ConnectorDeleted(existing)
 		}
@@ -165,7 +233,11 @@ func (b *Bindings) ToBridgeConfig() qdr.BridgeConfig {
 		TcpConnectors: qdr.TcpEndpointMap{},
 	}
 	for _, c := range b.connectors {
-		b.configure.connector(b.SiteId, c, &config)
+		if b.health != nil {
+			b.configureCheckedConnector(c, &config)
+		} else {
+			b.configureConnector(c, &config)
+		}
 	}
 	for _, l := range b.listeners {
 		b.configure.listener(b.SiteId, l, &config)
@@ -174,6 +246,44 @@ func (b *Bindings) ToBridgeConfig() qdr.BridgeConfig {
 	return config
 }
 
+// configureConnector adds the bridge configuration for the connector,
+// with one tcpConnector per target if it has multiple hosts or an SRV
+// record. Connectors whose hosts are invalid have no targets.
+func (b *Bindings) configureConnector(connector *skupperv2alpha1.Connector, config *qdr.BridgeConfig) {
+	if !HasMultipleTargets(connector) {
+		b.configure.connector(b.SiteId, connector, config)
+		return
+	}
+	for _, target := range b.ConnectorTargets(connector.Name) {
+		UpdateBridgeConfigForConnectorToTarget(b.SiteId, connector, target, config)
+	}
+}
+
+// configureCheckedConnector adds the bridge configuration for those
+// targets of the connector that are not failing their health check,
+// and lets the health monitor know what the targets are.
+func (b *Bindings) configureCheckedConnector(connector *skupperv2alpha1.Connector, config *qdr.BridgeConfig) {
+	desired := qdr.NewBridgeConfig()
+	b.configureConnector(connector, &desired)
+	var targets []Target
+	for _, endpoint := range desired.TcpConnectors {
+		if target := endpointTarget(endpoint); !slices.Contains(targets, target) {
+			targets = append(targets, target)
+		}
+	}
+	b.health.Monitor(connector, targets)
+	for _, endpoint := range desired.TcpConnectors {
+		if !b.health.Unhealthy(connector.Name, endpointTarget(endpoint)) {
+			config.AddTcpConnector(endpoint)
+		}
+	}
+}
+
+func endpointTarget(endpoint qdr.TcpEndpoint) Target {
+	port, _ := strconv.Atoi(endpoint.Port)
+	return Target{Host: endpoint.Host, Port: port}
+}
+
 func (b *Bindings) AddSslProfiles(config *qdr.RouterConfig) bool {
 	profiles := map[string]qdr.SslProfile{}
 	for _, c := range b.connectors {
@@ -209,6 +319,7 @@ func (b *Bindings) AddSslProfiles(config *qdr.RouterConfig) bool {
 func (b *Bindings) Apply(config *qdr.RouterConfig) bool {
 	b.AddSslProfiles(config)
 	config.UpdateBridgeConfig(b.ToBridgeConfig())
+	b.UpdateAddresses(config)
 	config.RemoveUnreferencedSslProfiles()
 	return true //TODO: can optimise by indicating if no change was required
 }
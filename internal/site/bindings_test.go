@@ -1,6 +1,9 @@
 package site
 
 import (
+	"context"
+	"errors"
+	"net"
 	"testing"
 
 	"github.com/skupperproject/skupper/internal/qdr"
@@ -980,3 +983,103 @@ func (h *TestBindingEventHandler) ConnectorUpdated(connector *skupperv2alpha1.Co
 func (h *TestBindingEventHandler) ConnectorDeleted(connector *skupperv2alpha1.Connector) {
 	h.connector.deleted(connector.Name)
 }
+
+func TestBindings_HealthCheckedConnector(t *testing.T) {
+	changes := 0
+	monitor := NewHealthMonitor(func(connector string) {
+		changes++
+	})
+	defer monitor.Stop()
+	monitor.probe = func(ctx context.Context, check skupperv2alpha1.HealthCheck, host string, port int) error {
+		if host == "10.0.0.2" {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+	pods := []skupperv2alpha1.PodDetails{
+		{Name: "backend-1", IP: "10.0.0.1"},
+		{Name: "backend-2", IP: "10.0.0.2"},
+	}
+	b := NewBindings("")
+	b.SetSiteId("site-1")
+	b.SetHealthMonitor(monitor)
+	b.SetConnectorConfiguration(func(siteId string, connector *skupperv2alpha1.Connector, config *qdr.BridgeConfig) {
+		for _, pod := range pods {
+			UpdateBridgeConfigForConnectorToPod(siteId, connector, pod, true, config)
+		}
+	})
+	connector := healthCheckedConnector(&skupperv2alpha1.HealthCheck{
+		PeriodSeconds:    3600,
+		FailureThreshold: 1,
+	})
+	b.UpdateConnector(connector.Name, connector)
+
+	config := b.ToBridgeConfig()
+	assert.Equal(t, len(config.TcpConnectors), 4)
+	assert.Equal(t, changes, 1)
+
+	monitor.check(context.Background(), connector.Name)
+	assert.Equal(t, changes, 2)
+	config = b.ToBridgeConfig()
+	assert.Equal(t, len(config.TcpConnectors), 2)
+	_, ok := config.TcpConnectors["backend@10.0.0.1"]
+	assert.Assert(t, ok)
+	_, ok = config.TcpConnectors["backend@backend-1"]
+	assert.Assert(t, ok)
+	healthy, unhealthy := b.TargetHealth(connector.Name)
+	assert.DeepEqual(t, healthy, []string{"10.0.0.1:8080"})
+	assert.DeepEqual(t, unhealthy, []string{"10.0.0.2:8080"})
+
+	b.UpdateConnector(connector.Name, nil)
+	healthy, unhealthy = b.TargetHealth(connector.Name)
+	assert.Assert(t, healthy == nil && unhealthy == nil)
+}
+
+func TestBindings_MultipleTargetConnector(t *testing.T) {
+	b := NewBindings("")
+	b.SetSiteId("site-1")
+	connector := multiTargetConnector(skupperv2alpha1.ConnectorSpec{
+		Hosts: []string{"10.0.0.2", "10.0.0.1:9090"},
+		Port:  8080,
+	})
+	b.UpdateConnector(connector.Name, connector)
+
+	config := b.ToBridgeConfig()
+	assert.Equal(t, len(config.TcpConnectors), 2)
+	endpoint, ok := config.TcpConnectors["backend@10.0.0.1:9090"]
+	assert.Assert(t, ok)
+	assert.Equal(t, endpoint.Host, "10.0.0.1")
+	assert.Equal(t, endpoint.Port, "9090")
+	assert.Equal(t, endpoint.Address, "backend")
+	endpoint, ok = config.TcpConnectors["backend@10.0.0.2:8080"]
+	assert.Assert(t, ok)
+	assert.Equal(t, endpoint.Port, "8080")
+	assert.DeepEqual(t, b.ResolvedTargets(connector.Name), []string{"10.0.0.1:9090", "10.0.0.2:8080"})
+
+	// an SRV record has no targets until resolved
+	srv := multiTargetConnector(skupperv2alpha1.ConnectorSpec{SrvRecord: "_backend._tcp.example.com"})
+	b.UpdateConnector(srv.Name, srv)
+	config = b.ToBridgeConfig()
+	assert.Equal(t, len(config.TcpConnectors), 0)
+
+	resolved := make(chan string, 1)
+	resolver := NewTargetResolver(func(connector string) {
+		resolved <- connector
+	})
+	defer resolver.Stop()
+	resolver.lookup = func(ctx context.Context, name string) ([]*net.SRV, error) {
+		return []*net.SRV{{Target: "a.example.com.", Port: 8443}}, nil
+	}
+	b.SetTargetResolver(resolver)
+	assert.Equal(t, <-resolved, srv.Name)
+	config = b.ToBridgeConfig()
+	assert.Equal(t, len(config.TcpConnectors), 1)
+	endpoint, ok = config.TcpConnectors["backend@a.example.com:8443"]
+	assert.Assert(t, ok)
+	assert.Equal(t, endpoint.Host, "a.example.com")
+	assert.DeepEqual(t, b.ResolvedTargets(srv.Name), []string{"a.example.com:8443"})
+
+	b.UpdateConnector(srv.Name, nil)
+	assert.Assert(t, resolver.Targets(srv.Name) == nil)
+	assert.Assert(t, b.ResolvedTargets(srv.Name) == nil)
+}
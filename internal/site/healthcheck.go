@@ -0,0 +1,375 @@
+package site
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+// Health check types that may be requested by a Connector.
+const (
+	HealthCheckTCP  = "tcp"
+	HealthCheckHTTP = "http"
+)
+
+const (
+	defaultHealthCheckPeriod           = 10 * time.Second
+	defaultHealthCheckTimeout          = 3 * time.Second
+	defaultHealthCheckFailureThreshold = 3
+	defaultHealthCheckSuccessThreshold = 1
+)
+
+// ValidateHealthCheck returns an error if the supplied health check
+// cannot be run.
+func ValidateHealthCheck(check *skupperv2alpha1.HealthCheck) error {
+	if check == nil {
+		return nil
+	}
+	switch check.Type {
+	case "", HealthCheckTCP:
+		if check.Path != "" || check.Scheme != "" {
+			return fmt.Errorf("invalid health check: path and scheme only apply to %s checks", HealthCheckHTTP)
+		}
+	case HealthCheckHTTP:
+		if check.Scheme != "" && check.Scheme != "http" && check.Scheme != "https" {
+			return fmt.Errorf("invalid health check scheme %q: must be http or https", check.Scheme)
+		}
+	default:
+		return fmt.Errorf("invalid health check type %q: must be %s or %s", check.Type, HealthCheckTCP, HealthCheckHTTP)
+	}
+	if check.Port < 0 || check.Port > 65535 {
+		return fmt.Errorf("invalid health check port %d", check.Port)
+	}
+	if check.PeriodSeconds < 0 || check.TimeoutSeconds < 0 || check.FailureThreshold < 0 || check.SuccessThreshold < 0 {
+		return fmt.Errorf("invalid health check: period, timeout and thresholds must not be negative")
+	}
+	return nil
+}
+
+// healthCheckConfig is a health check with defaults applied. Targets
+// are checked on their own port unless port is set.
+type healthCheckConfig struct {
+	check            skupperv2alpha1.HealthCheck
+	port             int
+	period           time.Duration
+	timeout          time.Duration
+	failureThreshold int
+	successThreshold int
+}
+
+func newHealthCheckConfig(connector *skupperv2alpha1.Connector) healthCheckConfig {
+	check := *connector.Spec.HealthCheck
+	config := healthCheckConfig{
+		check:            check,
+		port:             check.Port,
+		period:           defaultHealthCheckPeriod,
+		timeout:          defaultHealthCheckTimeout,
+		failureThreshold: defaultHealthCheckFailureThreshold,
+		successThreshold: defaultHealthCheckSuccessThreshold,
+	}
+	if check.PeriodSeconds > 0 {
+		config.period = time.Duration(check.PeriodSeconds) * time.Second
+	}
+	if check.TimeoutSeconds > 0 {
+		config.timeout = time.Duration(check.TimeoutSeconds) * time.Second
+	}
+	if config.timeout > config.period {
+		config.timeout = config.period
+	}
+	if check.FailureThreshold > 0 {
+		config.failureThreshold = check.FailureThreshold
+	}
+	if check.SuccessThreshold > 0 {
+		config.successThreshold = check.SuccessThreshold
+	}
+	return config
+}
+
+// Prober checks a single target of a connector, returning an error if
+// the target is not healthy.
+type Prober func(ctx context.Context, check skupperv2alpha1.HealthCheck, host string, port int) error
+
+// ProbeTarget opens a connection to the target and, for http checks,
+// issues a GET request, which must receive a 2xx or 3xx response.
+// Certificates presented by https targets are not verified.
+func ProbeTarget(ctx context.Context, check skupperv2alpha1.HealthCheck, host string, port int) error {
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+	if check.Type != HealthCheckHTTP {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+	scheme := check.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	path := check.Path
+	if path == "" || path[0] != '/' {
+		path = "/" + path
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, scheme+"://"+address+path, nil)
+	if err != nil {
+		return err
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+			DisableKeepAlives: true,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 400 {
+		return fmt.Errorf("unexpected response: %s", response.Status)
+	}
+	return nil
+}
+
+type targetHealth struct {
+	healthy   bool
+	failures  int
+	successes int
+}
+
+type connectorHealth struct {
+	config  healthCheckConfig
+	targets map[Target]*targetHealth
+	cancel  context.CancelFunc
+}
+
+// HealthMonitor runs the health checks requested by connectors
+// against each of their targets. Targets start out healthy and are
+// marked unhealthy once they fail the configured number of
+// consecutive checks.
+type HealthMonitor struct {
+	lock       sync.Mutex
+	connectors map[string]*connectorHealth
+	probe      Prober
+	changed    func(connector string)
+	logger     *slog.Logger
+}
+
+// NewHealthMonitor returns a monitor that calls changed whenever the
+// targets of a connector, or their health, change. The callback may
+// be invoked from any goroutine and must not block.
+func NewHealthMonitor(changed func(connector string)) *HealthMonitor {
+	return &HealthMonitor{
+		connectors: map[string]*connectorHealth{},
+		probe:      ProbeTarget,
+		changed:    changed,
+		logger: slog.New(slog.Default().Handler()).With(
+			slog.String("component", "site.healthcheck"),
+		),
+	}
+}
+
+// Monitor starts, updates or stops the health check for the supplied
+// connector, which currently has the supplied targets.
+func (m *HealthMonitor) Monitor(connector *skupperv2alpha1.Connector, targets []Target) {
+	name := connector.Name
+	if connector.Spec.HealthCheck == nil {
+		m.Forget(name)
+		return
+	}
+	if err := ValidateHealthCheck(connector.Spec.HealthCheck); err != nil {
+		m.logger.Error("Not checking health of connector targets",
+			slog.String("namespace", connector.Namespace),
+			slog.String("name", name),
+			slog.Any("error", err))
+		m.Forget(name)
+		return
+	}
+	config := newHealthCheckConfig(connector)
+	m.lock.Lock()
+	existing, ok := m.connectors[name]
+	if ok && existing.config != config {
+		existing.cancel()
+		ok = false
+	}
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		existing = &connectorHealth{
+			config:  config,
+			targets: map[Target]*targetHealth{},
+			cancel:  cancel,
+		}
+		m.connectors[name] = existing
+		go m.run(ctx, name, config.period)
+	}
+	changed := false
+	for _, target := range targets {
+		if _, ok := existing.targets[target]; !ok {
+			existing.targets[target] = &targetHealth{healthy: true}
+			changed = true
+		}
+	}
+	for target := range existing.targets {
+		if !slices.Contains(targets, target) {
+			delete(existing.targets, target)
+			changed = true
+		}
+	}
+	m.lock.Unlock()
+	if changed {
+		m.changed(name)
+	}
+}
+
+// Forget stops any health check for the named connector.
+func (m *HealthMonitor) Forget(name string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if existing, ok := m.connectors[name]; ok {
+		existing.cancel()
+		delete(m.connectors, name)
+	}
+}
+
+// Stop stops all health checks.
+func (m *HealthMonitor) Stop() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for name, existing := range m.connectors {
+		existing.cancel()
+		delete(m.connectors, name)
+	}
+}
+
+// Unhealthy indicates whether the target of the named connector is
+// currently failing its health check.
+func (m *HealthMonitor) Unhealthy(connector string, target Target) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if existing, ok := m.connectors[connector]; ok {
+		if health, ok := existing.targets[target]; ok {
+			return !health.healthy
+		}
+	}
+	return false
+}
+
+// Targets returns the sorted healthy and unhealthy targets of the
+// named connector, as host:port. Both are nil if its health is not
+// being checked.
+func (m *HealthMonitor) Targets(connector string) ([]string, []string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	existing, ok := m.connectors[connector]
+	if !ok {
+		return nil, nil
+	}
+	var healthy []string
+	var unhealthy []string
+	for target, health := range existing.targets {
+		if health.healthy {
+			healthy = append(healthy, target.String())
+		} else {
+			unhealthy = append(unhealthy, target.String())
+		}
+	}
+	slices.Sort(healthy)
+	slices.Sort(unhealthy)
+	return healthy, unhealthy
+}
+
+func (m *HealthMonitor) run(ctx context.Context, name string, period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.check(ctx, name)
+		}
+	}
+}
+
+// check probes all the targets of the named connector once.
+func (m *HealthMonitor) check(ctx context.Context, name string) {
+	m.lock.Lock()
+	existing, ok := m.connectors[name]
+	if !ok {
+		m.lock.Unlock()
+		return
+	}
+	config := existing.config
+	var targets []Target
+	for target := range existing.targets {
+		targets = append(targets, target)
+	}
+	m.lock.Unlock()
+
+	results := make([]error, len(targets))
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			probeCtx, cancel := context.WithTimeout(ctx, config.timeout)
+			defer cancel()
+			port := target.Port
+			if config.port > 0 {
+				port = config.port
+			}
+			results[i] = m.probe(probeCtx, config.check, target.Host, port)
+		}()
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return
+	}
+
+	m.lock.Lock()
+	changed := false
+	for i, target := range targets {
+		health, ok := existing.targets[target]
+		if !ok {
+			continue
+		}
+		if err := results[i]; err != nil {
+			health.successes = 0
+			health.failures++
+			if health.healthy && health.failures >= config.failureThreshold {
+				health.healthy = false
+				changed = true
+				m.logger.Warn("Connector target is unhealthy",
+					slog.String("connector", name),
+					slog.String("target", target.String()),
+					slog.Any("error", err))
+			}
+		} else {
+			health.failures = 0
+			health.successes++
+			if !health.healthy && health.successes >= config.successThreshold {
+				health.healthy = true
+				changed = true
+				m.logger.Info("Connector target is healthy again",
+					slog.String("connector", name),
+					slog.String("target", target.String()))
+			}
+		}
+	}
+	m.lock.Unlock()
+	if changed {
+		m.changed(name)
+	}
+}
@@ -0,0 +1,243 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateHealthCheck(t *testing.T) {
+	tests := []struct {
+		name  string
+		check *skupperv2alpha1.HealthCheck
+		err   string
+	}{
+		{
+			name: "none",
+		},
+		{
+			name:  "default",
+			check: &skupperv2alpha1.HealthCheck{},
+		},
+		{
+			name:  "http",
+			check: &skupperv2alpha1.HealthCheck{Type: "http", Path: "/healthz", Scheme: "https", Port: 8081, PeriodSeconds: 5},
+		},
+		{
+			name:  "bad type",
+			check: &skupperv2alpha1.HealthCheck{Type: "grpc"},
+			err:   "invalid health check type \"grpc\": must be tcp or http",
+		},
+		{
+			name:  "path for tcp",
+			check: &skupperv2alpha1.HealthCheck{Type: "tcp", Path: "/healthz"},
+			err:   "invalid health check: path and scheme only apply to http checks",
+		},
+		{
+			name:  "bad scheme",
+			check: &skupperv2alpha1.HealthCheck{Type: "http", Scheme: "ftp"},
+			err:   "invalid health check scheme \"ftp\": must be http or https",
+		},
+		{
+			name:  "bad port",
+			check: &skupperv2alpha1.HealthCheck{Port: 70000},
+			err:   "invalid health check port 70000",
+		},
+		{
+			name:  "negative threshold",
+			check: &skupperv2alpha1.HealthCheck{FailureThreshold: -1},
+			err:   "invalid health check: period, timeout and thresholds must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateHealthCheck(tt.check)
+			if tt.err == "" {
+				assert.Assert(t, err)
+			} else {
+				assert.Error(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestProbeTarget(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	host, portString, err := net.SplitHostPort(server.Listener.Addr().String())
+	assert.Assert(t, err)
+	port, _ := strconv.Atoi(portString)
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Assert(t, err)
+	closedPort := closed.Addr().(*net.TCPAddr).Port
+	closed.Close()
+
+	tests := []struct {
+		name    string
+		check   skupperv2alpha1.HealthCheck
+		port    int
+		healthy bool
+	}{
+		{
+			name:    "tcp open",
+			port:    port,
+			healthy: true,
+		},
+		{
+			name: "tcp closed",
+			port: closedPort,
+		},
+		{
+			name:    "http ok",
+			check:   skupperv2alpha1.HealthCheck{Type: "http", Path: "healthz"},
+			port:    port,
+			healthy: true,
+		},
+		{
+			name:  "http unavailable",
+			check: skupperv2alpha1.HealthCheck{Type: "http", Path: "/ready"},
+			port:  port,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ProbeTarget(context.Background(), tt.check, host, tt.port)
+			assert.Equal(t, err == nil, tt.healthy, "unexpected result: %v", err)
+		})
+	}
+}
+
+func healthCheckedConnector(check *skupperv2alpha1.HealthCheck) *skupperv2alpha1.Connector {
+	return &skupperv2alpha1.Connector{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backend",
+			Namespace: "test",
+		},
+		Spec: skupperv2alpha1.ConnectorSpec{
+			RoutingKey:  "backend",
+			Selector:    "app=backend",
+			Port:        8080,
+			HealthCheck: check,
+		},
+	}
+}
+
+func TestHealthMonitor(t *testing.T) {
+	var changes []string
+	monitor := NewHealthMonitor(func(connector string) {
+		changes = append(changes, connector)
+	})
+	defer monitor.Stop()
+	failing := map[string]bool{}
+	monitor.probe = func(ctx context.Context, check skupperv2alpha1.HealthCheck, host string, port int) error {
+		assert.Equal(t, port, 9090)
+		if failing[host] {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+	connector := healthCheckedConnector(&skupperv2alpha1.HealthCheck{
+		Port:             9090,
+		PeriodSeconds:    3600,
+		FailureThreshold: 2,
+		SuccessThreshold: 2,
+	})
+	check := func() {
+		monitor.check(context.Background(), connector.Name)
+	}
+	first := Target{Host: "10.0.0.1", Port: 8080}
+	second := Target{Host: "10.0.0.2", Port: 8080}
+
+	monitor.Monitor(connector, []Target{first, second})
+	assert.DeepEqual(t, changes, []string{"backend"})
+	healthy, unhealthy := monitor.Targets("backend")
+	assert.DeepEqual(t, healthy, []string{"10.0.0.1:8080", "10.0.0.2:8080"})
+	assert.Assert(t, unhealthy == nil)
+
+	// a single failure is tolerated
+	failing["10.0.0.2"] = true
+	check()
+	assert.Assert(t, !monitor.Unhealthy("backend", second))
+	assert.Equal(t, len(changes), 1)
+
+	check()
+	assert.Assert(t, monitor.Unhealthy("backend", second))
+	assert.Assert(t, !monitor.Unhealthy("backend", first))
+	assert.Equal(t, len(changes), 2)
+	healthy, unhealthy = monitor.Targets("backend")
+	assert.DeepEqual(t, healthy, []string{"10.0.0.1:8080"})
+	assert.DeepEqual(t, unhealthy, []string{"10.0.0.2:8080"})
+
+	// recovery requires the success threshold to be met
+	failing["10.0.0.2"] = false
+	check()
+	assert.Assert(t, monitor.Unhealthy("backend", second))
+	check()
+	assert.Assert(t, !monitor.Unhealthy("backend", second))
+	assert.Equal(t, len(changes), 3)
+
+	// unchanged targets are not reported
+	monitor.Monitor(connector, []Target{second, first})
+	assert.Equal(t, len(changes), 3)
+	monitor.Monitor(connector, []Target{first})
+	assert.Equal(t, len(changes), 4)
+	healthy, _ = monitor.Targets("backend")
+	assert.DeepEqual(t, healthy, []string{"10.0.0.1:8080"})
+
+	// removing the health check forgets the connector
+	monitor.Monitor(healthCheckedConnector(nil), []Target{first})
+	healthy, unhealthy = monitor.Targets("backend")
+	assert.Assert(t, healthy == nil && unhealthy == nil)
+}
+
+func TestHealthMonitorTargetPorts(t *testing.T) {
+	monitor := NewHealthMonitor(func(connector string) {})
+	defer monitor.Stop()
+	var probed []string
+	monitor.probe = func(ctx context.Context, check skupperv2alpha1.HealthCheck, host string, port int) error {
+		target := Target{Host: host, Port: port}
+		probed = append(probed, target.String())
+		if port == 9091 {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+	connector := healthCheckedConnector(&skupperv2alpha1.HealthCheck{
+		PeriodSeconds:    3600,
+		FailureThreshold: 1,
+	})
+	// targets on the same host are checked on their own ports and
+	// their health is tracked separately
+	first := Target{Host: "backend.example.com", Port: 9090}
+	second := Target{Host: "backend.example.com", Port: 9091}
+	monitor.Monitor(connector, []Target{first, second})
+	monitor.check(context.Background(), connector.Name)
+	slices.Sort(probed)
+	assert.DeepEqual(t, probed, []string{"backend.example.com:9090", "backend.example.com:9091"})
+	assert.Assert(t, !monitor.Unhealthy(connector.Name, first))
+	assert.Assert(t, monitor.Unhealthy(connector.Name, second))
+	healthy, unhealthy := monitor.Targets(connector.Name)
+	assert.DeepEqual(t, healthy, []string{"backend.example.com:9090"})
+	assert.DeepEqual(t, unhealthy, []string{"backend.example.com:9091"})
+
+	// an explicit health check port applies to every target
+	connector.Spec.HealthCheck.Port = 8081
+	monitor.Monitor(connector, []Target{first, second})
+	probed = nil
+	monitor.check(context.Background(), connector.Name)
+	assert.DeepEqual(t, probed, []string{"backend.example.com:8081", "backend.example.com:8081"})
+	assert.Assert(t, !monitor.Unhealthy(connector.Name, second))
+}
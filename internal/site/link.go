@@ -12,6 +12,7 @@ type Link struct {
 	name        string
 	profilePath string
 	definition  *skupperv2alpha1.Link
+	adjusted    *linkCost
 }
 
 func NewLink(name string, profilePath string) *Link {
@@ -36,7 +37,7 @@ func (l *Link) Apply(current *qdr.RouterConfig) bool {
 	profileName := sslProfileName(l.definition)
 	connector := qdr.Connector{
 		Name:       l.name,
-		Cost:       int32(l.definition.Spec.Cost),
+		Cost:       int32(l.Cost()),
 		SslProfile: profileName,
 		Role:       role,
 		Host:       endpoint.Host,
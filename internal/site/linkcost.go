@@ -0,0 +1,222 @@
+package site
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+// Modes that may be requested for automatic adjustment of link costs.
+const (
+	LinkCostAdjustmentNone    = ""
+	LinkCostAdjustmentLatency = "latency"
+)
+
+const (
+	defaultLinkCostMin            = 1
+	defaultLinkCostMax            = 100
+	defaultLinkCostLatencyCeiling = 250 * time.Millisecond
+	defaultLinkCostHoldTime       = time.Minute
+	// weight given to each new probe result when smoothing the
+	// measured latency and error rate
+	linkCostSmoothing = 0.3
+)
+
+// LinkCostPolicy controls the adjustment of the router connector cost
+// of links based on the measured latency and error rate to the
+// remote site.
+type LinkCostPolicy struct {
+	Enabled bool
+	// Min and Max bound the cost of any link that does not declare
+	// its own bounds.
+	Min int
+	Max int
+	// LatencyCeiling is the latency at or beyond which a link is
+	// given the maximum cost.
+	LatencyCeiling time.Duration
+	// HoldTime is the minimum time a link keeps a cost before it
+	// can be adjusted again.
+	HoldTime time.Duration
+}
+
+// GetLinkCostPolicy returns the link cost policy configured through
+// the settings of the supplied site.
+func GetLinkCostPolicy(spec *skupperv2alpha1.SiteSpec) (LinkCostPolicy, error) {
+	policy := LinkCostPolicy{
+		Min:            defaultLinkCostMin,
+		Max:            defaultLinkCostMax,
+		LatencyCeiling: defaultLinkCostLatencyCeiling,
+		HoldTime:       defaultLinkCostHoldTime,
+	}
+	switch mode := spec.GetLinkCostAdjustment(); mode {
+	case LinkCostAdjustmentNone:
+		return policy, nil
+	case LinkCostAdjustmentLatency:
+		policy.Enabled = true
+	default:
+		return policy, fmt.Errorf("invalid link-cost-adjustment %q: must be %q", mode, LinkCostAdjustmentLatency)
+	}
+	var err error
+	if policy.Min, policy.Max, err = parseCostBounds(spec.GetLinkCostMin(), spec.GetLinkCostMax(), policy.Min, policy.Max); err != nil {
+		return policy, err
+	}
+	if value := spec.GetLinkCostLatencyCeiling(); value != "" {
+		if policy.LatencyCeiling, err = time.ParseDuration(value); err != nil || policy.LatencyCeiling <= 0 {
+			return policy, fmt.Errorf("invalid link-cost-latency-ceiling %q: must be a positive duration", value)
+		}
+	}
+	if value := spec.GetLinkCostHoldTime(); value != "" {
+		if policy.HoldTime, err = time.ParseDuration(value); err != nil || policy.HoldTime < 0 {
+			return policy, fmt.Errorf("invalid link-cost-hold-time %q: must be a non-negative duration", value)
+		}
+	}
+	return policy, nil
+}
+
+// bounds returns the cost bounds for the supplied link, which may
+// override those of the policy.
+func (p LinkCostPolicy) bounds(spec *skupperv2alpha1.LinkSpec) (int, int, error) {
+	return parseCostBounds(spec.GetCostMin(), spec.GetCostMax(), p.Min, p.Max)
+}
+
+func parseCostBounds(minValue string, maxValue string, min int, max int) (int, int, error) {
+	var err error
+	if minValue != "" {
+		if min, err = strconv.Atoi(minValue); err != nil || min < 1 {
+			return 0, 0, fmt.Errorf("invalid minimum cost %q: must be a positive integer", minValue)
+		}
+	}
+	if maxValue != "" {
+		if max, err = strconv.Atoi(maxValue); err != nil || max < 1 {
+			return 0, 0, fmt.Errorf("invalid maximum cost %q: must be a positive integer", maxValue)
+		}
+	}
+	if min > max {
+		return 0, 0, fmt.Errorf("minimum cost %d is greater than maximum cost %d", min, max)
+	}
+	return min, max, nil
+}
+
+// linkCost tracks the smoothed measurements for a link and the cost
+// derived from them.
+type linkCost struct {
+	sampled bool
+	round   uint64  // probing round of the last sample
+	latency float64 // milliseconds
+	errors  float64 // fraction of probes failing
+	cost    int
+	changed time.Time
+}
+
+func (c *linkCost) observe(latency time.Duration, reachable bool) {
+	errors := 0.0
+	if !reachable {
+		errors = 1.0
+	}
+	if !c.sampled {
+		c.errors = errors
+		if reachable {
+			c.latency = float64(latency) / float64(time.Millisecond)
+		}
+		c.sampled = true
+		return
+	}
+	c.errors += linkCostSmoothing * (errors - c.errors)
+	if reachable {
+		c.latency += linkCostSmoothing * (float64(latency)/float64(time.Millisecond) - c.latency)
+	}
+}
+
+// target returns the cost warranted by the current measurements:
+// latency moves the cost linearly from min towards max, reaching max
+// at the latency ceiling, and any error rate then pulls it further
+// towards max.
+func (c *linkCost) target(min int, max int, ceiling time.Duration) int {
+	span := float64(max - min)
+	ratio := math.Min(c.latency/(float64(ceiling)/float64(time.Millisecond)), 1)
+	cost := float64(min) + span*ratio
+	cost += (float64(max) - cost) * c.errors
+	return int(math.Round(cost))
+}
+
+// AdjustCost records a probe of the remote site of the link and
+// returns true if the resulting cost differs from that previously in
+// effect. A probe from the same round as the last one recorded is
+// not sampled again, as the same result is reported on every network
+// status update until the next round completes. Changes smaller
+// than a tenth of the allowed range, or made within the hold time of
+// the previous change, are suppressed to avoid flapping between
+// similar costs. As applying a new cost re-creates the router
+// connector for the link, interrupting its flows, the hold time also
+// bounds how often that disruption can occur.
+func (l *Link) AdjustCost(policy LinkCostPolicy, round uint64, latency time.Duration, reachable bool, now time.Time) (bool, error) {
+	if l.definition == nil {
+		return false, nil
+	}
+	if l.definition.Spec.IsCostPinned() {
+		return l.ResetCost(), nil
+	}
+	min, max, err := policy.bounds(&l.definition.Spec)
+	if err != nil {
+		return false, err
+	}
+	if l.adjusted == nil {
+		l.adjusted = &linkCost{
+			cost: l.definition.Status.EffectiveCost,
+		}
+	}
+	if l.adjusted.sampled && l.adjusted.round == round {
+		return false, nil
+	}
+	l.adjusted.round = round
+	l.adjusted.observe(latency, reachable)
+	current := l.adjusted.cost
+	if current == 0 {
+		current = l.definition.Spec.Cost
+	}
+	target := l.adjusted.target(min, max, policy.LatencyCeiling)
+	if current >= min && current <= max {
+		threshold := int(math.Max(1, float64(max-min)/10))
+		if abs(target-current) < threshold || now.Sub(l.adjusted.changed) < policy.HoldTime {
+			return false, nil
+		}
+	}
+	if target == current {
+		return false, nil
+	}
+	l.adjusted.cost = target
+	l.adjusted.changed = now
+	return true, nil
+}
+
+// ResetCost discards any adjusted cost, returning true if the link
+// was not previously using the cost in its spec.
+func (l *Link) ResetCost() bool {
+	if l.adjusted == nil {
+		return false
+	}
+	changed := l.adjusted.cost != 0 && (l.definition == nil || l.adjusted.cost != l.definition.Spec.Cost)
+	l.adjusted = nil
+	return changed
+}
+
+// Cost returns the router connector cost in effect for the link.
+func (l *Link) Cost() int {
+	if l.adjusted != nil && l.adjusted.cost != 0 {
+		return l.adjusted.cost
+	}
+	if l.definition == nil {
+		return 0
+	}
+	return l.definition.Spec.Cost
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
@@ -0,0 +1,285 @@
+package site
+
+import (
+	"testing"
+	"time"
+
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"gotest.tools/v3/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetLinkCostPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]string
+		expected LinkCostPolicy
+		err      string
+	}{
+		{
+			name: "disabled",
+			expected: LinkCostPolicy{
+				Min:            1,
+				Max:            100,
+				LatencyCeiling: 250 * time.Millisecond,
+				HoldTime:       time.Minute,
+			},
+		},
+		{
+			name: "enabled with defaults",
+			settings: map[string]string{
+				"link-cost-adjustment": "latency",
+			},
+			expected: LinkCostPolicy{
+				Enabled:        true,
+				Min:            1,
+				Max:            100,
+				LatencyCeiling: 250 * time.Millisecond,
+				HoldTime:       time.Minute,
+			},
+		},
+		{
+			name: "enabled with settings",
+			settings: map[string]string{
+				"link-cost-adjustment":      "latency",
+				"link-cost-min":             "5",
+				"link-cost-max":             "50",
+				"link-cost-latency-ceiling": "100ms",
+				"link-cost-hold-time":       "5m",
+			},
+			expected: LinkCostPolicy{
+				Enabled:        true,
+				Min:            5,
+				Max:            50,
+				LatencyCeiling: 100 * time.Millisecond,
+				HoldTime:       5 * time.Minute,
+			},
+		},
+		{
+			name: "bad mode",
+			settings: map[string]string{
+				"link-cost-adjustment": "random",
+			},
+			err: "invalid link-cost-adjustment \"random\": must be \"latency\"",
+		},
+		{
+			name: "bad minimum",
+			settings: map[string]string{
+				"link-cost-adjustment": "latency",
+				"link-cost-min":        "0",
+			},
+			err: "invalid minimum cost \"0\": must be a positive integer",
+		},
+		{
+			name: "minimum greater than maximum",
+			settings: map[string]string{
+				"link-cost-adjustment": "latency",
+				"link-cost-min":        "20",
+				"link-cost-max":        "10",
+			},
+			err: "minimum cost 20 is greater than maximum cost 10",
+		},
+		{
+			name: "bad latency ceiling",
+			settings: map[string]string{
+				"link-cost-adjustment":      "latency",
+				"link-cost-latency-ceiling": "0s",
+			},
+			err: "invalid link-cost-latency-ceiling \"0s\": must be a positive duration",
+		},
+		{
+			name: "bad hold time",
+			settings: map[string]string{
+				"link-cost-adjustment": "latency",
+				"link-cost-hold-time":  "a while",
+			},
+			err: "invalid link-cost-hold-time \"a while\": must be a non-negative duration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &skupperv2alpha1.SiteSpec{
+				Settings: tt.settings,
+			}
+			policy, err := GetLinkCostPolicy(spec)
+			if tt.err != "" {
+				assert.Error(t, err, tt.err)
+				return
+			}
+			assert.Assert(t, err)
+			assert.DeepEqual(t, policy, tt.expected)
+		})
+	}
+}
+
+func TestLink_AdjustCost(t *testing.T) {
+	policy := LinkCostPolicy{
+		Enabled:        true,
+		Min:            1,
+		Max:            101,
+		LatencyCeiling: 100 * time.Millisecond,
+		HoldTime:       time.Minute,
+	}
+	type probe struct {
+		after     time.Duration
+		latency   time.Duration
+		reachable bool
+		changed   bool
+		cost      int
+	}
+	tests := []struct {
+		name   string
+		spec   skupperv2alpha1.LinkSpec
+		status skupperv2alpha1.LinkStatus
+		probes []probe
+		err    string
+	}{
+		{
+			name: "cost follows latency",
+			spec: skupperv2alpha1.LinkSpec{Cost: 1},
+			probes: []probe{
+				{latency: 50 * time.Millisecond, reachable: true, changed: true, cost: 51},
+				{after: 2 * time.Minute, latency: 50 * time.Millisecond, reachable: true, cost: 51},
+			},
+		},
+		{
+			name: "latency beyond ceiling is capped",
+			spec: skupperv2alpha1.LinkSpec{Cost: 1},
+			probes: []probe{
+				{latency: time.Second, reachable: true, changed: true, cost: 101},
+			},
+		},
+		{
+			name: "small changes are ignored",
+			spec: skupperv2alpha1.LinkSpec{Cost: 1},
+			probes: []probe{
+				{latency: 50 * time.Millisecond, reachable: true, changed: true, cost: 51},
+				{after: 2 * time.Minute, latency: 70 * time.Millisecond, reachable: true, cost: 51},
+				{after: 4 * time.Minute, latency: 70 * time.Millisecond, reachable: true, changed: true, cost: 61},
+			},
+		},
+		{
+			name: "changes within hold time are deferred",
+			spec: skupperv2alpha1.LinkSpec{Cost: 1},
+			probes: []probe{
+				{latency: 10 * time.Millisecond, reachable: true, changed: true, cost: 11},
+				{after: 10 * time.Second, latency: 100 * time.Millisecond, reachable: true, cost: 11},
+				{after: 70 * time.Second, latency: 100 * time.Millisecond, reachable: true, changed: true, cost: 57},
+			},
+		},
+		{
+			name: "errors raise the cost",
+			spec: skupperv2alpha1.LinkSpec{Cost: 1},
+			probes: []probe{
+				{latency: 0, reachable: true, cost: 1},
+				{after: 2 * time.Minute, reachable: false, changed: true, cost: 31},
+			},
+		},
+		{
+			name: "link bounds override site bounds",
+			spec: skupperv2alpha1.LinkSpec{
+				Cost: 1,
+				Settings: map[string]string{
+					"cost-min": "10",
+					"cost-max": "20",
+				},
+			},
+			probes: []probe{
+				{latency: 500 * time.Millisecond, reachable: true, changed: true, cost: 20},
+			},
+		},
+		{
+			name:   "previous effective cost is recovered",
+			spec:   skupperv2alpha1.LinkSpec{Cost: 1},
+			status: skupperv2alpha1.LinkStatus{EffectiveCost: 50},
+			probes: []probe{
+				{latency: 52 * time.Millisecond, reachable: true, cost: 50},
+			},
+		},
+		{
+			name: "pinned link keeps its cost",
+			spec: skupperv2alpha1.LinkSpec{
+				Cost: 5,
+				Settings: map[string]string{
+					"cost-pinned": "true",
+				},
+			},
+			probes: []probe{
+				{latency: time.Second, reachable: true, cost: 5},
+			},
+		},
+		{
+			name: "invalid link bounds",
+			spec: skupperv2alpha1.LinkSpec{
+				Cost: 1,
+				Settings: map[string]string{
+					"cost-max": "none",
+				},
+			},
+			err: "invalid maximum cost \"none\": must be a positive integer",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			link := NewLink("link1", "/etc/skupper-router-certs")
+			link.Update(&skupperv2alpha1.Link{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      "link1",
+					Namespace: "test",
+				},
+				Spec:   tt.spec,
+				Status: tt.status,
+			})
+			start := time.Now()
+			if tt.err != "" {
+				_, err := link.AdjustCost(policy, 1, time.Millisecond, true, start)
+				assert.Error(t, err, tt.err)
+				return
+			}
+			for i, p := range tt.probes {
+				changed, err := link.AdjustCost(policy, uint64(i+1), p.latency, p.reachable, start.Add(p.after))
+				assert.Assert(t, err)
+				assert.Equal(t, changed, p.changed, "probe %d", i)
+				assert.Equal(t, link.Cost(), p.cost, "probe %d", i)
+			}
+		})
+	}
+}
+
+func TestLink_ResetCost(t *testing.T) {
+	link := NewLink("link1", "/etc/skupper-router-certs")
+	link.Update(&skupperv2alpha1.Link{
+		Spec: skupperv2alpha1.LinkSpec{Cost: 3},
+	})
+	assert.Assert(t, !link.ResetCost())
+	changed, err := link.AdjustCost(LinkCostPolicy{Enabled: true, Min: 1, Max: 10, LatencyCeiling: time.Second}, 1, time.Second, true, time.Now())
+	assert.Assert(t, err)
+	assert.Assert(t, changed)
+	assert.Equal(t, link.Cost(), 10)
+	assert.Assert(t, link.ResetCost())
+	assert.Equal(t, link.Cost(), 3)
+}
+
+func TestLink_AdjustCostSameRound(t *testing.T) {
+	policy := LinkCostPolicy{Enabled: true, Min: 1, Max: 101, LatencyCeiling: 100 * time.Millisecond}
+	link := NewLink("link1", "/etc/skupper-router-certs")
+	link.Update(&skupperv2alpha1.Link{
+		Spec: skupperv2alpha1.LinkSpec{Cost: 1},
+	})
+	now := time.Now()
+	changed, err := link.AdjustCost(policy, 1, 50*time.Millisecond, true, now)
+	assert.Assert(t, err)
+	assert.Assert(t, changed)
+	assert.Equal(t, link.Cost(), 51)
+	// the same round reported again must not be sampled again
+	for i := 0; i < 10; i++ {
+		changed, err = link.AdjustCost(policy, 1, 50*time.Millisecond, false, now.Add(time.Duration(i)*time.Second))
+		assert.Assert(t, err)
+		assert.Assert(t, !changed)
+		assert.Equal(t, link.Cost(), 51)
+	}
+	changed, err = link.AdjustCost(policy, 2, 50*time.Millisecond, false, now.Add(time.Minute))
+	assert.Assert(t, err)
+	assert.Assert(t, changed)
+	assert.Equal(t, link.Cost(), 66)
+}
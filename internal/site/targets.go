@@ -0,0 +1,288 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/qdr"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+)
+
+const (
+	defaultResolvePeriod  = 30 * time.Second
+	defaultResolveTimeout = 5 * time.Second
+)
+
+// Target is a single host and port that a connector with multiple
+// hosts or an SRV record sends connections to.
+type Target struct {
+	Host string
+	Port int
+}
+
+func (t Target) String() string {
+	return net.JoinHostPort(t.Host, strconv.Itoa(t.Port))
+}
+
+func compareTargets(a, b Target) int {
+	if c := strings.Compare(a.Host, b.Host); c != 0 {
+		return c
+	}
+	return a.Port - b.Port
+}
+
+// HasMultipleTargets indicates whether the connector targets a list
+// of hosts or an SRV record rather than a single host or selector.
+func HasMultipleTargets(connector *skupperv2alpha1.Connector) bool {
+	return len(connector.Spec.Hosts) > 0 || connector.Spec.SrvRecord != ""
+}
+
+// ValidateTargets returns an error if the connector does not specify
+// exactly one way of finding its targets, or if its hosts cannot be
+// parsed.
+func ValidateTargets(connector *skupperv2alpha1.Connector) error {
+	count := 0
+	for _, set := range []bool{connector.Spec.Host != "", len(connector.Spec.Hosts) > 0, connector.Spec.SrvRecord != "", connector.Spec.Selector != ""} {
+		if set {
+			count++
+		}
+	}
+	if count > 1 {
+		return errors.New("only one of host, hosts, srvRecord and selector may be set")
+	}
+	if _, err := ParseHosts(connector); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ParseHosts returns the targets for the hosts of the connector. Each
+// host may specify its own port as host:port, otherwise the port of
+// the connector is used.
+func ParseHosts(connector *skupperv2alpha1.Connector) ([]Target, error) {
+	var targets []Target
+	for _, value := range connector.Spec.Hosts {
+		target := Target{
+			Host: value,
+			Port: connector.Spec.Port,
+		}
+		if host, port, err := net.SplitHostPort(value); err == nil {
+			target.Host = host
+			if target.Port, err = strconv.Atoi(port); err != nil {
+				return nil, fmt.Errorf("invalid port in connector host %q", value)
+			}
+		}
+		if target.Host == "" || strings.ContainsAny(target.Host, " /[]") {
+			return nil, fmt.Errorf("invalid connector host %q", value)
+		}
+		if target.Port < 1 || target.Port > 65535 {
+			return nil, fmt.Errorf("invalid connector host %q: a port between 1 and 65535 is required", value)
+		}
+		if !slices.Contains(targets, target) {
+			targets = append(targets, target)
+		}
+	}
+	slices.SortFunc(targets, compareTargets)
+	return targets, nil
+}
+
+// UpdateBridgeConfigForConnectorToTarget adds a tcpConnector for one
+// of the targets of a connector with multiple hosts or an SRV record.
+func UpdateBridgeConfigForConnectorToTarget(siteId string, connector *skupperv2alpha1.Connector, target Target, config *qdr.BridgeConfig) {
+	if connector.Spec.Type == "tcp" || connector.Spec.Type == "" {
+		config.AddTcpConnector(qdr.TcpEndpoint{
+			Name:           connector.Name + "@" + target.String(),
+			SiteId:         siteId,
+			Host:           target.Host,
+			Port:           strconv.Itoa(target.Port),
+			Address:        connector.Spec.RoutingKey,
+			SslProfile:     getSslProfileName(connector),
+			VerifyHostname: getVerifyHostname(connector),
+		})
+	}
+}
+
+// SrvLookup returns the records for an SRV name.
+type SrvLookup func(ctx context.Context, name string) ([]*net.SRV, error)
+
+// LookupSrv queries DNS for the records of the SRV name, which is
+// expected to be fully specified, e.g. _http._tcp.backend.example.com.
+func LookupSrv(ctx context.Context, name string) ([]*net.SRV, error) {
+	_, records, err := net.DefaultResolver.LookupSRV(ctx, "", "", name)
+	return records, err
+}
+
+// srvTargets returns the targets of the records with the highest
+// priority, i.e. the lowest priority value. Records with a lower
+// priority are only meant to be used when none of those are
+// reachable, so are left out.
+func srvTargets(records []*net.SRV) []Target {
+	usable := func(record *net.SRV) bool {
+		return record.Target != "." && record.Port != 0
+	}
+	var priority uint16
+	found := false
+	for _, record := range records {
+		if usable(record) && (!found || record.Priority < priority) {
+			priority = record.Priority
+			found = true
+		}
+	}
+	var targets []Target
+	for _, record := range records {
+		if !usable(record) || record.Priority != priority {
+			continue
+		}
+		target := Target{
+			Host: strings.TrimSuffix(record.Target, "."),
+			Port: int(record.Port),
+		}
+		if !slices.Contains(targets, target) {
+			targets = append(targets, target)
+		}
+	}
+	slices.SortFunc(targets, compareTargets)
+	return targets
+}
+
+type srvResolution struct {
+	record  string
+	targets []Target
+	cancel  context.CancelFunc
+}
+
+// TargetResolver periodically resolves the SRV records of connectors
+// into their targets. The last successfully resolved targets are kept
+// if a later lookup fails.
+type TargetResolver struct {
+	lock       sync.Mutex
+	connectors map[string]*srvResolution
+	lookup     SrvLookup
+	period     time.Duration
+	changed    func(connector string)
+	logger     *slog.Logger
+}
+
+// NewTargetResolver returns a resolver that calls changed whenever the
+// targets of a connector change. The callback may be invoked from any
+// goroutine and must not block.
+func NewTargetResolver(changed func(connector string)) *TargetResolver {
+	return &TargetResolver{
+		connectors: map[string]*srvResolution{},
+		lookup:     LookupSrv,
+		period:     defaultResolvePeriod,
+		changed:    changed,
+		logger: slog.New(slog.Default().Handler()).With(
+			slog.String("component", "site.targets"),
+		),
+	}
+}
+
+// Resolve starts, updates or stops the resolution of the SRV record
+// of the supplied connector.
+func (r *TargetResolver) Resolve(connector *skupperv2alpha1.Connector) {
+	name := connector.Name
+	if connector.Spec.SrvRecord == "" {
+		r.Forget(name)
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if existing, ok := r.connectors[name]; ok {
+		if existing.record == connector.Spec.SrvRecord {
+			return
+		}
+		existing.cancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r.connectors[name] = &srvResolution{
+		record: connector.Spec.SrvRecord,
+		cancel: cancel,
+	}
+	go r.run(ctx, connector.Namespace, name, connector.Spec.SrvRecord)
+}
+
+// Forget stops any resolution for the named connector.
+func (r *TargetResolver) Forget(name string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if existing, ok := r.connectors[name]; ok {
+		existing.cancel()
+		delete(r.connectors, name)
+	}
+}
+
+// Stop stops all resolution.
+func (r *TargetResolver) Stop() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for name, existing := range r.connectors {
+		existing.cancel()
+		delete(r.connectors, name)
+	}
+}
+
+// Targets returns the targets most recently resolved for the named
+// connector.
+func (r *TargetResolver) Targets(name string) []Target {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if existing, ok := r.connectors[name]; ok {
+		return slices.Clone(existing.targets)
+	}
+	return nil
+}
+
+func (r *TargetResolver) run(ctx context.Context, namespace string, name string, record string) {
+	ticker := time.NewTicker(r.period)
+	defer ticker.Stop()
+	for {
+		r.resolve(ctx, namespace, name, record)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// resolve looks up the SRV record of the named connector once.
+func (r *TargetResolver) resolve(ctx context.Context, namespace string, name string, record string) {
+	lookupCtx, cancel := context.WithTimeout(ctx, defaultResolveTimeout)
+	defer cancel()
+	records, err := r.lookup(lookupCtx, record)
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		r.logger.Error("Could not resolve SRV record for connector",
+			slog.String("namespace", namespace),
+			slog.String("name", name),
+			slog.String("record", record),
+			slog.Any("error", err))
+		return
+	}
+	targets := srvTargets(records)
+	r.lock.Lock()
+	existing, ok := r.connectors[name]
+	if !ok || existing.record != record || slices.Equal(existing.targets, targets) {
+		r.lock.Unlock()
+		return
+	}
+	existing.targets = targets
+	r.lock.Unlock()
+	r.logger.Info("Resolved SRV record for connector",
+		slog.String("namespace", namespace),
+		slog.String("name", name),
+		slog.String("record", record),
+		slog.Any("targets", targets))
+	r.changed(name)
+}
@@ -0,0 +1,204 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/internal/utils"
+	skupperv2alpha1 "github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func multiTargetConnector(spec skupperv2alpha1.ConnectorSpec) *skupperv2alpha1.Connector {
+	spec.RoutingKey = "backend"
+	return &skupperv2alpha1.Connector{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backend",
+			Namespace: "test",
+		},
+		Spec: spec,
+	}
+}
+
+func TestValidateTargets(t *testing.T) {
+	tests := []struct {
+		name string
+		spec skupperv2alpha1.ConnectorSpec
+		err  string
+	}{
+		{
+			name: "host",
+			spec: skupperv2alpha1.ConnectorSpec{Host: "backend", Port: 8080},
+		},
+		{
+			name: "hosts",
+			spec: skupperv2alpha1.ConnectorSpec{Hosts: []string{"10.0.0.1", "10.0.0.2:9090", "[fd00::1]:8443", "fd00::2"}, Port: 8080},
+		},
+		{
+			name: "srv record",
+			spec: skupperv2alpha1.ConnectorSpec{SrvRecord: "_backend._tcp.example.com"},
+		},
+		{
+			name: "host and hosts",
+			spec: skupperv2alpha1.ConnectorSpec{Host: "backend", Hosts: []string{"10.0.0.1"}, Port: 8080},
+			err:  "only one of host, hosts, srvRecord and selector may be set",
+		},
+		{
+			name: "hosts and srv record",
+			spec: skupperv2alpha1.ConnectorSpec{Hosts: []string{"10.0.0.1"}, SrvRecord: "_backend._tcp.example.com", Port: 8080},
+			err:  "only one of host, hosts, srvRecord and selector may be set",
+		},
+		{
+			name: "bad port",
+			spec: skupperv2alpha1.ConnectorSpec{Hosts: []string{"10.0.0.1:http"}, Port: 8080},
+			err:  "invalid port in connector host \"10.0.0.1:http\"",
+		},
+		{
+			name: "no port",
+			spec: skupperv2alpha1.ConnectorSpec{Hosts: []string{"10.0.0.1"}},
+			err:  "invalid connector host \"10.0.0.1\": a port between 1 and 65535 is required",
+		},
+		{
+			name: "empty host",
+			spec: skupperv2alpha1.ConnectorSpec{Hosts: []string{":8080"}},
+			err:  "invalid connector host \":8080\"",
+		},
+		{
+			name: "srv record health check on target ports",
+			spec: skupperv2alpha1.ConnectorSpec{SrvRecord: "_backend._tcp.example.com", HealthCheck: &skupperv2alpha1.HealthCheck{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTargets(multiTargetConnector(tt.spec))
+			if tt.err == "" {
+				assert.Assert(t, err)
+			} else {
+				assert.Error(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestParseHosts(t *testing.T) {
+	connector := multiTargetConnector(skupperv2alpha1.ConnectorSpec{
+		Hosts: []string{"10.0.0.2", "10.0.0.1:9090", "fd00::1", "10.0.0.2:8080"},
+		Port:  8080,
+	})
+	targets, err := ParseHosts(connector)
+	assert.Assert(t, err)
+	assert.DeepEqual(t, targets, []Target{
+		{Host: "10.0.0.1", Port: 9090},
+		{Host: "10.0.0.2", Port: 8080},
+		{Host: "fd00::1", Port: 8080},
+	})
+	assert.Equal(t, targets[2].String(), "[fd00::1]:8080")
+}
+
+func TestSrvTargets(t *testing.T) {
+	tests := []struct {
+		name    string
+		records []*net.SRV
+		targets []Target
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "same priority",
+			records: []*net.SRV{
+				{Target: "b.example.com.", Port: 8080, Priority: 10, Weight: 5},
+				{Target: "a.example.com.", Port: 8081, Priority: 10, Weight: 10},
+			},
+			targets: []Target{{Host: "a.example.com", Port: 8081}, {Host: "b.example.com", Port: 8080}},
+		},
+		{
+			name: "fallbacks left out",
+			records: []*net.SRV{
+				{Target: "backup.example.com.", Port: 8080, Priority: 20},
+				{Target: "a.example.com.", Port: 8080, Priority: 10},
+			},
+			targets: []Target{{Host: "a.example.com", Port: 8080}},
+		},
+		{
+			name: "service not available",
+			records: []*net.SRV{
+				{Target: ".", Port: 0, Priority: 0},
+				{Target: "a.example.com.", Port: 8080, Priority: 10},
+			},
+			targets: []Target{{Host: "a.example.com", Port: 8080}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.DeepEqual(t, srvTargets(tt.records), tt.targets)
+		})
+	}
+}
+
+func TestTargetResolver(t *testing.T) {
+	var lock sync.Mutex
+	var changes []string
+	records := map[string][]*net.SRV{
+		"_backend._tcp.example.com": {
+			{Target: "a.example.com.", Port: 8080},
+		},
+	}
+	resolver := NewTargetResolver(func(connector string) {
+		lock.Lock()
+		defer lock.Unlock()
+		changes = append(changes, connector)
+	})
+	defer resolver.Stop()
+	resolver.period = 10 * time.Millisecond
+	resolver.lookup = func(ctx context.Context, name string) ([]*net.SRV, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		if result, ok := records[name]; ok {
+			return result, nil
+		}
+		return nil, errors.New("no such host")
+	}
+	changeCount := func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(changes)
+	}
+	waitForTargets := func(targets []Target) {
+		err := utils.Retry(10*time.Millisecond, 100, func() (bool, error) {
+			return slices.Equal(resolver.Targets("backend"), targets), nil
+		})
+		assert.Assert(t, err, "targets: %v", resolver.Targets("backend"))
+	}
+
+	connector := multiTargetConnector(skupperv2alpha1.ConnectorSpec{SrvRecord: "_backend._tcp.example.com"})
+	resolver.Resolve(connector)
+	waitForTargets([]Target{{Host: "a.example.com", Port: 8080}})
+	assert.Equal(t, changeCount(), 1)
+
+	// re-resolution picks up new targets
+	lock.Lock()
+	records["_backend._tcp.example.com"] = append(records["_backend._tcp.example.com"], &net.SRV{Target: "b.example.com.", Port: 8080})
+	lock.Unlock()
+	waitForTargets([]Target{{Host: "a.example.com", Port: 8080}, {Host: "b.example.com", Port: 8080}})
+	assert.Equal(t, changeCount(), 2)
+
+	// the last targets are kept while lookups fail
+	resolver.Resolve(connector)
+	lock.Lock()
+	delete(records, "_backend._tcp.example.com")
+	lock.Unlock()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, len(resolver.Targets("backend")), 2)
+	assert.Equal(t, changeCount(), 2)
+
+	// removing the record forgets the connector
+	resolver.Resolve(multiTargetConnector(skupperv2alpha1.ConnectorSpec{Hosts: []string{"10.0.0.1"}, Port: 8080}))
+	assert.Assert(t, resolver.Targets("backend") == nil)
+}
@@ -0,0 +1,278 @@
+package formatter
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	OutputJson = "json"
+	OutputYaml = "yaml"
+	OutputWide = "wide"
+)
+
+const (
+	statusHeader  = "STATUS"
+	deletedStatus = "Deleted"
+)
+
+// Column describes a column of the table used to display resources.
+// Wide columns are only displayed when the wide output is requested.
+type Column[T any] struct {
+	Header string
+	Wide   bool
+	Value  func(T) string
+}
+
+// Layout holds the tabwriter settings used to align a table.
+type Layout struct {
+	MinWidth int
+	TabWidth int
+	Padding  int
+	PadChar  byte
+	Flags    uint
+}
+
+var (
+	alignedLayout  = Layout{MinWidth: 0, TabWidth: 8, Padding: 1, PadChar: '\t', Flags: tabwriter.AlignRight}
+	indentedLayout = Layout{MinWidth: 8, TabWidth: 8, Padding: 1, PadChar: '\t', Flags: tabwriter.TabIndent}
+)
+
+// watchColumnWidth is the minimum width of the columns of rows printed
+// while watching, which are printed as they arrive and so cannot be
+// aligned with each other by a tabwriter.
+const watchColumnWidth = 16
+
+// Table describes how a kind of resource is displayed: its columns
+// and the layout used when listing them.
+type Table[T any] struct {
+	Columns []Column[T]
+	Layout  Layout
+}
+
+// ResourcePrinter displays resources as a table or, when the output is
+// json or yaml, as encoded documents. When AllNamespaces is set, the
+// table begins with the namespace of each resource.
+type ResourcePrinter[T metav1.Object] struct {
+	Table         Table[T]
+	Output        string
+	AllNamespaces bool
+	// Writer receives the output, which goes to standard output when
+	// it is nil.
+	Writer        io.Writer
+	headerPrinted bool
+}
+
+func NewResourcePrinter[T metav1.Object](table Table[T], output string, allNamespaces bool) *ResourcePrinter[T] {
+	return &ResourcePrinter[T]{
+		Table:         table,
+		Output:        output,
+		AllNamespaces: allNamespaces,
+	}
+}
+
+// Print displays the given resources along with the table header.
+func (p *ResourcePrinter[T]) Print(resources []T) error {
+	if p.encoded() {
+		for _, resource := range resources {
+			if err := p.encode(resource); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	layout := p.Table.Layout
+	writer := tabwriter.NewWriter(p.writer(), layout.MinWidth, layout.TabWidth, layout.Padding, layout.PadChar, layout.Flags)
+	fmt.Fprintln(writer, strings.Join(p.headers(), "\t"))
+	for _, resource := range resources {
+		fmt.Fprintln(writer, strings.Join(p.values(resource, false), "\t"))
+	}
+	return writer.Flush()
+}
+
+// PrintUpdate displays a single resource, as received while watching,
+// printing the table header only before the first one.
+func (p *ResourcePrinter[T]) PrintUpdate(resource T) error {
+	if p.encoded() {
+		return p.encode(resource)
+	}
+	return p.printRow(resource, false)
+}
+
+// PrintDeleted displays a single resource reported as deleted while
+// watching. In a table, its status is shown as Deleted; encoded
+// documents carry a deletion timestamp.
+func (p *ResourcePrinter[T]) PrintDeleted(resource T) error {
+	if p.encoded() {
+		if resource.GetDeletionTimestamp() == nil {
+			now := metav1.Now()
+			resource.SetDeletionTimestamp(&now)
+			defer resource.SetDeletionTimestamp(nil)
+		}
+		return p.encode(resource)
+	}
+	return p.printRow(resource, true)
+}
+
+// PrintWatched displays a resource received while watching, through
+// PrintDeleted if it has been deleted or PrintUpdate otherwise.
+func (p *ResourcePrinter[T]) PrintWatched(resource T, deleted bool) error {
+	if deleted {
+		return p.PrintDeleted(resource)
+	}
+	return p.PrintUpdate(resource)
+}
+
+func (p *ResourcePrinter[T]) writer() io.Writer {
+	if p.Writer == nil {
+		return os.Stdout
+	}
+	return p.Writer
+}
+
+func (p *ResourcePrinter[T]) encoded() bool {
+	return IsEncodedOutput(p.Output)
+}
+
+// IsEncodedOutput tells whether resources are displayed as encoded
+// documents, rather than as a table, for the given output.
+func IsEncodedOutput(output string) bool {
+	return output == OutputJson || output == OutputYaml
+}
+
+func (p *ResourcePrinter[T]) encode(resource T) error {
+	encodedOutput, err := utils.Encode(p.Output, resource)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(p.writer(), encodedOutput)
+	return err
+}
+
+// printRow displays a single row received while watching, preceded
+// by the header if it has not been printed yet. Each column is padded
+// to a fixed width that fits its header, so that the rows line up
+// with the header and with each other as long as their values fit.
+func (p *ResourcePrinter[T]) printRow(resource T, deleted bool) error {
+	headers := p.headers()
+	var out strings.Builder
+	if !p.headerPrinted {
+		writeCells(&out, headers, headers)
+		p.headerPrinted = true
+	}
+	writeCells(&out, headers, p.values(resource, deleted))
+	_, err := io.WriteString(p.writer(), out.String())
+	return err
+}
+
+func writeCells(out *strings.Builder, headers []string, cells []string) {
+	for i, cell := range cells {
+		if i == len(cells)-1 {
+			out.WriteString(cell)
+			break
+		}
+		width := max(watchColumnWidth, len(headers[i])+1)
+		fmt.Fprintf(out, "%-*s ", width-1, cell)
+	}
+	out.WriteString("\n")
+}
+
+func (p *ResourcePrinter[T]) headers() []string {
+	var headers []string
+	if p.AllNamespaces {
+		headers = append(headers, "NAMESPACE")
+	}
+	for _, column := range p.visibleColumns() {
+		headers = append(headers, column.Header)
+	}
+	return headers
+}
+
+func (p *ResourcePrinter[T]) values(resource T, deleted bool) []string {
+	var values []string
+	if p.AllNamespaces {
+		values = append(values, resource.GetNamespace())
+	}
+	for _, column := range p.visibleColumns() {
+		if deleted && column.Header == statusHeader {
+			values = append(values, deletedStatus)
+			continue
+		}
+		values = append(values, column.Value(resource))
+	}
+	return values
+}
+
+func (p *ResourcePrinter[T]) visibleColumns() []Column[T] {
+	var columns []Column[T]
+	for _, column := range p.Table.Columns {
+		if !column.Wide || p.Output == OutputWide {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+var SiteTable = Table[*v2alpha1.Site]{
+	Layout: alignedLayout,
+	Columns: []Column[*v2alpha1.Site]{
+		{Header: "NAME", Value: func(s *v2alpha1.Site) string { return s.Name }},
+		{Header: statusHeader, Value: func(s *v2alpha1.Site) string { return string(s.Status.StatusType) }},
+		{Header: "SITES-IN-NETWORK", Wide: true, Value: func(s *v2alpha1.Site) string { return strconv.Itoa(s.Status.SitesInNetwork) }},
+		{Header: "LINK-ACCESS", Wide: true, Value: func(s *v2alpha1.Site) string { return s.Spec.LinkAccess }},
+		{Header: "HA", Wide: true, Value: func(s *v2alpha1.Site) string { return strconv.FormatBool(s.Spec.HA) }},
+		{Header: "MESSAGE", Value: func(s *v2alpha1.Site) string { return s.Status.Message }},
+	},
+}
+
+var LinkTable = Table[*v2alpha1.Link]{
+	Layout: alignedLayout,
+	Columns: []Column[*v2alpha1.Link]{
+		{Header: "NAME", Value: func(l *v2alpha1.Link) string { return l.Name }},
+		{Header: statusHeader, Value: func(l *v2alpha1.Link) string { return string(l.Status.StatusType) }},
+		{Header: "REMOTE-SITE", Wide: true, Value: func(l *v2alpha1.Link) string { return l.Status.RemoteSiteName }},
+		{Header: "TLS-CREDENTIALS", Wide: true, Value: func(l *v2alpha1.Link) string { return l.Spec.TlsCredentials }},
+		{Header: "COST", Value: func(l *v2alpha1.Link) string { return strconv.Itoa(l.Spec.Cost) }},
+		{Header: "MESSAGE", Value: func(l *v2alpha1.Link) string { return l.Status.Message }},
+	},
+}
+
+var ListenerTable = Table[*v2alpha1.Listener]{
+	Layout: indentedLayout,
+	Columns: []Column[*v2alpha1.Listener]{
+		{Header: "NAME", Value: func(l *v2alpha1.Listener) string { return l.Name }},
+		{Header: statusHeader, Value: func(l *v2alpha1.Listener) string { return string(l.Status.StatusType) }},
+		{Header: "ROUTING-KEY", Value: func(l *v2alpha1.Listener) string { return l.Spec.RoutingKey }},
+		{Header: "HOST", Value: func(l *v2alpha1.Listener) string { return l.Spec.Host }},
+		{Header: "PORT", Value: func(l *v2alpha1.Listener) string { return strconv.Itoa(l.Spec.Port) }},
+		{Header: "TYPE", Wide: true, Value: func(l *v2alpha1.Listener) string { return l.Spec.Type }},
+		{Header: "TLS-CREDENTIALS", Wide: true, Value: func(l *v2alpha1.Listener) string { return l.Spec.TlsCredentials }},
+		{Header: "MATCHING-CONNECTOR", Value: func(l *v2alpha1.Listener) string { return strconv.FormatBool(l.Status.HasMatchingConnector) }},
+		{Header: "MESSAGE", Value: func(l *v2alpha1.Listener) string { return l.Status.Message }},
+	},
+}
+
+var ConnectorTable = Table[*v2alpha1.Connector]{
+	Layout: indentedLayout,
+	Columns: []Column[*v2alpha1.Connector]{
+		{Header: "NAME", Value: func(c *v2alpha1.Connector) string { return c.Name }},
+		{Header: statusHeader, Value: func(c *v2alpha1.Connector) string { return string(c.Status.StatusType) }},
+		{Header: "ROUTING-KEY", Value: func(c *v2alpha1.Connector) string { return c.Spec.RoutingKey }},
+		{Header: "SELECTOR", Value: func(c *v2alpha1.Connector) string { return c.Spec.Selector }},
+		{Header: "HOST", Value: func(c *v2alpha1.Connector) string { return c.Spec.Host }},
+		{Header: "PORT", Value: func(c *v2alpha1.Connector) string { return strconv.Itoa(c.Spec.Port) }},
+		{Header: "TYPE", Wide: true, Value: func(c *v2alpha1.Connector) string { return c.Spec.Type }},
+		{Header: "TLS-CREDENTIALS", Wide: true, Value: func(c *v2alpha1.Connector) string { return c.Spec.TlsCredentials }},
+		{Header: "SELECTED-PODS", Wide: true, Value: func(c *v2alpha1.Connector) string { return strconv.Itoa(len(c.Status.SelectedPods)) }},
+		{Header: "HAS MATCHING LISTENER", Value: func(c *v2alpha1.Connector) string { return strconv.FormatBool(c.Status.HasMatchingListener) }},
+		{Header: "MESSAGE", Value: func(c *v2alpha1.Connector) string { return c.Status.Message }},
+	},
+}
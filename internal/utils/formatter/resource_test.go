@@ -0,0 +1,188 @@
+package formatter
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/skupperproject/skupper/internal/cmd/skupper/common/utils"
+	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// table renders rows with the tabwriter settings the status commands
+// used before resources could be watched.
+func table(minWidth int, flags uint, rows ...[]string) string {
+	var out bytes.Buffer
+	writer := tabwriter.NewWriter(&out, minWidth, 8, 1, '\t', flags)
+	for _, row := range rows {
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+	writer.Flush()
+	return out.String()
+}
+
+func testConnector() *v2alpha1.Connector {
+	return &v2alpha1.Connector{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "backend",
+			Namespace: "east",
+		},
+		Spec: v2alpha1.ConnectorSpec{
+			RoutingKey:     "backend",
+			Host:           "10.0.0.1",
+			Port:           8080,
+			Type:           "tcp",
+			TlsCredentials: "backend-tls",
+		},
+		Status: v2alpha1.ConnectorStatus{
+			Status: v2alpha1.Status{
+				StatusType: v2alpha1.StatusReady,
+				Message:    "OK",
+			},
+			HasMatchingListener: true,
+		},
+	}
+}
+
+func testLink() *v2alpha1.Link {
+	return &v2alpha1.Link{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "link-west",
+			Namespace: "east",
+		},
+		Spec: v2alpha1.LinkSpec{
+			Cost:           2,
+			TlsCredentials: "link-west-tls",
+		},
+		Status: v2alpha1.LinkStatus{
+			Status: v2alpha1.Status{
+				StatusType: v2alpha1.StatusPending,
+				Message:    "Not operational",
+			},
+			RemoteSiteName: "west",
+		},
+	}
+}
+
+func TestResourcePrinterPrint(t *testing.T) {
+	tests := []struct {
+		name          string
+		print         func(output string, allNamespaces bool, out *bytes.Buffer) error
+		output        string
+		allNamespaces bool
+		expected      string
+	}{
+		{
+			name: "connectors",
+			print: func(output string, allNamespaces bool, out *bytes.Buffer) error {
+				printer := NewResourcePrinter(ConnectorTable, output, allNamespaces)
+				printer.Writer = out
+				return printer.Print([]*v2alpha1.Connector{testConnector()})
+			},
+			expected: table(8, tabwriter.TabIndent,
+				[]string{"NAME", "STATUS", "ROUTING-KEY", "SELECTOR", "HOST", "PORT", "HAS MATCHING LISTENER", "MESSAGE"},
+				[]string{"backend", "Ready", "backend", "", "10.0.0.1", "8080", "true", "OK"},
+			),
+		},
+		{
+			name: "connectors wide",
+			print: func(output string, allNamespaces bool, out *bytes.Buffer) error {
+				printer := NewResourcePrinter(ConnectorTable, output, allNamespaces)
+				printer.Writer = out
+				return printer.Print([]*v2alpha1.Connector{testConnector()})
+			},
+			output: OutputWide,
+			expected: table(8, tabwriter.TabIndent,
+				[]string{"NAME", "STATUS", "ROUTING-KEY", "SELECTOR", "HOST", "PORT", "TYPE", "TLS-CREDENTIALS", "SELECTED-PODS", "HAS MATCHING LISTENER", "MESSAGE"},
+				[]string{"backend", "Ready", "backend", "", "10.0.0.1", "8080", "tcp", "backend-tls", "0", "true", "OK"},
+			),
+		},
+		{
+			name: "links",
+			print: func(output string, allNamespaces bool, out *bytes.Buffer) error {
+				printer := NewResourcePrinter(LinkTable, output, allNamespaces)
+				printer.Writer = out
+				return printer.Print([]*v2alpha1.Link{testLink()})
+			},
+			expected: table(0, tabwriter.AlignRight,
+				[]string{"NAME", "STATUS", "COST", "MESSAGE"},
+				[]string{"link-west", "Pending", "2", "Not operational"},
+			),
+		},
+		{
+			name: "links in all namespaces",
+			print: func(output string, allNamespaces bool, out *bytes.Buffer) error {
+				printer := NewResourcePrinter(LinkTable, output, allNamespaces)
+				printer.Writer = out
+				return printer.Print([]*v2alpha1.Link{testLink()})
+			},
+			allNamespaces: true,
+			expected: table(0, tabwriter.AlignRight,
+				[]string{"NAMESPACE", "NAME", "STATUS", "COST", "MESSAGE"},
+				[]string{"east", "link-west", "Pending", "2", "Not operational"},
+			),
+		},
+		{
+			name: "links as yaml",
+			print: func(output string, allNamespaces bool, out *bytes.Buffer) error {
+				printer := NewResourcePrinter(LinkTable, output, allNamespaces)
+				printer.Writer = out
+				return printer.Print([]*v2alpha1.Link{testLink()})
+			},
+			output: OutputYaml,
+			expected: func() string {
+				encoded, _ := utils.Encode(OutputYaml, testLink())
+				return encoded + "\n"
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			assert.Assert(t, tt.print(tt.output, tt.allNamespaces, &out))
+			assert.Equal(t, out.String(), tt.expected)
+		})
+	}
+}
+
+func TestResourcePrinterPrintWatched(t *testing.T) {
+	var out bytes.Buffer
+	printer := NewResourcePrinter(ConnectorTable, "", false)
+	printer.Writer = &out
+	connector := testConnector()
+	assert.Assert(t, printer.PrintWatched(connector, false))
+	assert.Assert(t, printer.PrintWatched(connector, false))
+	assert.Assert(t, printer.PrintWatched(connector, true))
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	// the header is only printed before the first resource
+	assert.Equal(t, len(lines), 4)
+	assert.Equal(t, strings.Join(strings.Fields(lines[0]), " "), "NAME STATUS ROUTING-KEY SELECTOR HOST PORT HAS MATCHING LISTENER MESSAGE")
+	assert.DeepEqual(t, strings.Fields(lines[1]), []string{"backend", "Ready", "backend", "10.0.0.1", "8080", "true", "OK"})
+	assert.Equal(t, lines[2], lines[1])
+	assert.DeepEqual(t, strings.Fields(lines[3]), []string{"backend", "Deleted", "backend", "10.0.0.1", "8080", "true", "OK"})
+	// rows printed separately stay aligned with the header
+	assert.Equal(t, strings.Index(lines[0], "STATUS"), strings.Index(lines[1], "Ready"))
+	assert.Equal(t, strings.Index(lines[0], "STATUS"), strings.Index(lines[3], "Deleted"))
+	assert.Equal(t, strings.Index(lines[0], "PORT"), strings.Index(lines[3], "8080"))
+}
+
+func TestResourcePrinterPrintDeletedEncoded(t *testing.T) {
+	var out bytes.Buffer
+	printer := NewResourcePrinter(ConnectorTable, OutputYaml, false)
+	printer.Writer = &out
+	connector := testConnector()
+	assert.Assert(t, printer.PrintDeleted(connector))
+
+	var printed v2alpha1.Connector
+	assert.Assert(t, yaml.Unmarshal(out.Bytes(), &printed))
+	assert.Equal(t, printed.Name, connector.Name)
+	assert.Assert(t, printed.DeletionTimestamp != nil)
+	// the resource itself is left as it was
+	assert.Assert(t, connector.DeletionTimestamp == nil)
+}
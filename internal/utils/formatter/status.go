@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/skupperproject/skupper/internal/network"
 )
@@ -180,6 +181,17 @@ func PrintNetworkStatus(currentSite string, currentNetworkStatus *network.Networ
 						}
 					}
 
+					if len(siteStatus.Probes) > 0 {
+						probes := siteLevel.NewChild("Probed sites:")
+						for _, probe := range siteStatus.Probes {
+							peer := probe.Peer
+							if peerStatus := statusManager.GetSiteById(probe.Peer); peerStatus != nil && peerStatus.Site.Name != "" {
+								peer = peerStatus.Site.Name
+							}
+							probes.NewChildWithDetail(fmt.Sprintln(peer), probeDetails(probe))
+						}
+					}
+
 					if verbose {
 						routers := siteLevel.NewChild("Routers:")
 						for _, routerStatus := range siteStatus.RouterStatus {
@@ -217,6 +229,23 @@ func PrintNetworkStatus(currentSite string, currentNetworkStatus *network.Networ
 	return nil
 }
 
+func probeDetails(probe network.ProbeInfo) map[string]string {
+	details := map[string]string{"status": probe.Status}
+	if probe.Reason != "" {
+		details["reason"] = probe.Reason
+	}
+	if probe.Latency > 0 {
+		details["latency"] = (time.Duration(probe.Latency) * time.Microsecond).String()
+	}
+	if probe.Throughput > 0 {
+		details["throughput"] = fmt.Sprintf("%.1f MB/s", float64(probe.Throughput)/1e6)
+	}
+	if probe.DownCount > 0 {
+		details["failures"] = strconv.FormatUint(probe.DownCount, 10)
+	}
+	return details
+}
+
 func PrintServiceStatus(currentNetworkStatus *network.NetworkStatusInfo, mapServiceLabels map[string]map[string]string, verboseServiceStatus bool, showLabels bool, localSiteInfo *network.LocalSiteInfo) error {
 	statusManager := network.SkupperStatus{
 		NetworkStatus: currentNetworkStatus,
@@ -3,6 +3,8 @@ package v2alpha1
 import (
 	"fmt"
 	"reflect"
+	"slices"
+	"strconv"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -194,12 +196,22 @@ type SiteList struct {
 }
 
 type SiteSpec struct {
-	ServiceAccount string            `json:"serviceAccount,omitempty"`
-	LinkAccess     string            `json:"linkAccess,omitempty"`
-	DefaultIssuer  string            `json:"defaultIssuer,omitempty"`
-	Edge           bool              `json:"edge,omitempty"`
-	HA             bool              `json:"ha,omitempty"`
-	Settings       map[string]string `json:"settings,omitempty"`
+	ServiceAccount string             `json:"serviceAccount,omitempty"`
+	LinkAccess     string             `json:"linkAccess,omitempty"`
+	DefaultIssuer  string             `json:"defaultIssuer,omitempty"`
+	Edge           bool               `json:"edge,omitempty"`
+	HA             bool               `json:"ha,omitempty"`
+	Settings       map[string]string  `json:"settings,omitempty"`
+	Autoscaling    *RouterAutoscaling `json:"autoscaling,omitempty"`
+}
+
+// RouterAutoscaling bounds the number of router groups the controller
+// may run for a site in response to the observed router load.
+type RouterAutoscaling struct {
+	MinRouters            int `json:"minRouters,omitempty"`
+	MaxRouters            int `json:"maxRouters"`
+	TargetConnections     int `json:"targetConnections,omitempty"`
+	ScaleDownDelaySeconds int `json:"scaleDownDelaySeconds,omitempty"`
 }
 
 func (s *SiteSpec) GetServiceAccount() string {
@@ -216,6 +228,60 @@ func (s *SiteSpec) GetRouterLogging() string {
 	return ""
 }
 
+func (s *SiteSpec) GetProbeInterval() string {
+	if value, ok := s.Settings["probe-interval"]; ok {
+		return value
+	}
+	if s.GetLinkCostAdjustment() != "" {
+		// link costs are derived from probe latency, so probing
+		// must be on whenever they are adjusted
+		return "30s"
+	}
+	return ""
+}
+
+func (s *SiteSpec) GetProbeThroughputBytes() string {
+	if value, ok := s.Settings["probe-throughput-bytes"]; ok {
+		return value
+	}
+	return ""
+}
+
+func (s *SiteSpec) GetLinkCostAdjustment() string {
+	if value, ok := s.Settings["link-cost-adjustment"]; ok {
+		return value
+	}
+	return ""
+}
+
+func (s *SiteSpec) GetLinkCostMin() string {
+	if value, ok := s.Settings["link-cost-min"]; ok {
+		return value
+	}
+	return ""
+}
+
+func (s *SiteSpec) GetLinkCostMax() string {
+	if value, ok := s.Settings["link-cost-max"]; ok {
+		return value
+	}
+	return ""
+}
+
+func (s *SiteSpec) GetLinkCostLatencyCeiling() string {
+	if value, ok := s.Settings["link-cost-latency-ceiling"]; ok {
+		return value
+	}
+	return ""
+}
+
+func (s *SiteSpec) GetLinkCostHoldTime() string {
+	if value, ok := s.Settings["link-cost-hold-time"]; ok {
+		return value
+	}
+	return ""
+}
+
 func (s *SiteSpec) GetRouterDataConnectionCount() string {
 	if value, ok := s.Settings["router-data-connection-count"]; ok {
 		return value
@@ -252,6 +318,16 @@ func (s *Site) SetRunning(state ConditionState) bool {
 	return false
 }
 
+// SetAutoscaling records the supplied autoscaling status, returning true
+// if it differs from that previously recorded.
+func (s *Site) SetAutoscaling(status *RouterAutoscalingStatus) bool {
+	if reflect.DeepEqual(s.Status.Autoscaling, status) {
+		return false
+	}
+	s.Status.Autoscaling = status
+	return true
+}
+
 func (s *Site) resolutionRequired() bool {
 	return s.Spec.LinkAccess != "" && s.Spec.LinkAccess != "none"
 }
@@ -281,14 +357,25 @@ const CONDITION_TYPE_PROCESSED = "Processed"
 const CONDITION_TYPE_REDEEMED = "Redeemed"
 const CONDITION_TYPE_OPERATIONAL = "Operational"
 const CONDITION_TYPE_READY = "Ready"
+const CONDITION_TYPE_BALANCING = "Balancing"
 
 type SiteStatus struct {
 	Status         `json:",inline"`
-	Endpoints      []Endpoint   `json:"endpoints,omitempty"`
-	SitesInNetwork int          `json:"sitesInNetwork,omitempty"`
-	Network        []SiteRecord `json:"network,omitempty"`
-	DefaultIssuer  string       `json:"defaultIssuer,omitempty"`
-	Controller     *Controller  `json:"controller,omitempty"`
+	Endpoints      []Endpoint               `json:"endpoints,omitempty"`
+	SitesInNetwork int                      `json:"sitesInNetwork,omitempty"`
+	Network        []SiteRecord             `json:"network,omitempty"`
+	DefaultIssuer  string                   `json:"defaultIssuer,omitempty"`
+	Controller     *Controller              `json:"controller,omitempty"`
+	Autoscaling    *RouterAutoscalingStatus `json:"autoscaling,omitempty"`
+}
+
+// RouterAutoscalingStatus records the most recent load observed by the
+// autoscaler and the decision taken on it.
+type RouterAutoscalingStatus struct {
+	Routers           int      `json:"routers"`
+	ActiveConnections int      `json:"activeConnections"`
+	Message           string   `json:"message,omitempty"`
+	LastScaleTime     *v1.Time `json:"lastScaleTime,omitempty"`
 }
 
 type Controller struct {
@@ -388,6 +475,34 @@ func (l *Listener) Protocol() corev1.Protocol {
 	return corev1.ProtocolTCP
 }
 
+// BalancingRequested indicates whether the listener specifies an
+// explicit balancing policy.
+func (l *Listener) BalancingRequested() bool {
+	return l.Spec.Balancing != ""
+}
+
+// SetBalancing records the balancing policy in effect for the
+// listener, along with a condition describing whether that is what
+// was requested. An empty policy clears any previously recorded.
+func (l *Listener) SetBalancing(effective string, state ConditionState) bool {
+	changed := false
+	if l.Status.Balancing != effective {
+		l.Status.Balancing = effective
+		changed = true
+	}
+	if effective == "" {
+		if meta.FindStatusCondition(l.Status.Conditions, CONDITION_TYPE_BALANCING) != nil {
+			meta.RemoveStatusCondition(&l.Status.Conditions, CONDITION_TYPE_BALANCING)
+			changed = true
+		}
+		return changed
+	}
+	if l.Status.SetCondition(CONDITION_TYPE_BALANCING, state, l.ObjectMeta.Generation) {
+		changed = true
+	}
+	return changed
+}
+
 func (s *Listener) IsConfigured() bool {
 	return meta.IsStatusConditionTrue(s.Status.Conditions, CONDITION_TYPE_CONFIGURED)
 }
@@ -408,12 +523,14 @@ type ListenerSpec struct {
 	TlsCredentials   string            `json:"tlsCredentials,omitempty"`
 	Type             string            `json:"type,omitempty"`
 	ExposePodsByName bool              `json:"exposePodsByName,omitempty"`
+	Balancing        string            `json:"balancing,omitempty"`
 	Settings         map[string]string `json:"settings,omitempty"`
 }
 
 type ListenerStatus struct {
 	Status               `json:",inline"`
-	HasMatchingConnector bool `json:"hasMatchingConnector,omitempty"`
+	HasMatchingConnector bool   `json:"hasMatchingConnector,omitempty"`
+	Balancing            string `json:"balancing,omitempty"`
 }
 
 type ServicePort struct {
@@ -467,6 +584,34 @@ func (c *Connector) SetHasMatchingListener(value bool) bool {
 	return changed
 }
 
+// BalancingRequested indicates whether the connector specifies a
+// weight or a locality preference.
+func (c *Connector) BalancingRequested() bool {
+	return c.Spec.Weight != 0 || c.Spec.Locality != ""
+}
+
+// SetBalancing records the balancing policy in effect for the
+// connector, along with a condition describing whether that is what
+// was requested. An empty policy clears any previously recorded.
+func (c *Connector) SetBalancing(effective string, state ConditionState) bool {
+	changed := false
+	if c.Status.Balancing != effective {
+		c.Status.Balancing = effective
+		changed = true
+	}
+	if effective == "" {
+		if meta.FindStatusCondition(c.Status.Conditions, CONDITION_TYPE_BALANCING) != nil {
+			meta.RemoveStatusCondition(&c.Status.Conditions, CONDITION_TYPE_BALANCING)
+			changed = true
+		}
+		return changed
+	}
+	if c.Status.SetCondition(CONDITION_TYPE_BALANCING, state, c.ObjectMeta.Generation) {
+		changed = true
+	}
+	return changed
+}
+
 func (c *Connector) SetSelectedPods(pods []PodDetails) bool {
 	if !reflect.DeepEqual(pods, c.Status.SelectedPods) {
 		c.Status.SelectedPods = pods
@@ -475,6 +620,35 @@ func (c *Connector) SetSelectedPods(pods []PodDetails) bool {
 	return false
 }
 
+func (c *Connector) SetSelectedContainers(containers []ContainerDetails) bool {
+	if !reflect.DeepEqual(containers, c.Status.SelectedContainers) {
+		c.Status.SelectedContainers = containers
+		return true
+	}
+	return false
+}
+
+// SetTargetHealth records the host:port targets of the connector that are
+// passing and failing its health check.
+func (c *Connector) SetTargetHealth(healthy []string, unhealthy []string) bool {
+	if slices.Equal(c.Status.HealthyTargets, healthy) && slices.Equal(c.Status.UnhealthyTargets, unhealthy) {
+		return false
+	}
+	c.Status.HealthyTargets = healthy
+	c.Status.UnhealthyTargets = unhealthy
+	return true
+}
+
+// SetResolvedTargets records the host:port targets that the hosts or
+// SRV record of the connector currently resolve to.
+func (c *Connector) SetResolvedTargets(targets []string) bool {
+	if slices.Equal(c.Status.ResolvedTargets, targets) {
+		return false
+	}
+	c.Status.ResolvedTargets = targets
+	return true
+}
+
 func (s *Connector) IsConfigured() bool {
 	return meta.IsStatusConditionTrue(s.Status.Conditions, CONDITION_TYPE_CONFIGURED)
 }
@@ -491,6 +665,8 @@ type ConnectorList struct {
 type ConnectorSpec struct {
 	RoutingKey          string            `json:"routingKey"`
 	Host                string            `json:"host,omitempty"`
+	Hosts               []string          `json:"hosts,omitempty"`
+	SrvRecord           string            `json:"srvRecord,omitempty"`
 	Selector            string            `json:"selector,omitempty"`
 	Port                int               `json:"port"`
 	TlsCredentials      string            `json:"tlsCredentials,omitempty"`
@@ -499,19 +675,49 @@ type ConnectorSpec struct {
 	Type                string            `json:"type,omitempty"`
 	ExposePodsByName    bool              `json:"exposePodsByName,omitempty"`
 	IncludeNotReadyPods bool              `json:"includeNotReadyPods,omitempty"`
+	Weight              int               `json:"weight,omitempty"`
+	Locality            string            `json:"locality,omitempty"`
+	HealthCheck         *HealthCheck      `json:"healthCheck,omitempty"`
 	Settings            map[string]string `json:"settings,omitempty"`
 }
 
+// HealthCheck describes an active check of the targets of a
+// Connector. Targets failing the check are not used for new
+// connections until they pass it again.
+type HealthCheck struct {
+	Type             string `json:"type,omitempty"`
+	Path             string `json:"path,omitempty"`
+	Scheme           string `json:"scheme,omitempty"`
+	Port             int    `json:"port,omitempty"`
+	PeriodSeconds    int    `json:"periodSeconds,omitempty"`
+	TimeoutSeconds   int    `json:"timeoutSeconds,omitempty"`
+	FailureThreshold int    `json:"failureThreshold,omitempty"`
+	SuccessThreshold int    `json:"successThreshold,omitempty"`
+}
+
 type PodDetails struct {
 	UID  string `json:"-"`
 	Name string `json:"name,omitempty"`
 	IP   string `json:"ip,omitempty"`
 }
 
+// ContainerDetails identifies a container selected by a Connector on
+// a podman or docker site.
+type ContainerDetails struct {
+	ID   string `json:"-"`
+	Name string `json:"name,omitempty"`
+	IP   string `json:"ip,omitempty"`
+}
+
 type ConnectorStatus struct {
 	Status              `json:",inline"`
-	SelectedPods        []PodDetails `json:"selectedPods,omitempty"`
-	HasMatchingListener bool         `json:"hasMatchingListener,omitempty"`
+	SelectedPods        []PodDetails       `json:"selectedPods,omitempty"`
+	SelectedContainers  []ContainerDetails `json:"selectedContainers,omitempty"`
+	HasMatchingListener bool               `json:"hasMatchingListener,omitempty"`
+	Balancing           string             `json:"balancing,omitempty"`
+	HealthyTargets      []string           `json:"healthyTargets,omitempty"`
+	UnhealthyTargets    []string           `json:"unhealthyTargets,omitempty"`
+	ResolvedTargets     []string           `json:"resolvedTargets,omitempty"`
 }
 
 // +genclient
@@ -591,10 +797,40 @@ func (s *LinkSpec) GetEndpointForRole(name string) (Endpoint, bool) {
 	return Endpoint{}, false
 }
 
+func (s *LinkSpec) GetCostMin() string {
+	if value, ok := s.Settings["cost-min"]; ok {
+		return value
+	}
+	return ""
+}
+
+func (s *LinkSpec) GetCostMax() string {
+	if value, ok := s.Settings["cost-max"]; ok {
+		return value
+	}
+	return ""
+}
+
+// IsCostPinned returns true if the link should always use the cost
+// in its spec, even when the site adjusts link costs automatically.
+func (s *LinkSpec) IsCostPinned() bool {
+	value, _ := strconv.ParseBool(s.Settings["cost-pinned"])
+	return value
+}
+
 type LinkStatus struct {
 	Status         `json:",inline"`
 	RemoteSiteId   string `json:"remoteSiteId,omitempty"`
 	RemoteSiteName string `json:"remoteSiteName,omitempty"`
+	EffectiveCost  int    `json:"effectiveCost,omitempty"`
+}
+
+func (l *Link) SetEffectiveCost(cost int) bool {
+	if l.Status.EffectiveCost == cost {
+		return false
+	}
+	l.Status.EffectiveCost = cost
+	return true
 }
 
 // +genclient
@@ -697,15 +933,30 @@ type AccessGrantSpec struct {
 	Code               string            `json:"code,omitempty"`
 	Issuer             string            `json:"issuer,omitempty"`
 	Settings           map[string]string `json:"settings,omitempty"`
+	// AllowedSourceCIDRs restricts the addresses from which access
+	// tokens can be redeemed. When empty, any address is allowed.
+	AllowedSourceCIDRs []string `json:"allowedSourceCIDRs,omitempty"`
+	// ExpectedSiteName, when set, is the only site allowed to redeem
+	// access tokens.
+	ExpectedSiteName string `json:"expectedSiteName,omitempty"`
 }
 
 type AccessGrantStatus struct {
-	Status         `json:",inline"`
-	Url            string `json:"url,omitempty"`
-	Code           string `json:"code,omitempty"`
-	Ca             string `json:"ca,omitempty"`
-	Redemptions    int    `json:"redemptions,omitempty"`
-	ExpirationTime string `json:"expirationTime,omitempty"`
+	Status              `json:",inline"`
+	Url                 string               `json:"url,omitempty"`
+	Code                string               `json:"code,omitempty"`
+	Ca                  string               `json:"ca,omitempty"`
+	Redemptions         int                  `json:"redemptions,omitempty"`
+	ExpirationTime      string               `json:"expirationTime,omitempty"`
+	RejectedRedemptions []RejectedRedemption `json:"rejectedRedemptions,omitempty"`
+}
+
+// RejectedRedemption records an attempt to redeem an access token that
+// was refused.
+type RejectedRedemption struct {
+	Time   string `json:"time"`
+	Source string `json:"source"`
+	Reason string `json:"reason"`
 }
 
 // +genclient
@@ -97,6 +97,11 @@ func (in *AccessGrantSpec) DeepCopyInto(out *AccessGrantSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.AllowedSourceCIDRs != nil {
+		in, out := &in.AllowedSourceCIDRs, &out.AllowedSourceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -114,6 +119,11 @@ func (in *AccessGrantSpec) DeepCopy() *AccessGrantSpec {
 func (in *AccessGrantStatus) DeepCopyInto(out *AccessGrantStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.RejectedRedemptions != nil {
+		in, out := &in.RejectedRedemptions, &out.RejectedRedemptions
+		*out = make([]RejectedRedemption, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -621,6 +631,16 @@ func (in *ConnectorList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectorSpec) DeepCopyInto(out *ConnectorSpec) {
 	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(HealthCheck)
+		**out = **in
+	}
 	if in.Settings != nil {
 		in, out := &in.Settings, &out.Settings
 		*out = make(map[string]string, len(*in))
@@ -650,6 +670,26 @@ func (in *ConnectorStatus) DeepCopyInto(out *ConnectorStatus) {
 		*out = make([]PodDetails, len(*in))
 		copy(*out, *in)
 	}
+	if in.SelectedContainers != nil {
+		in, out := &in.SelectedContainers, &out.SelectedContainers
+		*out = make([]ContainerDetails, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthyTargets != nil {
+		in, out := &in.HealthyTargets, &out.HealthyTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnhealthyTargets != nil {
+		in, out := &in.UnhealthyTargets, &out.UnhealthyTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResolvedTargets != nil {
+		in, out := &in.ResolvedTargets, &out.ResolvedTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -663,6 +703,22 @@ func (in *ConnectorStatus) DeepCopy() *ConnectorStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerDetails) DeepCopyInto(out *ContainerDetails) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerDetails.
+func (in *ContainerDetails) DeepCopy() *ContainerDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Controller) DeepCopyInto(out *Controller) {
 	*out = *in
@@ -695,6 +751,22 @@ func (in *Endpoint) DeepCopy() *Endpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthCheck) DeepCopyInto(out *HealthCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthCheck.
+func (in *HealthCheck) DeepCopy() *HealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Link) DeepCopyInto(out *Link) {
 	*out = *in
@@ -934,6 +1006,22 @@ func (in *PodDetails) DeepCopy() *PodDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RejectedRedemption) DeepCopyInto(out *RejectedRedemption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RejectedRedemption.
+func (in *RejectedRedemption) DeepCopy() *RejectedRedemption {
+	if in == nil {
+		return nil
+	}
+	out := new(RejectedRedemption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouterAccess) DeepCopyInto(out *RouterAccess) {
 	*out = *in
@@ -1066,6 +1154,42 @@ func (in *RouterAccessStatus) DeepCopy() *RouterAccessStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterAutoscaling) DeepCopyInto(out *RouterAutoscaling) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterAutoscaling.
+func (in *RouterAutoscaling) DeepCopy() *RouterAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouterAutoscalingStatus) DeepCopyInto(out *RouterAutoscalingStatus) {
+	*out = *in
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterAutoscalingStatus.
+func (in *RouterAutoscalingStatus) DeepCopy() *RouterAutoscalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouterAutoscalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecuredAccess) DeepCopyInto(out *SecuredAccess) {
 	*out = *in
@@ -1341,6 +1465,11 @@ func (in *SiteSpec) DeepCopyInto(out *SiteSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(RouterAutoscaling)
+		**out = **in
+	}
 	return
 }
 
@@ -1375,6 +1504,11 @@ func (in *SiteStatus) DeepCopyInto(out *SiteStatus) {
 		*out = new(Controller)
 		**out = **in
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(RouterAutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -0,0 +1,11 @@
+// extra hand written types referenced by the generated code
+package client
+
+import "strings"
+
+// AtmarkDelimitedString is a string made up of several parts joined by "@".
+type AtmarkDelimitedString string
+
+func (a AtmarkDelimitedString) Parts() []string {
+	return strings.Split(string(a), "@")
+}
@@ -0,0 +1,359 @@
+// Package client is a Go client for the network observer HTTP API.
+//
+// The record types and the low level Client are generated from the API
+// specification in cmd/network-observer/spec/openapi.yaml. Observer wraps
+// the generated Client to page through collections and to apply filters,
+// time ranges, TLS settings and credentials.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultPageSize is the number of records requested at a time when
+// listing a collection.
+const DefaultPageSize = 500
+
+// Config describes how to reach the network observer API.
+type Config struct {
+	// Endpoint is the base URL of the API, for example
+	// https://network-observer.example.com:8443
+	Endpoint string
+	// CAFile is the path of a PEM file of certificates used to verify the
+	// API server. The system roots are used when empty.
+	CAFile string
+	// CertFile and KeyFile are the paths of a PEM client certificate and
+	// key presented to the API server.
+	CertFile string
+	KeyFile  string
+	// InsecureSkipVerify disables verification of the API server
+	// certificate.
+	InsecureSkipVerify bool
+	// Token is sent as a bearer token with each request.
+	Token string
+	// Username and Password are sent using HTTP basic authentication when
+	// Token is empty.
+	Username string
+	Password string
+	// Timeout bounds each request. No timeout is applied when zero.
+	Timeout time.Duration
+	// HTTPClient, when set, is used for requests in place of a client
+	// built from the TLS settings and Timeout.
+	HTTPClient HttpRequestDoer
+}
+
+// Observer queries the network observer API.
+type Observer struct {
+	client   *Client
+	PageSize int
+}
+
+// NewObserver returns an Observer for the API described by config.
+func NewObserver(config Config) (*Observer, error) {
+	if config.Endpoint == "" {
+		return nil, fmt.Errorf("network observer endpoint is required")
+	}
+	doer := config.HTTPClient
+	if doer == nil {
+		tlsConfig, err := config.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		doer = &http.Client{
+			Transport: transport,
+			Timeout:   config.Timeout,
+		}
+	}
+	options := []ClientOption{WithHTTPClient(doer)}
+	switch {
+	case config.Token != "":
+		options = append(options, WithRequestEditorFn(func(_ context.Context, r *http.Request) error {
+			r.Header.Set("Authorization", "Bearer "+config.Token)
+			return nil
+		}))
+	case config.Username != "":
+		options = append(options, WithRequestEditorFn(func(_ context.Context, r *http.Request) error {
+			r.SetBasicAuth(config.Username, config.Password)
+			return nil
+		}))
+	}
+	client, err := NewClient(strings.TrimSuffix(config.Endpoint, "/"), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Observer{
+		client:   client,
+		PageSize: DefaultPageSize,
+	}, nil
+}
+
+func (c Config) tlsConfig() (*tls.Config, error) {
+	if c.CAFile == "" && c.CertFile == "" && c.KeyFile == "" && !c.InsecureSkipVerify {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if c.CAFile != "" {
+		ca, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no certificates found in CA file %q", c.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// State selects records by whether they have ended.
+type State string
+
+const (
+	StateAll        State = "all"
+	StateActive     State = "active"
+	StateTerminated State = "terminated"
+)
+
+// ListOptions narrow and order the records listed from a collection.
+type ListOptions struct {
+	// Network limits the records listed to a single network when the
+	// network observer observes more than one.
+	Network string
+	// Filter is a filter expression, for example
+	// `protocol == tcp and octetCount > 1e6`
+	Filter string
+	// Fields match records whose field has the given value, or for
+	// string fields starts with the given value.
+	Fields map[string]string
+	// Start and End bound the time range of the records listed. The API
+	// defaults to the last 15 minutes when Start is zero.
+	Start time.Time
+	End   time.Time
+	// State selects active or terminated records. All records are listed
+	// when empty.
+	State State
+	// SortBy orders the records by a field, with an optional ".asc" or
+	// ".desc" suffix. Records are ordered by identity when empty.
+	SortBy string
+	// Limit is the maximum number of records listed. All matching records
+	// are listed when zero.
+	Limit int
+}
+
+func (o ListOptions) editor(offset, limit int) RequestEditorFn {
+	return func(_ context.Context, r *http.Request) error {
+		query := r.URL.Query()
+		for field, value := range o.Fields {
+			query.Set(field, value)
+		}
+		if o.Network != "" {
+			query.Set("network", o.Network)
+		}
+		if o.Filter != "" {
+			query.Set("filter", o.Filter)
+		}
+		if !o.Start.IsZero() {
+			query.Set("timeRangeStart", strconv.FormatInt(o.Start.UnixMicro(), 10))
+		}
+		if !o.End.IsZero() {
+			query.Set("timeRangeEnd", strconv.FormatInt(o.End.UnixMicro(), 10))
+		}
+		if o.State != "" {
+			query.Set("state", string(o.State))
+		}
+		sortBy := o.SortBy
+		if sortBy == "" {
+			sortBy = "identity"
+		}
+		if !strings.HasSuffix(sortBy, ".asc") && !strings.HasSuffix(sortBy, ".desc") {
+			sortBy += ".asc"
+		}
+		query.Set("sortBy", sortBy)
+		query.Set("offset", strconv.Itoa(offset))
+		query.Set("limit", strconv.Itoa(limit))
+		r.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
+
+// Error is returned when the API responds to a request with an error.
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("network observer responded with %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("network observer responded with %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+type collectionPage[T any] struct {
+	Results        []T   `json:"results"`
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+type listFunc func(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+// list pages through a collection until all records matching options, or
+// options.Limit records, have been read.
+func list[T any](ctx context.Context, o *Observer, fn listFunc, options ListOptions) ([]T, error) {
+	pageSize := o.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	var results []T
+	for {
+		limit := pageSize
+		if options.Limit > 0 && options.Limit-len(results) < limit {
+			limit = options.Limit - len(results)
+		}
+		var page collectionPage[T]
+		if err := do(ctx, fn, &page, options.editor(len(results), limit)); err != nil {
+			return nil, err
+		}
+		results = append(results, page.Results...)
+		if len(page.Results) < limit || int64(len(results)) >= page.TimeRangeCount ||
+			(options.Limit > 0 && len(results) >= options.Limit) {
+			break
+		}
+	}
+	if results == nil {
+		results = []T{}
+	}
+	return results, nil
+}
+
+// byQuery adapts a client method taking query parameters to a listFunc.
+// The parameters are left unset, as ListOptions sets the query itself.
+func byQuery[P any](fn func(ctx context.Context, params *P, reqEditors ...RequestEditorFn) (*http.Response, error)) listFunc {
+	return func(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+		return fn(ctx, nil, reqEditors...)
+	}
+}
+
+// get reads a single record.
+func get[T any](ctx context.Context, fn listFunc) (T, error) {
+	var response struct {
+		Results T `json:"results"`
+	}
+	err := do(ctx, fn, &response)
+	return response.Results, err
+}
+
+func do(ctx context.Context, fn listFunc, out any, editors ...RequestEditorFn) error {
+	response, err := fn(ctx, editors...)
+	if err != nil {
+		return fmt.Errorf("failed to reach the network observer: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		var body ErrorResponse
+		_ = json.NewDecoder(response.Body).Decode(&body)
+		return &Error{StatusCode: response.StatusCode, Message: body.Message}
+	}
+	if err := json.NewDecoder(response.Body).Decode(out); err != nil {
+		return fmt.Errorf("invalid response from the network observer: %w", err)
+	}
+	return nil
+}
+
+func (o *Observer) Sites(ctx context.Context, options ListOptions) ([]SiteRecord, error) {
+	return list[SiteRecord](ctx, o, byQuery(o.client.Sites), options)
+}
+
+func (o *Observer) Site(ctx context.Context, id string) (SiteRecord, error) {
+	return get[SiteRecord](ctx, func(ctx context.Context, editors ...RequestEditorFn) (*http.Response, error) {
+		return o.client.SiteById(ctx, id, editors...)
+	})
+}
+
+func (o *Observer) Routers(ctx context.Context, options ListOptions) ([]RouterRecord, error) {
+	return list[RouterRecord](ctx, o, byQuery(o.client.Routers), options)
+}
+
+func (o *Observer) RouterLinks(ctx context.Context, options ListOptions) ([]RouterLinkRecord, error) {
+	return list[RouterLinkRecord](ctx, o, byQuery(o.client.Routerlinks), options)
+}
+
+func (o *Observer) Processes(ctx context.Context, options ListOptions) ([]ProcessRecord, error) {
+	return list[ProcessRecord](ctx, o, byQuery(o.client.Processes), options)
+}
+
+func (o *Observer) Process(ctx context.Context, id string) (ProcessRecord, error) {
+	return get[ProcessRecord](ctx, func(ctx context.Context, editors ...RequestEditorFn) (*http.Response, error) {
+		return o.client.ProcessById(ctx, id, editors...)
+	})
+}
+
+func (o *Observer) Components(ctx context.Context, options ListOptions) ([]ComponentRecord, error) {
+	return list[ComponentRecord](ctx, o, byQuery(o.client.Components), options)
+}
+
+func (o *Observer) Listeners(ctx context.Context, options ListOptions) ([]ListenerRecord, error) {
+	return list[ListenerRecord](ctx, o, byQuery(o.client.Listeners), options)
+}
+
+func (o *Observer) Connectors(ctx context.Context, options ListOptions) ([]ConnectorRecord, error) {
+	return list[ConnectorRecord](ctx, o, byQuery(o.client.Connectors), options)
+}
+
+func (o *Observer) Services(ctx context.Context, options ListOptions) ([]ServiceRecord, error) {
+	return list[ServiceRecord](ctx, o, byQuery(o.client.Services), options)
+}
+
+func (o *Observer) Connections(ctx context.Context, options ListOptions) ([]ConnectionRecord, error) {
+	return list[ConnectionRecord](ctx, o, byQuery(o.client.Connections), options)
+}
+
+func (o *Observer) ApplicationFlows(ctx context.Context, options ListOptions) ([]ApplicationFlowRecord, error) {
+	return list[ApplicationFlowRecord](ctx, o, byQuery(o.client.Applicationflows), options)
+}
+
+func (o *Observer) SitePairs(ctx context.Context, options ListOptions) ([]FlowAggregateRecord, error) {
+	return list[FlowAggregateRecord](ctx, o, byQuery(o.client.Sitepairs), options)
+}
+
+func (o *Observer) ProcessPairs(ctx context.Context, options ListOptions) ([]FlowAggregateRecord, error) {
+	return list[FlowAggregateRecord](ctx, o, byQuery(o.client.Processpairs), options)
+}
+
+func (o *Observer) ComponentPairs(ctx context.Context, options ListOptions) ([]FlowAggregateRecord, error) {
+	return list[FlowAggregateRecord](ctx, o, byQuery(o.client.Componentpairs), options)
+}
+
+// Networks returns the networks the network observer observes.
+func (o *Observer) Networks(ctx context.Context) ([]NetworkRecord, error) {
+	var response NetworkListResponse
+	if err := do(ctx, o.client.Networks, &response); err != nil {
+		return nil, err
+	}
+	return response.Results, nil
+}
+
+func (o *Observer) Logs(ctx context.Context, options ListOptions) ([]LogRecord, error) {
+	return list[LogRecord](ctx, o, byQuery(o.client.Logs), options)
+}
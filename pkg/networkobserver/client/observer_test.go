@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestObserverList(t *testing.T) {
+	sites := make([]SiteRecord, 7)
+	for i := range sites {
+		sites[i] = SiteRecord{Identity: fmt.Sprintf("site-%d", i), Name: fmt.Sprintf("site %d", i)}
+	}
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RawQuery)
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		query := r.URL.Query()
+		if query.Get("filter") == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(ErrorResponse{Message: "invalid filter"})
+			return
+		}
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		end := min(offset+limit, len(sites))
+		json.NewEncoder(w).Encode(SiteListResponse{
+			Results:        sites[offset:end],
+			Count:          int64(end - offset),
+			TimeRangeCount: int64(len(sites)),
+		})
+	}))
+	defer server.Close()
+
+	observer, err := NewObserver(Config{Endpoint: server.URL + "/", Token: "secret"})
+	assert.Assert(t, err)
+	observer.PageSize = 3
+
+	t.Run("all pages", func(t *testing.T) {
+		requests = nil
+		results, err := observer.Sites(context.TODO(), ListOptions{})
+		assert.Assert(t, err)
+		assert.DeepEqual(t, results, sites)
+		assert.DeepEqual(t, requests, []string{
+			"limit=3&offset=0&sortBy=identity.asc",
+			"limit=3&offset=3&sortBy=identity.asc",
+			"limit=3&offset=6&sortBy=identity.asc",
+		})
+	})
+	t.Run("limit", func(t *testing.T) {
+		requests = nil
+		results, err := observer.Sites(context.TODO(), ListOptions{Limit: 4})
+		assert.Assert(t, err)
+		assert.DeepEqual(t, results, sites[:4])
+		assert.Equal(t, len(requests), 2)
+		assert.Equal(t, requests[1], "limit=1&offset=3&sortBy=identity.asc")
+	})
+	t.Run("options", func(t *testing.T) {
+		requests = nil
+		start := time.UnixMicro(1000)
+		_, err := observer.Sites(context.TODO(), ListOptions{
+			Network: "prod",
+			Filter:  "name ^= east",
+			Fields:  map[string]string{"platform": "kubernetes"},
+			Start:   start,
+			End:     start.Add(time.Second),
+			State:   StateActive,
+			SortBy:  "name.desc",
+			Limit:   2,
+		})
+		assert.Assert(t, err)
+		assert.Equal(t, requests[0], "filter=name+%5E%3D+east&limit=2&network=prod&offset=0&platform=kubernetes&sortBy=name.desc&state=active&timeRangeEnd=1001000&timeRangeStart=1000")
+	})
+	t.Run("error", func(t *testing.T) {
+		_, err := observer.Sites(context.TODO(), ListOptions{Filter: "bad"})
+		assert.Error(t, err, "network observer responded with 400 Bad Request: invalid filter")
+	})
+	t.Run("unauthorized", func(t *testing.T) {
+		anonymous, err := NewObserver(Config{Endpoint: server.URL})
+		assert.Assert(t, err)
+		_, err = anonymous.Sites(context.TODO(), ListOptions{})
+		assert.Error(t, err, "network observer responded with 401 Unauthorized")
+	})
+}
+
+func TestNewObserver(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		err    string
+	}{
+		{
+			name:   "no endpoint",
+			config: Config{},
+			err:    "network observer endpoint is required",
+		},
+		{
+			name:   "missing ca",
+			config: Config{Endpoint: "https://observer", CAFile: "/does/not/exist"},
+			err:    "failed to read CA file: open /does/not/exist: no such file or directory",
+		},
+		{
+			name:   "missing key",
+			config: Config{Endpoint: "https://observer", CertFile: "/does/not/exist"},
+			err:    "failed to load client certificate: open /does/not/exist: no such file or directory",
+		},
+		{
+			name:   "insecure",
+			config: Config{Endpoint: "https://observer", InsecureSkipVerify: true, Username: "admin", Password: "pw"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewObserver(tt.config)
+			if tt.err != "" {
+				assert.Error(t, err, tt.err)
+				return
+			}
+			assert.Assert(t, err)
+		})
+	}
+}
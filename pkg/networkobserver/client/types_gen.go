@@ -0,0 +1,6718 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.3.0 DO NOT EDIT.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for ProcessRecordRole.
+const (
+	External ProcessRecordRole = "external"
+	Internal ProcessRecordRole = "internal"
+	Remote   ProcessRecordRole = "remote"
+)
+
+// Defines values for AlertStateType.
+const (
+	AlertStateFiring   AlertStateType = "firing"
+	AlertStatePending  AlertStateType = "pending"
+	AlertStateResolved AlertStateType = "resolved"
+)
+
+// Defines values for FlowAggregatePairType.
+const (
+	PROCESS      FlowAggregatePairType = "PROCESS"
+	PROCESSGROUP FlowAggregatePairType = "PROCESS_GROUP"
+	SITE         FlowAggregatePairType = "SITE"
+)
+
+// Defines values for HistoryResolutionType.
+const (
+	HistoryResolution10m HistoryResolutionType = "10m"
+	HistoryResolution1h  HistoryResolutionType = "1h"
+	HistoryResolution1m  HistoryResolutionType = "1m"
+)
+
+// Defines values for LinkRoleType.
+const (
+	LinkRoleTypeEdge        LinkRoleType = "edge"
+	LinkRoleTypeInterRouter LinkRoleType = "inter-router"
+	LinkRoleTypeUnknown     LinkRoleType = "unknown"
+)
+
+// Defines values for LogSeverityType.
+const (
+	LogSeverityCritical LogSeverityType = "critical"
+	LogSeverityDebug    LogSeverityType = "debug"
+	LogSeverityError    LogSeverityType = "error"
+	LogSeverityInfo     LogSeverityType = "info"
+	LogSeverityNotice   LogSeverityType = "notice"
+	LogSeverityTrace    LogSeverityType = "trace"
+	LogSeverityUnknown  LogSeverityType = "unknown"
+	LogSeverityWarning  LogSeverityType = "warning"
+)
+
+// Defines values for OperStatusType.
+const (
+	Down OperStatusType = "down"
+	Up   OperStatusType = "up"
+)
+
+// Defines values for ProcessBindingType.
+const (
+	Bound   ProcessBindingType = "bound"
+	Unbound ProcessBindingType = "unbound"
+)
+
+// Defines values for SitePlatformType.
+const (
+	SitePlatformTypeDocker     SitePlatformType = "docker"
+	SitePlatformTypeKubernetes SitePlatformType = "kubernetes"
+	SitePlatformTypeLinux      SitePlatformType = "linux"
+	SitePlatformTypePodman     SitePlatformType = "podman"
+	SitePlatformTypeUnknown    SitePlatformType = "unknown"
+)
+
+// AlertListResponse defines model for AlertListResponse.
+type AlertListResponse struct {
+	// Count number of results in response
+	Count   int64         `json:"count"`
+	Results []AlertRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// AlertRecord defines model for AlertRecord.
+type AlertRecord struct {
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// FiringTime The time the alert fired, in microseconds since the epoch
+	FiringTime *uint64 `json:"firingTime,omitempty"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network *string `json:"network,omitempty"`
+
+	// Rule The name of the rule that raised the alert
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64         `json:"startTime"`
+	State     AlertStateType `json:"state"`
+
+	// SubjectId The identity of the record the alert is about
+	SubjectId   string `json:"subjectId"`
+	SubjectName string `json:"subjectName"`
+	Summary     string `json:"summary"`
+}
+
+// ApplicationFlowRecord defines model for ApplicationFlowRecord.
+type ApplicationFlowRecord struct {
+	ConnectionId    string  `json:"connectionId"`
+	DestProcessId   string  `json:"destProcessId"`
+	DestProcessName string  `json:"destProcessName"`
+	DestSiteId      string  `json:"destSiteId"`
+	DestSiteName    string  `json:"destSiteName"`
+	Duration        *uint64 `json:"duration"`
+
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+	Method   string `json:"method"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network           *string `json:"network,omitempty"`
+	OctetCount        uint64  `json:"octetCount"`
+	OctetReverseCount uint64  `json:"octetReverseCount"`
+	Protocol          string  `json:"protocol"`
+	RoutingKey        string  `json:"routingKey"`
+	SourceProcessId   string  `json:"sourceProcessId"`
+	SourceProcessName string  `json:"sourceProcessName"`
+	SourceSiteId      string  `json:"sourceSiteId"`
+	SourceSiteName    string  `json:"sourceSiteName"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+	Status    string `json:"status"`
+
+	// TraceRouters Ordered array of the names of routers involved in proxying the connection
+	TraceRouters []string `json:"traceRouters"`
+
+	// TraceSites Ordered array of the names of sites involved in proxying the connection
+	TraceSites []string `json:"traceSites"`
+}
+
+// ApplicationFlowResponse defines model for ApplicationFlowResponse.
+type ApplicationFlowResponse struct {
+	// Count number of results in response
+	Count   int64                   `json:"count"`
+	Results []ApplicationFlowRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// ComponentListResponse defines model for ComponentListResponse.
+type ComponentListResponse struct {
+	// Count number of results in response
+	Count   int64             `json:"count"`
+	Results []ComponentRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// ComponentRecord defines model for ComponentRecord.
+type ComponentRecord struct {
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+	Name     string `json:"name"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network      *string `json:"network,omitempty"`
+	ProcessCount int     `json:"processCount"`
+	Role         string  `json:"role"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+}
+
+// ComponentResponse defines model for ComponentResponse.
+type ComponentResponse struct {
+	Results ComponentRecord `json:"results"`
+}
+
+// ConnectionListResponse defines model for ConnectionListResponse.
+type ConnectionListResponse struct {
+	// Count number of results in response
+	Count   int64              `json:"count"`
+	Results []ConnectionRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// ConnectionRecord defines model for ConnectionRecord.
+type ConnectionRecord struct {
+	ComponentPairId *string `json:"componentPairId"`
+	ConnectorError  *string `json:"connectorError"`
+	ConnectorId     string  `json:"connectorId"`
+	DestHost        string  `json:"destHost"`
+	DestPort        string  `json:"destPort"`
+	DestProcessId   string  `json:"destProcessId"`
+	DestProcessName string  `json:"destProcessName"`
+	DestSiteId      string  `json:"destSiteId"`
+	DestSiteName    string  `json:"destSiteName"`
+	Duration        *uint64 `json:"duration"`
+
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity       string  `json:"identity"`
+	Latency        uint64  `json:"latency"`
+	LatencyReverse uint64  `json:"latencyReverse"`
+	ListenerError  *string `json:"listenerError"`
+	ListenerId     string  `json:"listenerId"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network           *string `json:"network,omitempty"`
+	OctetCount        uint64  `json:"octetCount"`
+	OctetReverseCount uint64  `json:"octetReverseCount"`
+	ProcessPairId     *string `json:"processPairId"`
+	Protocol          string  `json:"protocol"`
+	ProxyHost         string  `json:"proxyHost"`
+	ProxyPort         string  `json:"proxyPort"`
+	RoutingKey        string  `json:"routingKey"`
+	SitePairId        *string `json:"sitePairId"`
+	SourceHost        string  `json:"sourceHost"`
+	SourcePort        string  `json:"sourcePort"`
+	SourceProcessId   string  `json:"sourceProcessId"`
+	SourceProcessName string  `json:"sourceProcessName"`
+	SourceSiteId      string  `json:"sourceSiteId"`
+	SourceSiteName    string  `json:"sourceSiteName"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+
+	// TraceRouters Ordered array of the names of routers involved in proxying the connection
+	TraceRouters []string `json:"traceRouters"`
+
+	// TraceSites Ordered array of the names of sites involved in proxying the connection
+	TraceSites []string `json:"traceSites"`
+}
+
+// ConnectorListResponse defines model for ConnectorListResponse.
+type ConnectorListResponse struct {
+	// Count number of results in response
+	Count   int64             `json:"count"`
+	Results []ConnectorRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// ConnectorRecord defines model for ConnectorRecord.
+type ConnectorRecord struct {
+	DestHost string `json:"destHost"`
+	DestPort string `json:"destPort"`
+
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+	Name     string `json:"name"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network    *string `json:"network,omitempty"`
+	ProcessId  string  `json:"processId"`
+	Protocol   string  `json:"protocol"`
+	RouterId   string  `json:"routerId"`
+	RoutingKey string  `json:"routingKey"`
+	ServiceId  *string `json:"serviceId,omitempty"`
+	SiteId     string  `json:"siteId"`
+	SiteName   string  `json:"siteName"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64  `json:"startTime"`
+	Target    *string `json:"target"`
+}
+
+// ConnectorResponse defines model for ConnectorResponse.
+type ConnectorResponse struct {
+	Results ConnectorRecord `json:"results"`
+}
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// FlowAggregateListResponse defines model for FlowAggregateListResponse.
+type FlowAggregateListResponse struct {
+	// Count number of results in response
+	Count   int64                 `json:"count"`
+	Results []FlowAggregateRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// FlowAggregateRecord defines model for FlowAggregateRecord.
+type FlowAggregateRecord struct {
+	DestinationId       string  `json:"destinationId"`
+	DestinationName     string  `json:"destinationName"`
+	DestinationSiteId   *string `json:"destinationSiteId,omitempty"`
+	DestinationSiteName *string `json:"destinationSiteName,omitempty"`
+
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network        *string               `json:"network,omitempty"`
+	PairType       FlowAggregatePairType `json:"pairType"`
+	Protocol       string                `json:"protocol"`
+	RecordCount    uint64                `json:"recordCount"`
+	SourceId       string                `json:"sourceId"`
+	SourceName     string                `json:"sourceName"`
+	SourceSiteId   *string               `json:"sourceSiteId,omitempty"`
+	SourceSiteName *string               `json:"sourceSiteName,omitempty"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+}
+
+// FlowAggregateResponse defines model for FlowAggregateResponse.
+type FlowAggregateResponse struct {
+	Results FlowAggregateRecord `json:"results"`
+}
+
+// HistoryListResponse defines model for HistoryListResponse.
+type HistoryListResponse struct {
+	// Count number of results in response
+	Count   int64           `json:"count"`
+	Results []HistoryRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// HistoryRecord defines model for HistoryRecord.
+type HistoryRecord struct {
+	// ClosedCount Number of connections closed
+	ClosedCount uint64 `json:"closedCount"`
+
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network *string `json:"network,omitempty"`
+
+	// OctetCount Bytes sent from source to destination
+	OctetCount uint64 `json:"octetCount"`
+
+	// OctetReverseCount Bytes sent from destination to source
+	OctetReverseCount uint64 `json:"octetReverseCount"`
+
+	// OpenedCount Number of connections opened
+	OpenedCount uint64 `json:"openedCount"`
+
+	// Resolution The width of a traffic history bucket.
+	Resolution HistoryResolutionType `json:"resolution"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+}
+
+// ListenerListResponse defines model for ListenerListResponse.
+type ListenerListResponse struct {
+	// Count number of results in response
+	Count   int64            `json:"count"`
+	Results []ListenerRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// ListenerRecord defines model for ListenerRecord.
+type ListenerRecord struct {
+	DestHost string `json:"destHost"`
+	DestPort string `json:"destPort"`
+
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+	Name     string `json:"name"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network    *string `json:"network,omitempty"`
+	Protocol   string  `json:"protocol"`
+	RouterId   string  `json:"routerId"`
+	RoutingKey string  `json:"routingKey"`
+	ServiceId  *string `json:"serviceId,omitempty"`
+	SiteId     string  `json:"siteId"`
+	SiteName   string  `json:"siteName"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+}
+
+// ListenerResponse defines model for ListenerResponse.
+type ListenerResponse struct {
+	Results ListenerRecord `json:"results"`
+}
+
+// LogListResponse defines model for LogListResponse.
+type LogListResponse struct {
+	// Count number of results in response
+	Count   int64       `json:"count"`
+	Results []LogRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// LogRecord defines model for LogRecord.
+type LogRecord struct {
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+
+	// Module The router module that logged the message, when known
+	Module string `json:"module"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network    *string `json:"network,omitempty"`
+	RouterId   string  `json:"routerId"`
+	RouterName string  `json:"routerName"`
+
+	// Severity The severity of a router log message.
+	Severity   LogSeverityType `json:"severity"`
+	SiteId     string          `json:"siteId"`
+	SiteName   string          `json:"siteName"`
+	SourceFile *string         `json:"sourceFile,omitempty"`
+	SourceLine *uint64         `json:"sourceLine,omitempty"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+	Text      string `json:"text"`
+}
+
+// NetworkListResponse defines model for NetworkListResponse.
+type NetworkListResponse struct {
+	// Count number of results in response
+	Count   int64           `json:"count"`
+	Results []NetworkRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// NetworkRecord defines model for NetworkRecord.
+type NetworkRecord struct {
+	// Name The name identifying the network in record identities and the network query parameter.
+	Name string `json:"name"`
+
+	// RouterEndpoint The router endpoint the observer collects records from for the network.
+	RouterEndpoint string `json:"routerEndpoint"`
+}
+
+// ProcessListResponse defines model for ProcessListResponse.
+type ProcessListResponse struct {
+	// Count number of results in response
+	Count   int64           `json:"count"`
+	Results []ProcessRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// ProcessRecord defines model for ProcessRecord.
+type ProcessRecord struct {
+	// Binding Indicates whether a process is exposed or not in a skupper network
+	Binding ProcessBindingType `json:"binding"`
+
+	// ComponentId Id of the component associated to the process.
+	ComponentId   string `json:"componentId"`
+	ComponentName string `json:"componentName"`
+
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// HostName The IP address of the pod within the Kubernetes cluster
+	HostName *string `json:"hostName"`
+
+	// Identity The unique identifier for the record.
+	Identity  string  `json:"identity"`
+	ImageName *string `json:"imageName"`
+	Name      string  `json:"name"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network *string `json:"network,omitempty"`
+
+	// Role Internal processes are processes related to Skupper. Remote processes are processes indirectly connected, such as a proxy
+	Role     ProcessRecordRole        `json:"role"`
+	Services *[]ServiceIdentifierType `json:"services"`
+
+	// SiteId Id of the site associated to the process.
+	SiteId   string `json:"siteId"`
+	SiteName string `json:"siteName"`
+
+	// SourceHost The IP address of the node where the pod is running
+	SourceHost string `json:"sourceHost"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+}
+
+// ProcessRecordRole Internal processes are processes related to Skupper. Remote processes are processes indirectly connected, such as a proxy
+type ProcessRecordRole string
+
+// ProcessResponse defines model for ProcessResponse.
+type ProcessResponse struct {
+	Results ProcessRecord `json:"results"`
+}
+
+// RouterAccessListResponse defines model for RouterAccessListResponse.
+type RouterAccessListResponse struct {
+	// Count number of results in response
+	Count   int64                `json:"count"`
+	Results []RouterAccessRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// RouterAccessRecord defines model for RouterAccessRecord.
+type RouterAccessRecord struct {
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity  string `json:"identity"`
+	LinkCount uint64 `json:"linkCount"`
+	Name      string `json:"name"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network  *string `json:"network,omitempty"`
+	Role     string  `json:"role"`
+	RouterId string  `json:"routerId"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+}
+
+// RouterAccessResponse defines model for RouterAccessResponse.
+type RouterAccessResponse struct {
+	Results RouterAccessRecord `json:"results"`
+}
+
+// RouterLinkListResponse defines model for RouterLinkListResponse.
+type RouterLinkListResponse struct {
+	// Count number of results in response
+	Count   int64              `json:"count"`
+	Results []RouterLinkRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// RouterLinkRecord defines model for RouterLinkRecord.
+type RouterLinkRecord struct {
+	// Cost When connected, cost will be set to the link cost attribute
+	Cost *uint64 `json:"cost"`
+
+	// DestinationRouterId When connected, the identity of the destitation (peer) router.
+	DestinationRouterId *string `json:"destinationRouterId"`
+
+	// DestinationRouterName When connected, the name of the destitation (peer) router.
+	DestinationRouterName *string `json:"destinationRouterName"`
+
+	// DestinationSiteId When connected, the identity of the destitation (peer) site.
+	DestinationSiteId *string `json:"destinationSiteId"`
+
+	// DestinationSiteName When connected, the name of the destitation (peer) site.
+	DestinationSiteName *string `json:"destinationSiteName"`
+
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+	Name     string `json:"name"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network           *string `json:"network,omitempty"`
+	OctetCount        uint64  `json:"octetCount"`
+	OctetReverseCount uint64  `json:"octetReverseCount"`
+
+	// Role The class of skupper link
+	Role LinkRoleType `json:"role"`
+
+	// RouterAccessId When connected, the identity of the destitation (peer) router access.
+	RouterAccessId *string `json:"routerAccessId"`
+	RouterId       string  `json:"routerId"`
+	RouterName     string  `json:"routerName"`
+	SourceSiteId   string  `json:"sourceSiteId"`
+	SourceSiteName string  `json:"sourceSiteName"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64         `json:"startTime"`
+	Status    OperStatusType `json:"status"`
+}
+
+// RouterLinkResponse defines model for RouterLinkResponse.
+type RouterLinkResponse struct {
+	Results RouterLinkRecord `json:"results"`
+}
+
+// RouterListResponse defines model for RouterListResponse.
+type RouterListResponse struct {
+	// Count number of results in response
+	Count   int64          `json:"count"`
+	Results []RouterRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// RouterRecord defines model for RouterRecord.
+type RouterRecord struct {
+	BuildVersion string `json:"buildVersion"`
+
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime  uint64 `json:"endTime"`
+	HostName string `json:"hostName"`
+
+	// Identity The unique identifier for the record.
+	Identity     string  `json:"identity"`
+	ImageName    string  `json:"imageName"`
+	ImageVersion string  `json:"imageVersion"`
+	Mode         string  `json:"mode"`
+	Name         string  `json:"name"`
+	Namespace    *string `json:"namespace,omitempty"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network *string `json:"network,omitempty"`
+	SiteId  string  `json:"siteId"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+}
+
+// RouterResponse defines model for RouterResponse.
+type RouterResponse struct {
+	Results RouterRecord `json:"results"`
+}
+
+// ServiceListResponse defines model for ServiceListResponse.
+type ServiceListResponse struct {
+	// Count number of results in response
+	Count   int64           `json:"count"`
+	Results []ServiceRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// ServiceRecord defines model for ServiceRecord.
+type ServiceRecord struct {
+	ConnectorCount int `json:"connectorCount"`
+
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// HasListener true when there is at least one listener for this routingKey
+	HasListener bool `json:"hasListener"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+
+	// IsBound true when there are both listeners and connectors configured
+	IsBound       bool   `json:"isBound"`
+	ListenerCount int    `json:"listenerCount"`
+	Name          string `json:"name"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network *string `json:"network,omitempty"`
+
+	// ObservedApplicationProtocols Array of the observed application level protocols
+	ObservedApplicationProtocols []string `json:"observedApplicationProtocols"`
+	Protocol                     string   `json:"protocol"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+}
+
+// ServiceResponse defines model for ServiceResponse.
+type ServiceResponse struct {
+	Results ServiceRecord `json:"results"`
+}
+
+// SiteListResponse defines model for SiteListResponse.
+type SiteListResponse struct {
+	// Count number of results in response
+	Count   int64        `json:"count"`
+	Results []SiteRecord `json:"results"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// SiteRecord defines model for SiteRecord.
+type SiteRecord struct {
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity  string  `json:"identity"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network *string `json:"network,omitempty"`
+
+	// Platform The platform used for the site.
+	Platform SitePlatformType `json:"platform"`
+
+	// Provider Possible values are 'AWS', 'IBM', 'Azure' ecc. Can be any string or 'unknown'
+	Provider    string `json:"provider"`
+	RouterCount int    `json:"routerCount"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+
+	// Version The current skupper version installed. Can be any string or 'unknown'
+	Version string `json:"version"`
+}
+
+// SiteResponse defines model for SiteResponse.
+type SiteResponse struct {
+	Results SiteRecord `json:"results"`
+}
+
+// AlertStateType defines model for alertStateType.
+type AlertStateType string
+
+// BaseRecord defines model for baseRecord.
+type BaseRecord struct {
+	// EndTime The end time in microseconds of the record in Unix timestamp format.
+	EndTime uint64 `json:"endTime"`
+
+	// Identity The unique identifier for the record.
+	Identity string `json:"identity"`
+
+	// Network The name of the network the record belongs to. Only set when more than one network is observed.
+	Network *string `json:"network,omitempty"`
+
+	// StartTime The creation time in microseconds of the record in Unix timestamp format. The value 0 means that the record is not terminated
+	StartTime uint64 `json:"startTime"`
+}
+
+// CollectionResponse defines model for collectionResponse.
+type CollectionResponse struct {
+	// Count number of results in response
+	Count int64 `json:"count"`
+
+	// TimeRangeCount number of results matching filtering and time range constraints before any limit or offset is applied.
+	TimeRangeCount int64 `json:"timeRangeCount"`
+}
+
+// FlowAggregatePairType defines model for flowAggregatePairType.
+type FlowAggregatePairType string
+
+// HistoryResolutionType The width of a traffic history bucket.
+type HistoryResolutionType string
+
+// LinkRoleType The class of skupper link
+type LinkRoleType string
+
+// LogSeverityType The severity of a router log message.
+type LogSeverityType string
+
+// OperStatusType defines model for operStatusType.
+type OperStatusType string
+
+// ProcessBindingType Indicates whether a process is exposed or not in a skupper network
+type ProcessBindingType string
+
+// ServiceIdentifierType a special string for identifying services uses the form `name@identity@protocol`
+type ServiceIdentifierType = AtmarkDelimitedString
+
+// SitePlatformType The platform used for the site.
+type SitePlatformType string
+
+// Filter defines model for filter.
+type Filter = string
+
+// PathID defines model for pathID.
+type PathID = string
+
+// ErrorBadRequest defines model for errorBadRequest.
+type ErrorBadRequest = ErrorResponse
+
+// ErrorNotFound defines model for errorNotFound.
+type ErrorNotFound = ErrorResponse
+
+// GetAlerts defines model for getAlerts.
+type GetAlerts = AlertListResponse
+
+// GetApplicationFlows defines model for getApplicationFlows.
+type GetApplicationFlows = ApplicationFlowResponse
+
+// GetComponentByID defines model for getComponentByID.
+type GetComponentByID = ComponentResponse
+
+// GetComponents defines model for getComponents.
+type GetComponents = ComponentListResponse
+
+// GetConnections defines model for getConnections.
+type GetConnections = ConnectionListResponse
+
+// GetConnectorByID defines model for getConnectorByID.
+type GetConnectorByID = ConnectorResponse
+
+// GetConnectors defines model for getConnectors.
+type GetConnectors = ConnectorListResponse
+
+// GetFlowAggregateByID defines model for getFlowAggregateByID.
+type GetFlowAggregateByID = FlowAggregateResponse
+
+// GetFlowAggregates defines model for getFlowAggregates.
+type GetFlowAggregates = FlowAggregateListResponse
+
+// GetHistory defines model for getHistory.
+type GetHistory = HistoryListResponse
+
+// GetListenerByID defines model for getListenerByID.
+type GetListenerByID = ListenerResponse
+
+// GetListeners defines model for getListeners.
+type GetListeners = ListenerListResponse
+
+// GetLogs defines model for getLogs.
+type GetLogs = LogListResponse
+
+// GetNetworks defines model for getNetworks.
+type GetNetworks = NetworkListResponse
+
+// GetProcessByID defines model for getProcessByID.
+type GetProcessByID = ProcessResponse
+
+// GetProcesses defines model for getProcesses.
+type GetProcesses = ProcessListResponse
+
+// GetRouterAccess defines model for getRouterAccess.
+type GetRouterAccess = RouterAccessListResponse
+
+// GetRouterAccessByID defines model for getRouterAccessByID.
+type GetRouterAccessByID = RouterAccessResponse
+
+// GetRouterByID defines model for getRouterByID.
+type GetRouterByID = RouterResponse
+
+// GetRouterLinkByID defines model for getRouterLinkByID.
+type GetRouterLinkByID = RouterLinkResponse
+
+// GetRouterLinks defines model for getRouterLinks.
+type GetRouterLinks = RouterLinkListResponse
+
+// GetRouters defines model for getRouters.
+type GetRouters = RouterListResponse
+
+// GetServiceByID defines model for getServiceByID.
+type GetServiceByID = ServiceResponse
+
+// GetServices defines model for getServices.
+type GetServices = ServiceListResponse
+
+// GetSiteByID defines model for getSiteByID.
+type GetSiteByID = SiteResponse
+
+// GetSites defines model for getSites.
+type GetSites = SiteListResponse
+
+// NotSupported defines model for notSupported.
+type NotSupported = ErrorResponse
+
+// AlertsParams defines parameters for Alerts.
+type AlertsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ApplicationflowsParams defines parameters for Applicationflows.
+type ApplicationflowsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ExportApplicationflowsParams defines parameters for ExportApplicationflows.
+type ExportApplicationflowsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ComponentpairsParams defines parameters for Componentpairs.
+type ComponentpairsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ComponentsParams defines parameters for Components.
+type ComponentsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ConnectionsParams defines parameters for Connections.
+type ConnectionsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ExportConnectionsParams defines parameters for ExportConnections.
+type ExportConnectionsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ConnectorsParams defines parameters for Connectors.
+type ConnectorsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ListenersParams defines parameters for Listeners.
+type ListenersParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// LogsParams defines parameters for Logs.
+type LogsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ProcessesParams defines parameters for Processes.
+type ProcessesParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ProcesspairsParams defines parameters for Processpairs.
+type ProcesspairsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// RouteraccessParams defines parameters for Routeraccess.
+type RouteraccessParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// RouterlinksParams defines parameters for Routerlinks.
+type RouterlinksParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// RoutersParams defines parameters for Routers.
+type RoutersParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// LogsByRouterParams defines parameters for LogsByRouter.
+type LogsByRouterParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ServicesParams defines parameters for Services.
+type ServicesParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ConnectionsByServiceParams defines parameters for ConnectionsByService.
+type ConnectionsByServiceParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ProcessesByServiceParams defines parameters for ProcessesByService.
+type ProcessesByServiceParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ProcessPairsByServiceParams defines parameters for ProcessPairsByService.
+type ProcessPairsByServiceParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// SitepairsParams defines parameters for Sitepairs.
+type SitepairsParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// SitesParams defines parameters for Sites.
+type SitesParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// LogsBySiteParams defines parameters for LogsBySite.
+type LogsBySiteParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// ProcessesBySiteParams defines parameters for ProcessesBySite.
+type ProcessesBySiteParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// RoutersBySiteParams defines parameters for RoutersBySite.
+type RoutersBySiteParams struct {
+	// Filter An expression selecting the records returned by their fields, for example: protocol == tcp and (octetCount > 1e6 or sourceSiteName ^= "east-"). Fields are referenced by their names in the record schema, with nested fields separated by dots. Comparisons support ==, !=, <, <=, >, >=, ^= (prefix), ~ (regular expression) and in (...), and can be combined with and, or, not and parentheses. An invalid expression is rejected with a 400 response.
+	Filter *Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// Alerts request
+	Alerts(ctx context.Context, params *AlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Applicationflows request
+	Applicationflows(ctx context.Context, params *ApplicationflowsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportApplicationflows request
+	ExportApplicationflows(ctx context.Context, params *ExportApplicationflowsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Componentpairs request
+	Componentpairs(ctx context.Context, params *ComponentpairsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ComponentpairByID request
+	ComponentpairByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Components request
+	Components(ctx context.Context, params *ComponentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ComponentByID request
+	ComponentByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Connections request
+	Connections(ctx context.Context, params *ConnectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportConnections request
+	ExportConnections(ctx context.Context, params *ExportConnectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Connectors request
+	Connectors(ctx context.Context, params *ConnectorsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConnectorByID request
+	ConnectorByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Hosts request
+	Hosts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HostsByID request
+	HostsByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Listeners request
+	Listeners(ctx context.Context, params *ListenersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListenerByID request
+	ListenerByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Logs request
+	Logs(ctx context.Context, params *LogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Networks request
+	Networks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Processes request
+	Processes(ctx context.Context, params *ProcessesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ProcessById request
+	ProcessById(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Processpairs request
+	Processpairs(ctx context.Context, params *ProcesspairsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ProcesspairByID request
+	ProcesspairByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HistoryByProcesspair request
+	HistoryByProcesspair(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Routeraccess request
+	Routeraccess(ctx context.Context, params *RouteraccessParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RouteraccessByID request
+	RouteraccessByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Routerlinks request
+	Routerlinks(ctx context.Context, params *RouterlinksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RouterlinkByID request
+	RouterlinkByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Routers request
+	Routers(ctx context.Context, params *RoutersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RouterByID request
+	RouterByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LogsByRouter request
+	LogsByRouter(ctx context.Context, id PathID, params *LogsByRouterParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Services request
+	Services(ctx context.Context, params *ServicesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ServiceByID request
+	ServiceByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConnectionsByService request
+	ConnectionsByService(ctx context.Context, id PathID, params *ConnectionsByServiceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HistoryByService request
+	HistoryByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ProcessesByService request
+	ProcessesByService(ctx context.Context, id PathID, params *ProcessesByServiceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ProcessPairsByService request
+	ProcessPairsByService(ctx context.Context, id PathID, params *ProcessPairsByServiceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Sitepairs request
+	Sitepairs(ctx context.Context, params *SitepairsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SitepairByID request
+	SitepairByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HistoryBySitepair request
+	HistoryBySitepair(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Sites request
+	Sites(ctx context.Context, params *SitesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SiteById request
+	SiteById(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// HostsBySite request
+	HostsBySite(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LogsBySite request
+	LogsBySite(ctx context.Context, id PathID, params *LogsBySiteParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ProcessesBySite request
+	ProcessesBySite(ctx context.Context, id PathID, params *ProcessesBySiteParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RoutersBySite request
+	RoutersBySite(ctx context.Context, id PathID, params *RoutersBySiteParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) Alerts(ctx context.Context, params *AlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAlertsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Applicationflows(ctx context.Context, params *ApplicationflowsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplicationflowsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportApplicationflows(ctx context.Context, params *ExportApplicationflowsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportApplicationflowsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Componentpairs(ctx context.Context, params *ComponentpairsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewComponentpairsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ComponentpairByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewComponentpairByIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Components(ctx context.Context, params *ComponentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewComponentsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ComponentByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewComponentByIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Connections(ctx context.Context, params *ConnectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConnectionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportConnections(ctx context.Context, params *ExportConnectionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportConnectionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Connectors(ctx context.Context, params *ConnectorsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConnectorsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConnectorByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConnectorByIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Hosts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHostsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HostsByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHostsByIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Listeners(ctx context.Context, params *ListenersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListenersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListenerByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListenerByIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Logs(ctx context.Context, params *LogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Networks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNetworksRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Processes(ctx context.Context, params *ProcessesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProcessById(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Processpairs(ctx context.Context, params *ProcesspairsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcesspairsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProcesspairByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcesspairByIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HistoryByProcesspair(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHistoryByProcesspairRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Routeraccess(ctx context.Context, params *RouteraccessParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRouteraccessRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RouteraccessByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRouteraccessByIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Routerlinks(ctx context.Context, params *RouterlinksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRouterlinksRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RouterlinkByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRouterlinkByIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Routers(ctx context.Context, params *RoutersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRoutersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RouterByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRouterByIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LogsByRouter(ctx context.Context, id PathID, params *LogsByRouterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogsByRouterRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Services(ctx context.Context, params *ServicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewServicesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ServiceByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewServiceByIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConnectionsByService(ctx context.Context, id PathID, params *ConnectionsByServiceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConnectionsByServiceRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HistoryByService(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHistoryByServiceRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProcessesByService(ctx context.Context, id PathID, params *ProcessesByServiceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessesByServiceRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProcessPairsByService(ctx context.Context, id PathID, params *ProcessPairsByServiceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessPairsByServiceRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Sitepairs(ctx context.Context, params *SitepairsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSitepairsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SitepairByID(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSitepairByIDRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HistoryBySitepair(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHistoryBySitepairRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Sites(ctx context.Context, params *SitesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSitesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SiteById(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSiteByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) HostsBySite(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHostsBySiteRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LogsBySite(ctx context.Context, id PathID, params *LogsBySiteParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogsBySiteRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProcessesBySite(ctx context.Context, id PathID, params *ProcessesBySiteParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProcessesBySiteRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RoutersBySite(ctx context.Context, id PathID, params *RoutersBySiteParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRoutersBySiteRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAlertsRequest generates requests for Alerts
+func NewAlertsRequest(server string, params *AlertsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/alerts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApplicationflowsRequest generates requests for Applicationflows
+func NewApplicationflowsRequest(server string, params *ApplicationflowsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/applicationflows")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportApplicationflowsRequest generates requests for ExportApplicationflows
+func NewExportApplicationflowsRequest(server string, params *ExportApplicationflowsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/applicationflows/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewComponentpairsRequest generates requests for Componentpairs
+func NewComponentpairsRequest(server string, params *ComponentpairsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/componentpairs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewComponentpairByIDRequest generates requests for ComponentpairByID
+func NewComponentpairByIDRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/componentpairs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewComponentsRequest generates requests for Components
+func NewComponentsRequest(server string, params *ComponentsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/components")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewComponentByIDRequest generates requests for ComponentByID
+func NewComponentByIDRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/components/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConnectionsRequest generates requests for Connections
+func NewConnectionsRequest(server string, params *ConnectionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/connections")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportConnectionsRequest generates requests for ExportConnections
+func NewExportConnectionsRequest(server string, params *ExportConnectionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/connections/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConnectorsRequest generates requests for Connectors
+func NewConnectorsRequest(server string, params *ConnectorsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/connectors")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConnectorByIDRequest generates requests for ConnectorByID
+func NewConnectorByIDRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/connectors/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHostsRequest generates requests for Hosts
+func NewHostsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/hosts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHostsByIDRequest generates requests for HostsByID
+func NewHostsByIDRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/hosts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListenersRequest generates requests for Listeners
+func NewListenersRequest(server string, params *ListenersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/listeners")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListenerByIDRequest generates requests for ListenerByID
+func NewListenerByIDRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/listeners/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLogsRequest generates requests for Logs
+func NewLogsRequest(server string, params *LogsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/logs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNetworksRequest generates requests for Networks
+func NewNetworksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/networks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewProcessesRequest generates requests for Processes
+func NewProcessesRequest(server string, params *ProcessesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/processes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewProcessByIdRequest generates requests for ProcessById
+func NewProcessByIdRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/processes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewProcesspairsRequest generates requests for Processpairs
+func NewProcesspairsRequest(server string, params *ProcesspairsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/processpairs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewProcesspairByIDRequest generates requests for ProcesspairByID
+func NewProcesspairByIDRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/processpairs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHistoryByProcesspairRequest generates requests for HistoryByProcesspair
+func NewHistoryByProcesspairRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/processpairs/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRouteraccessRequest generates requests for Routeraccess
+func NewRouteraccessRequest(server string, params *RouteraccessParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/routeraccess")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRouteraccessByIDRequest generates requests for RouteraccessByID
+func NewRouteraccessByIDRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/routeraccess/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRouterlinksRequest generates requests for Routerlinks
+func NewRouterlinksRequest(server string, params *RouterlinksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/routerlinks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRouterlinkByIDRequest generates requests for RouterlinkByID
+func NewRouterlinkByIDRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/routerlinks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRoutersRequest generates requests for Routers
+func NewRoutersRequest(server string, params *RoutersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/routers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRouterByIDRequest generates requests for RouterByID
+func NewRouterByIDRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/routers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLogsByRouterRequest generates requests for LogsByRouter
+func NewLogsByRouterRequest(server string, id PathID, params *LogsByRouterParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/routers/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewServicesRequest generates requests for Services
+func NewServicesRequest(server string, params *ServicesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/services")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewServiceByIDRequest generates requests for ServiceByID
+func NewServiceByIDRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/services/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConnectionsByServiceRequest generates requests for ConnectionsByService
+func NewConnectionsByServiceRequest(server string, id PathID, params *ConnectionsByServiceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/services/%s/connections", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHistoryByServiceRequest generates requests for HistoryByService
+func NewHistoryByServiceRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/services/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewProcessesByServiceRequest generates requests for ProcessesByService
+func NewProcessesByServiceRequest(server string, id PathID, params *ProcessesByServiceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/services/%s/processes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewProcessPairsByServiceRequest generates requests for ProcessPairsByService
+func NewProcessPairsByServiceRequest(server string, id PathID, params *ProcessPairsByServiceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/services/%s/processpairs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSitepairsRequest generates requests for Sitepairs
+func NewSitepairsRequest(server string, params *SitepairsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/sitepairs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSitepairByIDRequest generates requests for SitepairByID
+func NewSitepairByIDRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/sitepairs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHistoryBySitepairRequest generates requests for HistoryBySitepair
+func NewHistoryBySitepairRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/sitepairs/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSitesRequest generates requests for Sites
+func NewSitesRequest(server string, params *SitesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/sites")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSiteByIdRequest generates requests for SiteById
+func NewSiteByIdRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/sites/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHostsBySiteRequest generates requests for HostsBySite
+func NewHostsBySiteRequest(server string, id PathID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/sites/%s/hosts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLogsBySiteRequest generates requests for LogsBySite
+func NewLogsBySiteRequest(server string, id PathID, params *LogsBySiteParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/sites/%s/logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewProcessesBySiteRequest generates requests for ProcessesBySite
+func NewProcessesBySiteRequest(server string, id PathID, params *ProcessesBySiteParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/sites/%s/processes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRoutersBySiteRequest generates requests for RoutersBySite
+func NewRoutersBySiteRequest(server string, id PathID, params *RoutersBySiteParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v2alpha1/sites/%s/routers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AlertsWithResponse request
+	AlertsWithResponse(ctx context.Context, params *AlertsParams, reqEditors ...RequestEditorFn) (*AlertsResponse, error)
+
+	// ApplicationflowsWithResponse request
+	ApplicationflowsWithResponse(ctx context.Context, params *ApplicationflowsParams, reqEditors ...RequestEditorFn) (*ApplicationflowsResponse, error)
+
+	// ExportApplicationflowsWithResponse request
+	ExportApplicationflowsWithResponse(ctx context.Context, params *ExportApplicationflowsParams, reqEditors ...RequestEditorFn) (*ExportApplicationflowsResponse, error)
+
+	// ComponentpairsWithResponse request
+	ComponentpairsWithResponse(ctx context.Context, params *ComponentpairsParams, reqEditors ...RequestEditorFn) (*ComponentpairsResponse, error)
+
+	// ComponentpairByIDWithResponse request
+	ComponentpairByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ComponentpairByIDResponse, error)
+
+	// ComponentsWithResponse request
+	ComponentsWithResponse(ctx context.Context, params *ComponentsParams, reqEditors ...RequestEditorFn) (*ComponentsResponse, error)
+
+	// ComponentByIDWithResponse request
+	ComponentByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ComponentByIDResponse, error)
+
+	// ConnectionsWithResponse request
+	ConnectionsWithResponse(ctx context.Context, params *ConnectionsParams, reqEditors ...RequestEditorFn) (*ConnectionsResponse, error)
+
+	// ExportConnectionsWithResponse request
+	ExportConnectionsWithResponse(ctx context.Context, params *ExportConnectionsParams, reqEditors ...RequestEditorFn) (*ExportConnectionsResponse, error)
+
+	// ConnectorsWithResponse request
+	ConnectorsWithResponse(ctx context.Context, params *ConnectorsParams, reqEditors ...RequestEditorFn) (*ConnectorsResponse, error)
+
+	// ConnectorByIDWithResponse request
+	ConnectorByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ConnectorByIDResponse, error)
+
+	// HostsWithResponse request
+	HostsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HostsResponse, error)
+
+	// HostsByIDWithResponse request
+	HostsByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HostsByIDResponse, error)
+
+	// ListenersWithResponse request
+	ListenersWithResponse(ctx context.Context, params *ListenersParams, reqEditors ...RequestEditorFn) (*ListenersResponse, error)
+
+	// ListenerByIDWithResponse request
+	ListenerByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ListenerByIDResponse, error)
+
+	// LogsWithResponse request
+	LogsWithResponse(ctx context.Context, params *LogsParams, reqEditors ...RequestEditorFn) (*LogsResponse, error)
+
+	// NetworksWithResponse request
+	NetworksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NetworksResponse, error)
+
+	// ProcessesWithResponse request
+	ProcessesWithResponse(ctx context.Context, params *ProcessesParams, reqEditors ...RequestEditorFn) (*ProcessesResponse, error)
+
+	// ProcessByIdWithResponse request
+	ProcessByIdWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ProcessByIdResponse, error)
+
+	// ProcesspairsWithResponse request
+	ProcesspairsWithResponse(ctx context.Context, params *ProcesspairsParams, reqEditors ...RequestEditorFn) (*ProcesspairsResponse, error)
+
+	// ProcesspairByIDWithResponse request
+	ProcesspairByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ProcesspairByIDResponse, error)
+
+	// HistoryByProcesspairWithResponse request
+	HistoryByProcesspairWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HistoryByProcesspairResponse, error)
+
+	// RouteraccessWithResponse request
+	RouteraccessWithResponse(ctx context.Context, params *RouteraccessParams, reqEditors ...RequestEditorFn) (*RouteraccessResponse, error)
+
+	// RouteraccessByIDWithResponse request
+	RouteraccessByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*RouteraccessByIDResponse, error)
+
+	// RouterlinksWithResponse request
+	RouterlinksWithResponse(ctx context.Context, params *RouterlinksParams, reqEditors ...RequestEditorFn) (*RouterlinksResponse, error)
+
+	// RouterlinkByIDWithResponse request
+	RouterlinkByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*RouterlinkByIDResponse, error)
+
+	// RoutersWithResponse request
+	RoutersWithResponse(ctx context.Context, params *RoutersParams, reqEditors ...RequestEditorFn) (*RoutersResponse, error)
+
+	// RouterByIDWithResponse request
+	RouterByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*RouterByIDResponse, error)
+
+	// LogsByRouterWithResponse request
+	LogsByRouterWithResponse(ctx context.Context, id PathID, params *LogsByRouterParams, reqEditors ...RequestEditorFn) (*LogsByRouterResponse, error)
+
+	// ServicesWithResponse request
+	ServicesWithResponse(ctx context.Context, params *ServicesParams, reqEditors ...RequestEditorFn) (*ServicesResponse, error)
+
+	// ServiceByIDWithResponse request
+	ServiceByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ServiceByIDResponse, error)
+
+	// ConnectionsByServiceWithResponse request
+	ConnectionsByServiceWithResponse(ctx context.Context, id PathID, params *ConnectionsByServiceParams, reqEditors ...RequestEditorFn) (*ConnectionsByServiceResponse, error)
+
+	// HistoryByServiceWithResponse request
+	HistoryByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HistoryByServiceResponse, error)
+
+	// ProcessesByServiceWithResponse request
+	ProcessesByServiceWithResponse(ctx context.Context, id PathID, params *ProcessesByServiceParams, reqEditors ...RequestEditorFn) (*ProcessesByServiceResponse, error)
+
+	// ProcessPairsByServiceWithResponse request
+	ProcessPairsByServiceWithResponse(ctx context.Context, id PathID, params *ProcessPairsByServiceParams, reqEditors ...RequestEditorFn) (*ProcessPairsByServiceResponse, error)
+
+	// SitepairsWithResponse request
+	SitepairsWithResponse(ctx context.Context, params *SitepairsParams, reqEditors ...RequestEditorFn) (*SitepairsResponse, error)
+
+	// SitepairByIDWithResponse request
+	SitepairByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*SitepairByIDResponse, error)
+
+	// HistoryBySitepairWithResponse request
+	HistoryBySitepairWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HistoryBySitepairResponse, error)
+
+	// SitesWithResponse request
+	SitesWithResponse(ctx context.Context, params *SitesParams, reqEditors ...RequestEditorFn) (*SitesResponse, error)
+
+	// SiteByIdWithResponse request
+	SiteByIdWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*SiteByIdResponse, error)
+
+	// HostsBySiteWithResponse request
+	HostsBySiteWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HostsBySiteResponse, error)
+
+	// LogsBySiteWithResponse request
+	LogsBySiteWithResponse(ctx context.Context, id PathID, params *LogsBySiteParams, reqEditors ...RequestEditorFn) (*LogsBySiteResponse, error)
+
+	// ProcessesBySiteWithResponse request
+	ProcessesBySiteWithResponse(ctx context.Context, id PathID, params *ProcessesBySiteParams, reqEditors ...RequestEditorFn) (*ProcessesBySiteResponse, error)
+
+	// RoutersBySiteWithResponse request
+	RoutersBySiteWithResponse(ctx context.Context, id PathID, params *RoutersBySiteParams, reqEditors ...RequestEditorFn) (*RoutersBySiteResponse, error)
+}
+
+type AlertsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetAlerts
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApplicationflowsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetApplicationFlows
+}
+
+// Status returns HTTPResponse.Status
+func (r ApplicationflowsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApplicationflowsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportApplicationflowsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportApplicationflowsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportApplicationflowsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ComponentpairsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetFlowAggregates
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ComponentpairsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ComponentpairsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ComponentpairByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetFlowAggregateByID
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ComponentpairByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ComponentpairByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ComponentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetComponents
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ComponentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ComponentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ComponentByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetComponentByID
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ComponentByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ComponentByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConnectionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetConnections
+}
+
+// Status returns HTTPResponse.Status
+func (r ConnectionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConnectionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportConnectionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportConnectionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportConnectionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConnectorsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetConnectors
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ConnectorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConnectorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConnectorByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetConnectorByID
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ConnectorByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConnectorByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HostsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON410      *NotSupported
+}
+
+// Status returns HTTPResponse.Status
+func (r HostsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HostsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HostsByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotSupported
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r HostsByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HostsByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListenersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetListeners
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ListenersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListenersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListenerByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetListenerByID
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ListenerByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListenerByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetLogs
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r LogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NetworksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetNetworks
+}
+
+// Status returns HTTPResponse.Status
+func (r NetworksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NetworksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProcessesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetProcesses
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ProcessesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProcessByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetProcessByID
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ProcessByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProcesspairsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetFlowAggregates
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ProcesspairsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcesspairsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProcesspairByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetFlowAggregateByID
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ProcesspairByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcesspairByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HistoryByProcesspairResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetHistory
+	JSON400      *ErrorBadRequest
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r HistoryByProcesspairResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HistoryByProcesspairResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RouteraccessResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetRouterAccess
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r RouteraccessResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RouteraccessResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RouteraccessByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetRouterAccessByID
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RouteraccessByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RouteraccessByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RouterlinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetRouterLinks
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r RouterlinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RouterlinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RouterlinkByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetRouterLinkByID
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RouterlinkByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RouterlinkByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RoutersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetRouters
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r RoutersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RoutersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RouterByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetRouterByID
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RouterByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RouterByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogsByRouterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetLogs
+	JSON400      *ErrorBadRequest
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r LogsByRouterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogsByRouterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ServicesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetServices
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ServicesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ServicesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ServiceByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetServiceByID
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ServiceByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ServiceByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConnectionsByServiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetConnections
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ConnectionsByServiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConnectionsByServiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HistoryByServiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetHistory
+	JSON400      *ErrorBadRequest
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r HistoryByServiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HistoryByServiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProcessesByServiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetProcesses
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ProcessesByServiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessesByServiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProcessPairsByServiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetFlowAggregates
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ProcessPairsByServiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessPairsByServiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SitepairsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetFlowAggregates
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r SitepairsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SitepairsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SitepairByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetFlowAggregateByID
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r SitepairByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SitepairByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HistoryBySitepairResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetHistory
+	JSON400      *ErrorBadRequest
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r HistoryBySitepairResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HistoryBySitepairResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SitesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetSites
+	JSON400      *ErrorBadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r SitesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SitesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SiteByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetSiteByID
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r SiteByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SiteByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HostsBySiteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON410      *NotSupported
+}
+
+// Status returns HTTPResponse.Status
+func (r HostsBySiteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HostsBySiteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogsBySiteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetLogs
+	JSON400      *ErrorBadRequest
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r LogsBySiteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogsBySiteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProcessesBySiteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetProcesses
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ProcessesBySiteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProcessesBySiteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RoutersBySiteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GetRouters
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r RoutersBySiteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RoutersBySiteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// AlertsWithResponse request returning *AlertsResponse
+func (c *ClientWithResponses) AlertsWithResponse(ctx context.Context, params *AlertsParams, reqEditors ...RequestEditorFn) (*AlertsResponse, error) {
+	rsp, err := c.Alerts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertsResponse(rsp)
+}
+
+// ApplicationflowsWithResponse request returning *ApplicationflowsResponse
+func (c *ClientWithResponses) ApplicationflowsWithResponse(ctx context.Context, params *ApplicationflowsParams, reqEditors ...RequestEditorFn) (*ApplicationflowsResponse, error) {
+	rsp, err := c.Applicationflows(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplicationflowsResponse(rsp)
+}
+
+// ExportApplicationflowsWithResponse request returning *ExportApplicationflowsResponse
+func (c *ClientWithResponses) ExportApplicationflowsWithResponse(ctx context.Context, params *ExportApplicationflowsParams, reqEditors ...RequestEditorFn) (*ExportApplicationflowsResponse, error) {
+	rsp, err := c.ExportApplicationflows(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportApplicationflowsResponse(rsp)
+}
+
+// ComponentpairsWithResponse request returning *ComponentpairsResponse
+func (c *ClientWithResponses) ComponentpairsWithResponse(ctx context.Context, params *ComponentpairsParams, reqEditors ...RequestEditorFn) (*ComponentpairsResponse, error) {
+	rsp, err := c.Componentpairs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseComponentpairsResponse(rsp)
+}
+
+// ComponentpairByIDWithResponse request returning *ComponentpairByIDResponse
+func (c *ClientWithResponses) ComponentpairByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ComponentpairByIDResponse, error) {
+	rsp, err := c.ComponentpairByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseComponentpairByIDResponse(rsp)
+}
+
+// ComponentsWithResponse request returning *ComponentsResponse
+func (c *ClientWithResponses) ComponentsWithResponse(ctx context.Context, params *ComponentsParams, reqEditors ...RequestEditorFn) (*ComponentsResponse, error) {
+	rsp, err := c.Components(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseComponentsResponse(rsp)
+}
+
+// ComponentByIDWithResponse request returning *ComponentByIDResponse
+func (c *ClientWithResponses) ComponentByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ComponentByIDResponse, error) {
+	rsp, err := c.ComponentByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseComponentByIDResponse(rsp)
+}
+
+// ConnectionsWithResponse request returning *ConnectionsResponse
+func (c *ClientWithResponses) ConnectionsWithResponse(ctx context.Context, params *ConnectionsParams, reqEditors ...RequestEditorFn) (*ConnectionsResponse, error) {
+	rsp, err := c.Connections(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConnectionsResponse(rsp)
+}
+
+// ExportConnectionsWithResponse request returning *ExportConnectionsResponse
+func (c *ClientWithResponses) ExportConnectionsWithResponse(ctx context.Context, params *ExportConnectionsParams, reqEditors ...RequestEditorFn) (*ExportConnectionsResponse, error) {
+	rsp, err := c.ExportConnections(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportConnectionsResponse(rsp)
+}
+
+// ConnectorsWithResponse request returning *ConnectorsResponse
+func (c *ClientWithResponses) ConnectorsWithResponse(ctx context.Context, params *ConnectorsParams, reqEditors ...RequestEditorFn) (*ConnectorsResponse, error) {
+	rsp, err := c.Connectors(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConnectorsResponse(rsp)
+}
+
+// ConnectorByIDWithResponse request returning *ConnectorByIDResponse
+func (c *ClientWithResponses) ConnectorByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ConnectorByIDResponse, error) {
+	rsp, err := c.ConnectorByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConnectorByIDResponse(rsp)
+}
+
+// HostsWithResponse request returning *HostsResponse
+func (c *ClientWithResponses) HostsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HostsResponse, error) {
+	rsp, err := c.Hosts(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHostsResponse(rsp)
+}
+
+// HostsByIDWithResponse request returning *HostsByIDResponse
+func (c *ClientWithResponses) HostsByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HostsByIDResponse, error) {
+	rsp, err := c.HostsByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHostsByIDResponse(rsp)
+}
+
+// ListenersWithResponse request returning *ListenersResponse
+func (c *ClientWithResponses) ListenersWithResponse(ctx context.Context, params *ListenersParams, reqEditors ...RequestEditorFn) (*ListenersResponse, error) {
+	rsp, err := c.Listeners(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListenersResponse(rsp)
+}
+
+// ListenerByIDWithResponse request returning *ListenerByIDResponse
+func (c *ClientWithResponses) ListenerByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ListenerByIDResponse, error) {
+	rsp, err := c.ListenerByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListenerByIDResponse(rsp)
+}
+
+// LogsWithResponse request returning *LogsResponse
+func (c *ClientWithResponses) LogsWithResponse(ctx context.Context, params *LogsParams, reqEditors ...RequestEditorFn) (*LogsResponse, error) {
+	rsp, err := c.Logs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogsResponse(rsp)
+}
+
+// NetworksWithResponse request returning *NetworksResponse
+func (c *ClientWithResponses) NetworksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NetworksResponse, error) {
+	rsp, err := c.Networks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNetworksResponse(rsp)
+}
+
+// ProcessesWithResponse request returning *ProcessesResponse
+func (c *ClientWithResponses) ProcessesWithResponse(ctx context.Context, params *ProcessesParams, reqEditors ...RequestEditorFn) (*ProcessesResponse, error) {
+	rsp, err := c.Processes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessesResponse(rsp)
+}
+
+// ProcessByIdWithResponse request returning *ProcessByIdResponse
+func (c *ClientWithResponses) ProcessByIdWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ProcessByIdResponse, error) {
+	rsp, err := c.ProcessById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessByIdResponse(rsp)
+}
+
+// ProcesspairsWithResponse request returning *ProcesspairsResponse
+func (c *ClientWithResponses) ProcesspairsWithResponse(ctx context.Context, params *ProcesspairsParams, reqEditors ...RequestEditorFn) (*ProcesspairsResponse, error) {
+	rsp, err := c.Processpairs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcesspairsResponse(rsp)
+}
+
+// ProcesspairByIDWithResponse request returning *ProcesspairByIDResponse
+func (c *ClientWithResponses) ProcesspairByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ProcesspairByIDResponse, error) {
+	rsp, err := c.ProcesspairByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcesspairByIDResponse(rsp)
+}
+
+// HistoryByProcesspairWithResponse request returning *HistoryByProcesspairResponse
+func (c *ClientWithResponses) HistoryByProcesspairWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HistoryByProcesspairResponse, error) {
+	rsp, err := c.HistoryByProcesspair(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHistoryByProcesspairResponse(rsp)
+}
+
+// RouteraccessWithResponse request returning *RouteraccessResponse
+func (c *ClientWithResponses) RouteraccessWithResponse(ctx context.Context, params *RouteraccessParams, reqEditors ...RequestEditorFn) (*RouteraccessResponse, error) {
+	rsp, err := c.Routeraccess(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRouteraccessResponse(rsp)
+}
+
+// RouteraccessByIDWithResponse request returning *RouteraccessByIDResponse
+func (c *ClientWithResponses) RouteraccessByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*RouteraccessByIDResponse, error) {
+	rsp, err := c.RouteraccessByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRouteraccessByIDResponse(rsp)
+}
+
+// RouterlinksWithResponse request returning *RouterlinksResponse
+func (c *ClientWithResponses) RouterlinksWithResponse(ctx context.Context, params *RouterlinksParams, reqEditors ...RequestEditorFn) (*RouterlinksResponse, error) {
+	rsp, err := c.Routerlinks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRouterlinksResponse(rsp)
+}
+
+// RouterlinkByIDWithResponse request returning *RouterlinkByIDResponse
+func (c *ClientWithResponses) RouterlinkByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*RouterlinkByIDResponse, error) {
+	rsp, err := c.RouterlinkByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRouterlinkByIDResponse(rsp)
+}
+
+// RoutersWithResponse request returning *RoutersResponse
+func (c *ClientWithResponses) RoutersWithResponse(ctx context.Context, params *RoutersParams, reqEditors ...RequestEditorFn) (*RoutersResponse, error) {
+	rsp, err := c.Routers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRoutersResponse(rsp)
+}
+
+// RouterByIDWithResponse request returning *RouterByIDResponse
+func (c *ClientWithResponses) RouterByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*RouterByIDResponse, error) {
+	rsp, err := c.RouterByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRouterByIDResponse(rsp)
+}
+
+// LogsByRouterWithResponse request returning *LogsByRouterResponse
+func (c *ClientWithResponses) LogsByRouterWithResponse(ctx context.Context, id PathID, params *LogsByRouterParams, reqEditors ...RequestEditorFn) (*LogsByRouterResponse, error) {
+	rsp, err := c.LogsByRouter(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogsByRouterResponse(rsp)
+}
+
+// ServicesWithResponse request returning *ServicesResponse
+func (c *ClientWithResponses) ServicesWithResponse(ctx context.Context, params *ServicesParams, reqEditors ...RequestEditorFn) (*ServicesResponse, error) {
+	rsp, err := c.Services(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseServicesResponse(rsp)
+}
+
+// ServiceByIDWithResponse request returning *ServiceByIDResponse
+func (c *ClientWithResponses) ServiceByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*ServiceByIDResponse, error) {
+	rsp, err := c.ServiceByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseServiceByIDResponse(rsp)
+}
+
+// ConnectionsByServiceWithResponse request returning *ConnectionsByServiceResponse
+func (c *ClientWithResponses) ConnectionsByServiceWithResponse(ctx context.Context, id PathID, params *ConnectionsByServiceParams, reqEditors ...RequestEditorFn) (*ConnectionsByServiceResponse, error) {
+	rsp, err := c.ConnectionsByService(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConnectionsByServiceResponse(rsp)
+}
+
+// HistoryByServiceWithResponse request returning *HistoryByServiceResponse
+func (c *ClientWithResponses) HistoryByServiceWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HistoryByServiceResponse, error) {
+	rsp, err := c.HistoryByService(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHistoryByServiceResponse(rsp)
+}
+
+// ProcessesByServiceWithResponse request returning *ProcessesByServiceResponse
+func (c *ClientWithResponses) ProcessesByServiceWithResponse(ctx context.Context, id PathID, params *ProcessesByServiceParams, reqEditors ...RequestEditorFn) (*ProcessesByServiceResponse, error) {
+	rsp, err := c.ProcessesByService(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessesByServiceResponse(rsp)
+}
+
+// ProcessPairsByServiceWithResponse request returning *ProcessPairsByServiceResponse
+func (c *ClientWithResponses) ProcessPairsByServiceWithResponse(ctx context.Context, id PathID, params *ProcessPairsByServiceParams, reqEditors ...RequestEditorFn) (*ProcessPairsByServiceResponse, error) {
+	rsp, err := c.ProcessPairsByService(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessPairsByServiceResponse(rsp)
+}
+
+// SitepairsWithResponse request returning *SitepairsResponse
+func (c *ClientWithResponses) SitepairsWithResponse(ctx context.Context, params *SitepairsParams, reqEditors ...RequestEditorFn) (*SitepairsResponse, error) {
+	rsp, err := c.Sitepairs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSitepairsResponse(rsp)
+}
+
+// SitepairByIDWithResponse request returning *SitepairByIDResponse
+func (c *ClientWithResponses) SitepairByIDWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*SitepairByIDResponse, error) {
+	rsp, err := c.SitepairByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSitepairByIDResponse(rsp)
+}
+
+// HistoryBySitepairWithResponse request returning *HistoryBySitepairResponse
+func (c *ClientWithResponses) HistoryBySitepairWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HistoryBySitepairResponse, error) {
+	rsp, err := c.HistoryBySitepair(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHistoryBySitepairResponse(rsp)
+}
+
+// SitesWithResponse request returning *SitesResponse
+func (c *ClientWithResponses) SitesWithResponse(ctx context.Context, params *SitesParams, reqEditors ...RequestEditorFn) (*SitesResponse, error) {
+	rsp, err := c.Sites(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSitesResponse(rsp)
+}
+
+// SiteByIdWithResponse request returning *SiteByIdResponse
+func (c *ClientWithResponses) SiteByIdWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*SiteByIdResponse, error) {
+	rsp, err := c.SiteById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSiteByIdResponse(rsp)
+}
+
+// HostsBySiteWithResponse request returning *HostsBySiteResponse
+func (c *ClientWithResponses) HostsBySiteWithResponse(ctx context.Context, id PathID, reqEditors ...RequestEditorFn) (*HostsBySiteResponse, error) {
+	rsp, err := c.HostsBySite(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHostsBySiteResponse(rsp)
+}
+
+// LogsBySiteWithResponse request returning *LogsBySiteResponse
+func (c *ClientWithResponses) LogsBySiteWithResponse(ctx context.Context, id PathID, params *LogsBySiteParams, reqEditors ...RequestEditorFn) (*LogsBySiteResponse, error) {
+	rsp, err := c.LogsBySite(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogsBySiteResponse(rsp)
+}
+
+// ProcessesBySiteWithResponse request returning *ProcessesBySiteResponse
+func (c *ClientWithResponses) ProcessesBySiteWithResponse(ctx context.Context, id PathID, params *ProcessesBySiteParams, reqEditors ...RequestEditorFn) (*ProcessesBySiteResponse, error) {
+	rsp, err := c.ProcessesBySite(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessesBySiteResponse(rsp)
+}
+
+// RoutersBySiteWithResponse request returning *RoutersBySiteResponse
+func (c *ClientWithResponses) RoutersBySiteWithResponse(ctx context.Context, id PathID, params *RoutersBySiteParams, reqEditors ...RequestEditorFn) (*RoutersBySiteResponse, error) {
+	rsp, err := c.RoutersBySite(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRoutersBySiteResponse(rsp)
+}
+
+// ParseAlertsResponse parses an HTTP response from a AlertsWithResponse call
+func ParseAlertsResponse(rsp *http.Response) (*AlertsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AlertsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetAlerts
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApplicationflowsResponse parses an HTTP response from a ApplicationflowsWithResponse call
+func ParseApplicationflowsResponse(rsp *http.Response) (*ApplicationflowsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApplicationflowsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetApplicationFlows
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportApplicationflowsResponse parses an HTTP response from a ExportApplicationflowsWithResponse call
+func ParseExportApplicationflowsResponse(rsp *http.Response) (*ExportApplicationflowsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportApplicationflowsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseComponentpairsResponse parses an HTTP response from a ComponentpairsWithResponse call
+func ParseComponentpairsResponse(rsp *http.Response) (*ComponentpairsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ComponentpairsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetFlowAggregates
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseComponentpairByIDResponse parses an HTTP response from a ComponentpairByIDWithResponse call
+func ParseComponentpairByIDResponse(rsp *http.Response) (*ComponentpairByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ComponentpairByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetFlowAggregateByID
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseComponentsResponse parses an HTTP response from a ComponentsWithResponse call
+func ParseComponentsResponse(rsp *http.Response) (*ComponentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ComponentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetComponents
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseComponentByIDResponse parses an HTTP response from a ComponentByIDWithResponse call
+func ParseComponentByIDResponse(rsp *http.Response) (*ComponentByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ComponentByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetComponentByID
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConnectionsResponse parses an HTTP response from a ConnectionsWithResponse call
+func ParseConnectionsResponse(rsp *http.Response) (*ConnectionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConnectionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetConnections
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportConnectionsResponse parses an HTTP response from a ExportConnectionsWithResponse call
+func ParseExportConnectionsResponse(rsp *http.Response) (*ExportConnectionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportConnectionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConnectorsResponse parses an HTTP response from a ConnectorsWithResponse call
+func ParseConnectorsResponse(rsp *http.Response) (*ConnectorsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConnectorsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetConnectors
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConnectorByIDResponse parses an HTTP response from a ConnectorByIDWithResponse call
+func ParseConnectorByIDResponse(rsp *http.Response) (*ConnectorByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConnectorByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetConnectorByID
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHostsResponse parses an HTTP response from a HostsWithResponse call
+func ParseHostsResponse(rsp *http.Response) (*HostsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HostsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 410:
+		var dest NotSupported
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON410 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHostsByIDResponse parses an HTTP response from a HostsByIDWithResponse call
+func ParseHostsByIDResponse(rsp *http.Response) (*HostsByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HostsByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotSupported
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListenersResponse parses an HTTP response from a ListenersWithResponse call
+func ParseListenersResponse(rsp *http.Response) (*ListenersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListenersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetListeners
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListenerByIDResponse parses an HTTP response from a ListenerByIDWithResponse call
+func ParseListenerByIDResponse(rsp *http.Response) (*ListenerByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListenerByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetListenerByID
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogsResponse parses an HTTP response from a LogsWithResponse call
+func ParseLogsResponse(rsp *http.Response) (*LogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetLogs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNetworksResponse parses an HTTP response from a NetworksWithResponse call
+func ParseNetworksResponse(rsp *http.Response) (*NetworksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NetworksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetNetworks
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcessesResponse parses an HTTP response from a ProcessesWithResponse call
+func ParseProcessesResponse(rsp *http.Response) (*ProcessesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcessesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetProcesses
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcessByIdResponse parses an HTTP response from a ProcessByIdWithResponse call
+func ParseProcessByIdResponse(rsp *http.Response) (*ProcessByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcessByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetProcessByID
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcesspairsResponse parses an HTTP response from a ProcesspairsWithResponse call
+func ParseProcesspairsResponse(rsp *http.Response) (*ProcesspairsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcesspairsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetFlowAggregates
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcesspairByIDResponse parses an HTTP response from a ProcesspairByIDWithResponse call
+func ParseProcesspairByIDResponse(rsp *http.Response) (*ProcesspairByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcesspairByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetFlowAggregateByID
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHistoryByProcesspairResponse parses an HTTP response from a HistoryByProcesspairWithResponse call
+func ParseHistoryByProcesspairResponse(rsp *http.Response) (*HistoryByProcesspairResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HistoryByProcesspairResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRouteraccessResponse parses an HTTP response from a RouteraccessWithResponse call
+func ParseRouteraccessResponse(rsp *http.Response) (*RouteraccessResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RouteraccessResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetRouterAccess
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRouteraccessByIDResponse parses an HTTP response from a RouteraccessByIDWithResponse call
+func ParseRouteraccessByIDResponse(rsp *http.Response) (*RouteraccessByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RouteraccessByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetRouterAccessByID
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRouterlinksResponse parses an HTTP response from a RouterlinksWithResponse call
+func ParseRouterlinksResponse(rsp *http.Response) (*RouterlinksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RouterlinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetRouterLinks
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRouterlinkByIDResponse parses an HTTP response from a RouterlinkByIDWithResponse call
+func ParseRouterlinkByIDResponse(rsp *http.Response) (*RouterlinkByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RouterlinkByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetRouterLinkByID
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRoutersResponse parses an HTTP response from a RoutersWithResponse call
+func ParseRoutersResponse(rsp *http.Response) (*RoutersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RoutersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetRouters
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRouterByIDResponse parses an HTTP response from a RouterByIDWithResponse call
+func ParseRouterByIDResponse(rsp *http.Response) (*RouterByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RouterByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetRouterByID
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogsByRouterResponse parses an HTTP response from a LogsByRouterWithResponse call
+func ParseLogsByRouterResponse(rsp *http.Response) (*LogsByRouterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogsByRouterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetLogs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseServicesResponse parses an HTTP response from a ServicesWithResponse call
+func ParseServicesResponse(rsp *http.Response) (*ServicesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ServicesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetServices
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseServiceByIDResponse parses an HTTP response from a ServiceByIDWithResponse call
+func ParseServiceByIDResponse(rsp *http.Response) (*ServiceByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ServiceByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetServiceByID
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConnectionsByServiceResponse parses an HTTP response from a ConnectionsByServiceWithResponse call
+func ParseConnectionsByServiceResponse(rsp *http.Response) (*ConnectionsByServiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConnectionsByServiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetConnections
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHistoryByServiceResponse parses an HTTP response from a HistoryByServiceWithResponse call
+func ParseHistoryByServiceResponse(rsp *http.Response) (*HistoryByServiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HistoryByServiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcessesByServiceResponse parses an HTTP response from a ProcessesByServiceWithResponse call
+func ParseProcessesByServiceResponse(rsp *http.Response) (*ProcessesByServiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcessesByServiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetProcesses
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcessPairsByServiceResponse parses an HTTP response from a ProcessPairsByServiceWithResponse call
+func ParseProcessPairsByServiceResponse(rsp *http.Response) (*ProcessPairsByServiceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcessPairsByServiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetFlowAggregates
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSitepairsResponse parses an HTTP response from a SitepairsWithResponse call
+func ParseSitepairsResponse(rsp *http.Response) (*SitepairsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SitepairsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetFlowAggregates
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSitepairByIDResponse parses an HTTP response from a SitepairByIDWithResponse call
+func ParseSitepairByIDResponse(rsp *http.Response) (*SitepairByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SitepairByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetFlowAggregateByID
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHistoryBySitepairResponse parses an HTTP response from a HistoryBySitepairWithResponse call
+func ParseHistoryBySitepairResponse(rsp *http.Response) (*HistoryBySitepairResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HistoryBySitepairResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSitesResponse parses an HTTP response from a SitesWithResponse call
+func ParseSitesResponse(rsp *http.Response) (*SitesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SitesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetSites
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSiteByIdResponse parses an HTTP response from a SiteByIdWithResponse call
+func ParseSiteByIdResponse(rsp *http.Response) (*SiteByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SiteByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetSiteByID
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHostsBySiteResponse parses an HTTP response from a HostsBySiteWithResponse call
+func ParseHostsBySiteResponse(rsp *http.Response) (*HostsBySiteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HostsBySiteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 410:
+		var dest NotSupported
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON410 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogsBySiteResponse parses an HTTP response from a LogsBySiteWithResponse call
+func ParseLogsBySiteResponse(rsp *http.Response) (*LogsBySiteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogsBySiteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetLogs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProcessesBySiteResponse parses an HTTP response from a ProcessesBySiteWithResponse call
+func ParseProcessesBySiteResponse(rsp *http.Response) (*ProcessesBySiteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProcessesBySiteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetProcesses
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRoutersBySiteResponse parses an HTTP response from a RoutersBySiteWithResponse call
+func ParseRoutersBySiteResponse(rsp *http.Response) (*RoutersBySiteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RoutersBySiteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GetRouters
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
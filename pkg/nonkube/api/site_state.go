@@ -217,10 +217,19 @@ func (s *SiteState) CreateBridgeCertificates() {
 	}
 	for _, connector := range s.Connectors {
 		if connector.Spec.TlsCredentials != "" {
+			hosts := []string{connector.Spec.Host}
+			if targets, err := site.ParseHosts(connector); err == nil && len(targets) > 0 {
+				hosts = nil
+				for _, target := range targets {
+					if !slices.Contains(hosts, target.Host) {
+						hosts = append(hosts, target.Host)
+					}
+				}
+			}
 			s.Certificates[connector.Spec.TlsCredentials] = s.newCertificate(connector.Spec.TlsCredentials, &v2alpha1.CertificateSpec{
 				Ca:      caName,
-				Subject: connector.Spec.Host,
-				Hosts:   []string{connector.Spec.Host},
+				Subject: hosts[0],
+				Hosts:   hosts,
 				Server:  true,
 			})
 		}
@@ -274,6 +283,19 @@ func (s *SiteState) bindings(sslProfileBasePath string) *site.Bindings {
 		listener.SetConfigured(nil)
 		_ = b.UpdateListener(name, listener)
 	}
+	for name, connector := range s.Connectors {
+		if connector.BalancingRequested() {
+			connector.SetBalancing(b.ConnectorBalancing(connector))
+		}
+		if len(connector.Spec.Hosts) > 0 {
+			connector.SetResolvedTargets(b.ResolvedTargets(name))
+		}
+	}
+	for _, listener := range s.Listeners {
+		if listener.BalancingRequested() {
+			listener.SetBalancing(b.ListenerBalancing(listener))
+		}
+	}
 	return b
 }
 
@@ -319,8 +341,12 @@ func (s *SiteState) ToRouterConfig(sslProfileBasePath string, platform string) q
 	s.linkMap(sslProfileBasePath).Apply(&routerConfig)
 	// Bindings
 	s.bindings(sslProfileBasePath).Apply(&routerConfig)
-	// Log (static for now) TODO use site specific options to configure logging
-	routerConfig.SetLogLevel("ROUTER_CORE", "error+")
+	// Log
+	if logging, err := qdr.ParseRouterLogConfig(s.Site.Spec.GetRouterLogging()); err == nil {
+		qdr.ConfigureRouterLogging(&routerConfig, logging)
+	} else {
+		routerConfig.SetLogLevel("ROUTER_CORE", "error+")
+	}
 
 	return routerConfig
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/skupperproject/skupper/internal/network"
+	"github.com/skupperproject/skupper/internal/qdr"
 	"github.com/skupperproject/skupper/pkg/apis/skupper/v2alpha1"
 	"gotest.tools/v3/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -80,21 +81,37 @@ func TestSiteState_CreateRouterAccess(t *testing.T) {
 
 func TestSiteState_ToRouterConfig(t *testing.T) {
 	for _, test := range []struct {
-		name   string
-		bundle bool
+		name          string
+		bundle        bool
+		routerLogging string
+		expectedLog   map[string]qdr.LogConfig
 	}{
 		{
-			name:   "regular-config",
-			bundle: false,
+			name:        "regular-config",
+			bundle:      false,
+			expectedLog: map[string]qdr.LogConfig{"ROUTER_CORE": {Module: "ROUTER_CORE", Enable: "error+"}},
 		},
 		{
-			name:   "bundle-config",
-			bundle: true,
+			name:        "bundle-config",
+			bundle:      true,
+			expectedLog: map[string]qdr.LogConfig{"ROUTER_CORE": {Module: "ROUTER_CORE", Enable: "error+"}},
+		},
+		{
+			name:          "router-logging",
+			bundle:        true,
+			routerLogging: "debug,TCP_ADAPTOR:trace",
+			expectedLog: map[string]qdr.LogConfig{
+				"DEFAULT":     {Module: "DEFAULT", Enable: "debug+"},
+				"TCP_ADAPTOR": {Module: "TCP_ADAPTOR", Enable: "trace+"},
+			},
 		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			ss := fakeSiteState()
 			ss.bundle = test.bundle
+			if test.routerLogging != "" {
+				ss.Site.Spec.Settings = map[string]string{"router-logging": test.routerLogging}
+			}
 			sslProfileBasePath := "${SSL_PROFILE_BASE_PATH}"
 			routerConfig := ss.ToRouterConfig(sslProfileBasePath, "podman")
 			if test.bundle {
@@ -129,6 +146,7 @@ func TestSiteState_ToRouterConfig(t *testing.T) {
 			assert.Equal(t, routerConfig.SiteConfig.Namespace, expectedNamespace)
 			assert.Equal(t, len(routerConfig.Addresses), 1)
 			assert.Equal(t, routerConfig.Addresses["mc"].Distribution, "multicast")
+			assert.DeepEqual(t, routerConfig.LogConfig, test.expectedLog)
 		})
 	}
 }
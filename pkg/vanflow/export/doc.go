@@ -0,0 +1,12 @@
+/*
+Package export publishes vanflow record changes to external systems such as
+Kafka, NATS or a local file.
+
+An Exporter is fed record changes either from the store event handlers
+returned by StoreHandlers or from the eventsource.Client record handlers
+returned by RecordHandler. Changes are normalized into versioned JSON Events,
+queued, and published in batches to a Sink. Failed batches are retried with
+exponential backoff, and the Backpressure option chooses whether producers
+block or events are dropped when the queue is full.
+*/
+package export
@@ -0,0 +1,171 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+)
+
+// SchemaVersion identifies the layout of exported events. It changes
+// whenever a field is removed or its meaning changes; consumers should
+// ignore fields they do not recognize.
+const SchemaVersion = "v1"
+
+// EventType describes the change made to a record.
+type EventType string
+
+const (
+	EventAdd    EventType = "add"
+	EventUpdate EventType = "update"
+	EventDelete EventType = "delete"
+)
+
+// Event is the normalized form of a record change published to a Sink.
+type Event struct {
+	SchemaVersion string    `json:"schemaVersion"`
+	Type          EventType `json:"type"`
+	Time          time.Time `json:"time"`
+	// Network names the network the record belongs to when records from
+	// more than one network are exported.
+	Network string `json:"network,omitempty"`
+	// Source identifies the event source the record was received from.
+	// It is empty for records inferred by the collector.
+	Source Source `json:"source"`
+	// RecordType is the vanflow record type, for example "SiteRecord".
+	RecordType string `json:"recordType"`
+	ID         string `json:"id"`
+	// Attributes holds the attributes set on the record keyed by the
+	// lower camel case name of the record field. Timestamps are
+	// expressed in microseconds since the epoch as they are in vanflow.
+	Attributes map[string]any `json:"attributes,omitempty"`
+}
+
+// Source identifies a vanflow event source.
+type Source struct {
+	ID      string `json:"id,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// Key returns the key used to partition events so that changes to the same
+// record are kept in order.
+func (e Event) Key() string {
+	if e.Network != "" {
+		return e.Network + ":" + e.ID
+	}
+	return e.ID
+}
+
+// Marshal encodes an event as JSON.
+func Marshal(e Event) ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// Unmarshal decodes an event encoded with Marshal.
+func Unmarshal(data []byte) (Event, error) {
+	var e Event
+	if err := json.Unmarshal(data, &e); err != nil {
+		return e, err
+	}
+	if e.SchemaVersion != SchemaVersion {
+		return e, fmt.Errorf("unsupported event schema version %q", e.SchemaVersion)
+	}
+	return e, nil
+}
+
+// NewEvent returns the normalized event for a change to record.
+func NewEvent(typ EventType, record vanflow.Record, source store.SourceRef) Event {
+	return Event{
+		SchemaVersion: SchemaVersion,
+		Type:          typ,
+		Time:          time.Now(),
+		Source:        Source{ID: source.ID, Version: source.Version},
+		RecordType:    record.GetTypeMeta().Type,
+		ID:            record.Identity(),
+		Attributes:    attributes(record),
+	}
+}
+
+var vanflowTimeType = reflect.TypeOf(vanflow.Time{})
+
+// attributes flattens the set fields of a record, including those of
+// embedded structs such as BaseRecord, into a map.
+func attributes(record any) map[string]any {
+	v := reflect.ValueOf(record)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	result := make(map[string]any)
+	collectAttributes(v, result)
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+func collectAttributes(v reflect.Value, result map[string]any) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fv := v.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			collectAttributes(fv, result)
+			continue
+		}
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		if fv.Type() == vanflowTimeType {
+			ts := fv.Interface().(vanflow.Time)
+			if ts.IsZero() {
+				continue
+			}
+			result[attributeName(field.Name)] = ts.UnixMicro()
+			continue
+		}
+		switch fv.Kind() {
+		case reflect.String, reflect.Bool,
+			reflect.Int, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			result[attributeName(field.Name)] = fv.Interface()
+		}
+	}
+}
+
+// attributeName converts a Go field name to lower camel case, treating a
+// leading run of upper case letters as an initialism ("ID" to "id",
+// "TTL" to "ttl", "SourceHost" to "sourceHost").
+func attributeName(name string) string {
+	runes := []rune(name)
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+	switch {
+	case n == 0:
+		return name
+	case n == 1 || n == len(runes):
+		return strings.ToLower(string(runes[:n])) + string(runes[n:])
+	default:
+		// keep the last upper case letter as the start of the next word
+		return strings.ToLower(string(runes[:n-1])) + string(runes[n-1:])
+	}
+}
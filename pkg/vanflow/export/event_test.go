@@ -0,0 +1,83 @@
+package export
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+)
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
+
+func TestNewEvent(t *testing.T) {
+	start := time.UnixMicro(1700000000000000)
+	record := vanflow.SiteRecord{
+		BaseRecord: vanflow.NewBase("site-1", start),
+		Name:       ptrTo("east"),
+		Platform:   ptrTo("kubernetes"),
+	}
+	event := NewEvent(EventAdd, record, store.SourceRef{ID: "router-1", Version: "1"})
+	event.Time = time.Time{}
+	expected := Event{
+		SchemaVersion: SchemaVersion,
+		Type:          EventAdd,
+		Source:        Source{ID: "router-1", Version: "1"},
+		RecordType:    "SiteRecord",
+		ID:            "site-1",
+		Attributes: map[string]any{
+			"id":        "site-1",
+			"startTime": start.UnixMicro(),
+			"name":      "east",
+			"platform":  "kubernetes",
+		},
+	}
+	if !cmp.Equal(event, expected) {
+		t.Errorf("unexpected event: %s", cmp.Diff(expected, event))
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	event := NewEvent(EventDelete, vanflow.LinkRecord{
+		BaseRecord: vanflow.NewBase("link-1"),
+		LinkCost:   ptrTo(uint64(4)),
+	}, store.SourceRef{})
+	data, err := Marshal(event)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	decoded, err := Unmarshal(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if decoded.Type != EventDelete || decoded.RecordType != "LinkRecord" || decoded.ID != "link-1" {
+		t.Errorf("unexpected decoded event: %+v", decoded)
+	}
+	if cost := decoded.Attributes["linkCost"]; cost != float64(4) {
+		t.Errorf("expected linkCost 4 but got %v", cost)
+	}
+
+	if _, err := Unmarshal([]byte(`{"schemaVersion": "v0"}`)); err == nil {
+		t.Error("expected error for unsupported schema version")
+	}
+}
+
+func TestAttributeName(t *testing.T) {
+	testCases := map[string]string{
+		"ID":             "id",
+		"Name":           "name",
+		"TTL":            "ttl",
+		"SourceHost":     "sourceHost",
+		"ACKTime":        "ackTime",
+		"LinkCost":       "linkCost",
+		"alreadyLowered": "alreadyLowered",
+	}
+	for in, expected := range testCases {
+		if out := attributeName(in); out != expected {
+			t.Errorf("attributeName(%q): expected %q but got %q", in, expected, out)
+		}
+	}
+}
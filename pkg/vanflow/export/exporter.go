@@ -0,0 +1,277 @@
+package export
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+)
+
+// Sink publishes batches of events to an external system.
+type Sink interface {
+	// Publish delivers a batch of events in order. When it returns an
+	// error the whole batch may be retried.
+	Publish(ctx context.Context, events []Event) error
+	Close() error
+}
+
+// Backpressure selects what happens to events produced while the queue is
+// full.
+type Backpressure int
+
+const (
+	// Block waits for room in the queue, up to BlockTimeout when set.
+	Block Backpressure = iota
+	// Drop discards the event immediately.
+	Drop
+)
+
+// Options configure an Exporter. Zero values select the defaults.
+type Options struct {
+	// QueueSize is the number of events buffered ahead of the sink.
+	QueueSize int
+	// BatchSize is the largest number of events published at once.
+	BatchSize int
+	// FlushInterval is the longest an event waits for its batch to fill.
+	FlushInterval time.Duration
+	// MaxRetries is the number of times a failed batch is retried before
+	// it is discarded. Negative disables retries.
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry. It doubles with
+	// each attempt up to MaxRetryBackoff.
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	// Backpressure and BlockTimeout control what happens when the queue
+	// is full. A zero BlockTimeout blocks until there is room.
+	Backpressure Backpressure
+	BlockTimeout time.Duration
+
+	Logger *slog.Logger
+}
+
+func (o Options) withDefaults() Options {
+	if o.QueueSize <= 0 {
+		o.QueueSize = 4096
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = 256
+	}
+	if o.FlushInterval <= 0 {
+		o.FlushInterval = time.Second
+	}
+	if o.MaxRetries == 0 {
+		o.MaxRetries = 5
+	}
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = 500 * time.Millisecond
+	}
+	if o.MaxRetryBackoff <= 0 {
+		o.MaxRetryBackoff = 30 * time.Second
+	}
+	if o.Logger == nil {
+		o.Logger = slog.Default()
+	}
+	return o
+}
+
+// Stats counts the events handled by an Exporter.
+type Stats struct {
+	// Published events were accepted by the sink.
+	Published uint64
+	// Dropped events were discarded because the queue was full or the
+	// exporter had stopped.
+	Dropped uint64
+	// Failed events were discarded after the sink rejected their batch
+	// MaxRetries times.
+	Failed uint64
+}
+
+// Exporter queues record changes and publishes them to a Sink in batches.
+type Exporter struct {
+	sink    Sink
+	options Options
+	queue   chan Event
+	done    chan struct{}
+
+	published atomic.Uint64
+	dropped   atomic.Uint64
+	failed    atomic.Uint64
+}
+
+// New returns an Exporter publishing to sink. Events are queued from the
+// time it is created but are only published once Run is called.
+func New(sink Sink, options Options) *Exporter {
+	options = options.withDefaults()
+	return &Exporter{
+		sink:    sink,
+		options: options,
+		queue:   make(chan Event, options.QueueSize),
+		done:    make(chan struct{}),
+	}
+}
+
+// StoreHandlers returns store event handlers that export each record added
+// to, changed in or deleted from a store.
+func (e *Exporter) StoreHandlers() store.EventHandlerFuncs {
+	return e.NetworkStoreHandlers("")
+}
+
+// NetworkStoreHandlers is like StoreHandlers but tags each event with the
+// network the store holds records for.
+func (e *Exporter) NetworkStoreHandlers(network string) store.EventHandlerFuncs {
+	enqueue := func(typ EventType, entry store.Entry) {
+		event := NewEvent(typ, entry.Record, entry.Source)
+		event.Network = network
+		e.Enqueue(event)
+	}
+	return store.EventHandlerFuncs{
+		OnAdd: func(entry store.Entry) {
+			enqueue(EventAdd, entry)
+		},
+		OnChange: func(_, curr store.Entry) {
+			enqueue(EventUpdate, curr)
+		},
+		OnDelete: func(entry store.Entry) {
+			enqueue(EventDelete, entry)
+		},
+	}
+}
+
+// RecordHandler returns an eventsource.Client record handler that exports
+// the records received from source. Record messages carry partial record
+// state, so each record is exported as an update containing only the
+// attributes present in the message.
+func (e *Exporter) RecordHandler(source store.SourceRef) func(vanflow.RecordMessage) {
+	return func(msg vanflow.RecordMessage) {
+		for _, record := range msg.Records {
+			e.Enqueue(NewEvent(EventUpdate, record, source))
+		}
+	}
+}
+
+// Enqueue adds an event to the queue, applying the configured backpressure
+// when it is full. It reports whether the event was queued.
+func (e *Exporter) Enqueue(event Event) bool {
+	select {
+	case <-e.done:
+		e.dropped.Add(1)
+		return false
+	default:
+	}
+	select {
+	case e.queue <- event:
+		return true
+	default:
+	}
+	if e.options.Backpressure == Drop {
+		e.dropped.Add(1)
+		return false
+	}
+	var timeout <-chan time.Time
+	if e.options.BlockTimeout > 0 {
+		timer := time.NewTimer(e.options.BlockTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case e.queue <- event:
+		return true
+	case <-e.done:
+	case <-timeout:
+	}
+	e.dropped.Add(1)
+	return false
+}
+
+// Stats returns the counts of events handled so far.
+func (e *Exporter) Stats() Stats {
+	return Stats{
+		Published: e.published.Load(),
+		Dropped:   e.dropped.Load(),
+		Failed:    e.failed.Load(),
+	}
+}
+
+// Run publishes queued events until ctx is cancelled. The events still
+// queued at that point are published with a single attempt each before
+// the sink is closed.
+func (e *Exporter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.options.FlushInterval)
+	defer ticker.Stop()
+	batch := make([]Event, 0, e.options.BatchSize)
+	flush := func(ctx context.Context, retries int) {
+		if len(batch) == 0 {
+			return
+		}
+		e.publish(ctx, batch, retries)
+		batch = make([]Event, 0, e.options.BatchSize)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			close(e.done)
+			e.drain(batch)
+			return e.sink.Close()
+		case event := <-e.queue:
+			batch = append(batch, event)
+			if len(batch) >= e.options.BatchSize {
+				flush(ctx, e.options.MaxRetries)
+			}
+		case <-ticker.C:
+			flush(ctx, e.options.MaxRetries)
+		}
+	}
+}
+
+func (e *Exporter) drain(batch []Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for {
+		select {
+		case event := <-e.queue:
+			batch = append(batch, event)
+			if len(batch) < e.options.BatchSize {
+				continue
+			}
+		default:
+		}
+		if len(batch) > 0 {
+			e.publish(ctx, batch, -1)
+			batch = batch[:0]
+		}
+		if len(e.queue) == 0 {
+			return
+		}
+	}
+}
+
+func (e *Exporter) publish(ctx context.Context, batch []Event, retries int) {
+	backoff := e.options.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		err := e.sink.Publish(ctx, batch)
+		if err == nil {
+			e.published.Add(uint64(len(batch)))
+			return
+		}
+		if attempt >= retries || ctx.Err() != nil {
+			e.failed.Add(uint64(len(batch)))
+			e.options.Logger.Error("Failed to export records",
+				slog.Int("count", len(batch)),
+				slog.Int("attempts", attempt+1),
+				slog.Any("error", err))
+			return
+		}
+		e.options.Logger.Debug("Retrying record export",
+			slog.Int("count", len(batch)),
+			slog.Duration("backoff", backoff),
+			slog.Any("error", err))
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, e.options.MaxRetryBackoff)
+	}
+}
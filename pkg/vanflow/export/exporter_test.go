@@ -0,0 +1,283 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/skupperproject/skupper/pkg/vanflow"
+	"github.com/skupperproject/skupper/pkg/vanflow/store"
+)
+
+// memorySink is an in-process stand-in for a message broker.
+type memorySink struct {
+	mu       sync.Mutex
+	batches  [][]Event
+	failures int
+	attempts int
+	closed   bool
+	block    chan struct{}
+}
+
+func (s *memorySink) Publish(ctx context.Context, events []Event) error {
+	if s.block != nil {
+		select {
+		case <-s.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts++
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("broker unavailable")
+	}
+	s.batches = append(s.batches, append([]Event(nil), events...))
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+func (s *memorySink) ids() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []string
+	for _, batch := range s.batches {
+		for _, event := range batch {
+			ids = append(ids, event.ID)
+		}
+	}
+	return ids
+}
+
+func (s *memorySink) batchSizes() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var sizes []int
+	for _, batch := range s.batches {
+		sizes = append(sizes, len(batch))
+	}
+	return sizes
+}
+
+func runExporter(t *testing.T, exporter *Exporter) (stop func()) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- exporter.Run(ctx) }()
+	return func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("unexpected error from Run: %s", err)
+		}
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestExporterBatching(t *testing.T) {
+	sink := &memorySink{}
+	exporter := New(sink, Options{BatchSize: 3, FlushInterval: time.Hour})
+	stop := runExporter(t, exporter)
+
+	for _, id := range []string{"1", "2", "3", "4", "5", "6", "7"} {
+		exporter.Enqueue(Event{ID: id})
+	}
+	waitFor(t, func() bool { return len(sink.batchSizes()) == 2 })
+	stop()
+
+	if sizes := sink.batchSizes(); len(sizes) != 3 || sizes[0] != 3 || sizes[1] != 3 || sizes[2] != 1 {
+		t.Errorf("unexpected batch sizes: %v", sizes)
+	}
+	ids := sink.ids()
+	for i, id := range []string{"1", "2", "3", "4", "5", "6", "7"} {
+		if ids[i] != id {
+			t.Errorf("expected events in order but got %v", ids)
+			break
+		}
+	}
+	if !sink.closed {
+		t.Error("expected sink to be closed")
+	}
+	if stats := exporter.Stats(); stats.Published != 7 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestExporterFlushInterval(t *testing.T) {
+	sink := &memorySink{}
+	exporter := New(sink, Options{BatchSize: 100, FlushInterval: 10 * time.Millisecond})
+	stop := runExporter(t, exporter)
+	defer stop()
+
+	exporter.Enqueue(Event{ID: "1"})
+	waitFor(t, func() bool { return len(sink.ids()) == 1 })
+}
+
+func TestExporterRetries(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Failures      int
+		MaxRetries    int
+		ExpectedStats Stats
+		ExpectedTries int
+	}{
+		{
+			Name:          "succeeds after retries",
+			Failures:      2,
+			MaxRetries:    3,
+			ExpectedStats: Stats{Published: 1},
+			ExpectedTries: 3,
+		}, {
+			Name:          "gives up",
+			Failures:      5,
+			MaxRetries:    2,
+			ExpectedStats: Stats{Failed: 1},
+			ExpectedTries: 3,
+		}, {
+			Name:          "retries disabled",
+			Failures:      1,
+			MaxRetries:    -1,
+			ExpectedStats: Stats{Failed: 1},
+			ExpectedTries: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			sink := &memorySink{failures: tc.Failures}
+			exporter := New(sink, Options{
+				BatchSize:    1,
+				MaxRetries:   tc.MaxRetries,
+				RetryBackoff: time.Millisecond,
+			})
+			stop := runExporter(t, exporter)
+			exporter.Enqueue(Event{ID: "1"})
+			waitFor(t, func() bool {
+				stats := exporter.Stats()
+				return stats.Published+stats.Failed == 1
+			})
+			stop()
+			if stats := exporter.Stats(); stats != tc.ExpectedStats {
+				t.Errorf("expected stats %+v but got %+v", tc.ExpectedStats, stats)
+			}
+			if sink.attempts != tc.ExpectedTries {
+				t.Errorf("expected %d attempts but got %d", tc.ExpectedTries, sink.attempts)
+			}
+		})
+	}
+}
+
+func TestExporterBackpressure(t *testing.T) {
+	t.Run("drop", func(t *testing.T) {
+		exporter := New(&memorySink{}, Options{QueueSize: 2, Backpressure: Drop})
+		results := []bool{
+			exporter.Enqueue(Event{ID: "1"}),
+			exporter.Enqueue(Event{ID: "2"}),
+			exporter.Enqueue(Event{ID: "3"}),
+		}
+		if !results[0] || !results[1] || results[2] {
+			t.Errorf("expected only the third event to be dropped: %v", results)
+		}
+		if stats := exporter.Stats(); stats.Dropped != 1 {
+			t.Errorf("unexpected stats: %+v", stats)
+		}
+	})
+	t.Run("block with timeout", func(t *testing.T) {
+		exporter := New(&memorySink{}, Options{QueueSize: 1, BlockTimeout: 10 * time.Millisecond})
+		exporter.Enqueue(Event{ID: "1"})
+		begin := time.Now()
+		if exporter.Enqueue(Event{ID: "2"}) {
+			t.Error("expected event to be dropped")
+		}
+		if waited := time.Since(begin); waited < 10*time.Millisecond {
+			t.Errorf("expected enqueue to block but returned after %s", waited)
+		}
+	})
+	t.Run("block until room", func(t *testing.T) {
+		sink := &memorySink{block: make(chan struct{})}
+		exporter := New(sink, Options{QueueSize: 1, BatchSize: 1})
+		stop := runExporter(t, exporter)
+		defer stop()
+
+		exporter.Enqueue(Event{ID: "1"}) // taken by Run, blocked in the sink
+		waitFor(t, func() bool { return len(exporter.queue) == 0 })
+		exporter.Enqueue(Event{ID: "2"}) // fills the queue
+		queued := make(chan bool)
+		go func() { queued <- exporter.Enqueue(Event{ID: "3"}) }()
+		select {
+		case <-queued:
+			t.Fatal("expected enqueue to block while the queue is full")
+		case <-time.After(20 * time.Millisecond):
+		}
+		close(sink.block)
+		if !<-queued {
+			t.Error("expected event to be queued once there was room")
+		}
+		waitFor(t, func() bool { return len(sink.ids()) == 3 })
+	})
+}
+
+func TestExporterStoreHandlers(t *testing.T) {
+	sink := &memorySink{}
+	exporter := New(sink, Options{BatchSize: 1})
+	stop := runExporter(t, exporter)
+
+	records := store.NewSyncMapStore(store.SyncMapStoreConfig{Handlers: exporter.StoreHandlers()})
+	source := store.SourceRef{ID: "router-1", Version: "1"}
+	records.Add(vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-1")}, source)
+	records.Update(vanflow.SiteRecord{BaseRecord: vanflow.NewBase("site-1"), Name: ptrTo("east")})
+	records.Delete("site-1")
+	waitFor(t, func() bool { return len(sink.ids()) == 3 })
+	stop()
+
+	var types []EventType
+	for _, batch := range sink.batches {
+		for _, event := range batch {
+			types = append(types, event.Type)
+			if event.Source.ID != "router-1" {
+				t.Errorf("expected event source router-1 but got %q", event.Source.ID)
+			}
+		}
+	}
+	if len(types) != 3 || types[0] != EventAdd || types[1] != EventUpdate || types[2] != EventDelete {
+		t.Errorf("unexpected event types: %v", types)
+	}
+}
+
+func TestExporterRecordHandler(t *testing.T) {
+	sink := &memorySink{}
+	exporter := New(sink, Options{})
+	stop := runExporter(t, exporter)
+
+	handler := exporter.RecordHandler(store.SourceRef{ID: "router-1"})
+	handler(vanflow.RecordMessage{Records: []vanflow.Record{
+		vanflow.RouterRecord{BaseRecord: vanflow.NewBase("router-1")},
+		vanflow.LinkRecord{BaseRecord: vanflow.NewBase("link-1")},
+	}})
+	stop()
+
+	if ids := sink.ids(); len(ids) != 2 || ids[0] != "router-1" || ids[1] != "link-1" {
+		t.Errorf("expected queued events to be published on stop: %v", ids)
+	}
+	if exporter.Enqueue(Event{ID: "late"}) {
+		t.Error("expected events to be dropped once stopped")
+	}
+}
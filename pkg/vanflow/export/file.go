@@ -0,0 +1,129 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileSinkOptions configure a FileSink. Zero values select the defaults.
+type FileSinkOptions struct {
+	// MaxBytes is the size at which the file is rotated.
+	MaxBytes int64
+	// MaxFiles is the number of rotated files kept alongside the current
+	// one. Rotated files are named path.1 (newest) to path.MaxFiles.
+	MaxFiles int
+}
+
+// FileSink writes events to a local file as JSON lines, rotating it once it
+// grows past MaxBytes.
+type FileSink struct {
+	path     string
+	maxBytes int64
+	maxFiles int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewFileSink opens, or creates, the file at path for appending events.
+func NewFileSink(path string, options FileSinkOptions) (*FileSink, error) {
+	if options.MaxBytes <= 0 {
+		options.MaxBytes = 64 << 20
+	}
+	if options.MaxFiles <= 0 {
+		options.MaxFiles = 5
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create export directory: %w", err)
+	}
+	sink := &FileSink{
+		path:     path,
+		maxBytes: options.MaxBytes,
+		maxFiles: options.MaxFiles,
+	}
+	if err := sink.open(); err != nil {
+		return nil, err
+	}
+	return sink, nil
+}
+
+func (s *FileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open export file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to open export file: %w", err)
+	}
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close export file: %w", err)
+	}
+	s.file = nil
+	os.Remove(fmt.Sprintf("%s.%d", s.path, s.maxFiles))
+	for i := s.maxFiles - 1; i > 0; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", s.path, i), fmt.Sprintf("%s.%d", s.path, i+1))
+	}
+	if err := os.Rename(s.path, s.path+".1"); err != nil {
+		return fmt.Errorf("failed to rotate export file: %w", err)
+	}
+	return s.open()
+}
+
+func (s *FileSink) Publish(ctx context.Context, events []Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		if err := s.open(); err != nil {
+			return err
+		}
+	}
+	w := bufio.NewWriter(s.file)
+	for _, event := range events {
+		line, err := Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode event: %w", err)
+		}
+		line = append(line, '\n')
+		if s.size > 0 && s.size+int64(len(line)) > s.maxBytes {
+			if err := w.Flush(); err != nil {
+				return fmt.Errorf("failed to write export file: %w", err)
+			}
+			if err := s.rotate(); err != nil {
+				return err
+			}
+			w.Reset(s.file)
+		}
+		if _, err := w.Write(line); err != nil {
+			return fmt.Errorf("failed to write export file: %w", err)
+		}
+		s.size += int64(len(line))
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write export file: %w", err)
+	}
+	return nil
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
@@ -0,0 +1,102 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func readEvents(t *testing.T, path string) []Event {
+	t.Helper()
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer file.Close()
+	var events []Event
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		event, err := Unmarshal(scanner.Bytes())
+		if err != nil {
+			t.Fatalf("unexpected error decoding %q: %s", scanner.Text(), err)
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "export", "records.jsonl")
+	sink, err := NewFileSink(path, FileSinkOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = sink.Publish(context.Background(), []Event{
+		{SchemaVersion: SchemaVersion, Type: EventAdd, ID: "1"},
+		{SchemaVersion: SchemaVersion, Type: EventDelete, ID: "1"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// appends to an existing file
+	sink, err = NewFileSink(path, FileSinkOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = sink.Publish(context.Background(), []Event{{SchemaVersion: SchemaVersion, Type: EventAdd, ID: "2"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	sink.Close()
+
+	events := readEvents(t, path)
+	if len(events) != 3 || events[1].Type != EventDelete || events[2].ID != "2" {
+		t.Errorf("unexpected events: %+v", events)
+	}
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "records.jsonl")
+	line, _ := Marshal(Event{SchemaVersion: SchemaVersion, ID: "0"})
+	sink, err := NewFileSink(path, FileSinkOptions{
+		// room for two events per file
+		MaxBytes: int64(2 * (len(line) + 1)),
+		MaxFiles: 2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer sink.Close()
+	var events []Event
+	for i := 0; i < 7; i++ {
+		events = append(events, Event{SchemaVersion: SchemaVersion, ID: fmt.Sprint(i)})
+	}
+	if err := sink.Publish(context.Background(), events); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string][]string{
+		path:        {"6"},
+		path + ".1": {"4", "5"},
+		path + ".2": {"2", "3"},
+	}
+	for file, ids := range expected {
+		var got []string
+		for _, event := range readEvents(t, file) {
+			got = append(got, event.ID)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(ids) {
+			t.Errorf("expected %s to contain %v but got %v", file, ids, got)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("expected only %d rotated files to be kept", 2)
+	}
+}
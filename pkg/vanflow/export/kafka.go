@@ -0,0 +1,113 @@
+package export
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+)
+
+// KafkaWriter writes messages to Kafka. It is implemented by *kafka.Writer.
+type KafkaWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// KafkaSink publishes events to a Kafka topic. Messages are keyed by record
+// identity so that changes to a record land on the same partition in order.
+type KafkaSink struct {
+	writer KafkaWriter
+}
+
+// KafkaSinkOptions configure how a KafkaSink connects to its brokers.
+type KafkaSinkOptions struct {
+	// TLS is used to connect to the brokers when set.
+	TLS *tls.Config
+	// SASLMechanism is PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512 to
+	// authenticate with Username and Password. No SASL authentication is
+	// attempted when empty.
+	SASLMechanism string
+	Username      string
+	Password      string
+}
+
+func (o KafkaSinkOptions) mechanism() (sasl.Mechanism, error) {
+	switch strings.ToUpper(o.SASLMechanism) {
+	case "":
+		return nil, nil
+	case "PLAIN":
+		return plain.Mechanism{Username: o.Username, Password: o.Password}, nil
+	case "SCRAM-SHA-256":
+		return scram.Mechanism(scram.SHA256, o.Username, o.Password)
+	case "SCRAM-SHA-512":
+		return scram.Mechanism(scram.SHA512, o.Username, o.Password)
+	default:
+		return nil, fmt.Errorf("unsupported kafka sasl mechanism: %s", o.SASLMechanism)
+	}
+}
+
+// NewKafkaSink returns a KafkaSink producing to topic on the given brokers.
+func NewKafkaSink(brokers []string, topic string, options KafkaSinkOptions) (*KafkaSink, error) {
+	if len(brokers) == 0 {
+		return nil, fmt.Errorf("at least one kafka broker is required")
+	}
+	if topic == "" {
+		return nil, fmt.Errorf("kafka topic is required")
+	}
+	mechanism, err := options.mechanism()
+	if err != nil {
+		return nil, err
+	}
+	return NewKafkaSinkWithWriter(&kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		Transport: &kafka.Transport{
+			TLS:  options.TLS,
+			SASL: mechanism,
+		},
+		// batching and retries are handled by the Exporter
+		BatchTimeout: time.Millisecond,
+		MaxAttempts:  1,
+	}), nil
+}
+
+// NewKafkaSinkWithWriter returns a KafkaSink producing through writer.
+func NewKafkaSinkWithWriter(writer KafkaWriter) *KafkaSink {
+	return &KafkaSink{writer: writer}
+}
+
+func (s *KafkaSink) Publish(ctx context.Context, events []Event) error {
+	msgs := make([]kafka.Message, 0, len(events))
+	for _, event := range events {
+		value, err := Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode event: %w", err)
+		}
+		msgs = append(msgs, kafka.Message{
+			Key:   []byte(event.Key()),
+			Value: value,
+			Time:  event.Time,
+			Headers: []kafka.Header{
+				{Key: "schemaVersion", Value: []byte(event.SchemaVersion)},
+				{Key: "recordType", Value: []byte(event.RecordType)},
+				{Key: "type", Value: []byte(event.Type)},
+			},
+		})
+	}
+	if err := s.writer.WriteMessages(ctx, msgs...); err != nil {
+		return fmt.Errorf("failed to write to kafka: %w", err)
+	}
+	return nil
+}
+
+func (s *KafkaSink) Close() error {
+	return s.writer.Close()
+}
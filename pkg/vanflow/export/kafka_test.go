@@ -0,0 +1,121 @@
+package export
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// kafkaBroker is an in-process stand-in for a Kafka producer.
+type kafkaBroker struct {
+	messages []kafka.Message
+	err      error
+	closed   bool
+}
+
+func (b *kafkaBroker) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	if b.err != nil {
+		return b.err
+	}
+	b.messages = append(b.messages, msgs...)
+	return nil
+}
+
+func (b *kafkaBroker) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestKafkaSink(t *testing.T) {
+	broker := &kafkaBroker{}
+	sink := NewKafkaSinkWithWriter(broker)
+	events := []Event{
+		{SchemaVersion: SchemaVersion, Type: EventAdd, RecordType: "SiteRecord", ID: "site-1"},
+		{SchemaVersion: SchemaVersion, Type: EventUpdate, RecordType: "LinkRecord", ID: "link-1"},
+	}
+	if err := sink.Publish(context.Background(), events); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(broker.messages) != 2 {
+		t.Fatalf("expected 2 messages but got %d", len(broker.messages))
+	}
+	msg := broker.messages[1]
+	if string(msg.Key) != "link-1" {
+		t.Errorf("expected message keyed by record identity but got %q", msg.Key)
+	}
+	headers := map[string]string{}
+	for _, h := range msg.Headers {
+		headers[h.Key] = string(h.Value)
+	}
+	if headers["schemaVersion"] != SchemaVersion || headers["recordType"] != "LinkRecord" || headers["type"] != "update" {
+		t.Errorf("unexpected headers: %v", headers)
+	}
+	decoded, err := Unmarshal(msg.Value)
+	if err != nil || decoded.ID != "link-1" {
+		t.Errorf("unexpected message value %q: %v", msg.Value, err)
+	}
+
+	broker.err = errors.New("leader not available")
+	if err := sink.Publish(context.Background(), events); err == nil {
+		t.Error("expected publish error")
+	}
+	sink.Close()
+	if !broker.closed {
+		t.Error("expected writer to be closed")
+	}
+}
+
+func TestNewKafkaSink(t *testing.T) {
+	if _, err := NewKafkaSink(nil, "vanflow", KafkaSinkOptions{}); err == nil {
+		t.Error("expected error without brokers")
+	}
+	if _, err := NewKafkaSink([]string{"localhost:9092"}, "", KafkaSinkOptions{}); err == nil {
+		t.Error("expected error without topic")
+	}
+	if _, err := NewKafkaSink([]string{"localhost:9092"}, "vanflow", KafkaSinkOptions{SASLMechanism: "GSSAPI"}); err == nil {
+		t.Error("expected error with unsupported sasl mechanism")
+	}
+	sink, err := NewKafkaSink([]string{"localhost:9092"}, "vanflow", KafkaSinkOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	sink.Close()
+}
+
+func TestNewKafkaSinkOptions(t *testing.T) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	for _, mechanism := range []string{"plain", "SCRAM-SHA-256", "SCRAM-SHA-512"} {
+		sink, err := NewKafkaSink([]string{"localhost:9093"}, "vanflow", KafkaSinkOptions{
+			TLS:           tlsConfig,
+			SASLMechanism: mechanism,
+			Username:      "observer",
+			Password:      "secret",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %s", mechanism, err)
+		}
+		transport := sink.writer.(*kafka.Writer).Transport.(*kafka.Transport)
+		if transport.TLS != tlsConfig {
+			t.Errorf("expected tls config to be used for %s", mechanism)
+		}
+		if transport.SASL == nil {
+			t.Errorf("expected sasl mechanism %s to be used", mechanism)
+		}
+		sink.Close()
+	}
+}
+
+func TestKafkaSinkNetworkKey(t *testing.T) {
+	broker := &kafkaBroker{}
+	sink := NewKafkaSinkWithWriter(broker)
+	event := Event{SchemaVersion: SchemaVersion, Network: "prod", ID: "site-1"}
+	if err := sink.Publish(context.Background(), []Event{event}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if key := string(broker.messages[0].Key); key != "prod:site-1" {
+		t.Errorf("expected message keyed by network and identity but got %q", key)
+	}
+}
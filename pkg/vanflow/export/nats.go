@@ -0,0 +1,153 @@
+package export
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NATSConn publishes messages to NATS. It is implemented by *nats.Conn.
+type NATSConn interface {
+	PublishMsg(msg *nats.Msg) error
+	FlushWithContext(ctx context.Context) error
+	Close()
+}
+
+// NATSSinkOptions configure how a NATSSink connects to its server.
+type NATSSinkOptions struct {
+	// TLS is used to connect to the server when set. Servers with a
+	// tls:// URL are connected to using TLS regardless.
+	TLS *tls.Config
+	// CredentialsFile is the path of a NATS credentials file holding a
+	// user JWT and NKey seed.
+	CredentialsFile string
+	// Token authenticates with a token, and Username and Password with
+	// a user name and password.
+	Token    string
+	Username string
+	Password string
+}
+
+func (o NATSSinkOptions) natsOptions() []nats.Option {
+	options := []nats.Option{
+		nats.Name("skupper-vanflow-export"),
+		// once connected, keep reconnecting rather than giving up
+		nats.MaxReconnects(-1),
+	}
+	if o.TLS != nil {
+		options = append(options, nats.Secure(o.TLS))
+	}
+	if o.CredentialsFile != "" {
+		options = append(options, nats.UserCredentials(o.CredentialsFile))
+	}
+	if o.Token != "" {
+		options = append(options, nats.Token(o.Token))
+	}
+	if o.Username != "" {
+		options = append(options, nats.UserInfo(o.Username, o.Password))
+	}
+	return options
+}
+
+// NATSSink publishes events to NATS subjects of the form
+// <prefix>.<recordType>.<type>, for example vanflow.SiteRecord.add, so that
+// consumers can subscribe to the record types they need.
+type NATSSink struct {
+	dial   func() (NATSConn, error)
+	prefix string
+
+	mu     sync.Mutex
+	conn   NATSConn
+	closed bool
+}
+
+// NewNATSSink returns a NATSSink publishing to subjects under prefix on the
+// NATS server at url. The server is connected to when the first batch is
+// published; a batch that cannot be published because the connection
+// fails is retried by the Exporter, connecting again.
+func NewNATSSink(url string, prefix string, options NATSSinkOptions) (*NATSSink, error) {
+	if url == "" {
+		return nil, fmt.Errorf("nats url is required")
+	}
+	return newNATSSink(func() (NATSConn, error) {
+		return nats.Connect(url, options.natsOptions()...)
+	}, prefix), nil
+}
+
+// NewNATSSinkWithConn returns a NATSSink publishing through conn.
+func NewNATSSinkWithConn(conn NATSConn, prefix string) *NATSSink {
+	sink := newNATSSink(nil, prefix)
+	sink.conn = conn
+	return sink
+}
+
+func newNATSSink(dial func() (NATSConn, error), prefix string) *NATSSink {
+	if prefix == "" {
+		prefix = "vanflow"
+	}
+	return &NATSSink{
+		dial:   dial,
+		prefix: strings.TrimSuffix(prefix, "."),
+	}
+}
+
+func (s *NATSSink) subject(event Event) string {
+	return fmt.Sprintf("%s.%s.%s", s.prefix, event.RecordType, event.Type)
+}
+
+// connection returns the connection to the server, connecting if it has
+// not yet been established.
+func (s *NATSSink) connection() (NATSConn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, fmt.Errorf("nats sink is closed")
+	}
+	if s.conn == nil {
+		conn, err := s.dial()
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to nats: %w", err)
+		}
+		s.conn = conn
+	}
+	return s.conn, nil
+}
+
+func (s *NATSSink) Publish(ctx context.Context, events []Event) error {
+	conn, err := s.connection()
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		data, err := Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode event: %w", err)
+		}
+		msg := nats.NewMsg(s.subject(event))
+		msg.Header.Set("Schema-Version", event.SchemaVersion)
+		msg.Data = data
+		if err := conn.PublishMsg(msg); err != nil {
+			return fmt.Errorf("failed to publish to nats: %w", err)
+		}
+	}
+	// wait for the server to process the batch so that failures surface
+	// here and the batch can be retried
+	if err := conn.FlushWithContext(ctx); err != nil {
+		return fmt.Errorf("failed to publish to nats: %w", err)
+	}
+	return nil
+}
+
+func (s *NATSSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	return nil
+}
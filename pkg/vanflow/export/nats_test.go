@@ -0,0 +1,129 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+)
+
+// natsServer is an in-process stand-in for a NATS connection.
+type natsServer struct {
+	pending   []*nats.Msg
+	delivered []*nats.Msg
+	flushErr  error
+	closed    bool
+}
+
+func (s *natsServer) PublishMsg(msg *nats.Msg) error {
+	s.pending = append(s.pending, msg)
+	return nil
+}
+
+func (s *natsServer) FlushWithContext(ctx context.Context) error {
+	if s.flushErr != nil {
+		s.pending = nil
+		return s.flushErr
+	}
+	s.delivered = append(s.delivered, s.pending...)
+	s.pending = nil
+	return nil
+}
+
+func (s *natsServer) Close() {
+	s.closed = true
+}
+
+func TestNATSSink(t *testing.T) {
+	server := &natsServer{}
+	sink := NewNATSSinkWithConn(server, "skupper.vanflow.")
+	events := []Event{
+		{SchemaVersion: SchemaVersion, Type: EventAdd, RecordType: "SiteRecord", ID: "site-1"},
+		{SchemaVersion: SchemaVersion, Type: EventDelete, RecordType: "ProcessRecord", ID: "process-1"},
+	}
+	if err := sink.Publish(context.Background(), events); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(server.delivered) != 2 {
+		t.Fatalf("expected 2 messages but got %d", len(server.delivered))
+	}
+	for i, subject := range []string{"skupper.vanflow.SiteRecord.add", "skupper.vanflow.ProcessRecord.delete"} {
+		msg := server.delivered[i]
+		if msg.Subject != subject {
+			t.Errorf("expected subject %q but got %q", subject, msg.Subject)
+		}
+		if v := msg.Header.Get("Schema-Version"); v != SchemaVersion {
+			t.Errorf("expected schema version header but got %q", v)
+		}
+		if decoded, err := Unmarshal(msg.Data); err != nil || decoded.ID != events[i].ID {
+			t.Errorf("unexpected message data %q: %v", msg.Data, err)
+		}
+	}
+
+	server.flushErr = errors.New("nats: connection closed")
+	if err := sink.Publish(context.Background(), events); err == nil {
+		t.Error("expected publish error")
+	}
+	sink.Close()
+	if !server.closed {
+		t.Error("expected connection to be closed")
+	}
+}
+
+func TestNATSSinkConnectsLazily(t *testing.T) {
+	server := &natsServer{}
+	dials := 0
+	sink := newNATSSink(func() (NATSConn, error) {
+		dials++
+		if dials == 1 {
+			return nil, errors.New("nats: no servers available for connection")
+		}
+		return server, nil
+	}, "vanflow")
+	if dials != 0 {
+		t.Fatalf("expected no connection before publishing but got %d", dials)
+	}
+	events := []Event{{SchemaVersion: SchemaVersion, Type: EventAdd, RecordType: "SiteRecord", ID: "site-1"}}
+	if err := sink.Publish(context.Background(), events); err == nil {
+		t.Error("expected publish error while the server is unavailable")
+	}
+	for i := 0; i < 2; i++ {
+		if err := sink.Publish(context.Background(), events); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if dials != 2 {
+		t.Errorf("expected 2 connection attempts but got %d", dials)
+	}
+	if len(server.delivered) != 2 {
+		t.Errorf("expected 2 messages but got %d", len(server.delivered))
+	}
+	sink.Close()
+	if !server.closed {
+		t.Error("expected connection to be closed")
+	}
+	if err := sink.Publish(context.Background(), events); err == nil {
+		t.Error("expected publish error once closed")
+	}
+}
+
+func TestNewNATSSink(t *testing.T) {
+	if _, err := NewNATSSink("", "vanflow", NATSSinkOptions{}); err == nil {
+		t.Error("expected error without url")
+	}
+	// no server listens on this address; the sink must not connect
+	// until it publishes
+	sink, err := NewNATSSink("nats://127.0.0.1:1", "vanflow", NATSSinkOptions{Token: "secret"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	sink.Close()
+}
+
+func TestNATSSinkDefaultPrefix(t *testing.T) {
+	sink := NewNATSSinkWithConn(&natsServer{}, "")
+	if subject := sink.subject(Event{RecordType: "LinkRecord", Type: EventUpdate}); subject != "vanflow.LinkRecord.update" {
+		t.Errorf("unexpected subject %q", subject)
+	}
+}
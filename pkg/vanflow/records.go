@@ -23,6 +23,7 @@ func init() {
 	encoding.MustRegisterRecord(15, RouterAccessRecord{})
 	encoding.MustRegisterRecord(16, TransportBiflowRecord{})
 	encoding.MustRegisterRecord(17, AppBiflowRecord{})
+	encoding.MustRegisterRecord(18, SiteProbeRecord{}) //unspeced
 }
 
 type SiteRecord struct {
@@ -306,3 +307,24 @@ func (r AppBiflowRecord) GetTypeMeta() TypeMeta {
 		Type:       "AppBiflowRecord",
 	}
 }
+
+// SiteProbeRecord holds the latest results of one site actively probing
+// another over the router network.
+type SiteProbeRecord struct {
+	BaseRecord
+	Parent    *string `vflow:"2"`  // ID of the probing site
+	Peer      *string `vflow:"6"`  // ID of the probed site
+	Latency   *uint64 `vflow:"24"` // round trip time in microseconds
+	OctetRate *uint64 `vflow:"35"` // measured throughput in bytes per second
+	Result    *string `vflow:"28"`
+	Reason    *string `vflow:"29"`
+	DownCount *uint64 `vflow:"57"`
+	Round     *uint64 `vflow:"66"` // probing round the result was last updated in
+}
+
+func (r SiteProbeRecord) GetTypeMeta() TypeMeta {
+	return TypeMeta{
+		APIVersion: apiVersion,
+		Type:       "SiteProbeRecord",
+	}
+}
@@ -128,6 +128,17 @@ spec:
               value: ${SKUPPER_ROUTER_IMAGE}
             - name: SKUPPER_ROUTER_IMAGE_PULL_POLICY
               value: Always
+          ports:
+            - name: metrics
+              containerPort: 9191
+          livenessProbe:
+            httpGet:
+              path: /healthz
+              port: metrics
+          readinessProbe:
+            httpGet:
+              path: /readyz
+              port: metrics
           securityContext:
             capabilities:
               drop:
@@ -194,6 +205,17 @@ spec:
               value: ${SKUPPER_ROUTER_IMAGE}
             - name: SKUPPER_ROUTER_IMAGE_PULL_POLICY
               value: Always
+          ports:
+            - name: metrics
+              containerPort: 9191
+          livenessProbe:
+            httpGet:
+              path: /healthz
+              port: metrics
+          readinessProbe:
+            httpGet:
+              path: /readyz
+              port: metrics
           securityContext:
             capabilities:
               drop: